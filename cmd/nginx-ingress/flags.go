@@ -147,6 +147,18 @@ var (
 	nginxReloadTimeout = flag.Int("nginx-reload-timeout", 60000,
 		`The timeout in milliseconds which the Ingress Controller will wait for a successful NGINX reload after a change or at the initial start. (default 60000)`)
 
+	reloadDebounceWindow = flag.Int("reload-debounce-window", 0,
+		`The time window in milliseconds during which the NGINX reloads requested by configuration changes are coalesced into a single reload. The reloads are not coalesced if the window is 0. (default 0)`)
+
+	minReloadInterval = flag.Int("min-reload-interval", 0,
+		`The minimum interval in milliseconds between NGINX reloads. The reloads requested during the interval are coalesced into a single reload at the end of the interval. (default 0)`)
+
+	syncWorkers = flag.Int("sync-workers", 1,
+		`The number of workers that process the changes to the resources and generate the NGINX configuration of the resources. The changes are applied one at a time in order, while the configuration of several resources is generated concurrently. (default 1)`)
+
+	hostOwnershipConfigMap = flag.String("host-ownership-configmap", "",
+		`A ConfigMap resource that restricts the namespaces allowed to claim hosts. Every key of the ConfigMap is a namespace and its value is a comma-separated list of host patterns, like cafe.example.com or *.example.com, the namespace is allowed to claim. The hosts that don't match any pattern can be claimed from any namespace. Format: <namespace>/<name>`)
+
 	wildcardTLSSecret = flag.String("wildcard-tls-secret", "",
 		`A Secret with a TLS certificate and key for TLS termination of every Ingress/VirtualServer host for which TLS termination is enabled but the Secret is not specified.
 		Format: <namespace>/<name>. If the argument is not set, for such Ingress/VirtualServer hosts NGINX will break any attempt to establish a TLS connection.
@@ -173,6 +185,12 @@ var (
 	enableCustomResources = flag.Bool("enable-custom-resources", true,
 		"Enable custom resources")
 
+	enableGatewayAPI = flag.Bool("enable-gateway-api", false,
+		"Enable support for the Gateway API resources: GatewayClass, Gateway, HTTPRoute, TLSRoute and TCPRoute. Requires -enable-custom-resources")
+
+	gatewayControllerName = flag.String("gateway-controller-name", "nginx.org/ingress-controller",
+		"The controller name of the GatewayClasses handled by the Ingress Controller. Requires -enable-gateway-api")
+
 	enableOIDC = flag.Bool("enable-oidc", false,
 		"Enable OIDC Policies.")
 
@@ -225,6 +243,9 @@ var (
 
 	enableDynamicWeightChangesReload = flag.Bool(dynamicWeightChangesParam, false, "Enable changing weights of split clients without reloading NGINX. Requires -nginx-plus")
 
+	enableStreamHealthProbes = flag.Bool("enable-stream-health-probes", false,
+		"Enable the health checks of the TransportServer upstreams performed by the Ingress Controller. The failing upstream servers are marked down in the NGINX configuration. Requires -enable-custom-resources. Not supported with -nginx-plus")
+
 	enableDirectiveAutoadjust = flag.Bool("enable-directive-autoadjust", false, "Enable automatic adjustment of NGINX directives to avoid conflicting NGINX configuration. Results may vary and might not be ideal in all cases.")
 
 	startupCheckFn func() error
@@ -266,6 +287,11 @@ func initValidate(ctx context.Context) {
 		*enableDynamicWeightChangesReload = false
 	}
 
+	if *enableStreamHealthProbes && *nginxPlus {
+		nl.Warn(l, "enable-stream-health-probes flag is not supported with NGINX Plus, which performs the health checks itself")
+		*enableStreamHealthProbes = false
+	}
+
 	if *mgmtConfigMap != "" && !*nginxPlus {
 		nl.Warn(l, "mgmt-configmap flag requires -nginx-plus, mgmt configmap will not be used")
 		*mgmtConfigMap = ""
@@ -421,6 +447,26 @@ func mustValidateFlags(ctx context.Context) {
 		nl.Fatal(l, "enable-cert-manager flag requires -enable-custom-resources")
 	}
 
+	if *syncWorkers < 1 {
+		nl.Fatal(l, "sync-workers flag must be at least 1")
+	}
+
+	if *reloadDebounceWindow < 0 {
+		nl.Fatal(l, "reload-debounce-window flag must not be negative")
+	}
+
+	if *minReloadInterval < 0 {
+		nl.Fatal(l, "min-reload-interval flag must not be negative")
+	}
+
+	if *enableStreamHealthProbes && !*enableCustomResources {
+		nl.Fatal(l, "enable-stream-health-probes flag requires -enable-custom-resources")
+	}
+
+	if *enableGatewayAPI && !*enableCustomResources {
+		nl.Fatal(l, "enable-gateway-api flag requires -enable-custom-resources")
+	}
+
 	if *enableExternalDNS && !*enableCustomResources {
 		nl.Fatal(l, "enable-external-dns flag requires -enable-custom-resources")
 	}
@@ -48,6 +48,8 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	gateway_client "sigs.k8s.io/gateway-api/pkg/client/clientset/versioned"
 
 	nl "github.com/nginx/kubernetes-ingress/internal/logger"
 	nic_glog "github.com/nginx/kubernetes-ingress/internal/logger/glog"
@@ -122,10 +124,11 @@ func main() {
 	checkNamespaces(ctx, kubeClient)
 
 	dynClient, confClient := createCustomClients(ctx, config)
+	gatewayClient := createGatewayClient(ctx, config)
 
 	constLabels := map[string]string{"class": *ingressClass}
 
-	managerCollector, controllerCollector, registry := createManagerAndControllerCollectors(ctx, constLabels)
+	managerCollector, controllerCollector, workQueueMetricsProvider, registry := createManagerAndControllerCollectors(ctx, constLabels)
 
 	var licenseReporter *license_reporting.LicenseReporter
 
@@ -259,6 +262,15 @@ func main() {
 		licenseReporter.Config.PlusClient = plusClient
 	}
 
+	var reloadCoalescer *nginx.ReloadCoalescer
+	if *reloadDebounceWindow > 0 || *minReloadInterval > 0 {
+		reloadCoalescer = nginx.NewReloadCoalescer(
+			time.Duration(*reloadDebounceWindow)*time.Millisecond,
+			time.Duration(*minReloadInterval)*time.Millisecond,
+			managerCollector,
+		)
+	}
+
 	plusCollector, syslogListener, latencyCollector := createPlusAndLatencyCollectors(ctx, registry, constLabels, kubeClient, plusClient, staticCfgParams.NginxServiceMesh)
 	cnf := configs.NewConfigurator(configs.ConfiguratorParams{
 		NginxManager:                        nginxManager,
@@ -276,6 +288,8 @@ func main() {
 		IsDynamicSSLReloadEnabled:           *enableDynamicSSLReload,
 		IsDynamicWeightChangesReloadEnabled: *enableDynamicWeightChangesReload,
 		NginxVersion:                        nginxVersion,
+		ReloadCoalescer:                     reloadCoalescer,
+		ConfigWorkers:                       *syncWorkers,
 	})
 
 	transportServerValidator := cr_validation.NewTransportServerValidator(*enableTLSPassthrough, *enableSnippets, *nginxPlus)
@@ -295,6 +309,7 @@ func main() {
 		KubeClient:                   kubeClient,
 		ConfClient:                   confClient,
 		DynClient:                    dynClient,
+		GatewayClient:                gatewayClient,
 		RestConfig:                   config,
 		Recorder:                     eventRecorder,
 		ResyncPeriod:                 30 * time.Second,
@@ -318,8 +333,11 @@ func main() {
 		WildcardTLSSecret:            *wildcardTLSSecret,
 		ConfigMaps:                   *nginxConfigMaps,
 		MGMTConfigMap:                *mgmtConfigMap,
+		HostOwnershipConfigMap:       *hostOwnershipConfigMap,
 		GlobalConfiguration:          *globalConfiguration,
 		AreCustomResourcesEnabled:    *enableCustomResources,
+		EnableGatewayAPI:             *enableGatewayAPI,
+		GatewayControllerName:        *gatewayControllerName,
 		EnableOIDC:                   *enableOIDC,
 		MetricsCollector:             controllerCollector,
 		GlobalConfigurationValidator: globalConfigurationValidator,
@@ -342,6 +360,10 @@ func main() {
 		BuildOS:                      buildOS,
 		NICVersion:                   version,
 		DynamicWeightChangesReload:   *enableDynamicWeightChangesReload,
+		StreamHealthProbesEnabled:    *enableStreamHealthProbes,
+		ReloadCoalescingEnabled:      reloadCoalescer != nil,
+		SyncWorkers:                  *syncWorkers,
+		WorkQueueMetricsProvider:     workQueueMetricsProvider,
 		InstallationFlags:            parsedFlags,
 		ShuttingDown:                 false,
 	}
@@ -527,6 +549,19 @@ func createCustomClients(ctx context.Context, config *rest.Config) (dynamic.Inte
 	return dynClient, confClient
 }
 
+func createGatewayClient(ctx context.Context, config *rest.Config) gateway_client.Interface {
+	if !*enableGatewayAPI {
+		return nil
+	}
+
+	gatewayClient, err := gateway_client.NewForConfig(config)
+	if err != nil {
+		nl.Fatalf(nl.LoggerFromContext(ctx), "Failed to create a Gateway API client: %v", err)
+	}
+
+	return gatewayClient
+}
+
 func createPlusClient(ctx context.Context, nginxPlus bool, useFakeNginxManager bool, nginxManager nginx.Manager) *client.NginxClient {
 	l := nl.LoggerFromContext(ctx)
 	var plusClient *client.NginxClient
@@ -871,13 +906,14 @@ func ready(lbc *k8s.LoadBalancerController) http.HandlerFunc {
 	}
 }
 
-func createManagerAndControllerCollectors(ctx context.Context, constLabels map[string]string) (collectors.ManagerCollector, collectors.ControllerCollector, *prometheus.Registry) {
+func createManagerAndControllerCollectors(ctx context.Context, constLabels map[string]string) (collectors.ManagerCollector, collectors.ControllerCollector, workqueue.MetricsProvider, *prometheus.Registry) {
 	l := nl.LoggerFromContext(ctx)
 	var err error
 
 	var registry *prometheus.Registry
 	var mc collectors.ManagerCollector
 	var cc collectors.ControllerCollector
+	var wqp workqueue.MetricsProvider
 	mc = collectors.NewManagerFakeCollector()
 	cc = collectors.NewControllerFakeCollector()
 
@@ -907,8 +943,9 @@ func createManagerAndControllerCollectors(ctx context.Context, constLabels map[s
 		if err != nil {
 			nl.Errorf(l, "Error registering WorkQueue Prometheus metrics: %v", err)
 		}
+		wqp = workQueueCollector
 	}
-	return mc, cc, registry
+	return mc, cc, wqp, registry
 }
 
 func createPlusAndLatencyCollectors(
@@ -237,12 +237,22 @@ func (g *CRDDocGenerator) getDescription(kind string) string {
 
 // extractSpecSchema extracts the spec schema from a CRD
 func (g *CRDDocGenerator) extractSpecSchema(crd *apiextensionsv1.CustomResourceDefinition) *apiextensionsv1.JSONSchemaProps {
+	return g.extractSchema(crd, "spec")
+}
+
+// extractStatusSchema extracts the status schema from a CRD
+func (g *CRDDocGenerator) extractStatusSchema(crd *apiextensionsv1.CustomResourceDefinition) *apiextensionsv1.JSONSchemaProps {
+	return g.extractSchema(crd, "status")
+}
+
+// extractSchema extracts the schema of a top-level property from a CRD
+func (g *CRDDocGenerator) extractSchema(crd *apiextensionsv1.CustomResourceDefinition, property string) *apiextensionsv1.JSONSchemaProps {
 	for _, v := range crd.Spec.Versions {
 		if v.Schema != nil && v.Schema.OpenAPIV3Schema != nil {
 			schema := v.Schema.OpenAPIV3Schema
 			if schema.Properties != nil {
-				if spec, exists := schema.Properties["spec"]; exists {
-					return &spec
+				if prop, exists := schema.Properties[property]; exists {
+					return &prop
 				}
 			}
 		}
@@ -256,6 +266,11 @@ func (g *CRDDocGenerator) formatMarkdownHeader(kind, group, version, scope, desc
 		kind, group, version, kind, scope, description)
 }
 
+// formatStatusHeader formats the header of the status section of the markdown documentation
+func (g *CRDDocGenerator) formatStatusHeader() string {
+	return "\n## Status Fields\n\nThe `.status` object supports the following fields:\n\n| Field | Type | Description |\n|---|---|---|\n"
+}
+
 // formatMarkdownTable formats the table rows for field information
 func (g *CRDDocGenerator) formatMarkdownTable(fields []FieldInfo) string {
 	var tableRows strings.Builder
@@ -278,7 +293,14 @@ func (g *CRDDocGenerator) generateMarkdown(crd *apiextensionsv1.CustomResourceDe
 	header := g.formatMarkdownHeader(kind, group, version, scope, description)
 	table := g.formatMarkdownTable(fields)
 
-	return header + table
+	statusSchema := g.extractStatusSchema(crd)
+	if statusSchema == nil || statusSchema.Properties == nil {
+		return header + table
+	}
+
+	statusFields := g.processProperties(statusSchema.Properties, "")
+
+	return header + table + g.formatStatusHeader() + g.formatMarkdownTable(statusFields)
 }
 
 // processCRDFile processes a single CRD YAML file and generates its documentation
@@ -68,6 +68,7 @@ type CmController struct {
 	cmClient      *cm_clientset.Clientset
 	kubeClient    kubernetes.Interface
 	vsClient      k8s_nginx.Interface
+	serverAliases ServerAliasesFn
 }
 
 // CmOpts is the options required for building the CmController
@@ -79,6 +80,7 @@ type CmOpts struct {
 	eventRecorder record.EventRecorder
 	vsClient      k8s_nginx.Interface
 	isDynamicNs   bool
+	serverAliases ServerAliasesFn
 }
 
 type namespacedInformer struct {
@@ -93,12 +95,12 @@ type namespacedInformer struct {
 }
 
 func (c *CmController) register() workqueue.TypedRateLimitingInterface[types.NamespacedName] {
-	c.sync = SyncFnFor(c.recorder, c.cmClient, c.informerGroup)
+	c.sync = SyncFnFor(c.recorder, c.cmClient, c.informerGroup, c.serverAliases)
 	return c.queue
 }
 
 // BuildOpts builds a CmOpts from the given parameters
-func BuildOpts(ctx context.Context, kc *rest.Config, cl kubernetes.Interface, ns []string, er record.EventRecorder, vsc k8s_nginx.Interface, idn bool) *CmOpts {
+func BuildOpts(ctx context.Context, kc *rest.Config, cl kubernetes.Interface, ns []string, er record.EventRecorder, vsc k8s_nginx.Interface, idn bool, sa ServerAliasesFn) *CmOpts {
 	return &CmOpts{
 		context:       ctx,
 		kubeClient:    cl,
@@ -107,9 +109,15 @@ func BuildOpts(ctx context.Context, kc *rest.Config, cl kubernetes.Interface, ns
 		eventRecorder: er,
 		vsClient:      vsc,
 		isDynamicNs:   idn,
+		serverAliases: sa,
 	}
 }
 
+// EnqueueVirtualServer adds the VirtualServer to the queue, so that its Certificates are synced again.
+func (c *CmController) EnqueueVirtualServer(namespace string, name string) {
+	c.queue.Add(types.NamespacedName{Namespace: namespace, Name: name})
+}
+
 func (c *CmController) newNamespacedInformer(ns string) *namespacedInformer {
 	nsi := &namespacedInformer{}
 	nsi.stopCh = make(chan struct{})
@@ -218,6 +226,7 @@ func NewCmController(opts *CmOpts) *CmController {
 		cmClient:      intcl,
 		kubeClient:    opts.kubeClient,
 		vsClient:      opts.vsClient,
+		serverAliases: opts.serverAliases,
 	}
 
 	for _, ns := range opts.namespace {
@@ -45,6 +45,9 @@ var vsGVK = vsapi.SchemeGroupVersion.WithKind("VirtualServer")
 // SyncFn is the reconciliation function passed to cert manager VS controller.
 type SyncFn func(context.Context, *vsapi.VirtualServer) error
 
+// ServerAliasesFn returns the server aliases of the VirtualServer that are not taken by other resources.
+type ServerAliasesFn func(*vsapi.VirtualServer) []string
+
 // SyncFnFor contains logic to reconcile VirtualServer objects.
 //
 // Reconciling a VirtualServer object with respect to Certificates means looking at its annotations
@@ -54,6 +57,7 @@ func SyncFnFor(
 	rec record.EventRecorder,
 	cmClient clientset.Interface,
 	ig map[string]*namespacedInformer,
+	serverAliases ServerAliasesFn,
 ) SyncFn {
 	return func(ctx context.Context, vs *vsapi.VirtualServer) error {
 		var err error
@@ -71,7 +75,12 @@ func SyncFnFor(
 
 		nsi := getNamespacedInformer(vs.GetNamespace(), ig)
 
-		newCrts, updateCrts, err := buildCertificates(ctx, nsi.cmLister, vs, issuerName, issuerKind, issuerGroup)
+		var aliases []string
+		if serverAliases != nil {
+			aliases = serverAliases(vs)
+		}
+
+		newCrts, updateCrts, err := buildCertificates(ctx, nsi.cmLister, vs, aliases, issuerName, issuerKind, issuerGroup)
 		if err != nil {
 			nl.Errorf(l, "Incorrect cert-manager configuration for VirtualServer resource: %v", err)
 			rec.Eventf(vs, corev1.EventTypeWarning, nl.EventReasonBadConfig, "Incorrect cert-manager configuration for VirtualServer resource: %s",
@@ -125,6 +134,7 @@ func buildCertificates(
 	ctx context.Context,
 	cmLister cmlisters.CertificateLister,
 	vs *vsapi.VirtualServer,
+	aliases []string,
 	issuerName, issuerKind, issuerGroup string,
 ) (newCert, update []*cmapi.Certificate, _ error) {
 	var newCrts []*cmapi.Certificate
@@ -139,7 +149,7 @@ func buildCertificates(
 	}
 
 	var controllerGVK schema.GroupVersionKind = vsGVK
-	hosts := []string{vs.Spec.Host}
+	hosts := append([]string{vs.Spec.Host}, aliases...)
 
 	crt := &cmapi.Certificate{
 		ObjectMeta: metav1.ObjectMeta{
@@ -502,7 +502,7 @@ func TestSync(t *testing.T) {
 
 			ig[""] = nsi
 
-			sync := SyncFnFor(b.Recorder, b.CMClient, ig)
+			sync := SyncFnFor(b.Recorder, b.CMClient, ig, nil)
 			b.Start()
 
 			err := sync(context.Background(), &test.VirtualServer)
@@ -31,6 +31,9 @@ const BasicAuthSecretAnnotation = "nginx.org/basic-auth-secret" // #nosec G101
 // PathRegexAnnotation is the annotation where the regex location (path) modifier is specified.
 const PathRegexAnnotation = "nginx.org/path-regex"
 
+// PathRegexMapAnnotation is the annotation where the regex location (path) modifiers of individual paths are specified.
+const PathRegexMapAnnotation = "nginx.org/path-regex-map"
+
 // RewriteTargetAnnotation is the annotation where the regex-based rewrite target is specified.
 const RewriteTargetAnnotation = "nginx.org/rewrite-target"
 
@@ -82,6 +85,21 @@ const StickyCookieServicesAnnotation = "nginx.org/sticky-cookie-services"
 // StickyCookieServicesAnnotationPlus is the annotation where the sticky cookie configuration is specified for NGINX Plus.
 const StickyCookieServicesAnnotationPlus = "nginx.com/sticky-cookie-services"
 
+// CanaryAnnotation is the annotation which marks an Ingress as a canary of the primary Ingress with the same host and path.
+const CanaryAnnotation = "nginx.org/canary"
+
+// CanaryWeightAnnotation is the annotation where the percentage of requests sent to the canary is specified.
+const CanaryWeightAnnotation = "nginx.org/canary-weight"
+
+// CanaryByHeaderAnnotation is the annotation where the request header that routes requests to the canary is specified.
+const CanaryByHeaderAnnotation = "nginx.org/canary-by-header"
+
+// CanaryByHeaderValueAnnotation is the annotation where the value of the canary request header is specified.
+const CanaryByHeaderValueAnnotation = "nginx.org/canary-by-header-value"
+
+// CanaryByCookieAnnotation is the annotation where the cookie that routes requests to the canary is specified.
+const CanaryByCookieAnnotation = "nginx.org/canary-by-cookie"
+
 var masterDenylist = map[string]bool{
 	"nginx.org/rewrites":                      true,
 	"nginx.org/ssl-services":                  true,
@@ -93,6 +111,7 @@ var masterDenylist = map[string]bool{
 	"nginx.com/health-checks-mandatory":       true,
 	"nginx.com/health-checks-mandatory-queue": true,
 	UseClusterIPAnnotation:                    true,
+	PathRegexMapAnnotation:                    true,
 }
 
 var minionDenylist = map[string]bool{
@@ -700,6 +719,47 @@ func getRewriteTarget(ctx context.Context, ingEx *IngressEx) (string, Warnings)
 	return "", warnings
 }
 
+// canaryConfig holds the canary annotations of a canary Ingress.
+type canaryConfig struct {
+	Weight      int
+	Header      string
+	HeaderValue string
+	Cookie      string
+}
+
+func getCanaryConfig(ctx context.Context, ingEx *IngressEx) canaryConfig {
+	l := nl.LoggerFromContext(ctx)
+	annotations := ingEx.Ingress.Annotations
+
+	cfg := canaryConfig{
+		Header:      annotations[CanaryByHeaderAnnotation],
+		HeaderValue: annotations[CanaryByHeaderValueAnnotation],
+		Cookie:      annotations[CanaryByCookieAnnotation],
+	}
+
+	if weight, exists, err := GetMapKeyAsInt(annotations, CanaryWeightAnnotation, ingEx.Ingress); exists {
+		if err != nil {
+			nl.Error(l, err)
+		} else {
+			cfg.Weight = weight
+		}
+	}
+
+	return cfg
+}
+
+func getPathRegexMap(ctx context.Context, ingEx *IngressEx) map[string]string {
+	l := nl.LoggerFromContext(ctx)
+	if value, exists := ingEx.Ingress.Annotations[PathRegexMapAnnotation]; exists {
+		pathRegexes, err := ParsePathRegexMap(value)
+		if err != nil {
+			nl.Errorf(l, "Ingress %s/%s: Invalid value %s: %v", ingEx.Ingress.GetNamespace(), ingEx.Ingress.GetName(), PathRegexMapAnnotation, err)
+		}
+		return pathRegexes
+	}
+	return nil
+}
+
 func getSSLServices(ingEx *IngressEx) map[string]bool {
 	if value, exists := ingEx.Ingress.Annotations["nginx.org/ssl-services"]; exists {
 		return ParseServiceList(value)
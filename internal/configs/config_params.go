@@ -104,6 +104,7 @@ type ConfigParams struct {
 	VariablesHashBucketSize                uint64
 	VariablesHashMaxSize                   uint64
 	ZoneSync                               ZoneSync
+	RequestID                              RequestID
 
 	RealIPHeader    string
 	RealIPRecursive bool
@@ -143,6 +144,13 @@ type ConfigParams struct {
 	LimitReqLogLevel   string
 	LimitReqRejectCode int
 	LimitReqScale      bool
+
+	DefaultBackendService      string
+	DefaultBackendServicePort  int
+	DefaultBackendAddress      string
+	DefaultErrorPagesConfigMap string
+	DefaultErrorPages          map[int]string
+	DefaultErrorPageFiles      map[int]string
 }
 
 // StaticConfigParams holds immutable NGINX configuration parameters that affect the main NGINX config.
@@ -201,6 +209,14 @@ type ZoneSync struct {
 	ResolverIPV6      *bool
 }
 
+// RequestID holds request ID propagation parameters.
+type RequestID struct {
+	Enable bool
+	Header string
+	Mode   string
+	Echo   bool
+}
+
 // OIDC holds OIDC configuration parameters.
 type OIDC struct {
 	PKCETimeout  string
@@ -286,6 +302,10 @@ func NewDefaultConfigParams(ctx context.Context, isPlus bool) *ConfigParams {
 		LimitReqZoneSize:              "10m",
 		LimitReqLogLevel:              "error",
 		LimitReqRejectCode:            429,
+		RequestID: RequestID{
+			Header: "X-Request-ID",
+			Mode:   "trust",
+		},
 		OIDC: OIDC{
 			PKCETimeout:     "90s",
 			PKCEZoneSize:    "128K",
@@ -18,6 +18,7 @@ import (
 	"k8s.io/client-go/tools/record"
 
 	"github.com/nginx/kubernetes-ingress/internal/configs/version1"
+	"github.com/nginx/kubernetes-ingress/internal/configs/version2"
 	nl "github.com/nginx/kubernetes-ingress/internal/logger"
 	k8s_validation "k8s.io/apimachinery/pkg/util/validation"
 )
@@ -612,6 +613,14 @@ func ParseConfigMap(ctx context.Context, cfgm *v1.ConfigMap, nginxPlus bool, has
 		configOk = false
 	}
 
+	if requestIDErr := parseConfigMapRequestID(l, cfgm, cfgParams, eventLog); requestIDErr != nil {
+		configOk = false
+	}
+
+	if defaultBackendErr := parseConfigMapDefaultBackend(l, cfgm, cfgParams, eventLog); defaultBackendErr != nil {
+		configOk = false
+	}
+
 	if hasAppProtect {
 		if appProtectFailureModeAction, exists := cfgm.Data["app-protect-failure-mode-action"]; exists {
 			if appProtectFailureModeAction == "pass" || appProtectFailureModeAction == "drop" {
@@ -994,6 +1003,138 @@ func parseConfigMapOpenTelemetry(l *slog.Logger, cfgm *v1.ConfigMap, cfgParams *
 	return cfgParams, nil
 }
 
+func parseConfigMapRequestID(l *slog.Logger, cfgm *v1.ConfigMap, cfgParams *ConfigParams, eventLog record.EventRecorder) error {
+	requestIDValid := true
+
+	if requestID, exists, err := GetMapKeyAsBool(cfgm.Data, "request-id", cfgm); exists {
+		if err != nil {
+			nl.Error(l, err)
+			eventLog.Event(cfgm, v1.EventTypeWarning, nl.EventReasonInvalidValue, err.Error())
+			requestIDValid = false
+		} else {
+			cfgParams.RequestID.Enable = requestID
+		}
+	}
+
+	if requestIDHeader, exists := cfgm.Data["request-id-header"]; exists {
+		requestIDHeader = strings.TrimSpace(requestIDHeader)
+		errorMessages := k8s_validation.IsHTTPHeaderName(requestIDHeader)
+		if len(errorMessages) > 0 {
+			errorText := fmt.Sprintf("ConfigMap %s/%s: invalid value for 'request-id-header': %q, %v, ignoring", cfgm.GetNamespace(), cfgm.GetName(), requestIDHeader, errorMessages)
+			nl.Error(l, errorText)
+			eventLog.Event(cfgm, v1.EventTypeWarning, nl.EventReasonInvalidValue, errorText)
+			requestIDValid = false
+		} else {
+			cfgParams.RequestID.Header = requestIDHeader
+		}
+	}
+
+	if requestIDMode, exists := cfgm.Data["request-id-mode"]; exists {
+		if requestIDMode == "trust" || requestIDMode == "generate" {
+			cfgParams.RequestID.Mode = requestIDMode
+		} else {
+			errorText := fmt.Sprintf("ConfigMap %s/%s: invalid value for 'request-id-mode': %q, must be 'trust' or 'generate', ignoring", cfgm.GetNamespace(), cfgm.GetName(), requestIDMode)
+			nl.Error(l, errorText)
+			eventLog.Event(cfgm, v1.EventTypeWarning, nl.EventReasonInvalidValue, errorText)
+			requestIDValid = false
+		}
+	}
+
+	if requestIDEcho, exists, err := GetMapKeyAsBool(cfgm.Data, "request-id-echo", cfgm); exists {
+		if err != nil {
+			nl.Error(l, err)
+			eventLog.Event(cfgm, v1.EventTypeWarning, nl.EventReasonInvalidValue, err.Error())
+			requestIDValid = false
+		} else {
+			cfgParams.RequestID.Echo = requestIDEcho
+		}
+	}
+
+	if !requestIDValid {
+		return errors.New("invalid request ID configuration")
+	}
+
+	return nil
+}
+
+func parseConfigMapDefaultBackend(l *slog.Logger, cfgm *v1.ConfigMap, cfgParams *ConfigParams, eventLog record.EventRecorder) error {
+	defaultBackendValid := true
+
+	if defaultBackendService, exists := cfgm.Data["default-backend-service"]; exists {
+		service, port, err := parseDefaultBackendService(strings.TrimSpace(defaultBackendService))
+		if err != nil {
+			errorText := fmt.Sprintf("ConfigMap %s/%s: invalid value for 'default-backend-service': %q, %v, ignoring", cfgm.GetNamespace(), cfgm.GetName(), defaultBackendService, err)
+			nl.Error(l, errorText)
+			eventLog.Event(cfgm, v1.EventTypeWarning, nl.EventReasonInvalidValue, errorText)
+			defaultBackendValid = false
+		} else {
+			cfgParams.DefaultBackendService = service
+			cfgParams.DefaultBackendServicePort = port
+		}
+	}
+
+	if defaultErrorPagesConfigMap, exists := cfgm.Data["default-error-pages-configmap"]; exists {
+		defaultErrorPagesConfigMap = strings.TrimSpace(defaultErrorPagesConfigMap)
+		if err := validateNamespacedName(defaultErrorPagesConfigMap); err != nil {
+			errorText := fmt.Sprintf("ConfigMap %s/%s: invalid value for 'default-error-pages-configmap': %q, %v, ignoring", cfgm.GetNamespace(), cfgm.GetName(), defaultErrorPagesConfigMap, err)
+			nl.Error(l, errorText)
+			eventLog.Event(cfgm, v1.EventTypeWarning, nl.EventReasonInvalidValue, errorText)
+			defaultBackendValid = false
+		} else {
+			cfgParams.DefaultErrorPagesConfigMap = defaultErrorPagesConfigMap
+		}
+	}
+
+	if !defaultBackendValid {
+		return errors.New("invalid default backend configuration")
+	}
+
+	return nil
+}
+
+// parseDefaultBackendService parses a default backend service in the format <namespace>/<name>:<port>.
+func parseDefaultBackendService(value string) (string, int, error) {
+	service, portValue, found := strings.Cut(value, ":")
+	if !found {
+		return "", 0, errors.New("must be in the format <namespace>/<name>:<port>")
+	}
+
+	if err := validateNamespacedName(service); err != nil {
+		return "", 0, err
+	}
+
+	port, err := strconv.Atoi(portValue)
+	if err != nil || port < 1 || port > 65535 {
+		return "", 0, fmt.Errorf("port %q must be a number between 1 and 65535", portValue)
+	}
+
+	return service, port, nil
+}
+
+// validateNamespacedName validates a reference to a resource in the format <namespace>/<name>.
+func validateNamespacedName(value string) error {
+	namespace, name, found := strings.Cut(value, "/")
+	if !found {
+		return errors.New("must be in the format <namespace>/<name>")
+	}
+
+	for _, part := range []string{namespace, name} {
+		if msgs := k8s_validation.IsDNS1123Label(part); len(msgs) > 0 {
+			return fmt.Errorf("%q is not a valid name: %v", part, strings.Join(msgs, ", "))
+		}
+	}
+
+	return nil
+}
+
+// DefaultErrorPageCodes are the status codes of the cluster-wide error pages.
+var DefaultErrorPageCodes = []int{404, 502, 503, 504}
+
+// GetDefaultErrorPageKey returns the key of the page of a status code in the default error pages ConfigMap.
+func GetDefaultErrorPageKey(code int) string {
+	return fmt.Sprintf("%d.html", code)
+}
+
 // ParseHTTPRedirectCode parses and validates an HTTP redirect code.
 func ParseHTTPRedirectCode(code string) (int, error) {
 	redirectCode, err := strconv.Atoi(code)
@@ -1183,6 +1324,8 @@ func GenerateNginxMainConfig(staticCfgParams *StaticConfigParams, config *Config
 		AccessLog:                          config.MainAccessLog,
 		DefaultServerAccessLogOff:          config.DefaultServerAccessLogOff,
 		DefaultServerReturn:                config.DefaultServerReturn,
+		DefaultBackendAddress:              config.DefaultBackendAddress,
+		DefaultErrorPages:                  generateDefaultErrorPages(config.DefaultErrorPageFiles),
 		DisableIPV6:                        staticCfgParams.DisableIPV6,
 		DefaultHTTPListenerPort:            staticCfgParams.DefaultHTTPListenerPort,
 		DefaultHTTPSListenerPort:           staticCfgParams.DefaultHTTPSListenerPort,
@@ -1195,6 +1338,7 @@ func GenerateNginxMainConfig(staticCfgParams *StaticConfigParams, config *Config
 		KeepaliveTimeout:                   config.MainKeepaliveTimeout,
 		LogFormat:                          config.MainLogFormat,
 		LogFormatEscaping:                  config.MainLogFormatEscaping,
+		LogFormatRequestID:                 config.RequestID.Enable && !logFormatHasVariable(config.MainLogFormat, requestIDVariable),
 		MainSnippets:                       config.MainMainSnippets,
 		MGMTConfig:                         mgmtConfig,
 		NginxStatus:                        staticCfgParams.NginxStatus,
@@ -1254,6 +1398,7 @@ func GenerateNginxMainConfig(staticCfgParams *StaticConfigParams, config *Config
 		InternalRouteServer:                staticCfgParams.EnableInternalRoutes,
 		InternalRouteServerName:            staticCfgParams.InternalRouteServerName,
 		LatencyMetrics:                     staticCfgParams.EnableLatencyMetrics,
+		RequestID:                          generateRequestID(config.RequestID, requestIDIncomingVariable),
 		OIDC: version1.OIDCConfig{
 			Enable:          staticCfgParams.EnableOIDC,
 			PKCETimeout:     config.OIDC.PKCETimeout,
@@ -1275,6 +1420,60 @@ func GenerateNginxMainConfig(staticCfgParams *StaticConfigParams, config *Config
 	return nginxCfg
 }
 
+// generateDefaultErrorPages returns the cluster-wide error pages sorted by status code.
+func generateDefaultErrorPages(files map[int]string) []version2.DefaultErrorPage {
+	var pages []version2.DefaultErrorPage
+	for _, code := range DefaultErrorPageCodes {
+		if file, exists := files[code]; exists {
+			pages = append(pages, version2.DefaultErrorPage{
+				Code:     code,
+				Location: fmt.Sprintf("/_default_error_page_%d", code),
+				File:     file,
+			})
+		}
+	}
+	return pages
+}
+
+// requestIDVariable is the variable that holds the ID of the request. It is appended to a custom log format
+// unless the format already references it, for example in a JSON log format.
+const requestIDVariable = "$ingress_request_id"
+
+// logFormatHasVariable returns true if any line of the log format references the variable.
+func logFormatHasVariable(logFormat []string, variable string) bool {
+	for _, line := range logFormat {
+		if strings.Contains(line, variable) {
+			return true
+		}
+	}
+	return false
+}
+
+// requestIDIncomingVariable is the variable that holds the ID of the request when the incoming ID is trusted.
+// It is defined in the http context of the main NGINX config.
+const requestIDIncomingVariable = "$ingress_request_id_incoming"
+
+// generateRequestID returns the request ID configuration or nil if the propagation is disabled.
+// When the incoming ID is trusted, incomingVariable is used to hold the ID of the request.
+func generateRequestID(requestID RequestID, incomingVariable string) *version2.RequestID {
+	if !requestID.Enable {
+		return nil
+	}
+
+	cfg := &version2.RequestID{
+		Header:   requestID.Header,
+		Variable: "$request_id",
+		Echo:     requestID.Echo,
+	}
+
+	if requestID.Mode != "generate" {
+		cfg.IncomingVariable = "$http_" + strings.ReplaceAll(strings.ToLower(requestID.Header), "-", "_")
+		cfg.Variable = incomingVariable
+	}
+
+	return cfg
+}
+
 // parseStringField is a helper function to parse, validate, and optionally
 // report errors about fields in cfgm.Data that are going to be used as strings.
 // This is used to parse and validate the OIDC configmap fields for now.
@@ -7,7 +7,9 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/nginx/kubernetes-ingress/internal/configs/commonhelpers"
+	"github.com/nginx/kubernetes-ingress/internal/configs/version2"
 	"github.com/stretchr/testify/assert"
 
 	v1 "k8s.io/api/core/v1"
@@ -2294,6 +2296,323 @@ func TestOpenTelemetryConfigurationInvalid(t *testing.T) {
 	}
 }
 
+func TestParseConfigMapRequestID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		configMap *v1.ConfigMap
+		expected  RequestID
+		msg       string
+	}{
+		{
+			configMap: &v1.ConfigMap{
+				Data: map[string]string{},
+			},
+			expected: RequestID{
+				Header: "X-Request-ID",
+				Mode:   "trust",
+			},
+			msg: "no config",
+		},
+		{
+			configMap: &v1.ConfigMap{
+				Data: map[string]string{
+					"request-id": "true",
+				},
+			},
+			expected: RequestID{
+				Enable: true,
+				Header: "X-Request-ID",
+				Mode:   "trust",
+			},
+			msg: "enabled with defaults",
+		},
+		{
+			configMap: &v1.ConfigMap{
+				Data: map[string]string{
+					"request-id":        "true",
+					"request-id-header": "X-Correlation-ID",
+					"request-id-mode":   "generate",
+					"request-id-echo":   "true",
+				},
+			},
+			expected: RequestID{
+				Enable: true,
+				Header: "X-Correlation-ID",
+				Mode:   "generate",
+				Echo:   true,
+			},
+			msg: "full config",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			result, configOk := ParseConfigMap(context.Background(), test.configMap, false, false, false, false, false, makeEventLogger())
+			if !configOk {
+				t.Errorf("configOk: want true, got false")
+			}
+			if !cmp.Equal(test.expected, result.RequestID) {
+				t.Error(cmp.Diff(test.expected, result.RequestID))
+			}
+		})
+	}
+}
+
+func TestParseConfigMapRequestIDInvalid(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		configMap *v1.ConfigMap
+		msg       string
+	}{
+		{
+			configMap: &v1.ConfigMap{
+				Data: map[string]string{
+					"request-id": "maybe",
+				},
+			},
+			msg: "invalid enable",
+		},
+		{
+			configMap: &v1.ConfigMap{
+				Data: map[string]string{
+					"request-id":        "true",
+					"request-id-header": "X Request ID",
+				},
+			},
+			msg: "invalid header",
+		},
+		{
+			configMap: &v1.ConfigMap{
+				Data: map[string]string{
+					"request-id":      "true",
+					"request-id-mode": "random",
+				},
+			},
+			msg: "invalid mode",
+		},
+		{
+			configMap: &v1.ConfigMap{
+				Data: map[string]string{
+					"request-id":      "true",
+					"request-id-echo": "sometimes",
+				},
+			},
+			msg: "invalid echo",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			result, configOk := ParseConfigMap(context.Background(), test.configMap, false, false, false, false, false, makeEventLogger())
+			if configOk {
+				t.Errorf("configOk: want false, got true")
+			}
+			if result.RequestID.Header != "X-Request-ID" || result.RequestID.Mode != "trust" || result.RequestID.Echo {
+				t.Errorf("invalid values must be ignored, got %+v", result.RequestID)
+			}
+		})
+	}
+}
+
+func TestParseConfigMapDefaultBackend(t *testing.T) {
+	t.Parallel()
+	configMap := &v1.ConfigMap{
+		Data: map[string]string{
+			"default-backend-service":       "default/default-backend:8080",
+			"default-error-pages-configmap": "nginx-ingress/error-pages",
+		},
+	}
+
+	result, configOk := ParseConfigMap(context.Background(), configMap, false, false, false, false, false, makeEventLogger())
+	if !configOk {
+		t.Errorf("configOk: want true, got false")
+	}
+	if result.DefaultBackendService != "default/default-backend" {
+		t.Errorf("DefaultBackendService: want %q, got %q", "default/default-backend", result.DefaultBackendService)
+	}
+	if result.DefaultBackendServicePort != 8080 {
+		t.Errorf("DefaultBackendServicePort: want 8080, got %d", result.DefaultBackendServicePort)
+	}
+	if result.DefaultErrorPagesConfigMap != "nginx-ingress/error-pages" {
+		t.Errorf("DefaultErrorPagesConfigMap: want %q, got %q", "nginx-ingress/error-pages", result.DefaultErrorPagesConfigMap)
+	}
+}
+
+func TestParseConfigMapDefaultBackendInvalid(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		configMap *v1.ConfigMap
+		msg       string
+	}{
+		{
+			configMap: &v1.ConfigMap{
+				Data: map[string]string{
+					"default-backend-service": "default/default-backend",
+				},
+			},
+			msg: "missing port",
+		},
+		{
+			configMap: &v1.ConfigMap{
+				Data: map[string]string{
+					"default-backend-service": "default-backend:8080",
+				},
+			},
+			msg: "missing namespace",
+		},
+		{
+			configMap: &v1.ConfigMap{
+				Data: map[string]string{
+					"default-backend-service": "default/default-backend:99999",
+				},
+			},
+			msg: "invalid port",
+		},
+		{
+			configMap: &v1.ConfigMap{
+				Data: map[string]string{
+					"default-backend-service": "default/Default_Backend:8080",
+				},
+			},
+			msg: "invalid name",
+		},
+		{
+			configMap: &v1.ConfigMap{
+				Data: map[string]string{
+					"default-error-pages-configmap": "error-pages",
+				},
+			},
+			msg: "invalid error pages configmap",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			result, configOk := ParseConfigMap(context.Background(), test.configMap, false, false, false, false, false, makeEventLogger())
+			if configOk {
+				t.Errorf("configOk: want false, got true")
+			}
+			if result.DefaultBackendService != "" || result.DefaultBackendServicePort != 0 || result.DefaultErrorPagesConfigMap != "" {
+				t.Errorf("invalid values must be ignored, got %q, %d, %q", result.DefaultBackendService, result.DefaultBackendServicePort, result.DefaultErrorPagesConfigMap)
+			}
+		})
+	}
+}
+
+func TestGenerateDefaultErrorPages(t *testing.T) {
+	t.Parallel()
+	files := map[int]string{
+		503: "/etc/nginx/secrets/default_error_page_503.html",
+		404: "/etc/nginx/secrets/default_error_page_404.html",
+		500: "/etc/nginx/secrets/default_error_page_500.html",
+	}
+	expected := []version2.DefaultErrorPage{
+		{
+			Code:     404,
+			Location: "/_default_error_page_404",
+			File:     "/etc/nginx/secrets/default_error_page_404.html",
+		},
+		{
+			Code:     503,
+			Location: "/_default_error_page_503",
+			File:     "/etc/nginx/secrets/default_error_page_503.html",
+		},
+	}
+
+	result := generateDefaultErrorPages(files)
+	if !cmp.Equal(expected, result) {
+		t.Error(cmp.Diff(expected, result))
+	}
+
+	if result := generateDefaultErrorPages(nil); result != nil {
+		t.Errorf("generateDefaultErrorPages(nil) returned %v, want nil", result)
+	}
+}
+
+func TestGenerateNginxMainConfigLogFormatRequestID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		requestID bool
+		logFormat []string
+		expected  bool
+		msg       string
+	}{
+		{
+			requestID: true,
+			logFormat: []string{"$remote_addr", "$status"},
+			expected:  true,
+			msg:       "custom log format",
+		},
+		{
+			requestID: true,
+			logFormat: []string{`{"remote_addr":"$remote_addr",`, `"request_id":"$ingress_request_id"}`},
+			expected:  false,
+			msg:       "custom log format that references the request ID",
+		},
+		{
+			requestID: false,
+			logFormat: []string{"$remote_addr", "$status"},
+			expected:  false,
+			msg:       "request ID disabled",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			configParams := NewDefaultConfigParams(context.Background(), false)
+			configParams.RequestID.Enable = test.requestID
+			configParams.MainLogFormat = test.logFormat
+
+			result := GenerateNginxMainConfig(&StaticConfigParams{}, configParams, &MGMTConfigParams{})
+			if result.LogFormatRequestID != test.expected {
+				t.Errorf("LogFormatRequestID: want %v, got %v", test.expected, result.LogFormatRequestID)
+			}
+		})
+	}
+}
+
+func TestGenerateRequestID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		requestID RequestID
+		expected  *version2.RequestID
+		msg       string
+	}{
+		{
+			requestID: RequestID{Header: "X-Request-ID", Mode: "trust"},
+			expected:  nil,
+			msg:       "disabled",
+		},
+		{
+			requestID: RequestID{Enable: true, Header: "X-Request-ID", Mode: "trust", Echo: true},
+			expected: &version2.RequestID{
+				Header:           "X-Request-ID",
+				IncomingVariable: "$http_x_request_id",
+				Variable:         "$ingress_request_id_incoming",
+				Echo:             true,
+			},
+			msg: "trust incoming id",
+		},
+		{
+			requestID: RequestID{Enable: true, Header: "X-Correlation-ID", Mode: "generate"},
+			expected: &version2.RequestID{
+				Header:   "X-Correlation-ID",
+				Variable: "$request_id",
+			},
+			msg: "always generate id",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			result := generateRequestID(test.requestID, requestIDIncomingVariable)
+			if !cmp.Equal(test.expected, result) {
+				t.Error(cmp.Diff(test.expected, result))
+			}
+		})
+	}
+}
+
 func TestParseProxyBuffers(t *testing.T) {
 	t.Parallel()
 
@@ -2,10 +2,15 @@ package configs
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"os"
+	"slices"
 	"strings"
+	"sync"
+	"time"
 
 	nl "github.com/nginx/kubernetes-ingress/internal/logger"
 
@@ -39,6 +44,8 @@ const (
 	appProtectDosPolicyFolder       = "/etc/nginx/dos/policies/"
 	appProtectDosLogConfFolder      = "/etc/nginx/dos/logconfs/"
 	appProtectDosAllowListFolder    = "/etc/nginx/dos/allowlist/"
+	// the underscores keep the name from clashing with the config names of Ingresses and VirtualServers.
+	sharedLimitReqZonesConfigName = "shared_limit_req_zones"
 )
 
 // DefaultServerSecretPath is the full path to the Secret with a TLS cert and a key for the default server. #nosec G101
@@ -91,13 +98,19 @@ type ExtendedResources struct {
 	TransportServerExes []*TransportServerEx
 }
 
-// WeightUpdate holds the information about the weight updates for weight changes without reloading.
+// WeightUpdate holds the information about the keyval updates for weight changes and maintenance mode toggles without reloading.
 type WeightUpdate struct {
 	Zone  string
 	Key   string
 	Value string
 }
 
+// VirtualServerEjectedPeers holds the upstream servers of a VirtualServer that are ejected by the circuit breaker.
+type VirtualServerEjectedPeers struct {
+	VirtualServer *conf_v1.VirtualServer
+	EjectedPeers  []conf_v1.EjectedPeer
+}
+
 type tlsPassthroughPair struct {
 	Host       string
 	UnixSocket string
@@ -135,6 +148,7 @@ type Configurator struct {
 	virtualServers            map[string]*VirtualServerEx
 	transportServers          map[string]*TransportServerEx
 	tlsPassthroughPairs       map[string]tlsPassthroughPair
+	sharedLimitReqZones       map[string][]version2.LimitReqZone
 	isWildcardEnabled         bool
 	isPlus                    bool
 	labelUpdater              collector.LabelUpdater
@@ -145,6 +159,19 @@ type Configurator struct {
 	isReloadsEnabled          bool
 	isDynamicSSLReloadEnabled bool
 	ingressControllerReplicas int
+	circuitBreaker            *nginx.CircuitBreaker
+	streamProber              *nginx.StreamProber
+	reloadCoalescer           *nginx.ReloadCoalescer
+	defaultErrorPageFiles     map[int]string
+	configWorkers             int
+
+	// maintenanceKeyValUpdates are the maintenance mode toggles of the VirtualServers that wait for NGINX to be reloaded
+	// with their keyval zones. They don't enable the reloads, so that they can be deferred by a batch sync.
+	maintenanceKeyValUpdates []WeightUpdate
+
+	// lock serialises the configuration writes and the reloads.
+	// The exported methods hold it, so they must not call each other.
+	lock sync.Mutex
 }
 
 // ConfiguratorParams is a collection of parameters used for the
@@ -165,6 +192,8 @@ type ConfiguratorParams struct {
 	IsDynamicSSLReloadEnabled           bool
 	IsDynamicWeightChangesReloadEnabled bool
 	NginxVersion                        nginx.Version
+	ReloadCoalescer                     *nginx.ReloadCoalescer
+	ConfigWorkers                       int
 }
 
 // NewConfigurator creates a new Configurator.
@@ -194,6 +223,7 @@ func NewConfigurator(p ConfiguratorParams) *Configurator {
 		minions:                   make(map[string]map[string]bool),
 		mergeableIngresses:        make(map[string]*MergeableIngresses),
 		tlsPassthroughPairs:       make(map[string]tlsPassthroughPair),
+		sharedLimitReqZones:       make(map[string][]version2.LimitReqZone),
 		isPlus:                    p.IsPlus,
 		isWildcardEnabled:         p.IsWildcardEnabled,
 		labelUpdater:              p.LabelUpdater,
@@ -203,12 +233,47 @@ func NewConfigurator(p ConfiguratorParams) *Configurator {
 		isLatencyMetricsEnabled:   p.IsLatencyMetricsEnabled,
 		isDynamicSSLReloadEnabled: p.IsDynamicSSLReloadEnabled,
 		isReloadsEnabled:          false,
+		circuitBreaker:            nginx.NewCircuitBreaker(),
+		streamProber:              nginx.NewStreamProber(),
+		reloadCoalescer:           p.ReloadCoalescer,
+		configWorkers:             p.ConfigWorkers,
 	}
 	return &cnf
 }
 
+// generateConcurrently calls generate for the indexes from 0 to n-1 on the given number of workers and waits for them.
+func generateConcurrently(n int, workers int, generate func(i int)) {
+	workers = min(workers, n)
+	if workers <= 1 {
+		for i := range n {
+			generate(i)
+		}
+		return
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				generate(i)
+			}
+		}()
+	}
+	for i := range n {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+}
+
 // AddOrUpdateDHParam creates a dhparam file with the content of the string.
 func (cnf *Configurator) AddOrUpdateDHParam(content string) (string, error) {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	return cnf.nginxManager.CreateDHParam(content)
 }
 
@@ -299,12 +364,15 @@ func (cnf *Configurator) deleteIngressMetricsLabels(key string) {
 
 // AddOrUpdateIngress adds or updates NGINX configuration for the Ingress resource.
 func (cnf *Configurator) AddOrUpdateIngress(ingEx *IngressEx) (Warnings, error) {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	_, warnings, err := cnf.addOrUpdateIngress(ingEx)
 	if err != nil {
 		return warnings, fmt.Errorf("error adding or updating ingress %v/%v: %w", ingEx.Ingress.Namespace, ingEx.Ingress.Name, err)
 	}
 
-	if err := cnf.Reload(nginx.ReloadForOtherUpdate); err != nil {
+	if err := cnf.reload(nginx.ReloadForOtherUpdate); err != nil {
 		return warnings, fmt.Errorf("error reloading NGINX for %v/%v: %w", ingEx.Ingress.Namespace, ingEx.Ingress.Name, err)
 	}
 
@@ -313,17 +381,23 @@ func (cnf *Configurator) AddOrUpdateIngress(ingEx *IngressEx) (Warnings, error)
 
 // AddOrUpdateIngresses adds or updates NGINX configuration for the list of Ingress resources.
 func (cnf *Configurator) AddOrUpdateIngresses(ingExes []*IngressEx) (Warnings, error) {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	allWarnings := newWarnings()
 
-	for _, ingEx := range ingExes {
-		_, warnings, err := cnf.addOrUpdateIngress(ingEx)
+	err := cnf.addOrUpdateIngresses(ingExes, func(_ *IngressEx, _ bool, warnings Warnings, err error) error {
 		if err != nil {
-			return allWarnings, err
+			return err
 		}
 		allWarnings.Add(warnings)
+		return nil
+	})
+	if err != nil {
+		return allWarnings, err
 	}
 
-	if err := cnf.Reload(nginx.ReloadForOtherUpdate); err != nil {
+	if err := cnf.reload(nginx.ReloadForOtherUpdate); err != nil {
 		return allWarnings, fmt.Errorf("error when reloading NGINX when updating Ingresses: %w", err)
 	}
 
@@ -333,7 +407,7 @@ func (cnf *Configurator) AddOrUpdateIngresses(ingExes []*IngressEx) (Warnings, e
 // virtualServerExForHost takes a hostname and returns a VirtualServerEx for the given hostname.
 func (cnf *Configurator) virtualServerExForHost(hostname string) *VirtualServerEx {
 	for _, vsEx := range cnf.virtualServers {
-		if vsEx.VirtualServer.Spec.Host == hostname {
+		if vsEx.VirtualServer.Spec.Host == hostname || slices.Contains(vsEx.ServerAliases, hostname) {
 			return vsEx
 		}
 	}
@@ -371,6 +445,9 @@ func (cnf *Configurator) upstreamsForVirtualServer(vsEx *VirtualServerEx) []stri
 
 // UpstreamsForHost takes a hostname and returns upstreams for the given hostname.
 func (cnf *Configurator) UpstreamsForHost(hostname string) []string {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	l := nl.LoggerFromContext(cnf.CfgParams.Context)
 	nl.Debugf(l, "Get upstream for host: %s", hostname)
 	vsEx := cnf.virtualServerExForHost(hostname)
@@ -384,6 +461,9 @@ func (cnf *Configurator) UpstreamsForHost(hostname string) []string {
 // associated with this name. The name represents TS's
 // (TransportServer) action name.
 func (cnf *Configurator) StreamUpstreamsForName(name string) []string {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	l := nl.LoggerFromContext(cnf.CfgParams.Context)
 	nl.Debugf(l, "Get stream upstreams for name: '%s'", name)
 	ts := cnf.transportServerForActionName(name)
@@ -398,9 +478,11 @@ func (cnf *Configurator) StreamUpstreamsForName(name string) []string {
 func (cnf *Configurator) transportServerForActionName(name string) *conf_v1.TransportServer {
 	l := nl.LoggerFromContext(cnf.CfgParams.Context)
 	for _, tsEx := range cnf.transportServers {
-		nl.Debugf(l, "Check ts action '%s' for requested name: '%s'", tsEx.TransportServer.Spec.Action.Pass, name)
-		if tsEx.TransportServer.Spec.Action.Pass == name {
-			return tsEx.TransportServer
+		for _, actionName := range getTransportServerActionUpstreamNames(tsEx.TransportServer) {
+			nl.Debugf(l, "Check ts action '%s' for requested name: '%s'", actionName, name)
+			if actionName == name {
+				return tsEx.TransportServer
+			}
 		}
 	}
 	return nil
@@ -421,6 +503,44 @@ func (cnf *Configurator) streamUpstreamsForTransportServer(ts *conf_v1.Transport
 // addOrUpdateIngress returns a bool that specifies if the underlying config
 // file has changed, and any warnings or errors
 func (cnf *Configurator) addOrUpdateIngress(ingEx *IngressEx) (bool, Warnings, error) {
+	generate := cnf.prepareIngress(ingEx)
+	return cnf.applyIngress(ingEx, generate())
+}
+
+// addOrUpdateIngresses adds or updates NGINX configuration for the Ingress resources.
+// The configuration of the resources is generated concurrently and applied in order.
+func (cnf *Configurator) addOrUpdateIngresses(ingExes []*IngressEx, apply func(ingEx *IngressEx, changed bool, warnings Warnings, err error) error) error {
+	generators := make([]func() ingressConfig, len(ingExes))
+	for i, ingEx := range ingExes {
+		generators[i] = cnf.prepareIngress(ingEx)
+	}
+
+	cfgs := make([]ingressConfig, len(ingExes))
+	generateConcurrently(len(generators), cnf.configWorkers, func(i int) {
+		cfgs[i] = generators[i]()
+	})
+
+	for i, ingEx := range ingExes {
+		changed, warnings, err := cnf.applyIngress(ingEx, cfgs[i])
+		if err := apply(ingEx, changed, warnings, err); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ingressConfig is the generated NGINX configuration of an Ingress resource.
+type ingressConfig struct {
+	nginxCfg version1.IngressNginxConfig
+	content  []byte
+	warnings Warnings
+	err      error
+}
+
+// prepareIngress writes the files referenced by the configuration of the Ingress resource
+// and returns the function that generates the configuration. The function doesn't change the Configurator,
+// so the configuration of several resources can be generated concurrently.
+func (cnf *Configurator) prepareIngress(ingEx *IngressEx) func() ingressConfig {
 	apResources := cnf.updateApResources(ingEx)
 
 	cnf.updateDosResource(ingEx.DosEx)
@@ -436,42 +556,64 @@ func (cnf *Configurator) addOrUpdateIngress(ingEx *IngressEx) (bool, Warnings, e
 		ingEx.SecretRefs[basicAuth].Path = cnf.nginxManager.GetFilenameForSecret(ingEx.Ingress.Namespace + "-" + basicAuth)
 	}
 
-	isMinion := false
-	nginxCfg, warnings := generateNginxCfg(NginxCfgParams{
+	params := NginxCfgParams{
 		staticParams:              cnf.staticCfgParams,
 		ingEx:                     ingEx,
 		apResources:               apResources,
 		dosResource:               dosResource,
-		isMinion:                  isMinion,
+		isMinion:                  false,
 		isPlus:                    cnf.isPlus,
 		BaseCfgParams:             cnf.CfgParams,
-		isResolverConfigured:      cnf.IsResolverConfigured(),
+		isResolverConfigured:      cnf.isResolverConfigured(),
 		isWildcardEnabled:         cnf.isWildcardEnabled,
 		ingressControllerReplicas: cnf.ingressControllerReplicas,
-	})
+	}
+
+	return func() ingressConfig {
+		var cfg ingressConfig
+		cfg.nginxCfg, cfg.warnings = generateNginxCfg(params)
+		cfg.content, cfg.err = cnf.templateExecutor.ExecuteIngressConfigTemplate(&cfg.nginxCfg)
+		if cfg.err != nil {
+			cfg.err = fmt.Errorf("error generating Ingress Config %v: %w", objectMetaToFileName(&ingEx.Ingress.ObjectMeta), cfg.err)
+		}
+		return cfg
+	}
+}
+
+// applyIngress writes the generated configuration of the Ingress resource.
+func (cnf *Configurator) applyIngress(ingEx *IngressEx, cfg ingressConfig) (bool, Warnings, error) {
+	warnings := cfg.warnings
+	if cfg.err != nil {
+		return false, warnings, cfg.err
+	}
 
 	name := objectMetaToFileName(&ingEx.Ingress.ObjectMeta)
-	content, err := cnf.templateExecutor.ExecuteIngressConfigTemplate(&nginxCfg)
+	configChanged := cnf.nginxManager.CreateConfig(name, cfg.content)
+
+	zonesChanged, err := cnf.updateSharedLimitReqZones(name, cfg.nginxCfg.SharedLimitReqZones)
 	if err != nil {
-		return false, warnings, fmt.Errorf("error generating Ingress Config %v: %w", name, err)
+		return false, warnings, err
 	}
-	configChanged := cnf.nginxManager.CreateConfig(name, content)
+	configChanged = configChanged || zonesChanged
 
 	cnf.ingresses[name] = ingEx
 	if (cnf.isPlus && cnf.isPrometheusEnabled) || cnf.isLatencyMetricsEnabled {
-		cnf.updateIngressMetricsLabels(ingEx, nginxCfg.Upstreams)
+		cnf.updateIngressMetricsLabels(ingEx, cfg.nginxCfg.Upstreams)
 	}
 	return configChanged, warnings, nil
 }
 
 // AddOrUpdateMergeableIngress adds or updates NGINX configuration for the Ingress resources with Mergeable Types.
 func (cnf *Configurator) AddOrUpdateMergeableIngress(mergeableIngs *MergeableIngresses) (Warnings, error) {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	_, warnings, err := cnf.addOrUpdateMergeableIngress(mergeableIngs)
 	if err != nil {
 		return warnings, fmt.Errorf("error when adding or updating ingress %v/%v: %w", mergeableIngs.Master.Ingress.Namespace, mergeableIngs.Master.Ingress.Name, err)
 	}
 
-	if err := cnf.Reload(nginx.ReloadForOtherUpdate); err != nil {
+	if err := cnf.reload(nginx.ReloadForOtherUpdate); err != nil {
 		return warnings, fmt.Errorf("error reloading NGINX for %v/%v: %w", mergeableIngs.Master.Ingress.Namespace, mergeableIngs.Master.Ingress.Name, err)
 	}
 
@@ -480,6 +622,9 @@ func (cnf *Configurator) AddOrUpdateMergeableIngress(mergeableIngs *MergeableIng
 
 // AddOrUpdateMergeableIngresses adds or updates NGINX configuration for the list of mergeable Ingress resources.
 func (cnf *Configurator) AddOrUpdateMergeableIngresses(mergeableIngs []*MergeableIngresses) (Warnings, error) {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	allWarnings := newWarnings()
 
 	for _, mergeableIng := range mergeableIngs {
@@ -490,7 +635,7 @@ func (cnf *Configurator) AddOrUpdateMergeableIngresses(mergeableIngs []*Mergeabl
 		allWarnings.Add(warnings)
 	}
 
-	if err := cnf.Reload(nginx.ReloadForOtherUpdate); err != nil {
+	if err := cnf.reload(nginx.ReloadForOtherUpdate); err != nil {
 		return allWarnings, fmt.Errorf("error when reloading NGINX when updating mergeable Ingresses: %w", err)
 	}
 
@@ -526,7 +671,7 @@ func (cnf *Configurator) addOrUpdateMergeableIngress(mergeableIngs *MergeableIng
 		dosResource:               dosResource,
 		BaseCfgParams:             cnf.CfgParams,
 		isPlus:                    cnf.isPlus,
-		isResolverConfigured:      cnf.IsResolverConfigured(),
+		isResolverConfigured:      cnf.isResolverConfigured(),
 		staticParams:              cnf.staticCfgParams,
 		isWildcardEnabled:         cnf.isWildcardEnabled,
 		ingressControllerReplicas: cnf.ingressControllerReplicas,
@@ -539,6 +684,12 @@ func (cnf *Configurator) addOrUpdateMergeableIngress(mergeableIngs *MergeableIng
 	}
 	changed := cnf.nginxManager.CreateConfig(name, content)
 
+	zonesChanged, err := cnf.updateSharedLimitReqZones(name, nginxCfg.SharedLimitReqZones)
+	if err != nil {
+		return false, warnings, err
+	}
+	changed = changed || zonesChanged
+
 	cnf.ingresses[name] = mergeableIngs.Master
 	cnf.minions[name] = make(map[string]bool)
 	for _, minion := range mergeableIngs.Minions {
@@ -636,16 +787,19 @@ func (cnf *Configurator) deleteVirtualServerMetricsLabels(key string) {
 
 // AddOrUpdateVirtualServer adds or updates NGINX configuration for the VirtualServer resource.
 func (cnf *Configurator) AddOrUpdateVirtualServer(virtualServerEx *VirtualServerEx) (Warnings, error) {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	_, warnings, weightUpdates, err := cnf.addOrUpdateVirtualServer(virtualServerEx)
 	if err != nil {
 		return warnings, fmt.Errorf("error adding or updating VirtualServer %v/%v: %w", virtualServerEx.VirtualServer.Namespace, virtualServerEx.VirtualServer.Name, err)
 	}
 
 	if len(weightUpdates) > 0 {
-		cnf.EnableReloads()
+		cnf.enableReloads()
 	}
 
-	if err := cnf.Reload(nginx.ReloadForOtherUpdate); err != nil {
+	if err := cnf.reload(nginx.ReloadForOtherUpdate); err != nil {
 		return warnings, fmt.Errorf("error reloading NGINX for VirtualServer %v/%v: %w", virtualServerEx.VirtualServer.Namespace, virtualServerEx.VirtualServer.Name, err)
 	}
 
@@ -656,8 +810,67 @@ func (cnf *Configurator) AddOrUpdateVirtualServer(virtualServerEx *VirtualServer
 	return warnings, nil
 }
 
+// ValidateVirtualServer generates NGINX configuration for the VirtualServer resource and tests it with NGINX without applying it.
+// It is used to check that a standby VirtualServer is ready to take over its host.
+func (cnf *Configurator) ValidateVirtualServer(virtualServerEx *VirtualServerEx) (Warnings, error) {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
+	name := getFileNameForVirtualServer(virtualServerEx.VirtualServer)
+
+	vsc := newVirtualServerConfigurator(cnf.CfgParams, cnf.isPlus, cnf.isResolverConfigured(), cnf.staticCfgParams, cnf.isWildcardEnabled, nil)
+	vsc.IngressControllerReplicas = cnf.ingressControllerReplicas
+	vsCfg, warnings := vsc.GenerateVirtualServerConfig(virtualServerEx, nil, nil)
+	content, err := cnf.templateExecutorV2.ExecuteVirtualServerTemplate(&vsCfg)
+	if err != nil {
+		return warnings, fmt.Errorf("error generating VirtualServer config: %v: %w", name, err)
+	}
+	if err := cnf.nginxManager.ValidateConfig(name, content); err != nil {
+		return warnings, fmt.Errorf("error validating VirtualServer config: %v: %w", name, err)
+	}
+	return warnings, nil
+}
+
 func (cnf *Configurator) addOrUpdateVirtualServer(virtualServerEx *VirtualServerEx) (bool, Warnings, []WeightUpdate, error) {
-	var weightUpdates []WeightUpdate
+	generate := cnf.prepareVirtualServer(virtualServerEx)
+	return cnf.applyVirtualServer(virtualServerEx, generate())
+}
+
+// addOrUpdateVirtualServers adds or updates NGINX configuration for the VirtualServer resources.
+// The configuration of the resources is generated concurrently and applied in order.
+func (cnf *Configurator) addOrUpdateVirtualServers(virtualServerExes []*VirtualServerEx, apply func(vsEx *VirtualServerEx, changed bool, warnings Warnings, weightUpdates []WeightUpdate, err error) error) error {
+	generators := make([]func() virtualServerConfig, len(virtualServerExes))
+	for i, vsEx := range virtualServerExes {
+		generators[i] = cnf.prepareVirtualServer(vsEx)
+	}
+
+	cfgs := make([]virtualServerConfig, len(virtualServerExes))
+	generateConcurrently(len(generators), cnf.configWorkers, func(i int) {
+		cfgs[i] = generators[i]()
+	})
+
+	for i, vsEx := range virtualServerExes {
+		changed, warnings, weightUpdates, err := cnf.applyVirtualServer(vsEx, cfgs[i])
+		if err := apply(vsEx, changed, warnings, weightUpdates, err); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// virtualServerConfig is the generated NGINX configuration of a VirtualServer resource.
+type virtualServerConfig struct {
+	vsCfg       version2.VirtualServerConfig
+	content     []byte
+	oidcContent []byte
+	warnings    Warnings
+	err         error
+}
+
+// prepareVirtualServer writes the files referenced by the configuration of the VirtualServer resource
+// and returns the function that generates the configuration. The function doesn't change the Configurator,
+// so the configuration of several resources can be generated concurrently.
+func (cnf *Configurator) prepareVirtualServer(virtualServerEx *VirtualServerEx) func() virtualServerConfig {
 	apResources := cnf.updateApResourcesForVs(virtualServerEx)
 	dosResources := map[string]*appProtectDosResource{}
 	for k, v := range virtualServerEx.DosProtectedEx {
@@ -668,25 +881,51 @@ func (cnf *Configurator) addOrUpdateVirtualServer(virtualServerEx *VirtualServer
 		}
 	}
 
+	vsc := newVirtualServerConfigurator(cnf.CfgParams, cnf.isPlus, cnf.isResolverConfigured(), cnf.staticCfgParams, cnf.isWildcardEnabled, nil)
+	vsc.IngressControllerReplicas = cnf.ingressControllerReplicas
+	vsc.maintenancePageFiles = cnf.addOrUpdateMaintenancePages(virtualServerEx)
+
+	return func() virtualServerConfig {
+		name := getFileNameForVirtualServer(virtualServerEx.VirtualServer)
+
+		var cfg virtualServerConfig
+		cfg.vsCfg, cfg.warnings = vsc.GenerateVirtualServerConfig(virtualServerEx, apResources, dosResources)
+		cfg.content, cfg.err = cnf.templateExecutorV2.ExecuteVirtualServerTemplate(&cfg.vsCfg)
+		if cfg.err != nil {
+			cfg.err = fmt.Errorf("error generating VirtualServer config: %v: %w", name, cfg.err)
+			return cfg
+		}
+
+		if cfg.vsCfg.Server.OIDC != nil {
+			cfg.oidcContent, cfg.err = cnf.templateExecutorV2.ExecuteOIDCTemplate(cfg.vsCfg.Server.OIDC)
+			if cfg.err != nil {
+				cfg.err = fmt.Errorf("error generating VirtualServer OIDC config: %v: %w", getFileNameForOIDCVirtualServer(virtualServerEx.VirtualServer), cfg.err)
+			}
+		}
+		return cfg
+	}
+}
+
+// applyVirtualServer writes the generated configuration of the VirtualServer resource.
+func (cnf *Configurator) applyVirtualServer(virtualServerEx *VirtualServerEx, cfg virtualServerConfig) (bool, Warnings, []WeightUpdate, error) {
+	var weightUpdates []WeightUpdate
+	warnings := cfg.warnings
+	if cfg.err != nil {
+		return false, warnings, weightUpdates, cfg.err
+	}
+
 	name := getFileNameForVirtualServer(virtualServerEx.VirtualServer)
+	vsCfg := cfg.vsCfg
+	changed := cnf.nginxManager.CreateConfig(name, cfg.content)
 
-	vsc := newVirtualServerConfigurator(cnf.CfgParams, cnf.isPlus, cnf.IsResolverConfigured(), cnf.staticCfgParams, cnf.isWildcardEnabled, nil)
-	vsc.IngressControllerReplicas = cnf.ingressControllerReplicas
-	vsCfg, warnings := vsc.GenerateVirtualServerConfig(virtualServerEx, apResources, dosResources)
-	content, err := cnf.templateExecutorV2.ExecuteVirtualServerTemplate(&vsCfg)
+	zonesChanged, err := cnf.updateSharedLimitReqZones(name, vsCfg.SharedLimitReqZones)
 	if err != nil {
-		return false, warnings, weightUpdates, fmt.Errorf("error generating VirtualServer config: %v: %w", name, err)
+		return false, warnings, weightUpdates, err
 	}
-	changed := cnf.nginxManager.CreateConfig(name, content)
+	changed = changed || zonesChanged
 
 	if vsCfg.Server.OIDC != nil {
-		name := getFileNameForOIDCVirtualServer(virtualServerEx.VirtualServer)
-
-		content, err := cnf.templateExecutorV2.ExecuteOIDCTemplate(vsCfg.Server.OIDC)
-		if err != nil {
-			return false, warnings, weightUpdates, fmt.Errorf("error generating VirtualServer OIDC config: %v: %w", name, err)
-		}
-		oidcChanged := cnf.nginxManager.CreateOIDCConfig(name, content)
+		oidcChanged := cnf.nginxManager.CreateOIDCConfig(getFileNameForOIDCVirtualServer(virtualServerEx.VirtualServer), cfg.oidcContent)
 		if oidcChanged {
 			changed = true
 		}
@@ -707,24 +946,73 @@ func (cnf *Configurator) addOrUpdateVirtualServer(virtualServerEx *VirtualServer
 			weightUpdates = append(weightUpdates, WeightUpdate{Zone: splitClient.ZoneName, Key: splitClient.Key, Value: value})
 		}
 	}
+
+	if cnf.isPlus {
+		cnf.maintenanceKeyValUpdates = append(cnf.maintenanceKeyValUpdates, GenerateMaintenanceKeyValUpdates(virtualServerEx)...)
+	}
 	return changed, warnings, weightUpdates, nil
 }
 
+// addOrUpdateDefaultErrorPages writes the cluster-wide error pages to disk, deletes the pages that are no longer configured
+// and returns the files of the pages keyed by status code.
+func (cnf *Configurator) addOrUpdateDefaultErrorPages(pages map[int]string) map[int]string {
+	files := make(map[int]string)
+	for _, code := range DefaultErrorPageCodes {
+		name := getFileNameForDefaultErrorPage(code)
+		page, exists := pages[code]
+		if !exists {
+			if _, written := cnf.defaultErrorPageFiles[code]; written {
+				cnf.nginxManager.DeleteSecret(name)
+			}
+			continue
+		}
+		files[code] = cnf.nginxManager.CreateSecret(name, []byte(page), nginx.MaintenancePageFileMode)
+	}
+	cnf.defaultErrorPageFiles = files
+	return files
+}
+
+// addOrUpdateMaintenancePages writes the maintenance pages of a VirtualServer to disk, deletes the pages that are no longer referenced
+// and returns the files of the pages keyed by GetMaintenancePageKey.
+func (cnf *Configurator) addOrUpdateMaintenancePages(virtualServerEx *VirtualServerEx) map[string]string {
+	files := make(map[string]string)
+	for key, page := range virtualServerEx.MaintenancePages {
+		name := getFileNameForMaintenancePage(virtualServerEx.VirtualServer, key)
+		files[key] = cnf.nginxManager.CreateSecret(name, []byte(page), nginx.MaintenancePageFileMode)
+	}
+
+	if oldVsEx, exists := cnf.virtualServers[getFileNameForVirtualServer(virtualServerEx.VirtualServer)]; exists {
+		for key := range oldVsEx.MaintenancePages {
+			if _, exists := virtualServerEx.MaintenancePages[key]; !exists {
+				cnf.nginxManager.DeleteSecret(getFileNameForMaintenancePage(oldVsEx.VirtualServer, key))
+			}
+		}
+	}
+
+	return files
+}
+
 // AddOrUpdateVirtualServers adds or updates NGINX configuration for multiple VirtualServer resources.
 func (cnf *Configurator) AddOrUpdateVirtualServers(virtualServerExes []*VirtualServerEx) (Warnings, error) {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	allWarnings := newWarnings()
 	allWeightUpdates := []WeightUpdate{}
 
-	for _, vsEx := range virtualServerExes {
-		_, warnings, weightUpdates, err := cnf.addOrUpdateVirtualServer(vsEx)
+	err := cnf.addOrUpdateVirtualServers(virtualServerExes, func(_ *VirtualServerEx, _ bool, warnings Warnings, weightUpdates []WeightUpdate, err error) error {
 		if err != nil {
-			return allWarnings, err
+			return err
 		}
 		allWarnings.Add(warnings)
 		allWeightUpdates = append(allWeightUpdates, weightUpdates...)
+		return nil
+	})
+	if err != nil {
+		return allWarnings, err
 	}
 
-	if err := cnf.Reload(nginx.ReloadForOtherUpdate); err != nil {
+	if err := cnf.reload(nginx.ReloadForOtherUpdate); err != nil {
 		return allWarnings, fmt.Errorf("error when reloading NGINX when updating Policy: %w", err)
 	}
 
@@ -805,11 +1093,14 @@ func (cnf *Configurator) deleteTransportServerMetricsLabels(key string) {
 // AddOrUpdateTransportServer adds or updates NGINX configuration for the TransportServer resource.
 // It is a responsibility of the caller to check that the TransportServer references an existing listener.
 func (cnf *Configurator) AddOrUpdateTransportServer(transportServerEx *TransportServerEx) (Warnings, error) {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	_, warnings, err := cnf.addOrUpdateTransportServer(transportServerEx)
 	if err != nil {
 		return nil, fmt.Errorf("error adding or updating TransportServer %v/%v: %w", transportServerEx.TransportServer.Namespace, transportServerEx.TransportServer.Name, err)
 	}
-	if err := cnf.Reload(nginx.ReloadForOtherUpdate); err != nil {
+	if err := cnf.reload(nginx.ReloadForOtherUpdate); err != nil {
 		return nil, fmt.Errorf("error reloading NGINX for TransportServer %v/%v: %w", transportServerEx.TransportServer.Namespace, transportServerEx.TransportServer.Name, err)
 	}
 	return warnings, nil
@@ -821,9 +1112,10 @@ func (cnf *Configurator) addOrUpdateTransportServer(transportServerEx *Transport
 		transportServerEx:      transportServerEx,
 		listenerPort:           transportServerEx.ListenerPort,
 		isPlus:                 cnf.isPlus,
-		isResolverConfigured:   cnf.IsResolverConfigured(),
+		isResolverConfigured:   cnf.isResolverConfigured(),
 		isDynamicReloadEnabled: cnf.staticCfgParams.DynamicSSLReload,
 		staticSSLPath:          cnf.staticCfgParams.StaticSSLPath,
+		downServers:            cnf.streamDownServers(),
 	})
 
 	content, err := cnf.templateExecutorV2.ExecuteTransportServerTemplate(tsCfg)
@@ -858,6 +1150,9 @@ func (cnf *Configurator) addOrUpdateTransportServer(transportServerEx *Transport
 // GetVirtualServerRoutesForVirtualServer returns the virtualServerRoutes that a virtualServer
 // references, if that virtualServer exists
 func (cnf *Configurator) GetVirtualServerRoutesForVirtualServer(key string) []*conf_v1.VirtualServerRoute {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	vsFileName := getFileNameForVirtualServerFromKey(key)
 	if cnf.virtualServers[vsFileName] != nil {
 		return cnf.virtualServers[vsFileName].VirtualServerRoutes
@@ -876,6 +1171,49 @@ func (cnf *Configurator) updateTLSPassthroughHostsConfig() (bool, error) {
 	return cnf.nginxManager.CreateTLSPassthroughHostsConfig(content), nil
 }
 
+// updateSharedLimitReqZones sets the shared rate limit zones used by the resource with the config name
+// and regenerates the config of the shared rate limit zones, which defines every zone once.
+func (cnf *Configurator) updateSharedLimitReqZones(name string, zones []version2.LimitReqZone) (bool, error) {
+	if len(zones) == 0 {
+		if _, exists := cnf.sharedLimitReqZones[name]; !exists {
+			return false, nil
+		}
+		delete(cnf.sharedLimitReqZones, name)
+	} else {
+		cnf.sharedLimitReqZones[name] = zones
+	}
+
+	cfg := generateSharedLimitReqZonesConfig(cnf.sharedLimitReqZones)
+
+	content, err := cnf.templateExecutorV2.ExecuteSharedLimitReqZonesTemplate(cfg)
+	if err != nil {
+		return false, fmt.Errorf("error generating config for shared rate limit zones: %w", err)
+	}
+
+	return cnf.nginxManager.CreateConfig(sharedLimitReqZonesConfigName, content), nil
+}
+
+func generateSharedLimitReqZonesConfig(sharedLimitReqZones map[string][]version2.LimitReqZone) *version2.SharedLimitReqZonesConfig {
+	cfg := version2.SharedLimitReqZonesConfig{}
+	encountered := make(map[string]bool)
+
+	for _, name := range slices.Sorted(maps.Keys(sharedLimitReqZones)) {
+		for _, zone := range sharedLimitReqZones[name] {
+			if encountered[zone.ZoneName] {
+				continue
+			}
+			encountered[zone.ZoneName] = true
+			cfg = append(cfg, zone)
+		}
+	}
+
+	slices.SortFunc(cfg, func(a, b version2.LimitReqZone) int {
+		return strings.Compare(a.ZoneName, b.ZoneName)
+	})
+
+	return &cfg
+}
+
 func generateTLSPassthroughHostsConfig(tlsPassthroughPairs map[string]tlsPassthroughPair) *version2.TLSPassthroughHostsConfig {
 	cfg := version2.TLSPassthroughHostsConfig{}
 
@@ -888,6 +1226,13 @@ func generateTLSPassthroughHostsConfig(tlsPassthroughPairs map[string]tlsPassthr
 
 // AddOrUpdateCASecret writes the secret content to disk returning the files added/updated
 func (cnf *Configurator) AddOrUpdateCASecret(secret *api_v1.Secret, crtFileName, crlFileName string) string {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
+	return cnf.addOrUpdateCASecret(secret, crtFileName, crlFileName)
+}
+
+func (cnf *Configurator) addOrUpdateCASecret(secret *api_v1.Secret, crtFileName, crlFileName string) string {
 	crtData, crlData := GenerateCAFileContent(secret)
 	crtFilePath := cnf.nginxManager.CreateSecret(crtFileName, crtData, nginx.ReadWriteOnlyFileMode)
 	crlFilePath := cnf.nginxManager.CreateSecret(crlFileName, crlData, nginx.ReadWriteOnlyFileMode)
@@ -908,6 +1253,9 @@ func (cnf *Configurator) addOrUpdateHtpasswdSecret(secret *api_v1.Secret) string
 
 // AddOrUpdateResources adds or updates configuration for resources.
 func (cnf *Configurator) AddOrUpdateResources(resources ExtendedResources, reloadIfUnchanged bool) (Warnings, error) {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	allWarnings := newWarnings()
 	allWeightUpdates := []WeightUpdate{}
 	configsChanged := false
@@ -924,10 +1272,9 @@ func (cnf *Configurator) AddOrUpdateResources(resources ExtendedResources, reloa
 		return nil
 	}
 
-	updateVSResource := func(updateFunc func() (bool, Warnings, []WeightUpdate, error), namespace, name string) error {
-		changed, warnings, weightUpdates, err := updateFunc()
+	updateVSResource := func(vsEx *VirtualServerEx, changed bool, warnings Warnings, weightUpdates []WeightUpdate, err error) error {
 		if err != nil {
-			return fmt.Errorf("error adding or updating resource %v/%v: %w", namespace, name, err)
+			return fmt.Errorf("error adding or updating resource %v/%v: %w", vsEx.VirtualServer.Namespace, vsEx.VirtualServer.Name, err)
 		}
 		allWarnings.Add(warnings)
 		allWeightUpdates = append(allWeightUpdates, weightUpdates...)
@@ -938,13 +1285,13 @@ func (cnf *Configurator) AddOrUpdateResources(resources ExtendedResources, reloa
 		return nil
 	}
 
-	for _, ingEx := range resources.IngressExes {
-		err := updateResource(func() (bool, Warnings, error) {
-			return cnf.addOrUpdateIngress(ingEx)
+	err := cnf.addOrUpdateIngresses(resources.IngressExes, func(ingEx *IngressEx, changed bool, warnings Warnings, err error) error {
+		return updateResource(func() (bool, Warnings, error) {
+			return changed, warnings, err
 		}, ingEx.Ingress.Namespace, ingEx.Ingress.Name)
-		if err != nil {
-			return nil, err
-		}
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	for _, m := range resources.MergeableIngresses {
@@ -956,13 +1303,8 @@ func (cnf *Configurator) AddOrUpdateResources(resources ExtendedResources, reloa
 		}
 	}
 
-	for _, vsEx := range resources.VirtualServerExes {
-		err := updateVSResource(func() (bool, Warnings, []WeightUpdate, error) {
-			return cnf.addOrUpdateVirtualServer(vsEx)
-		}, vsEx.VirtualServer.Namespace, vsEx.VirtualServer.Name)
-		if err != nil {
-			return nil, err
-		}
+	if err := cnf.addOrUpdateVirtualServers(resources.VirtualServerExes, updateVSResource); err != nil {
+		return nil, err
 	}
 
 	for _, tsEx := range resources.TransportServerExes {
@@ -975,15 +1317,20 @@ func (cnf *Configurator) AddOrUpdateResources(resources ExtendedResources, reloa
 	}
 
 	if configsChanged || reloadIfUnchanged {
-		if err := cnf.Reload(nginx.ReloadForOtherUpdate); err != nil {
+		if err := cnf.reload(nginx.ReloadForOtherUpdate); err != nil {
 			return nil, fmt.Errorf("error when reloading NGINX when updating resources: %w", err)
 		}
+	} else {
+		cnf.upsertMaintenanceKeyVals()
 	}
 	return allWarnings, nil
 }
 
 // AddOrUpdateLicenseSecret adds or updates NGINX Plus license secret.
 func (cnf *Configurator) AddOrUpdateLicenseSecret(secret *api_v1.Secret) error {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	l := nl.LoggerFromContext(cnf.CfgParams.Context)
 	nl.Debugf(l, "AddOrUpdateLicenseSecret: [%v]", secret.Name)
 	data, err := GenerateLicenseSecret(secret)
@@ -1003,6 +1350,9 @@ func (cnf *Configurator) addOrUpdateTLSSecret(secret *api_v1.Secret) string {
 
 // AddOrUpdateSpecialTLSSecrets adds or updates a file with a TLS cert and a key from a Special TLS Secret (eg. DefaultServerSecret, WildcardTLSSecret).
 func (cnf *Configurator) AddOrUpdateSpecialTLSSecrets(secret *api_v1.Secret, secretNames []string) {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	l := nl.LoggerFromContext(cnf.CfgParams.Context)
 	nl.Debugf(l, "AddOrUpdateSpecialTLSSecrets: secrets [%v]", secretNames)
 	data := GenerateCertAndKeyFileContent(secret)
@@ -1014,6 +1364,9 @@ func (cnf *Configurator) AddOrUpdateSpecialTLSSecrets(secret *api_v1.Secret, sec
 
 // AddOrUpdateMGMTClientAuthSecret adds or updates the MGMT Client Auth Secret file with a TLS cert and key.
 func (cnf *Configurator) AddOrUpdateMGMTClientAuthSecret(secret *api_v1.Secret) {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	data := GenerateCertAndKeyFileContent(secret)
 	cnf.nginxManager.CreateSecret("mgmt/client", data, nginx.ReadWriteOnlyFileMode)
 }
@@ -1055,6 +1408,13 @@ func GenerateLicenseSecret(secret *api_v1.Secret) ([]byte, error) {
 
 // DeleteIngress deletes NGINX configuration for the Ingress resource.
 func (cnf *Configurator) DeleteIngress(key string, skipReload bool) error {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
+	return cnf.deleteIngress(key, skipReload)
+}
+
+func (cnf *Configurator) deleteIngress(key string, skipReload bool) error {
 	name := keyToFileName(key)
 	cnf.nginxManager.DeleteConfig(name)
 
@@ -1062,12 +1422,16 @@ func (cnf *Configurator) DeleteIngress(key string, skipReload bool) error {
 	delete(cnf.minions, name)
 	delete(cnf.mergeableIngresses, name)
 
+	if _, err := cnf.updateSharedLimitReqZones(name, nil); err != nil {
+		return fmt.Errorf("error when removing ingress %v: %w", key, err)
+	}
+
 	if (cnf.isPlus && cnf.isPrometheusEnabled) || cnf.isLatencyMetricsEnabled {
 		cnf.deleteIngressMetricsLabels(key)
 	}
 
 	if !skipReload {
-		if err := cnf.Reload(nginx.ReloadForOtherUpdate); err != nil {
+		if err := cnf.reload(nginx.ReloadForOtherUpdate); err != nil {
 			return fmt.Errorf("error when removing ingress %v: %w", key, err)
 		}
 	}
@@ -1077,6 +1441,13 @@ func (cnf *Configurator) DeleteIngress(key string, skipReload bool) error {
 
 // DeleteVirtualServer deletes NGINX configuration for the VirtualServer resource.
 func (cnf *Configurator) DeleteVirtualServer(key string, skipReload bool) error {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
+	return cnf.deleteVirtualServer(key, skipReload)
+}
+
+func (cnf *Configurator) deleteVirtualServer(key string, skipReload bool) error {
 	name := getFileNameForVirtualServerFromKey(key)
 	cnf.nginxManager.DeleteConfig(name)
 	if cnf.virtualServers[name] != nil {
@@ -1086,6 +1457,9 @@ func (cnf *Configurator) DeleteVirtualServer(key string, skipReload bool) error
 				cnf.nginxManager.DeleteOIDCConfig(oidcName)
 			}
 		}
+		for key := range cnf.virtualServers[name].MaintenancePages {
+			cnf.nginxManager.DeleteSecret(getFileNameForMaintenancePage(cnf.virtualServers[name].VirtualServer, key))
+		}
 	}
 
 	if cnf.isPlus {
@@ -1093,12 +1467,15 @@ func (cnf *Configurator) DeleteVirtualServer(key string, skipReload bool) error
 	}
 
 	delete(cnf.virtualServers, name)
+	if _, err := cnf.updateSharedLimitReqZones(name, nil); err != nil {
+		return fmt.Errorf("error when removing VirtualServer %v: %w", key, err)
+	}
 	if (cnf.isPlus && cnf.isPrometheusEnabled) || cnf.isLatencyMetricsEnabled {
 		cnf.deleteVirtualServerMetricsLabels(key)
 	}
 
 	if !skipReload {
-		if err := cnf.Reload(nginx.ReloadForOtherUpdate); err != nil {
+		if err := cnf.reload(nginx.ReloadForOtherUpdate); err != nil {
 			return fmt.Errorf("error when removing VirtualServer %v: %w", key, err)
 		}
 	}
@@ -1108,6 +1485,9 @@ func (cnf *Configurator) DeleteVirtualServer(key string, skipReload bool) error
 
 // DeleteTransportServer deletes NGINX configuration for the TransportServer resource.
 func (cnf *Configurator) DeleteTransportServer(key string) error {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	if cnf.isPlus && cnf.isPrometheusEnabled {
 		cnf.deleteTransportServerMetricsLabels(key)
 	}
@@ -1117,7 +1497,7 @@ func (cnf *Configurator) DeleteTransportServer(key string) error {
 		return fmt.Errorf("error when removing TransportServer %v: %w", key, err)
 	}
 
-	err = cnf.Reload(nginx.ReloadForOtherUpdate)
+	err = cnf.reload(nginx.ReloadForOtherUpdate)
 	if err != nil {
 		return fmt.Errorf("error when removing TransportServer %v: %w", key, err)
 	}
@@ -1142,6 +1522,9 @@ func (cnf *Configurator) deleteTransportServer(key string) error {
 
 // UpdateEndpoints updates endpoints in NGINX configuration for the Ingress resources.
 func (cnf *Configurator) UpdateEndpoints(ingExes []*IngressEx) error {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	l := nl.LoggerFromContext(cnf.CfgParams.Context)
 	reloadPlus := false
 
@@ -1158,6 +1541,14 @@ func (cnf *Configurator) UpdateEndpoints(ingExes []*IngressEx) error {
 				nl.Warnf(l, "Couldn't update the endpoints via the API: %v; reloading configuration instead", err)
 				reloadPlus = true
 			}
+
+			for _, canaryEx := range ingEx.Canaries {
+				err = cnf.updatePlusEndpoints(canaryEx)
+				if err != nil {
+					nl.Warnf(l, "Couldn't update the endpoints via the API: %v; reloading configuration instead", err)
+					reloadPlus = true
+				}
+			}
 		}
 	}
 
@@ -1166,7 +1557,7 @@ func (cnf *Configurator) UpdateEndpoints(ingExes []*IngressEx) error {
 		return nil
 	}
 
-	if err := cnf.Reload(nginx.ReloadForEndpointsUpdate); err != nil {
+	if err := cnf.reload(nginx.ReloadForEndpointsUpdate); err != nil {
 		return fmt.Errorf("error reloading NGINX when updating endpoints: %w", err)
 	}
 
@@ -1175,6 +1566,9 @@ func (cnf *Configurator) UpdateEndpoints(ingExes []*IngressEx) error {
 
 // UpdateEndpointsMergeableIngress updates endpoints in NGINX configuration for a mergeable Ingress resource.
 func (cnf *Configurator) UpdateEndpointsMergeableIngress(mergeableIngresses []*MergeableIngresses) error {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	l := nl.LoggerFromContext(cnf.CfgParams.Context)
 	reloadPlus := false
 
@@ -1201,7 +1595,7 @@ func (cnf *Configurator) UpdateEndpointsMergeableIngress(mergeableIngresses []*M
 		return nil
 	}
 
-	if err := cnf.Reload(nginx.ReloadForEndpointsUpdate); err != nil {
+	if err := cnf.reload(nginx.ReloadForEndpointsUpdate); err != nil {
 		return fmt.Errorf("error reloading NGINX when updating endpoints for %v: %w", mergeableIngresses, err)
 	}
 
@@ -1210,6 +1604,9 @@ func (cnf *Configurator) UpdateEndpointsMergeableIngress(mergeableIngresses []*M
 
 // UpdateEndpointsForVirtualServers updates endpoints in NGINX configuration for the VirtualServer resources.
 func (cnf *Configurator) UpdateEndpointsForVirtualServers(virtualServerExes []*VirtualServerEx) error {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	l := nl.LoggerFromContext(cnf.CfgParams.Context)
 	reloadPlus := false
 
@@ -1234,7 +1631,7 @@ func (cnf *Configurator) UpdateEndpointsForVirtualServers(virtualServerExes []*V
 		return nil
 	}
 
-	if err := cnf.Reload(nginx.ReloadForEndpointsUpdate); err != nil {
+	if err := cnf.reload(nginx.ReloadForEndpointsUpdate); err != nil {
 		return fmt.Errorf("error reloading NGINX when updating endpoints: %w", err)
 	}
 
@@ -1245,6 +1642,7 @@ func (cnf *Configurator) updatePlusEndpointsForVirtualServer(virtualServerEx *Vi
 	upstreams := createUpstreamsForPlus(virtualServerEx, cnf.CfgParams, cnf.staticCfgParams)
 	for _, upstream := range upstreams {
 		serverCfg := createUpstreamServersConfigForPlus(upstream)
+		serverCfg.Ejected = cnf.circuitBreaker.Ejected(upstream.Name, time.Now())
 
 		endpoints := createEndpointsFromUpstream(upstream)
 
@@ -1257,8 +1655,118 @@ func (cnf *Configurator) updatePlusEndpointsForVirtualServer(virtualServerEx *Vi
 	return nil
 }
 
+// UpdateCircuitBreakers ejects the failing servers of the NGINX Plus upstreams of the VirtualServers with the circuit breaker enabled
+// and restores the servers whose ejection has expired. It returns the ejected servers of those VirtualServers.
+func (cnf *Configurator) UpdateCircuitBreakers() ([]VirtualServerEjectedPeers, error) {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
+	if !cnf.isPlus || !cnf.isReloadsEnabled {
+		return nil, nil
+	}
+
+	configs := make(map[string]nginx.CircuitBreakerConfig)
+	vsConfigs := make(map[string]map[string]nginx.CircuitBreakerConfig)
+	for name, vsEx := range cnf.virtualServers {
+		cbConfigs := createCircuitBreakerConfigsForPlus(vsEx)
+		if len(cbConfigs) == 0 {
+			continue
+		}
+		vsConfigs[name] = cbConfigs
+		for upstream, cfg := range cbConfigs {
+			configs[upstream] = cfg
+		}
+	}
+
+	if len(vsConfigs) == 0 {
+		return nil, nil
+	}
+
+	peers, err := cnf.nginxManager.GetUpstreamPeersInPlus()
+	if err != nil {
+		return nil, fmt.Errorf("error getting the upstream servers: %w", err)
+	}
+
+	ejected := cnf.circuitBreaker.Evaluate(configs, peers, time.Now())
+
+	var result []VirtualServerEjectedPeers
+	for name, cbConfigs := range vsConfigs {
+		vsEx := cnf.virtualServers[name]
+		for _, upstream := range createUpstreamsForPlus(vsEx, cnf.CfgParams, cnf.staticCfgParams) {
+			if _, exists := cbConfigs[upstream.Name]; !exists {
+				continue
+			}
+			serverCfg := createUpstreamServersConfigForPlus(upstream)
+			serverCfg.Ejected = ejected[upstream.Name]
+			err := cnf.updateServersInPlus(upstream.Name, createEndpointsFromUpstream(upstream), serverCfg)
+			if err != nil {
+				return nil, fmt.Errorf("couldn't update the endpoints for %v: %w", upstream.Name, err)
+			}
+		}
+
+		result = append(result, VirtualServerEjectedPeers{
+			VirtualServer: vsEx.VirtualServer,
+			EjectedPeers:  generateEjectedPeers(cbConfigs, ejected),
+		})
+	}
+
+	return result, nil
+}
+
+// UpdateStreamHealthProbes probes the servers of the TransportServer upstreams with health checks enabled
+// and updates the configuration of the TransportServers when a server goes up or down.
+// The probes are used only for NGINX, as NGINX Plus performs the health checks itself.
+func (cnf *Configurator) UpdateStreamHealthProbes(ctx context.Context) error {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
+	if cnf.isPlus || !cnf.isReloadsEnabled {
+		return nil
+	}
+
+	configs := make(map[string]nginx.StreamProbeConfig)
+	servers := make(map[string][]string)
+	var probedTSExes []*TransportServerEx
+	for _, tsEx := range cnf.transportServers {
+		probeConfigs, probeServers := createStreamProbeConfigs(tsEx)
+		if len(probeConfigs) == 0 {
+			continue
+		}
+		probedTSExes = append(probedTSExes, tsEx)
+		maps.Copy(configs, probeConfigs)
+		maps.Copy(servers, probeServers)
+	}
+
+	if !cnf.streamProber.Probe(ctx, configs, servers, time.Now()) {
+		return nil
+	}
+
+	for _, tsEx := range probedTSExes {
+		if _, _, err := cnf.addOrUpdateTransportServer(tsEx); err != nil {
+			return fmt.Errorf("error updating TransportServer %v/%v: %w", tsEx.TransportServer.Namespace, tsEx.TransportServer.Name, err)
+		}
+	}
+
+	if err := cnf.reload(nginx.ReloadForOtherUpdate); err != nil {
+		return fmt.Errorf("error reloading NGINX for the health probes of TransportServers: %w", err)
+	}
+
+	return nil
+}
+
+// streamDownServers returns the function that returns the servers of a stream upstream marked down by the health probes.
+func (cnf *Configurator) streamDownServers() func(upstream string) map[string]bool {
+	if cnf.isPlus {
+		return nil
+	}
+	return cnf.streamProber.Down
+}
+
 // UpdateEndpointsForTransportServers updates endpoints in NGINX configuration for the TransportServer resources.
 func (cnf *Configurator) UpdateEndpointsForTransportServers(transportServerExes []*TransportServerEx) error {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	l := nl.LoggerFromContext(cnf.CfgParams.Context)
 	reloadPlus := false
 
@@ -1281,7 +1789,7 @@ func (cnf *Configurator) UpdateEndpointsForTransportServers(transportServerExes
 		nl.Debug(l, "No need to reload nginx")
 		return nil
 	}
-	if err := cnf.Reload(nginx.ReloadForEndpointsUpdate); err != nil {
+	if err := cnf.reload(nginx.ReloadForEndpointsUpdate); err != nil {
 		return fmt.Errorf("error reloading NGINX when updating endpoints: %w", err)
 	}
 	return nil
@@ -1360,21 +1868,100 @@ func (cnf *Configurator) updatePlusEndpoints(ingEx *IngressEx) error {
 
 // EnableReloads enables NGINX reloads meaning that configuration changes will be followed by a reload.
 func (cnf *Configurator) EnableReloads() {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
+	cnf.enableReloads()
+}
+
+func (cnf *Configurator) enableReloads() {
 	cnf.isReloadsEnabled = true
 }
 
 // DisableReloads disables NGINX reloads meaning that configuration changes will not be followed by a reload.
 func (cnf *Configurator) DisableReloads() {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	cnf.isReloadsEnabled = false
 }
 
-// Reload reloads nginx if reloads is enabled
+// Reload reloads nginx if reloads is enabled.
+// If reload coalescing is enabled, the reload is deferred until the pending reloads are flushed by FlushReloads
+// and Reload returns nil, so the changes are reported as applied before NGINX is reloaded.
+// The caller is responsible for reporting the resources of a failed flush.
 func (cnf *Configurator) Reload(isEndpointsUpdate bool) error {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
+	return cnf.reload(isEndpointsUpdate)
+}
+
+func (cnf *Configurator) reload(isEndpointsUpdate bool) error {
 	if !cnf.isReloadsEnabled {
 		return nil
 	}
 
-	return cnf.nginxManager.Reload(isEndpointsUpdate)
+	if cnf.reloadCoalescer != nil {
+		cnf.reloadCoalescer.Request(isEndpointsUpdate, time.Now())
+		return nil
+	}
+
+	if err := cnf.nginxManager.Reload(isEndpointsUpdate); err != nil {
+		return err
+	}
+	cnf.upsertMaintenanceKeyVals()
+	return nil
+}
+
+// upsertMaintenanceKeyVals upserts the maintenance mode toggles through the API once NGINX runs with the configuration
+// of their keyval zones. While the reloads are disabled or deferred, the toggles are kept until the next reload.
+func (cnf *Configurator) upsertMaintenanceKeyVals() {
+	if !cnf.isReloadsEnabled || (cnf.reloadCoalescer != nil && cnf.reloadCoalescer.Pending()) {
+		return
+	}
+
+	for _, update := range cnf.maintenanceKeyValUpdates {
+		cnf.nginxManager.UpsertSplitClientsKeyVal(update.Zone, update.Key, update.Value)
+	}
+	cnf.maintenanceKeyValUpdates = nil
+}
+
+// HasPendingReloads returns true if there are deferred reloads that are not flushed yet.
+func (cnf *Configurator) HasPendingReloads() bool {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
+	return cnf.reloadCoalescer != nil && cnf.reloadCoalescer.Pending()
+}
+
+// IsReloadDue returns true if reloads are enabled and the deferred reloads are due.
+func (cnf *Configurator) IsReloadDue() bool {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
+	return cnf.isReloadsEnabled && cnf.reloadCoalescer != nil && cnf.reloadCoalescer.Due(time.Now())
+}
+
+// FlushReloads reloads nginx once for the reloads coalesced since the previous reload if they are due.
+func (cnf *Configurator) FlushReloads() error {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
+	if !cnf.isReloadsEnabled || cnf.reloadCoalescer == nil {
+		return nil
+	}
+
+	isEndpointsUpdate, due := cnf.reloadCoalescer.Flush(time.Now())
+	if !due {
+		return nil
+	}
+
+	if err := cnf.nginxManager.Reload(isEndpointsUpdate); err != nil {
+		return err
+	}
+	cnf.upsertMaintenanceKeyVals()
+	return nil
 }
 
 func (cnf *Configurator) updateServersInPlus(upstream string, servers []string, config nginx.ServerConfig) error {
@@ -1397,6 +1984,9 @@ func (cnf *Configurator) updateStreamServersInPlus(upstream string, servers []st
 //
 //gocyclo:ignore
 func (cnf *Configurator) UpdateConfig(resources ExtendedResources) (Warnings, error) {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	allWarnings := newWarnings()
 	allWeightUpdates := []WeightUpdate{}
 
@@ -1408,6 +1998,8 @@ func (cnf *Configurator) UpdateConfig(resources ExtendedResources) (Warnings, er
 		cnf.CfgParams.MainServerSSLDHParam = fileName
 	}
 
+	cnf.CfgParams.DefaultErrorPageFiles = cnf.addOrUpdateDefaultErrorPages(cnf.CfgParams.DefaultErrorPages)
+
 	// Apply custom main-template defined in ConfigMap obj
 	if cnf.CfgParams.MainTemplate != nil {
 		err := cnf.templateExecutor.UpdateMainTemplate(cnf.CfgParams.MainTemplate)
@@ -1487,7 +2079,7 @@ func (cnf *Configurator) UpdateConfig(resources ExtendedResources) (Warnings, er
 		allWarnings.Add(warnings)
 	}
 
-	if err := cnf.Reload(nginx.ReloadForOtherUpdate); err != nil {
+	if err := cnf.reload(nginx.ReloadForOtherUpdate); err != nil {
 		return allWarnings, fmt.Errorf("error when updating config from ConfigMap: %w", err)
 	}
 
@@ -1500,10 +2092,13 @@ func (cnf *Configurator) UpdateConfig(resources ExtendedResources) (Warnings, er
 
 // ReloadForBatchUpdates reloads NGINX after a batch event.
 func (cnf *Configurator) ReloadForBatchUpdates(batchReloadsEnabled bool) error {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	if !batchReloadsEnabled {
 		return nil
 	}
-	if err := cnf.Reload(nginx.ReloadForOtherUpdate); err != nil {
+	if err := cnf.reload(nginx.ReloadForOtherUpdate); err != nil {
 		return fmt.Errorf("error when reloading NGINX after a batch event: %w", err)
 	}
 	return nil
@@ -1511,6 +2106,9 @@ func (cnf *Configurator) ReloadForBatchUpdates(batchReloadsEnabled bool) error {
 
 // UpdateVirtualServers updates VirtualServers.
 func (cnf *Configurator) UpdateVirtualServers(updatedVSExes []*VirtualServerEx, deletedKeys []string) []error {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	var errList []error
 	var allWeightUpdates []WeightUpdate
 	for _, vsEx := range updatedVSExes {
@@ -1522,13 +2120,13 @@ func (cnf *Configurator) UpdateVirtualServers(updatedVSExes []*VirtualServerEx,
 	}
 
 	for _, key := range deletedKeys {
-		err := cnf.DeleteVirtualServer(key, true)
+		err := cnf.deleteVirtualServer(key, true)
 		if err != nil {
 			errList = append(errList, fmt.Errorf("error when removing VirtualServer %v: %w", key, err))
 		}
 	}
 
-	if err := cnf.Reload(nginx.ReloadForOtherUpdate); err != nil {
+	if err := cnf.reload(nginx.ReloadForOtherUpdate); err != nil {
 		errList = append(errList, fmt.Errorf("error when updating VirtualServer: %w", err))
 	}
 
@@ -1541,6 +2139,9 @@ func (cnf *Configurator) UpdateVirtualServers(updatedVSExes []*VirtualServerEx,
 
 // UpdateTransportServers updates TransportServers.
 func (cnf *Configurator) UpdateTransportServers(updatedTSExes []*TransportServerEx, deletedKeys []string) []error {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	var errList []error
 	for _, tsEx := range updatedTSExes {
 		_, _, err := cnf.addOrUpdateTransportServer(tsEx)
@@ -1556,7 +2157,7 @@ func (cnf *Configurator) UpdateTransportServers(updatedTSExes []*TransportServer
 		}
 	}
 
-	if err := cnf.Reload(nginx.ReloadForOtherUpdate); err != nil {
+	if err := cnf.reload(nginx.ReloadForOtherUpdate); err != nil {
 		errList = append(errList, fmt.Errorf("error when updating TransportServers: %w", err))
 	}
 
@@ -1565,15 +2166,18 @@ func (cnf *Configurator) UpdateTransportServers(updatedTSExes []*TransportServer
 
 // BatchDeleteVirtualServers takes a list of VirtualServer resource keys, deletes their configuration, and reloads once
 func (cnf *Configurator) BatchDeleteVirtualServers(deletedKeys []string) []error {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	var errList []error
 	for _, key := range deletedKeys {
-		err := cnf.DeleteVirtualServer(key, true)
+		err := cnf.deleteVirtualServer(key, true)
 		if err != nil {
 			errList = append(errList, fmt.Errorf("error when removing VirtualServer %v: %w", key, err))
 		}
 	}
 
-	if err := cnf.Reload(nginx.ReloadForOtherUpdate); err != nil {
+	if err := cnf.reload(nginx.ReloadForOtherUpdate); err != nil {
 		errList = append(errList, fmt.Errorf("error when reloading NGINX for deleted VirtualServers: %w", err))
 	}
 
@@ -1582,15 +2186,18 @@ func (cnf *Configurator) BatchDeleteVirtualServers(deletedKeys []string) []error
 
 // BatchDeleteIngresses takes a list of Ingress resource keys, deletes their configuration, and reloads once
 func (cnf *Configurator) BatchDeleteIngresses(deletedKeys []string) []error {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	var errList []error
 	for _, key := range deletedKeys {
-		err := cnf.DeleteIngress(key, true)
+		err := cnf.deleteIngress(key, true)
 		if err != nil {
 			errList = append(errList, fmt.Errorf("error when removing Ingress %v: %w", key, err))
 		}
 	}
 
-	if err := cnf.Reload(nginx.ReloadForOtherUpdate); err != nil {
+	if err := cnf.reload(nginx.ReloadForOtherUpdate); err != nil {
 		errList = append(errList, fmt.Errorf("error when reloading NGINX for deleted Ingresses: %w", err))
 	}
 
@@ -1617,6 +2224,14 @@ func getFileNameForOIDCVirtualServer(virtualServer *conf_v1.VirtualServer) strin
 	return fmt.Sprintf("oidc_%s_%s", virtualServer.Namespace, virtualServer.Name)
 }
 
+func getFileNameForDefaultErrorPage(code int) string {
+	return fmt.Sprintf("default_error_page_%d.html", code)
+}
+
+func getFileNameForMaintenancePage(virtualServer *conf_v1.VirtualServer, pageKey string) string {
+	return fmt.Sprintf("maintenance_%s_%s_%s", virtualServer.Namespace, virtualServer.Name, strings.ReplaceAll(pageKey, "/", "_"))
+}
+
 func getFileNameForTransportServer(transportServer *conf_v1.TransportServer) string {
 	return fmt.Sprintf("ts_%s_%s", transportServer.Namespace, transportServer.Name)
 }
@@ -1633,6 +2248,9 @@ func getFileNameForTransportServerFromKey(key string) string {
 
 // HasIngress checks if the Ingress resource is present in NGINX configuration.
 func (cnf *Configurator) HasIngress(ing *networking.Ingress) bool {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	name := objectMetaToFileName(&ing.ObjectMeta)
 	_, exists := cnf.ingresses[name]
 	return exists
@@ -1640,6 +2258,9 @@ func (cnf *Configurator) HasIngress(ing *networking.Ingress) bool {
 
 // HasMinion checks if the minion Ingress resource of the master is present in NGINX configuration.
 func (cnf *Configurator) HasMinion(master *networking.Ingress, minion *networking.Ingress) bool {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	masterName := objectMetaToFileName(&master.ObjectMeta)
 
 	if _, exists := cnf.minions[masterName]; !exists {
@@ -1651,11 +2272,21 @@ func (cnf *Configurator) HasMinion(master *networking.Ingress, minion *networkin
 
 // IsResolverConfigured checks if a DNS resolver is present in NGINX configuration.
 func (cnf *Configurator) IsResolverConfigured() bool {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
+	return cnf.isResolverConfigured()
+}
+
+func (cnf *Configurator) isResolverConfigured() bool {
 	return len(cnf.CfgParams.ResolverAddresses) != 0
 }
 
 // GetIngressCounts returns the total count of Ingress resources that are handled by the Ingress Controller grouped by their type
 func (cnf *Configurator) GetIngressCounts() map[string]int {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	counters := map[string]int{
 		"master":  0,
 		"regular": 0,
@@ -1680,6 +2311,9 @@ func (cnf *Configurator) GetIngressCounts() map[string]int {
 
 // GetIngressAnnotations returns a list of annotation keys set across all Ingress resources
 func (cnf *Configurator) GetIngressAnnotations() []string {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	if cnf == nil || cnf.ingresses == nil {
 		return nil
 	}
@@ -1733,6 +2367,9 @@ func (cnf *Configurator) getMinionIngressAnnotations(annotationSet map[string]bo
 // GetVirtualServerCounts returns the total count of
 // VirtualServer and VirtualServerRoute resources that are handled by the Ingress Controller
 func (cnf *Configurator) GetVirtualServerCounts() (int, int) {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	vsCount := len(cnf.virtualServers)
 	vsrCount := 0
 	for _, vs := range cnf.virtualServers {
@@ -1744,11 +2381,17 @@ func (cnf *Configurator) GetVirtualServerCounts() (int, int) {
 // GetTransportServerCounts returns the total count of
 // TransportServer resources that are handled by the Ingress Controller
 func (cnf *Configurator) GetTransportServerCounts() (tsCount int) {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	return len(cnf.transportServers)
 }
 
 // AddOrUpdateSpiffeCerts writes Spiffe certs and keys to disk and reloads NGINX
 func (cnf *Configurator) AddOrUpdateSpiffeCerts(svidResponse *workloadapi.X509Context) error {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	svid := svidResponse.DefaultSVID()
 	trustDomain := svid.ID.TrustDomain()
 	caBundle, err := svidResponse.Bundles.GetX509BundleForTrustDomain(trustDomain)
@@ -1770,7 +2413,7 @@ func (cnf *Configurator) AddOrUpdateSpiffeCerts(svidResponse *workloadapi.X509Co
 	cnf.nginxManager.CreateSecret(spiffeCertFileName, pemCerts, spiffeCertsFileMode)
 	cnf.nginxManager.CreateSecret(spiffeBundleFileName, pemBundle, spiffeCertsFileMode)
 
-	err = cnf.Reload(nginx.ReloadForOtherUpdate)
+	err = cnf.reload(nginx.ReloadForOtherUpdate)
 	if err != nil {
 		return fmt.Errorf("error when reloading NGINX when updating the SPIFFE Certs: %w", err)
 	}
@@ -1910,12 +2553,19 @@ type ResourceOperation func(resource *v1beta1.DosProtectedResource, ingExes []*I
 
 // AddOrUpdateAppProtectResource updates Ingresses and VirtualServers that use App Protect or App Protect DoS resources.
 func (cnf *Configurator) AddOrUpdateAppProtectResource(resource *unstructured.Unstructured, ingExes []*IngressEx, mergeableIngresses []*MergeableIngresses, vsExes []*VirtualServerEx) (Warnings, error) {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
+	return cnf.addOrUpdateAppProtectResource(resource, ingExes, mergeableIngresses, vsExes)
+}
+
+func (cnf *Configurator) addOrUpdateAppProtectResource(resource *unstructured.Unstructured, ingExes []*IngressEx, mergeableIngresses []*MergeableIngresses, vsExes []*VirtualServerEx) (Warnings, error) {
 	warnings, err := cnf.addOrUpdateIngressesAndVirtualServers(ingExes, mergeableIngresses, vsExes)
 	if err != nil {
 		return warnings, fmt.Errorf("error when updating %v %v/%v: %w", resource.GetKind(), resource.GetNamespace(), resource.GetName(), err)
 	}
 
-	err = cnf.Reload(nginx.ReloadForOtherUpdate)
+	err = cnf.reload(nginx.ReloadForOtherUpdate)
 	if err != nil {
 		return warnings, fmt.Errorf("error when reloading NGINX when updating %v %v/%v: %w", resource.GetKind(), resource.GetNamespace(), resource.GetName(), err)
 	}
@@ -1925,12 +2575,15 @@ func (cnf *Configurator) AddOrUpdateAppProtectResource(resource *unstructured.Un
 
 // AddOrUpdateResourcesThatUseDosProtected updates Ingresses and VirtualServers that use DoS resources.
 func (cnf *Configurator) AddOrUpdateResourcesThatUseDosProtected(ingExes []*IngressEx, mergeableIngresses []*MergeableIngresses, vsExes []*VirtualServerEx) (Warnings, error) {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	warnings, err := cnf.addOrUpdateIngressesAndVirtualServers(ingExes, mergeableIngresses, vsExes)
 	if err != nil {
 		return warnings, fmt.Errorf("error when updating resources that use Dos: %w", err)
 	}
 
-	err = cnf.Reload(nginx.ReloadForOtherUpdate)
+	err = cnf.reload(nginx.ReloadForOtherUpdate)
 	if err != nil {
 		return warnings, fmt.Errorf("error when updating resources that use Dos: %w", err)
 	}
@@ -1976,10 +2629,13 @@ func (cnf *Configurator) addOrUpdateIngressesAndVirtualServers(ingExes []*Ingres
 
 // DeleteAppProtectPolicy updates Ingresses and VirtualServers that use AP Policy after that policy is deleted
 func (cnf *Configurator) DeleteAppProtectPolicy(resource *unstructured.Unstructured, ingExes []*IngressEx, mergeableIngresses []*MergeableIngresses, vsExes []*VirtualServerEx) (Warnings, error) {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	warnings := newWarnings()
 	var err error
 	if len(ingExes)+len(mergeableIngresses)+len(vsExes) > 0 {
-		warnings, err = cnf.AddOrUpdateAppProtectResource(resource, ingExes, mergeableIngresses, vsExes)
+		warnings, err = cnf.addOrUpdateAppProtectResource(resource, ingExes, mergeableIngresses, vsExes)
 	}
 	cnf.nginxManager.DeleteAppProtectResourceFile(appProtectPolicyFileNameFromUnstruct(resource))
 	return warnings, err
@@ -1987,10 +2643,13 @@ func (cnf *Configurator) DeleteAppProtectPolicy(resource *unstructured.Unstructu
 
 // DeleteAppProtectLogConf updates Ingresses and VirtualServers that use AP Log Configuration after that policy is deleted
 func (cnf *Configurator) DeleteAppProtectLogConf(resource *unstructured.Unstructured, ingExes []*IngressEx, mergeableIngresses []*MergeableIngresses, vsExes []*VirtualServerEx) (Warnings, error) {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	warnings := newWarnings()
 	var err error
 	if len(ingExes)+len(mergeableIngresses)+len(vsExes) > 0 {
-		warnings, err = cnf.AddOrUpdateAppProtectResource(resource, ingExes, mergeableIngresses, vsExes)
+		warnings, err = cnf.addOrUpdateAppProtectResource(resource, ingExes, mergeableIngresses, vsExes)
 	}
 	cnf.nginxManager.DeleteAppProtectResourceFile(appProtectLogConfFileNameFromUnstruct(resource))
 	return warnings, err
@@ -2000,6 +2659,9 @@ func (cnf *Configurator) DeleteAppProtectLogConf(resource *unstructured.Unstruct
 func (cnf *Configurator) RefreshAppProtectUserSigs(
 	userSigs []*unstructured.Unstructured, delPols []string, ingExes []*IngressEx, mergeableIngresses []*MergeableIngresses, vsExes []*VirtualServerEx,
 ) (Warnings, error) {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	allWarnings, err := cnf.addOrUpdateIngressesAndVirtualServers(ingExes, mergeableIngresses, vsExes)
 	if err != nil {
 		return allWarnings, err
@@ -2018,7 +2680,7 @@ func (cnf *Configurator) RefreshAppProtectUserSigs(
 		fmt.Fprintf(&builder, "app_protect_user_defined_signatures %s;\n", fName)
 	}
 	cnf.nginxManager.CreateAppProtectResourceFile(appProtectUserSigIndex, []byte(builder.String()))
-	return allWarnings, cnf.Reload(nginx.ReloadForOtherUpdate)
+	return allWarnings, cnf.reload(nginx.ReloadForOtherUpdate)
 }
 
 func appProtectDosPolicyFileName(namespace string, name string) string {
@@ -2035,21 +2697,33 @@ func appProtectDosAllowListFileName(namespace string, name string) string {
 
 // DeleteAppProtectDosPolicy updates Ingresses and VirtualServers that use AP Dos Policy after that policy is deleted
 func (cnf *Configurator) DeleteAppProtectDosPolicy(resource *unstructured.Unstructured) {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	cnf.nginxManager.DeleteAppProtectResourceFile(appProtectDosPolicyFileName(resource.GetNamespace(), resource.GetName()))
 }
 
 // DeleteAppProtectDosLogConf updates Ingresses and VirtualServers that use AP Log Configuration after that policy is deleted
 func (cnf *Configurator) DeleteAppProtectDosLogConf(resource *unstructured.Unstructured) {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	cnf.nginxManager.DeleteAppProtectResourceFile(appProtectDosLogConfFileName(resource.GetNamespace(), resource.GetName()))
 }
 
 // DeleteAppProtectDosAllowList updates Ingresses and VirtualServers that use AP Allow List Configuration after that policy is deleted
 func (cnf *Configurator) DeleteAppProtectDosAllowList(obj *v1beta1.DosProtectedResource) {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	cnf.nginxManager.DeleteAppProtectResourceFile(appProtectDosAllowListFileName(obj.Namespace, obj.Name))
 }
 
 // AddInternalRouteConfig adds internal route server to NGINX Configuration and reloads NGINX
 func (cnf *Configurator) AddInternalRouteConfig() error {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	cnf.staticCfgParams.EnableInternalRoutes = true
 	cnf.staticCfgParams.InternalRouteServerName = fmt.Sprintf("%s.%s.svc", os.Getenv("POD_SERVICEACCOUNT"), os.Getenv("POD_NAMESPACE"))
 	mainCfg := GenerateNginxMainConfig(cnf.staticCfgParams, cnf.CfgParams, cnf.MgmtCfgParams)
@@ -2058,7 +2732,7 @@ func (cnf *Configurator) AddInternalRouteConfig() error {
 		return fmt.Errorf("error when writing main Config: %w", err)
 	}
 	cnf.nginxManager.CreateMainConfig(mainCfgContent)
-	if err := cnf.Reload(nginx.ReloadForOtherUpdate); err != nil {
+	if err := cnf.reload(nginx.ReloadForOtherUpdate); err != nil {
 		return fmt.Errorf("error when reloading nginx: %w", err)
 	}
 	return nil
@@ -2066,12 +2740,15 @@ func (cnf *Configurator) AddInternalRouteConfig() error {
 
 // AddOrUpdateSecret adds or updates a secret.
 func (cnf *Configurator) AddOrUpdateSecret(secret *api_v1.Secret) string {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	switch secret.Type {
 	case secrets.SecretTypeCA:
 		name := objectMetaToFileName(&secret.ObjectMeta)
 		crtSecretName := fmt.Sprintf("%s-%s", name, CACrtKey)
 		crlSecretName := fmt.Sprintf("%s-%s", name, CACrlKey)
-		return cnf.AddOrUpdateCASecret(secret, crtSecretName, crlSecretName)
+		return cnf.addOrUpdateCASecret(secret, crtSecretName, crlSecretName)
 	case secrets.SecretTypeJWK:
 		return cnf.addOrUpdateJWKSecret(secret)
 	case secrets.SecretTypeHtpasswd:
@@ -2091,26 +2768,41 @@ func (cnf *Configurator) AddOrUpdateSecret(secret *api_v1.Secret) string {
 
 // DeleteSecret deletes a secret.
 func (cnf *Configurator) DeleteSecret(key string) {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	cnf.nginxManager.DeleteSecret(keyToFileName(key))
 }
 
 // DynamicSSLReloadEnabled is used to check if dynamic reloading of SSL certificates is enabled
 func (cnf *Configurator) DynamicSSLReloadEnabled() bool {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	return cnf.isDynamicSSLReloadEnabled
 }
 
 // UpsertSplitClientsKeyVal upserts a key-value pair in a keyzal zone for weight changes without reloads.
 func (cnf *Configurator) UpsertSplitClientsKeyVal(zoneName, key, value string) {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	cnf.nginxManager.UpsertSplitClientsKeyVal(zoneName, key, value)
 }
 
 // GetIngressControllerReplicas returns the number of ingresscontroller-replicas (previously stored via SetIngressControllerReplicas)
 func (cnf *Configurator) GetIngressControllerReplicas() int {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	return cnf.ingressControllerReplicas
 }
 
 // SetIngressControllerReplicas sets the number of ingresscontroller-replicas
 // Is used for calculating ratelimits
 func (cnf *Configurator) SetIngressControllerReplicas(replicas int) {
+	cnf.lock.Lock()
+	defer cnf.lock.Unlock()
+
 	cnf.ingressControllerReplicas = replicas
 }
@@ -4,8 +4,11 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"os"
 	"reflect"
+	"sync"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -115,6 +118,40 @@ func TestConfiguratorUpdatesConfigWithNilCustomMainTemplate(t *testing.T) {
 	}
 }
 
+func TestConfiguratorUpdatesConfigWithDefaultErrorPages(t *testing.T) {
+	t.Parallel()
+
+	cnf := createTestConfigurator(t)
+	cnf.CfgParams = &ConfigParams{
+		DefaultErrorPages: map[int]string{
+			404: "<html>Not Found</html>",
+			503: "<html>Service Unavailable</html>",
+		},
+	}
+	cnf.MgmtCfgParams = &MGMTConfigParams{}
+	_, err := cnf.UpdateConfig(ExtendedResources{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[int]string{
+		404: "/etc/nginx/secrets/default_error_page_404.html",
+		503: "/etc/nginx/secrets/default_error_page_503.html",
+	}
+	if !cmp.Equal(want, cnf.CfgParams.DefaultErrorPageFiles) {
+		t.Error(cmp.Diff(want, cnf.CfgParams.DefaultErrorPageFiles))
+	}
+
+	cnf.CfgParams = &ConfigParams{}
+	_, err = cnf.UpdateConfig(ExtendedResources{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cnf.CfgParams.DefaultErrorPageFiles) != 0 {
+		t.Errorf("Want no default error page files, got %v", cnf.CfgParams.DefaultErrorPageFiles)
+	}
+}
+
 func TestConfiguratorUpdatesConfigWithCustomMainTemplate(t *testing.T) {
 	t.Parallel()
 
@@ -304,6 +341,61 @@ func TestAddOrUpdateIngress(t *testing.T) {
 	}
 }
 
+func TestAddOrUpdateResourcesGeneratesConfigsConcurrently(t *testing.T) {
+	t.Parallel()
+	cnf := createTestConfigurator(t)
+	cnf.configWorkers = 4
+
+	var resources ExtendedResources
+	for i := range 3 {
+		ingEx := createCafeIngressEx()
+		ingEx.Ingress.Name = fmt.Sprintf("cafe-ingress-%d", i)
+		resources.IngressExes = append(resources.IngressExes, &ingEx)
+	}
+	for i := range 5 {
+		resources.VirtualServerExes = append(resources.VirtualServerExes, &VirtualServerEx{
+			VirtualServer: &conf_v1.VirtualServer{
+				ObjectMeta: meta_v1.ObjectMeta{Name: fmt.Sprintf("cafe-%d", i), Namespace: "default"},
+				Spec:       conf_v1.VirtualServerSpec{Host: fmt.Sprintf("cafe-%d.example.com", i)},
+			},
+		})
+	}
+
+	if _, err := cnf.AddOrUpdateResources(resources, true); err != nil {
+		t.Fatalf("AddOrUpdateResources() returned unexpected error: %v", err)
+	}
+
+	for _, ingEx := range resources.IngressExes {
+		if !cnf.HasIngress(ingEx.Ingress) {
+			t.Errorf("AddOrUpdateResources() didn't add the Ingress %v", ingEx.Ingress.Name)
+		}
+	}
+	for _, vsEx := range resources.VirtualServerExes {
+		if _, exists := cnf.virtualServers[getFileNameForVirtualServer(vsEx.VirtualServer)]; !exists {
+			t.Errorf("AddOrUpdateResources() didn't add the VirtualServer %v", vsEx.VirtualServer.Name)
+		}
+	}
+}
+
+func TestGenerateConcurrently(t *testing.T) {
+	t.Parallel()
+	for _, workers := range []int{0, 1, 3, 20} {
+		var lock sync.Mutex
+		calls := make(map[int]int)
+		generateConcurrently(10, workers, func(i int) {
+			lock.Lock()
+			defer lock.Unlock()
+			calls[i]++
+		})
+
+		for i := range 10 {
+			if calls[i] != 1 {
+				t.Errorf("generateConcurrently() with %d workers called the index %d %d times, want 1", workers, i, calls[i])
+			}
+		}
+	}
+}
+
 func TestAddOrUpdateMergeableIngress(t *testing.T) {
 	t.Parallel()
 	cnf := createTestConfigurator(t)
@@ -513,6 +605,59 @@ func TestGenerateTLSPassthroughHostsConfig(t *testing.T) {
 	}
 }
 
+func TestGenerateSharedLimitReqZonesConfig(t *testing.T) {
+	t.Parallel()
+	sharedLimitReqZones := map[string][]version2.LimitReqZone{
+		"vs_default_cafe": {
+			{ZoneName: "pol_rl_default_tenant_b", Key: "${binary_remote_addr}", ZoneSize: "10M", Rate: "20r/s"},
+			{ZoneName: "pol_rl_default_tenant_a", Key: "${binary_remote_addr}", ZoneSize: "10M", Rate: "10r/s"},
+		},
+		"default-cafe-ingress": {
+			{ZoneName: "pol_rl_default_tenant_a", Key: "${binary_remote_addr}", ZoneSize: "10M", Rate: "10r/s"},
+		},
+	}
+
+	expectedCfg := &version2.SharedLimitReqZonesConfig{
+		{ZoneName: "pol_rl_default_tenant_a", Key: "${binary_remote_addr}", ZoneSize: "10M", Rate: "10r/s"},
+		{ZoneName: "pol_rl_default_tenant_b", Key: "${binary_remote_addr}", ZoneSize: "10M", Rate: "20r/s"},
+	}
+
+	resultCfg := generateSharedLimitReqZonesConfig(sharedLimitReqZones)
+	if diff := cmp.Diff(expectedCfg, resultCfg); diff != "" {
+		t.Errorf("generateSharedLimitReqZonesConfig() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestUpdateSharedLimitReqZones(t *testing.T) {
+	t.Parallel()
+	cnf := createTestConfigurator(t)
+	zones := []version2.LimitReqZone{
+		{ZoneName: "pol_rl_default_tenant_a", Key: "${binary_remote_addr}", ZoneSize: "10M", Rate: "10r/s"},
+	}
+
+	if _, err := cnf.updateSharedLimitReqZones("vs_default_cafe", zones); err != nil {
+		t.Fatalf("updateSharedLimitReqZones() returned an unexpected error: %v", err)
+	}
+	if _, exists := cnf.sharedLimitReqZones["vs_default_cafe"]; !exists {
+		t.Errorf("updateSharedLimitReqZones() didn't store the zones of the resource")
+	}
+
+	changed, err := cnf.updateSharedLimitReqZones("vs_default_tea", nil)
+	if err != nil {
+		t.Fatalf("updateSharedLimitReqZones() returned an unexpected error: %v", err)
+	}
+	if changed {
+		t.Errorf("updateSharedLimitReqZones() returned true for a resource without shared zones")
+	}
+
+	if _, err := cnf.updateSharedLimitReqZones("vs_default_cafe", nil); err != nil {
+		t.Fatalf("updateSharedLimitReqZones() returned an unexpected error: %v", err)
+	}
+	if len(cnf.sharedLimitReqZones) != 0 {
+		t.Errorf("updateSharedLimitReqZones() didn't remove the zones of the resource: %v", cnf.sharedLimitReqZones)
+	}
+}
+
 func TestAddInternalRouteConfig(t *testing.T) {
 	t.Parallel()
 	cnf := createTestConfigurator(t)
@@ -1609,6 +1754,22 @@ func TestUpstreamsForHost_ReturnsUpstreamsNamesForValidHostname(t *testing.T) {
 	}
 }
 
+func TestUpstreamsForHost_ReturnsUpstreamsNamesForServerAlias(t *testing.T) {
+	t.Parallel()
+	tcnf := createTestConfigurator(t)
+	vsEx := *validVirtualServerExWithUpstreams
+	vsEx.ServerAliases = []string{"www.tea.example.com"}
+	tcnf.virtualServers = map[string]*VirtualServerEx{
+		"vs": &vsEx,
+	}
+
+	want := []string{"vs_default_test-vs_tea-app"}
+	got := tcnf.UpstreamsForHost("www.tea.example.com")
+	if !cmp.Equal(want, got) {
+		t.Error(cmp.Diff(want, got))
+	}
+}
+
 func TestStreamUpstreamsForName_DoesNotReturnUpstreamsForBogusName(t *testing.T) {
 	t.Parallel()
 
@@ -1638,6 +1799,32 @@ func TestStreamUpstreamsForName_ReturnsStreamUpstreamsNamesOnValidServiceName(t
 	}
 }
 
+func TestStreamUpstreamsForName_ReturnsStreamUpstreamsNamesOnSplitServiceName(t *testing.T) {
+	t.Parallel()
+
+	tsEx := *validTransportServerExWithUpstreams
+	ts := tsEx.TransportServer.DeepCopy()
+	ts.Spec.Upstreams = append(ts.Spec.Upstreams, conf_v1.TransportServerUpstream{Name: "canary", Service: "canary-svc", Port: 5001})
+	ts.Spec.Action = &conf_v1.TransportServerAction{
+		Splits: []conf_v1.TransportServerSplit{
+			{Weight: 90, Pass: "secure-app"},
+			{Weight: 10, Pass: "canary"},
+		},
+	}
+	tsEx.TransportServer = ts
+
+	tcnf := createTestConfigurator(t)
+	tcnf.transportServers = map[string]*TransportServerEx{
+		"ts": &tsEx,
+	}
+
+	want := []string{"ts_default_secure-app_secure-app", "ts_default_secure-app_canary"}
+	got := tcnf.StreamUpstreamsForName("canary")
+	if !cmp.Equal(want, got) {
+		t.Error(cmp.Diff(want, got))
+	}
+}
+
 func TestGetIngressAnnotations(t *testing.T) {
 	t.Parallel()
 
@@ -3458,3 +3645,95 @@ server {
     {{- end }}
 }`
 )
+
+type invalidConfigManager struct {
+	*nginx.FakeManager
+	validated []string
+}
+
+func (m *invalidConfigManager) ValidateConfig(name string, _ []byte) error {
+	m.validated = append(m.validated, name)
+	return errors.New("invalid config")
+}
+
+func TestValidateVirtualServerTestsConfigWithNginx(t *testing.T) {
+	t.Parallel()
+	cnf := createTestConfigurator(t)
+
+	manager := &invalidConfigManager{FakeManager: nginx.NewFakeManager("/etc/nginx")}
+	cnf.nginxManager = manager
+
+	vsEx := &VirtualServerEx{
+		VirtualServer: &conf_v1.VirtualServer{
+			ObjectMeta: meta_v1.ObjectMeta{
+				Name:      "cafe",
+				Namespace: "default",
+			},
+			Spec: conf_v1.VirtualServerSpec{
+				Host: "cafe.example.com",
+			},
+		},
+	}
+
+	if _, err := cnf.ValidateVirtualServer(vsEx); err == nil {
+		t.Error("ValidateVirtualServer() returned no error for a config rejected by NGINX")
+	}
+	if diff := cmp.Diff([]string{"vs_default_cafe"}, manager.validated); diff != "" {
+		t.Errorf("ValidateVirtualServer() validated unexpected configs (-want +got):\n%s", diff)
+	}
+	if _, exists := cnf.virtualServers["default/cafe"]; exists {
+		t.Error("ValidateVirtualServer() applied the config of the VirtualServer")
+	}
+}
+
+type keyValRecordingManager struct {
+	*nginx.FakeManager
+	upserted []string
+}
+
+func (m *keyValRecordingManager) UpsertSplitClientsKeyVal(_ string, key string, value string) {
+	m.upserted = append(m.upserted, key+"="+value)
+}
+
+func TestAddOrUpdateVirtualServerDefersMaintenanceKeyValsWhileReloadsAreDisabled(t *testing.T) {
+	t.Parallel()
+	cnf := createTestConfigurator(t)
+	cnf.isPlus = true
+	cnf.isReloadsEnabled = false
+
+	manager := &keyValRecordingManager{FakeManager: nginx.NewFakeManager("/etc/nginx")}
+	cnf.nginxManager = manager
+
+	vsEx := &VirtualServerEx{
+		VirtualServer: &conf_v1.VirtualServer{
+			ObjectMeta: meta_v1.ObjectMeta{
+				Name:      "cafe",
+				Namespace: "default",
+			},
+			Spec: conf_v1.VirtualServerSpec{
+				Host:        "cafe.example.com",
+				Maintenance: &conf_v1.Maintenance{Enable: true},
+			},
+		},
+	}
+
+	if _, err := cnf.AddOrUpdateVirtualServer(vsEx); err != nil {
+		t.Fatalf("AddOrUpdateVirtualServer() returned unexpected error: %v", err)
+	}
+	if cnf.isReloadsEnabled {
+		t.Error("AddOrUpdateVirtualServer() enabled the reloads for a VirtualServer with maintenance mode")
+	}
+	if len(manager.upserted) != 0 {
+		t.Errorf("AddOrUpdateVirtualServer() upserted %v while the reloads are disabled", manager.upserted)
+	}
+
+	cnf.EnableReloads()
+	if err := cnf.ReloadForBatchUpdates(true); err != nil {
+		t.Fatalf("ReloadForBatchUpdates() returned unexpected error: %v", err)
+	}
+
+	expected := []string{`"vs_default_cafe_keyval_key_maintenance_server"=1`}
+	if diff := cmp.Diff(expected, manager.upserted); diff != "" {
+		t.Errorf("ReloadForBatchUpdates() upserted unexpected maintenance keyvals (-want +got):\n%s", diff)
+	}
+}
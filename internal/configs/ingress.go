@@ -2,6 +2,7 @@ package configs
 
 import (
 	"fmt"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -52,6 +53,7 @@ type IngressEx struct {
 	DosEx            *DosEx
 	SecretRefs       map[string]*secrets.SecretReference
 	ZoneSync         bool
+	Canaries         []*IngressEx
 }
 
 // DosEx holds a DosProtectedResource and the dos policy and log confs it references.
@@ -108,6 +110,7 @@ func generateNginxCfg(ncp NginxCfgParams) (version1.IngressNginxConfig, Warnings
 	wsServices := getWebsocketServices(ncp.ingEx)
 	spServices := getSessionPersistenceServices(ncp.BaseCfgParams.Context, ncp.ingEx)
 	rewrites := getRewrites(ncp.BaseCfgParams.Context, ncp.ingEx)
+	pathRegexes := getPathRegexMap(ncp.BaseCfgParams.Context, ncp.ingEx)
 	rewriteTarget, rewriteTargetWarnings := getRewriteTarget(ncp.BaseCfgParams.Context, ncp.ingEx)
 	sslServices := getSSLServices(ncp.ingEx)
 	grpcServices := getGrpcServices(ncp.ingEx)
@@ -137,6 +140,10 @@ func generateNginxCfg(ncp NginxCfgParams) (version1.IngressNginxConfig, Warnings
 	allWarnings := newWarnings()
 	allWarnings.Add(rewriteTargetWarnings)
 
+	if len(ncp.ingEx.Canaries) > 0 {
+		addCanaryUpstreams(upstreams, ncp)
+	}
+
 	// Check for deprecated SSL redirect annotation and add warning
 	if _, exists := ncp.ingEx.Ingress.Annotations["ingress.kubernetes.io/ssl-redirect"]; exists {
 		allWarnings.AddWarningf(ncp.ingEx.Ingress, "The annotation 'ingress.kubernetes.io/ssl-redirect' is deprecated and will be removed. Please use 'nginx.org/ssl-redirect' instead.")
@@ -145,6 +152,8 @@ func generateNginxCfg(ncp NginxCfgParams) (version1.IngressNginxConfig, Warnings
 	var servers []version1.Server
 	var limitReqZones []version1.LimitReqZone
 	var maps []version2.Map
+	var splitClients []version2.SplitClient
+	canaryIndex := 0
 
 	// Run generate Policies
 	var policyRefs []conf_v1.PolicyReference
@@ -193,6 +202,11 @@ func generateNginxCfg(ncp NginxCfgParams) (version1.IngressNginxConfig, Warnings
 		maps = append(maps, *policyCfg.CORSMap)
 	}
 
+	sharedLimitReq, sharedLimitReqZones := generateIngressSharedLimitReq(policyCfg.RateLimit)
+	if sharedLimitReq != nil && cfgParams.LimitReqRate != "" {
+		allWarnings.AddWarning(ncp.ingEx.Ingress, "The nginx.org/limit-req-* annotations are ignored because the Ingress references a shared rate limit policy")
+	}
+
 	for _, rule := range ncp.ingEx.Ingress.Spec.Rules {
 		// skipping invalid hosts
 		if !ncp.ingEx.ValidHosts[rule.Host] {
@@ -241,6 +255,7 @@ func generateNginxCfg(ncp NginxCfgParams) (version1.IngressNginxConfig, Warnings
 			AppRoot:                cfgParams.AppRoot,
 			Allow:                  policyCfg.Allow,
 			Deny:                   policyCfg.Deny,
+			RequestID:              generateRequestID(cfgParams.RequestID, requestIDIncomingVariable),
 		}
 
 		warnings := addSSLConfig(&server, ncp.ingEx.Ingress, rule.Host, ncp.ingEx.Ingress.Spec.TLS, ncp.ingEx.SecretRefs, ncp.isWildcardEnabled)
@@ -324,6 +339,20 @@ func generateNginxCfg(ncp NginxCfgParams) (version1.IngressNginxConfig, Warnings
 			loc := createLocation(pathOrDefault(path.Path), upstreams[upsName], &cfgParams, wsServices[path.Backend.Service.Name], rewrites[path.Backend.Service.Name],
 				ssl, grpcServices[path.Backend.Service.Name], proxySSLName, path.PathType, path.Backend.Service.Name, rewriteTarget)
 
+			if path.PathType != nil && *path.PathType == networking.PathTypeImplementationSpecific {
+				loc.PathRegex = pathRegexes[path.Path]
+			}
+
+			if len(ncp.ingEx.Canaries) > 0 {
+				canarySplitClients, canaryMaps, routed, warnings := generateCanaryRouting(ncp, &loc, rule.Host, canaryIndex)
+				if routed {
+					splitClients = append(splitClients, canarySplitClients...)
+					maps = append(maps, canaryMaps...)
+					canaryIndex++
+				}
+				allWarnings.Add(warnings)
+			}
+
 			if ncp.isMinion {
 				if cfgParams.JWTKey != "" {
 					jwtAuth, redirectLoc, warnings := generateJWTConfig(ncp.ingEx.Ingress, ncp.ingEx.SecretRefs, &cfgParams, getNameForRedirectLocation(ncp.ingEx.Ingress))
@@ -354,8 +383,11 @@ func generateNginxCfg(ncp NginxCfgParams) (version1.IngressNginxConfig, Warnings
 				loc.AddHeaders = append(loc.AddHeaders, policyCfg.CORSHeaders...)
 				loc.CORSEnabled = true
 			}
+			addHeadersCfgToIngressLocation(policyCfg.Headers, &loc, &server)
 
-			if cfgParams.LimitReqRate != "" {
+			if sharedLimitReq != nil {
+				loc.LimitReq = sharedLimitReq
+			} else if cfgParams.LimitReqRate != "" {
 				zoneName := ncp.ingEx.Ingress.Namespace + "/" + ncp.ingEx.Ingress.Name
 				if ncp.ingEx.ZoneSync {
 					zoneName = fmt.Sprintf("%v_sync", zoneName)
@@ -409,6 +441,7 @@ func generateNginxCfg(ncp NginxCfgParams) (version1.IngressNginxConfig, Warnings
 				loc.AddHeaders = append(loc.AddHeaders, policyCfg.CORSHeaders...)
 				loc.CORSEnabled = true
 			}
+			addHeadersCfgToIngressLocation(policyCfg.Headers, &loc, &server)
 			locations = append(locations, loc)
 
 			if cfgParams.HealthCheckEnabled {
@@ -422,6 +455,10 @@ func generateNginxCfg(ncp NginxCfgParams) (version1.IngressNginxConfig, Warnings
 			}
 		}
 
+		if !rootLocation && ncp.ingEx.Ingress.Spec.DefaultBackend == nil {
+			server.DefaultBackendAddress = cfgParams.DefaultBackendAddress
+		}
+
 		server.Locations = locations
 		server.HealthChecks = healthChecks
 		server.GRPCOnly = grpcOnly
@@ -429,6 +466,8 @@ func generateNginxCfg(ncp NginxCfgParams) (version1.IngressNginxConfig, Warnings
 		servers = append(servers, server)
 	}
 
+	addWarningsForShadowedRegexPaths(allWarnings, ncp.ingEx.Ingress, pathRegexes)
+
 	var keepalive string
 	if cfgParams.Keepalive > 0 {
 		keepalive = fmt.Sprint(cfgParams.Keepalive)
@@ -448,10 +487,171 @@ func generateNginxCfg(ncp NginxCfgParams) (version1.IngressNginxConfig, Warnings
 		DynamicSSLReloadEnabled: ncp.staticParams.DynamicSSLReload,
 		StaticSSLPath:           ncp.staticParams.StaticSSLPath,
 		LimitReqZones:           limitReqZones,
+		SharedLimitReqZones:     sharedLimitReqZones,
 		Maps:                    removeDuplicateMaps(maps),
+		SplitClients:            splitClients,
 	}, allWarnings
 }
 
+// generateIngressSharedLimitReq generates the rate limit of the first shared rate limit policy that the Ingress references
+// along with the shared zone of the policy. The other rate limit policies are not supported for Ingresses.
+func generateIngressSharedLimitReq(rl rateLimit) (*version1.LimitReq, []version2.LimitReqZone) {
+	for _, zone := range rl.SharedZones {
+		for _, req := range rl.Reqs {
+			if req.ZoneName != zone.ZoneName {
+				continue
+			}
+			return &version1.LimitReq{
+				Zone:       req.ZoneName,
+				Burst:      req.Burst,
+				Delay:      req.Delay,
+				NoDelay:    req.NoDelay,
+				RejectCode: rl.Options.RejectCode,
+				DryRun:     rl.Options.DryRun,
+				LogLevel:   rl.Options.LogLevel,
+			}, []version2.LimitReqZone{zone}
+		}
+	}
+	return nil, nil
+}
+
+// addCanaryUpstreams adds the upstreams for the backends of the canary Ingresses on the valid hosts of the primary Ingress.
+// The upstreams are configured from the annotations of the canary Ingress.
+func addCanaryUpstreams(upstreams map[string]version1.Upstream, ncp NginxCfgParams) {
+	for _, canaryEx := range ncp.ingEx.Canaries {
+		canaryCfgParams := parseAnnotations(canaryEx, ncp.BaseCfgParams, ncp.isPlus, ncp.staticParams.MainAppProtectLoadModule, ncp.staticParams.MainAppProtectDosLoadModule,
+			ncp.staticParams.EnableInternalRoutes, ncp.staticParams.IsDirectiveAutoadjustEnabled)
+		spServices := getSessionPersistenceServices(ncp.BaseCfgParams.Context, canaryEx)
+
+		for _, rule := range canaryEx.Ingress.Spec.Rules {
+			if !ncp.ingEx.ValidHosts[rule.Host] || rule.HTTP == nil {
+				continue
+			}
+
+			for i := range rule.HTTP.Paths {
+				backend := &rule.HTTP.Paths[i].Backend
+				if backend.Service == nil {
+					continue
+				}
+
+				name := getNameForUpstream(canaryEx.Ingress, rule.Host, backend)
+				if _, exists := upstreams[name]; !exists {
+					upstreams[name] = createUpstream(canaryEx, name, backend, spServices[backend.Service.Name], &canaryCfgParams, ncp.isPlus, ncp.isResolverConfigured,
+						ncp.staticParams.EnableLatencyMetrics)
+				}
+			}
+		}
+	}
+}
+
+// findCanaryBackend returns the first canary Ingress with a backend for the host and path, together with that backend.
+func findCanaryBackend(canaries []*IngressEx, host string, path string) (*IngressEx, *networking.IngressBackend) {
+	for _, canaryEx := range canaries {
+		for _, rule := range canaryEx.Ingress.Spec.Rules {
+			if rule.Host != host || rule.HTTP == nil {
+				continue
+			}
+
+			for i := range rule.HTTP.Paths {
+				p := &rule.HTTP.Paths[i]
+				if pathOrDefault(p.Path) == path && p.Backend.Service != nil {
+					return canaryEx, &p.Backend
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// generateCanaryRouting routes the requests of the location between the primary upstream and the upstream of the canary
+// Ingress for the same host and path. A matching canary header takes precedence over the canary cookie, which takes
+// precedence over the canary weight.
+func generateCanaryRouting(ncp NginxCfgParams, loc *version1.Location, host string, index int) ([]version2.SplitClient, []version2.Map, bool, Warnings) {
+	warnings := newWarnings()
+
+	canaryEx, backend := findCanaryBackend(ncp.ingEx.Canaries, host, loc.Path)
+	if canaryEx == nil {
+		return nil, nil, false, warnings
+	}
+
+	if loc.Rewrite != "" {
+		warnings.AddWarningf(canaryEx.Ingress, "canary for path %s of host %s is ignored: the primary Ingress %s/%s rewrites the path", loc.Path, host,
+			ncp.ingEx.Ingress.Namespace, ncp.ingEx.Ingress.Name)
+		return nil, nil, false, warnings
+	}
+
+	cfg := getCanaryConfig(ncp.BaseCfgParams.Context, canaryEx)
+	primaryUpstream := loc.Upstream.Name
+	canaryUpstream := getNameForUpstream(canaryEx.Ingress, host, backend)
+	variable := strings.NewReplacer("-", "_", ".", "_").Replace(fmt.Sprintf("$canary_%s_%s_%d", ncp.ingEx.Ingress.Namespace, ncp.ingEx.Ingress.Name, index))
+
+	var splitClients []version2.SplitClient
+	var maps []version2.Map
+	result := primaryUpstream
+
+	if cfg.Weight >= 100 {
+		result = canaryUpstream
+	} else if cfg.Weight > 0 {
+		weightVariable := variable + "_weight"
+		splitClients = append(splitClients, version2.SplitClient{
+			Source:   "$request_id",
+			Variable: weightVariable,
+			Distributions: []version2.Distribution{
+				{Weight: fmt.Sprintf("%d%%", cfg.Weight), Value: canaryUpstream},
+				{Weight: "*", Value: primaryUpstream},
+			},
+		})
+		result = weightVariable
+	}
+
+	if cfg.Cookie != "" {
+		cookieVariable := variable + "_cookie"
+		maps = append(maps, version2.Map{
+			Source:   "$cookie_" + cfg.Cookie,
+			Variable: cookieVariable,
+			Parameters: []version2.Parameter{
+				{Value: "always", Result: canaryUpstream},
+				{Value: "never", Result: primaryUpstream},
+				{Value: "default", Result: result},
+			},
+		})
+		result = cookieVariable
+	}
+
+	if cfg.Header != "" {
+		headerVariable := variable + "_header"
+		var params []version2.Parameter
+		if cfg.HeaderValue != "" {
+			params = append(params, version2.Parameter{Value: generateCanaryHeaderMapValue(cfg.HeaderValue), Result: canaryUpstream})
+		} else {
+			params = append(params,
+				version2.Parameter{Value: "always", Result: canaryUpstream},
+				version2.Parameter{Value: "never", Result: primaryUpstream},
+			)
+		}
+		params = append(params, version2.Parameter{Value: "default", Result: result})
+
+		maps = append(maps, version2.Map{
+			Source:     "$http_" + strings.ReplaceAll(strings.ToLower(cfg.Header), "-", "_"),
+			Variable:   headerVariable,
+			Parameters: params,
+		})
+		result = headerVariable
+	}
+
+	loc.UpstreamVariable = result
+
+	return splitClients, maps, true, warnings
+}
+
+func generateCanaryHeaderMapValue(value string) string {
+	if specialMapParameters[value] {
+		return `\` + value
+	}
+	return fmt.Sprintf(`"%s"`, value)
+}
+
 func generateJWTConfig(
 	owner runtime.Object,
 	secretRefs map[string]*secrets.SecretReference,
@@ -569,6 +769,56 @@ func addSSLConfig(server *version1.Server, owner runtime.Object, host string, in
 	return warnings
 }
 
+// addIngressHeadersConfig adds the Headers policies of an Ingress in the order of their keys.
+func (p *policiesCfg) addIngressHeadersConfig(ingPolicies map[string]*conf_v1.Policy) {
+	keys := make([]string, 0, len(ingPolicies))
+	for key := range ingPolicies {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if ingPolicies[key].Spec.Headers != nil {
+			p.addHeadersConfig(ingPolicies[key].Spec.Headers)
+		}
+	}
+}
+
+// addHeadersCfgToIngressLocation adds the headers of the Headers policies to a location of an Ingress.
+// The headers already configured for the location take precedence.
+func addHeadersCfgToIngressLocation(cfg headersCfg, loc *version1.Location, server *version1.Server) {
+	for _, h := range cfg.Set {
+		if !hasHeader(loc.ProxySetHeaders, h.Name) && !hasHeader(loc.PolicyProxySetHeaders, h.Name) {
+			loc.PolicyProxySetHeaders = append(loc.PolicyProxySetHeaders, h)
+		}
+	}
+
+	// the proxy_hide_header and proxy_pass_header directives of a location replace the ones of the server
+	if len(cfg.Hide) > 0 && len(loc.ProxyHideHeaders) == 0 {
+		loc.ProxyHideHeaders = append(loc.ProxyHideHeaders, server.ProxyHideHeaders...)
+	}
+	for _, h := range cfg.Hide {
+		if !containsHeaderName(loc.ProxyPassHeaders, h) && !containsHeaderName(loc.ProxyHideHeaders, h) {
+			loc.ProxyHideHeaders = append(loc.ProxyHideHeaders, h)
+		}
+	}
+
+	if len(cfg.Pass) > 0 && len(loc.ProxyPassHeaders) == 0 {
+		loc.ProxyPassHeaders = append(loc.ProxyPassHeaders, server.ProxyPassHeaders...)
+	}
+	for _, h := range cfg.Pass {
+		if !containsHeaderName(loc.ProxyHideHeaders, h) && !containsHeaderName(loc.ProxyPassHeaders, h) {
+			loc.ProxyPassHeaders = append(loc.ProxyPassHeaders, h)
+		}
+	}
+
+	for _, h := range cfg.Add {
+		if !hasAddHeader(loc.AddHeaders, h.Name) {
+			loc.AddHeaders = append(loc.AddHeaders, h)
+		}
+	}
+}
+
 func generateIngressPath(path string, pathType *networking.PathType) string {
 	if pathType == nil {
 		return path
@@ -700,6 +950,91 @@ func headersToString(headers []api_v1.HTTPHeader) map[string]string {
 	return m
 }
 
+// addWarningsForShadowedRegexPaths adds warnings for the regex paths of the Ingress shadowed by a preceding regex path of the same rule.
+// Like the regex routes of a VirtualServer, the regex locations of a rule are generated and matched by NGINX in the order of the paths,
+// so a shadowed path never matches a request. The order of the regex locations of different Minions of a host is not checked.
+func addWarningsForShadowedRegexPaths(warnings Warnings, ing *networking.Ingress, pathRegexes map[string]string) {
+	defaultRegex := ing.Annotations[PathRegexAnnotation]
+
+	for _, r := range ing.Spec.Rules {
+		if r.HTTP == nil {
+			continue
+		}
+
+		var regexPaths []string
+		regexTypes := make(map[string]string)
+
+		for _, path := range r.HTTP.Paths {
+			regexType := defaultRegex
+			if path.PathType != nil && *path.PathType == networking.PathTypeImplementationSpecific {
+				if pathRegex, exists := pathRegexes[path.Path]; exists {
+					regexType = pathRegex
+				}
+			}
+
+			if regexType != "case_sensitive" && regexType != "case_insensitive" {
+				continue
+			}
+
+			for _, prev := range regexPaths {
+				if isRegexPathShadowed(path.Path, regexType, prev, regexTypes[prev]) {
+					warnings.AddWarningf(ing, "regex path %s of host %s is shadowed by the preceding regex path %s", path.Path, r.Host, prev)
+					break
+				}
+			}
+
+			regexPaths = append(regexPaths, path.Path)
+			regexTypes[path.Path] = regexType
+		}
+	}
+}
+
+// isRegexPathShadowed checks if every request matched by the regex location of the path is matched
+// by the regex location of the preceding path. The check is conservative: it only detects preceding paths
+// that are the same regex or that consist of a literal prefix, optionally followed by '.*'.
+func isRegexPathShadowed(path string, regexType string, prevPath string, prevRegexType string) bool {
+	if path == prevPath && regexType == prevRegexType {
+		return true
+	}
+
+	// a case-sensitive location does not match all the requests of a case-insensitive one
+	if regexType == "case_insensitive" && prevRegexType == "case_sensitive" {
+		return false
+	}
+
+	prevPrefix, ok := getLiteralRegexPrefix(prevPath)
+	if !ok {
+		return false
+	}
+
+	re, err := regexp.Compile(path)
+	if err != nil {
+		return false
+	}
+	prefix, _ := re.LiteralPrefix()
+
+	if prevRegexType == "case_insensitive" {
+		return strings.HasPrefix(strings.ToLower(prefix), strings.ToLower(prevPrefix))
+	}
+	return strings.HasPrefix(prefix, prevPrefix)
+}
+
+// getLiteralRegexPrefix returns the literal prefix of the regex path, if the path matches every request starting with it.
+func getLiteralRegexPrefix(path string) (string, bool) {
+	for _, suffix := range []string{"(.*)", ".*"} {
+		if trimmed, found := strings.CutSuffix(path, suffix); found {
+			path = trimmed
+			break
+		}
+	}
+
+	re, err := regexp.Compile(path)
+	if err != nil {
+		return "", false
+	}
+	return re.LiteralPrefix()
+}
+
 func pathOrDefault(path string) string {
 	if path == "" {
 		return "/"
@@ -742,6 +1077,7 @@ func generateNginxCfgForMergeableIngresses(ncp NginxCfgParams) (version1.Ingress
 	var upstreams []version1.Upstream
 	healthChecks := make(map[string]version1.HealthCheck)
 	var limitReqZones []version1.LimitReqZone
+	var sharedLimitReqZones []version2.LimitReqZone
 	var maps []version2.Map
 	var keepalive string
 
@@ -779,6 +1115,7 @@ func generateNginxCfgForMergeableIngresses(ncp NginxCfgParams) (version1.Ingress
 	masterServer = masterNginxCfg.Servers[0]
 	masterServer.Locations = []version1.Location{}
 	masterPolicyCfg := policiesCfg{CORSHeaders: masterNginxCfg.CORSHeaders}
+	masterPolicyCfg.addIngressHeadersConfig(ncp.mergeableIngs.Master.Policies)
 
 	upstreams = append(upstreams, masterNginxCfg.Upstreams...)
 	maps = append(maps, masterNginxCfg.Maps...)
@@ -838,6 +1175,8 @@ func generateNginxCfgForMergeableIngresses(ncp NginxCfgParams) (version1.Ingress
 					loc.AddHeaders = append(loc.AddHeaders, masterPolicyCfg.CORSHeaders...)
 					loc.CORSEnabled = true
 				}
+				// Mergeable mode fallback: master headers apply unless the minion location sets the same headers.
+				addHeadersCfgToIngressLocation(masterPolicyCfg.Headers, &loc, &masterServer)
 				loc.MinionIngress = &minionNginxCfg.Ingress
 				locations = append(locations, loc)
 			}
@@ -849,11 +1188,16 @@ func generateNginxCfgForMergeableIngresses(ncp NginxCfgParams) (version1.Ingress
 
 		upstreams = append(upstreams, minionNginxCfg.Upstreams...)
 		limitReqZones = append(limitReqZones, minionNginxCfg.LimitReqZones...)
+		sharedLimitReqZones = append(sharedLimitReqZones, minionNginxCfg.SharedLimitReqZones...)
 		maps = append(maps, minionNginxCfg.Maps...)
 	}
 
 	masterServer.HealthChecks = healthChecks
 	masterServer.Locations = locations
+	masterServer.DefaultBackendAddress = ""
+	if !hasRootLocation(locations) {
+		masterServer.DefaultBackendAddress = ncp.BaseCfgParams.DefaultBackendAddress
+	}
 
 	return version1.IngressNginxConfig{
 		Servers:                 []version1.Server{masterServer},
@@ -864,10 +1208,21 @@ func generateNginxCfgForMergeableIngresses(ncp NginxCfgParams) (version1.Ingress
 		DynamicSSLReloadEnabled: ncp.staticParams.DynamicSSLReload,
 		StaticSSLPath:           ncp.staticParams.StaticSSLPath,
 		LimitReqZones:           limitReqZones,
+		SharedLimitReqZones:     sharedLimitReqZones,
 		Maps:                    removeDuplicateMaps(maps),
 	}, warnings
 }
 
+// hasRootLocation returns true if one of the locations is the location for the / path.
+func hasRootLocation(locations []version1.Location) bool {
+	for _, loc := range locations {
+		if loc.Path == "/" {
+			return true
+		}
+	}
+	return false
+}
+
 func limitReqZoneExists(zones []version1.LimitReqZone, zoneName string) bool {
 	for _, zone := range zones {
 		if zone.Name == zoneName {
@@ -172,6 +172,176 @@ func TestGenerateNginxCfgForAppRoot(t *testing.T) {
 	}
 }
 
+func TestGenerateNginxCfgForPathRegexMap(t *testing.T) {
+	t.Parallel()
+	cafeIngressEx := createCafeIngressEx()
+	cafeIngressEx.Ingress.Annotations["nginx.org/path-regex-map"] = "path=/coffee regex=case_insensitive;path=/tea regex=exact"
+
+	implementationSpecific := networking.PathTypeImplementationSpecific
+	prefix := networking.PathTypePrefix
+	cafeIngressEx.Ingress.Spec.Rules[0].HTTP.Paths[0].PathType = &implementationSpecific
+	cafeIngressEx.Ingress.Spec.Rules[0].HTTP.Paths[1].PathType = &prefix
+
+	isPlus := false
+	configParams := NewDefaultConfigParams(context.Background(), isPlus)
+
+	result, warnings := generateNginxCfg(NginxCfgParams{
+		staticParams:         &StaticConfigParams{},
+		ingEx:                &cafeIngressEx,
+		apResources:          nil,
+		dosResource:          nil,
+		isMinion:             false,
+		isPlus:               isPlus,
+		BaseCfgParams:        configParams,
+		isResolverConfigured: false,
+		isWildcardEnabled:    false,
+	})
+
+	// only the paths with the ImplementationSpecific pathType get the regex modifier from the annotation
+	expected := []string{"case_insensitive", ""}
+	for i, loc := range result.Servers[0].Locations {
+		if loc.PathRegex != expected[i] {
+			t.Errorf("generateNginxCfg returned PathRegex %q for location %s, but expected %q", loc.PathRegex, loc.Path, expected[i])
+		}
+	}
+	if len(warnings) != 0 {
+		t.Errorf("generateNginxCfg returned warnings: %v", warnings)
+	}
+}
+
+func TestGenerateNginxCfgForShadowedRegexPaths(t *testing.T) {
+	t.Parallel()
+	cafeIngressEx := createCafeIngressEx()
+	cafeIngressEx.Ingress.Annotations["nginx.org/path-regex"] = "case_sensitive"
+	cafeIngressEx.Ingress.Spec.Rules[0].HTTP.Paths[0].Path = "/tea"
+	cafeIngressEx.Ingress.Spec.Rules[0].HTTP.Paths[1].Path = "/tea/green"
+
+	isPlus := false
+	configParams := NewDefaultConfigParams(context.Background(), isPlus)
+
+	result, warnings := generateNginxCfg(NginxCfgParams{
+		staticParams:         &StaticConfigParams{},
+		ingEx:                &cafeIngressEx,
+		apResources:          nil,
+		dosResource:          nil,
+		isMinion:             false,
+		isPlus:               isPlus,
+		BaseCfgParams:        configParams,
+		isResolverConfigured: false,
+		isWildcardEnabled:    false,
+	})
+
+	// the regex locations are generated in the order of the paths, as NGINX matches them in that order
+	var paths []string
+	for _, loc := range result.Servers[0].Locations {
+		paths = append(paths, loc.Path)
+	}
+	if diff := cmp.Diff([]string{"/tea", "/tea/green"}, paths); diff != "" {
+		t.Errorf("generateNginxCfg returned unexpected locations (-want +got):\n%s", diff)
+	}
+
+	expectedWarnings := Warnings{
+		cafeIngressEx.Ingress: {"regex path /tea/green of host cafe.example.com is shadowed by the preceding regex path /tea"},
+	}
+	if diff := cmp.Diff(expectedWarnings, warnings); diff != "" {
+		t.Errorf("generateNginxCfg returned unexpected warnings (-want +got):\n%s", diff)
+	}
+}
+
+func TestAddWarningsForShadowedRegexPaths(t *testing.T) {
+	t.Parallel()
+
+	implementationSpecific := networking.PathTypeImplementationSpecific
+	prefix := networking.PathTypePrefix
+
+	tests := []struct {
+		annotations map[string]string
+		paths       []networking.HTTPIngressPath
+		expected    []string
+		msg         string
+	}{
+		{
+			annotations: map[string]string{
+				"nginx.org/path-regex-map": "path=/tea/(v[0-9]+)/(.*) regex=case_sensitive;path=/tea regex=case_sensitive",
+			},
+			paths: []networking.HTTPIngressPath{
+				{Path: "/tea/(v[0-9]+)/(.*)", PathType: &implementationSpecific},
+				{Path: "/tea", PathType: &implementationSpecific},
+				{Path: "/coffee", PathType: &prefix},
+			},
+			expected: nil,
+			msg:      "more specific regex path precedes less specific one",
+		},
+		{
+			annotations: map[string]string{
+				"nginx.org/path-regex-map": "path=/tea regex=case_sensitive;path=/tea/(v[0-9]+)/(.*) regex=case_sensitive",
+			},
+			paths: []networking.HTTPIngressPath{
+				{Path: "/tea", PathType: &implementationSpecific},
+				{Path: "/tea/(v[0-9]+)/(.*)", PathType: &implementationSpecific},
+			},
+			expected: []string{"regex path /tea/(v[0-9]+)/(.*) of host foo.example.com is shadowed by the preceding regex path /tea"},
+			msg:      "less specific regex path precedes more specific one",
+		},
+		{
+			annotations: map[string]string{
+				"nginx.org/path-regex":     "case_insensitive",
+				"nginx.org/path-regex-map": "path=/tea/green regex=case_sensitive",
+			},
+			paths: []networking.HTTPIngressPath{
+				{Path: "/TEA.*", PathType: &prefix},
+				{Path: "/tea/green", PathType: &implementationSpecific},
+			},
+			expected: []string{"regex path /tea/green of host foo.example.com is shadowed by the preceding regex path /TEA.*"},
+			msg:      "case-insensitive regex path shadows case-sensitive one",
+		},
+		{
+			annotations: map[string]string{
+				"nginx.org/path-regex":     "case_sensitive",
+				"nginx.org/path-regex-map": "path=/tea/green regex=case_insensitive;path=/tea/black regex=exact",
+			},
+			paths: []networking.HTTPIngressPath{
+				{Path: "/tea", PathType: &prefix},
+				{Path: "/tea/green", PathType: &implementationSpecific},
+				{Path: "/tea/black", PathType: &implementationSpecific},
+			},
+			expected: nil,
+			msg:      "case-sensitive regex path does not shadow case-insensitive or exact ones",
+		},
+	}
+
+	for _, test := range tests {
+		ing := &networking.Ingress{
+			ObjectMeta: meta_v1.ObjectMeta{
+				Annotations: test.annotations,
+			},
+			Spec: networking.IngressSpec{
+				Rules: []networking.IngressRule{
+					{
+						Host: "foo.example.com",
+						IngressRuleValue: networking.IngressRuleValue{
+							HTTP: &networking.HTTPIngressRuleValue{
+								Paths: test.paths,
+							},
+						},
+					},
+				},
+			},
+		}
+		pathRegexes, err := ParsePathRegexMap(test.annotations[PathRegexMapAnnotation])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		warnings := newWarnings()
+		addWarningsForShadowedRegexPaths(warnings, ing, pathRegexes)
+
+		if diff := cmp.Diff(test.expected, warnings[ing]); diff != "" {
+			t.Errorf("addWarningsForShadowedRegexPaths() returned unexpected result for the case of %s (-want +got):\n%s", test.msg, diff)
+		}
+	}
+}
+
 func TestGenerateNginxCfgForCORSPolicy(t *testing.T) {
 	t.Parallel()
 
@@ -280,6 +450,129 @@ func TestGenerateNginxCfgForCORSPolicy(t *testing.T) {
 	}
 }
 
+func TestGenerateNginxCfgForSharedRateLimitPolicy(t *testing.T) {
+	t.Parallel()
+	burst := 10
+
+	cafeIngressEx := createCafeIngressEx()
+	cafeIngressEx.Policies = map[string]*conf_v1.Policy{
+		"default/shared-rate-limit": {
+			ObjectMeta: meta_v1.ObjectMeta{
+				Name:      "shared-rate-limit",
+				Namespace: "default",
+			},
+			Spec: conf_v1.PolicySpec{
+				RateLimit: &conf_v1.RateLimit{
+					Rate:     "10r/s",
+					Key:      "${binary_remote_addr}",
+					ZoneSize: "10M",
+					Burst:    &burst,
+					LogLevel: "warn",
+					Shared:   true,
+				},
+			},
+		},
+	}
+
+	isPlus := false
+	configParams := NewDefaultConfigParams(context.Background(), isPlus)
+	result, warnings := generateNginxCfg(NginxCfgParams{
+		staticParams:  &StaticConfigParams{},
+		ingEx:         &cafeIngressEx,
+		isPlus:        isPlus,
+		BaseCfgParams: configParams,
+	})
+	if len(warnings) != 0 {
+		t.Fatalf("generateNginxCfg() returned warnings: %v", warnings)
+	}
+
+	expectedZones := []version2.LimitReqZone{
+		{
+			ZoneName: "pol_rl_default_shared_rate_limit",
+			Key:      "${binary_remote_addr}",
+			ZoneSize: "10M",
+			Rate:     "10r/s",
+		},
+	}
+	if diff := cmp.Diff(expectedZones, result.SharedLimitReqZones); diff != "" {
+		t.Errorf("generateNginxCfg() SharedLimitReqZones mismatch (-want +got):\n%s", diff)
+	}
+	if len(result.LimitReqZones) != 0 {
+		t.Errorf("generateNginxCfg() returned LimitReqZones %v but expected none", result.LimitReqZones)
+	}
+
+	expectedLimitReq := &version1.LimitReq{
+		Zone:       "pol_rl_default_shared_rate_limit",
+		Burst:      10,
+		RejectCode: 503,
+		LogLevel:   "warn",
+	}
+	for _, server := range result.Servers {
+		for _, loc := range server.Locations {
+			if diff := cmp.Diff(expectedLimitReq, loc.LimitReq); diff != "" {
+				t.Errorf("generateNginxCfg() LimitReq of location %s mismatch (-want +got):\n%s", loc.Path, diff)
+			}
+		}
+	}
+}
+
+func TestGenerateNginxCfgForHeadersPolicy(t *testing.T) {
+	t.Parallel()
+
+	cafeIngressEx := createCafeIngressEx()
+	cafeIngressEx.Ingress.Annotations["nginx.org/proxy-set-headers"] = "X-Version: v2"
+	cafeIngressEx.Policies = map[string]*conf_v1.Policy{
+		"default/headers-policy": {
+			ObjectMeta: meta_v1.ObjectMeta{
+				Name:      "headers-policy",
+				Namespace: "default",
+			},
+			Spec: conf_v1.PolicySpec{
+				Headers: &conf_v1.Headers{
+					Request: &conf_v1.HeadersRequest{
+						Set: []conf_v1.Header{{Name: "X-Forwarded-Prefix", Value: "/cafe"}, {Name: "X-Version", Value: "v1"}},
+					},
+					Response: &conf_v1.HeadersResponse{
+						Hide: []string{"Server"},
+						Add:  []conf_v1.AddHeader{{Header: conf_v1.Header{Name: "X-Frame-Options", Value: "DENY"}}},
+					},
+				},
+			},
+		},
+	}
+
+	isPlus := false
+	configParams := NewDefaultConfigParams(context.Background(), isPlus)
+	configParams.ProxyHideHeaders = []string{"X-Powered-By"}
+	result, warnings := generateNginxCfg(NginxCfgParams{
+		staticParams:  &StaticConfigParams{},
+		ingEx:         &cafeIngressEx,
+		isPlus:        isPlus,
+		BaseCfgParams: configParams,
+	})
+	if len(warnings) != 0 {
+		t.Fatalf("generateNginxCfg() returned warnings: %v", warnings)
+	}
+
+	expectedSetHeaders := []version2.Header{{Name: "X-Forwarded-Prefix", Value: "/cafe"}}
+	expectedHideHeaders := []string{"X-Powered-By", "Server"}
+	expectedAddHeaders := []version2.AddHeader{{Header: version2.Header{Name: "X-Frame-Options", Value: "DENY"}}}
+
+	for _, server := range result.Servers {
+		for _, loc := range server.Locations {
+			if diff := cmp.Diff(expectedSetHeaders, loc.PolicyProxySetHeaders); diff != "" {
+				t.Errorf("generateNginxCfg() returned unexpected request headers for location %s (-want +got):\n%s", loc.Path, diff)
+			}
+			if diff := cmp.Diff(expectedHideHeaders, loc.ProxyHideHeaders); diff != "" {
+				t.Errorf("generateNginxCfg() returned unexpected hidden headers for location %s (-want +got):\n%s", loc.Path, diff)
+			}
+			if diff := cmp.Diff(expectedAddHeaders, loc.AddHeaders); diff != "" {
+				t.Errorf("generateNginxCfg() returned unexpected response headers for location %s (-want +got):\n%s", loc.Path, diff)
+			}
+		}
+	}
+}
+
 func TestGenerateNginxCfgForMergeableIngressesCORSPolicy(t *testing.T) {
 	t.Parallel()
 
@@ -431,6 +724,265 @@ func TestGenerateNginxCfgForAccessControl(t *testing.T) {
 	}
 }
 
+func TestGenerateNginxCfgForCanary(t *testing.T) {
+	t.Parallel()
+	cafeIngressEx := createCafeIngressEx()
+	canaryIngressEx := createCafeCanaryIngressEx()
+	canaryIngressEx.Ingress.Annotations[CanaryWeightAnnotation] = "20"
+	canaryIngressEx.Ingress.Annotations[CanaryByHeaderAnnotation] = "X-Canary"
+	canaryIngressEx.Ingress.Annotations[CanaryByCookieAnnotation] = "canary"
+	cafeIngressEx.Canaries = []*IngressEx{&canaryIngressEx}
+
+	isPlus := false
+	configParams := NewDefaultConfigParams(context.Background(), isPlus)
+
+	result, warnings := generateNginxCfg(NginxCfgParams{
+		staticParams:  &StaticConfigParams{},
+		ingEx:         &cafeIngressEx,
+		isPlus:        isPlus,
+		BaseCfgParams: configParams,
+	})
+
+	primaryUpstream := "default-cafe-ingress-cafe.example.com-coffee-svc-80"
+	canaryUpstream := "default-cafe-ingress-canary-cafe.example.com-coffee-canary-svc-80"
+
+	expectedSplitClients := []version2.SplitClient{
+		{
+			Source:   "$request_id",
+			Variable: "$canary_default_cafe_ingress_0_weight",
+			Distributions: []version2.Distribution{
+				{Weight: "20%", Value: canaryUpstream},
+				{Weight: "*", Value: primaryUpstream},
+			},
+		},
+	}
+	expectedMaps := []version2.Map{
+		{
+			Source:   "$cookie_canary",
+			Variable: "$canary_default_cafe_ingress_0_cookie",
+			Parameters: []version2.Parameter{
+				{Value: "always", Result: canaryUpstream},
+				{Value: "never", Result: primaryUpstream},
+				{Value: "default", Result: "$canary_default_cafe_ingress_0_weight"},
+			},
+		},
+		{
+			Source:   "$http_x_canary",
+			Variable: "$canary_default_cafe_ingress_0_header",
+			Parameters: []version2.Parameter{
+				{Value: "always", Result: canaryUpstream},
+				{Value: "never", Result: primaryUpstream},
+				{Value: "default", Result: "$canary_default_cafe_ingress_0_cookie"},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(expectedSplitClients, result.SplitClients); diff != "" {
+		t.Errorf("generateNginxCfg() returned unexpected split clients (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(expectedMaps, result.Maps); diff != "" {
+		t.Errorf("generateNginxCfg() returned unexpected maps (-want +got):\n%s", diff)
+	}
+
+	locations := result.Servers[0].Locations
+	if locations[0].UpstreamVariable != "$canary_default_cafe_ingress_0_header" {
+		t.Errorf("generateNginxCfg() returned upstream variable %q for the coffee location", locations[0].UpstreamVariable)
+	}
+	if locations[1].UpstreamVariable != "" {
+		t.Errorf("generateNginxCfg() returned upstream variable %q for the tea location", locations[1].UpstreamVariable)
+	}
+
+	foundCanaryUpstream := false
+	for _, u := range result.Upstreams {
+		if u.Name == canaryUpstream {
+			foundCanaryUpstream = true
+			if len(u.UpstreamServers) != 1 || u.UpstreamServers[0].Address != "10.0.0.3:80" {
+				t.Errorf("generateNginxCfg() returned unexpected servers for the canary upstream: %v", u.UpstreamServers)
+			}
+		}
+	}
+	if !foundCanaryUpstream {
+		t.Errorf("generateNginxCfg() didn't return the canary upstream %s", canaryUpstream)
+	}
+	if len(warnings) != 0 {
+		t.Errorf("generateNginxCfg() returned warnings: %v", warnings)
+	}
+}
+
+func TestGenerateNginxCfgForCanaryWithRewrites(t *testing.T) {
+	t.Parallel()
+	cafeIngressEx := createCafeIngressEx()
+	cafeIngressEx.Ingress.Annotations["nginx.org/rewrites"] = "serviceName=coffee-svc rewrite=/beans/"
+	canaryIngressEx := createCafeCanaryIngressEx()
+	canaryIngressEx.Ingress.Annotations[CanaryWeightAnnotation] = "50"
+	cafeIngressEx.Canaries = []*IngressEx{&canaryIngressEx}
+
+	isPlus := false
+	configParams := NewDefaultConfigParams(context.Background(), isPlus)
+
+	result, warnings := generateNginxCfg(NginxCfgParams{
+		staticParams:  &StaticConfigParams{},
+		ingEx:         &cafeIngressEx,
+		isPlus:        isPlus,
+		BaseCfgParams: configParams,
+	})
+
+	if len(result.SplitClients) != 0 {
+		t.Errorf("generateNginxCfg() returned split clients for a rewritten location: %v", result.SplitClients)
+	}
+	if result.Servers[0].Locations[0].UpstreamVariable != "" {
+		t.Errorf("generateNginxCfg() returned upstream variable %q for a rewritten location", result.Servers[0].Locations[0].UpstreamVariable)
+	}
+	if len(warnings[canaryIngressEx.Ingress]) != 1 {
+		t.Errorf("generateNginxCfg() returned warnings %v, expected one warning for the canary Ingress", warnings)
+	}
+}
+
+func TestGenerateCanaryRouting(t *testing.T) {
+	t.Parallel()
+	primaryUpstream := "default-cafe-ingress-cafe.example.com-coffee-svc-80"
+	canaryUpstream := "default-cafe-ingress-canary-cafe.example.com-coffee-canary-svc-80"
+
+	tests := []struct {
+		annotations          map[string]string
+		expectedSplitClients []version2.SplitClient
+		expectedMaps         []version2.Map
+		expectedVariable     string
+		msg                  string
+	}{
+		{
+			annotations:      map[string]string{},
+			expectedVariable: primaryUpstream,
+			msg:              "no canary conditions",
+		},
+		{
+			annotations: map[string]string{
+				CanaryWeightAnnotation: "100",
+			},
+			expectedVariable: canaryUpstream,
+			msg:              "all requests to the canary",
+		},
+		{
+			annotations: map[string]string{
+				CanaryByHeaderAnnotation:      "X-Version",
+				CanaryByHeaderValueAnnotation: "v2",
+			},
+			expectedMaps: []version2.Map{
+				{
+					Source:   "$http_x_version",
+					Variable: "$canary_default_cafe_ingress_3_header",
+					Parameters: []version2.Parameter{
+						{Value: `"v2"`, Result: canaryUpstream},
+						{Value: "default", Result: primaryUpstream},
+					},
+				},
+			},
+			expectedVariable: "$canary_default_cafe_ingress_3_header",
+			msg:              "header with value",
+		},
+		{
+			annotations: map[string]string{
+				CanaryByHeaderAnnotation:      "X-Version",
+				CanaryByHeaderValueAnnotation: "default",
+			},
+			expectedMaps: []version2.Map{
+				{
+					Source:   "$http_x_version",
+					Variable: "$canary_default_cafe_ingress_3_header",
+					Parameters: []version2.Parameter{
+						{Value: `\default`, Result: canaryUpstream},
+						{Value: "default", Result: primaryUpstream},
+					},
+				},
+			},
+			expectedVariable: "$canary_default_cafe_ingress_3_header",
+			msg:              "header with a special map value",
+		},
+	}
+
+	for _, test := range tests {
+		cafeIngressEx := createCafeIngressEx()
+		canaryIngressEx := createCafeCanaryIngressEx()
+		for k, v := range test.annotations {
+			canaryIngressEx.Ingress.Annotations[k] = v
+		}
+		cafeIngressEx.Canaries = []*IngressEx{&canaryIngressEx}
+
+		loc := version1.Location{
+			Path:     "/coffee",
+			Upstream: version1.Upstream{Name: primaryUpstream},
+		}
+		ncp := NginxCfgParams{
+			ingEx:         &cafeIngressEx,
+			BaseCfgParams: NewDefaultConfigParams(context.Background(), false),
+		}
+
+		splitClients, maps, routed, warnings := generateCanaryRouting(ncp, &loc, "cafe.example.com", 3)
+		if !routed {
+			t.Errorf("generateCanaryRouting() didn't route the location for the case of %s", test.msg)
+		}
+		if diff := cmp.Diff(test.expectedSplitClients, splitClients); diff != "" {
+			t.Errorf("generateCanaryRouting() returned unexpected split clients for the case of %s (-want +got):\n%s", test.msg, diff)
+		}
+		if diff := cmp.Diff(test.expectedMaps, maps); diff != "" {
+			t.Errorf("generateCanaryRouting() returned unexpected maps for the case of %s (-want +got):\n%s", test.msg, diff)
+		}
+		if loc.UpstreamVariable != test.expectedVariable {
+			t.Errorf("generateCanaryRouting() set upstream variable %q but expected %q for the case of %s", loc.UpstreamVariable, test.expectedVariable, test.msg)
+		}
+		if len(warnings) != 0 {
+			t.Errorf("generateCanaryRouting() returned warnings %v for the case of %s", warnings, test.msg)
+		}
+	}
+}
+
+func createCafeCanaryIngressEx() IngressEx {
+	canaryIngress := networking.Ingress{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      "cafe-ingress-canary",
+			Namespace: "default",
+			Annotations: map[string]string{
+				"kubernetes.io/ingress.class": "nginx",
+				CanaryAnnotation:              "true",
+			},
+		},
+		Spec: networking.IngressSpec{
+			Rules: []networking.IngressRule{
+				{
+					Host: "cafe.example.com",
+					IngressRuleValue: networking.IngressRuleValue{
+						HTTP: &networking.HTTPIngressRuleValue{
+							Paths: []networking.HTTPIngressPath{
+								{
+									Path: "/coffee",
+									Backend: networking.IngressBackend{
+										Service: &networking.IngressServiceBackend{
+											Name: "coffee-canary-svc",
+											Port: networking.ServiceBackendPort{
+												Number: 80,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	return IngressEx{
+		Ingress: &canaryIngress,
+		Endpoints: map[string][]string{
+			"coffee-canary-svc80": {"10.0.0.3:80"},
+		},
+		ExternalNameSvcs: map[string]bool{},
+		ValidHosts: map[string]bool{
+			"cafe.example.com": true,
+		},
+	}
+}
+
 func TestGenerateNginxCfgWithMissingTLSSecret(t *testing.T) {
 	t.Parallel()
 	cafeIngressEx := createCafeIngressEx()
@@ -787,6 +1339,100 @@ func TestGenerateNginxCfgForMergeableIngresses(t *testing.T) {
 	}
 }
 
+func TestGenerateNginxCfgForDefaultBackendAddress(t *testing.T) {
+	t.Parallel()
+	rootPath := networking.HTTPIngressPath{
+		Path: "/",
+		Backend: networking.IngressBackend{
+			Service: &networking.IngressServiceBackend{
+				Name: "coffee-svc",
+				Port: networking.ServiceBackendPort{
+					Number: 80,
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		withRootPath bool
+		expected     string
+		msg          string
+	}{
+		{
+			withRootPath: false,
+			expected:     "10.0.0.100:8080",
+			msg:          "no path for /",
+		},
+		{
+			withRootPath: true,
+			expected:     "",
+			msg:          "path for /",
+		},
+	}
+
+	for _, test := range tests {
+		cafeIngressEx := createCafeIngressEx()
+		if test.withRootPath {
+			rule := &cafeIngressEx.Ingress.Spec.Rules[0]
+			rule.HTTP.Paths = append(rule.HTTP.Paths, rootPath)
+		}
+		configParams := NewDefaultConfigParams(context.Background(), false)
+		configParams.DefaultBackendAddress = "10.0.0.100:8080"
+
+		result, _ := generateNginxCfg(NginxCfgParams{
+			staticParams:  &StaticConfigParams{},
+			ingEx:         &cafeIngressEx,
+			BaseCfgParams: configParams,
+		})
+
+		if result.Servers[0].DefaultBackendAddress != test.expected {
+			t.Errorf("generateNginxCfg() returned default backend address %q but expected %q for the case of %s",
+				result.Servers[0].DefaultBackendAddress, test.expected, test.msg)
+		}
+	}
+}
+
+func TestGenerateNginxCfgForMergeableIngressesForDefaultBackendAddress(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		withRootPath bool
+		expected     string
+		msg          string
+	}{
+		{
+			withRootPath: false,
+			expected:     "10.0.0.100:8080",
+			msg:          "no minion with a path for /",
+		},
+		{
+			withRootPath: true,
+			expected:     "",
+			msg:          "minion with a path for /",
+		},
+	}
+
+	for _, test := range tests {
+		mergeableIngresses := createMergeableCafeIngress()
+		if test.withRootPath {
+			mergeableIngresses.Minions[1].Ingress.Spec.Rules[0].HTTP.Paths[0].Path = "/"
+			mergeableIngresses.Minions[1].ValidMinionPaths = map[string]bool{"/": true}
+		}
+		configParams := NewDefaultConfigParams(context.Background(), false)
+		configParams.DefaultBackendAddress = "10.0.0.100:8080"
+
+		result, _ := generateNginxCfgForMergeableIngresses(NginxCfgParams{
+			mergeableIngs: mergeableIngresses,
+			BaseCfgParams: configParams,
+			staticParams:  &StaticConfigParams{},
+		})
+
+		if result.Servers[0].DefaultBackendAddress != test.expected {
+			t.Errorf("generateNginxCfgForMergeableIngresses() returned default backend address %q but expected %q for the case of %s",
+				result.Servers[0].DefaultBackendAddress, test.expected, test.msg)
+		}
+	}
+}
+
 func TestGenerateNginxConfigForCrossNamespaceMergeableIngresses(t *testing.T) {
 	t.Parallel()
 	mergeableIngresses := createMergeableCafeIngress()
@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/nginx/kubernetes-ingress/internal/configs/version2"
 
@@ -211,6 +212,40 @@ func ParseTime(s string) (string, error) {
 	return fmt.Sprintf("%s%s%s%s%s%s%s%s", years, months, weeks, days, hours, mins, secs, millis), nil
 }
 
+// timeUnitDurations holds the durations of the NGINX time units in the order of the timeRegexp groups.
+var timeUnitDurations = []time.Duration{
+	365 * 24 * time.Hour,
+	30 * 24 * time.Hour,
+	7 * 24 * time.Hour,
+	24 * time.Hour,
+	time.Hour,
+	time.Minute,
+	time.Second,
+	time.Millisecond,
+}
+
+// ParseTimeToDuration converts an NGINX time string into a time.Duration.
+func ParseTimeToDuration(s string) (time.Duration, error) {
+	if _, err := ParseTime(s); err != nil {
+		return 0, err
+	}
+
+	var duration time.Duration
+	units := timeRegexp.FindStringSubmatch(s)
+	for i, unit := range units[1:] {
+		if unit == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(strings.TrimRight(unit, "yMwdhms"), 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		duration += time.Duration(n) * timeUnitDurations[i]
+	}
+
+	return duration, nil
+}
+
 // OffsetFmt http://nginx.org/en/docs/syntax.html
 const OffsetFmt = `\d+[kKmMgG]?`
 
@@ -358,6 +393,42 @@ func ParseRewriteList(s string) (map[string]string, error) {
 	return rewrites, nil
 }
 
+// ParsePathRegexMap ensures that the string is a semicolon-separated list of paths with their regex modifiers
+func ParsePathRegexMap(s string) (map[string]string, error) {
+	pathRegexes := make(map[string]string)
+	for _, part := range strings.Split(s, ";") {
+		path, regexType, err := parsePathRegex(part)
+		if err != nil {
+			return nil, err
+		}
+		if _, exists := pathRegexes[path]; exists {
+			return nil, fmt.Errorf("path '%s' is specified more than once", path)
+		}
+		pathRegexes[path] = regexType
+	}
+	return pathRegexes, nil
+}
+
+func parsePathRegex(pathRegex string) (path string, regexType string, err error) {
+	parts := strings.SplitN(strings.TrimSpace(pathRegex), " ", 2)
+
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("'%s' is not a valid path regex format, e.g. 'path=/tea/(.*) regex=case_sensitive'", pathRegex)
+	}
+
+	pathParts := strings.SplitN(parts[0], "=", 2)
+	if len(pathParts) != 2 || pathParts[0] != "path" || !strings.HasPrefix(pathParts[1], "/") {
+		return "", "", fmt.Errorf("'%s' is not a valid path format, e.g. 'path=/tea'", parts[0])
+	}
+
+	regexParts := strings.Split(strings.TrimSpace(parts[1]), "=")
+	if len(regexParts) != 2 || regexParts[0] != "regex" || !validPathRegex[regexParts[1]] {
+		return "", "", fmt.Errorf("'%s' is not a valid regex format, allowed values: 'regex=case_sensitive', 'regex=case_insensitive' or 'regex=exact'", parts[1])
+	}
+
+	return pathParts[1], regexParts[1], nil
+}
+
 // ParseStickyServiceList ensures that the string is a semicolon-separated list of sticky services
 func ParseStickyServiceList(s string) (map[string]string, error) {
 	services := make(map[string]string)
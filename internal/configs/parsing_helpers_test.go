@@ -3,6 +3,7 @@ package configs
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/nginx/kubernetes-ingress/internal/configs/version2"
@@ -102,6 +103,42 @@ func TestParseRewritesList_FailsOnBogusInputString(t *testing.T) {
 	}
 }
 
+func TestParsePathRegexMap(t *testing.T) {
+	t.Parallel()
+
+	want := map[string]string{
+		"/tea/(.*)":   "case_sensitive",
+		"/Coffee":     "case_insensitive",
+		"/health=yes": "exact",
+	}
+	got, err := ParsePathRegexMap("path=/tea/(.*) regex=case_sensitive; path=/Coffee regex=case_insensitive;path=/health=yes regex=exact")
+	if err != nil {
+		t.Fatalf("ParsePathRegexMap() returned unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("ParsePathRegexMap() returned %v, want %v", got, want)
+	}
+}
+
+func TestParsePathRegexMap_FailsOnBogusInputString(t *testing.T) {
+	t.Parallel()
+
+	invalidInputs := []string{
+		"",
+		"path=/tea",
+		"path=tea regex=exact",
+		"path=/tea regex=prefix",
+		"regex=exact path=/tea",
+		"path=/tea regex=exact;path=/tea regex=case_sensitive",
+	}
+	for _, s := range invalidInputs {
+		_, err := ParsePathRegexMap(s)
+		if err == nil {
+			t.Errorf("want err on invalid input %q, got nil", s)
+		}
+	}
+}
+
 func TestParseServicesFromString(t *testing.T) {
 	t.Parallel()
 
@@ -554,6 +591,40 @@ func TestParseTime(t *testing.T) {
 	}
 }
 
+func TestParseTimeToDuration(t *testing.T) {
+	t.Parallel()
+	testsWithValidInput := []struct {
+		input    string
+		expected time.Duration
+	}{
+		{"1h30m 5 100ms", time.Hour + 30*time.Minute + 5*time.Second + 100*time.Millisecond},
+		{"10ms", 10 * time.Millisecond},
+		{"1", time.Second},
+		{"30s", 30 * time.Second},
+		{"2d", 48 * time.Hour},
+		{"1w", 7 * 24 * time.Hour},
+	}
+	invalidInput := []string{"5s 5s", "ss", "-5s", "", " "}
+
+	for _, test := range testsWithValidInput {
+		result, err := ParseTimeToDuration(test.input)
+		if err != nil {
+			t.Fatalf("ParseTimeToDuration(%q) returned an error for valid input", test.input)
+		}
+
+		if result != test.expected {
+			t.Errorf("ParseTimeToDuration(%q) returned %v expected %v", test.input, result, test.expected)
+		}
+	}
+
+	for _, test := range invalidInput {
+		result, err := ParseTimeToDuration(test)
+		if err == nil {
+			t.Errorf("ParseTimeToDuration(%q) didn't return error. Returned: %v", test, result)
+		}
+	}
+}
+
 func TestParseOffset(t *testing.T) {
 	t.Parallel()
 	testsWithValidInput := []string{"1", "2k", "2K", "3m", "3M", "4g", "4G"}
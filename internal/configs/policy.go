@@ -24,6 +24,7 @@ import (
 type rateLimit struct {
 	Reqs             []version2.LimitReq
 	Zones            []version2.LimitReqZone
+	SharedZones      []version2.LimitReqZone
 	GroupMaps        []version2.Map
 	PolicyGroupMaps  []version2.Map
 	Options          version2.LimitReqOptions
@@ -65,10 +66,19 @@ type policiesCfg struct {
 	Cache           *version2.Cache
 	CORSHeaders     []version2.AddHeader
 	CORSMap         *version2.Map
+	Headers         headersCfg
 	ErrorReturn     *version2.Return
 	BundleValidator bundleValidator
 }
 
+// headersCfg holds the request and response headers of the Headers policies.
+type headersCfg struct {
+	Set  []version2.Header
+	Hide []string
+	Pass []string
+	Add  []version2.AddHeader
+}
+
 type policyOwnerDetails struct {
 	owner           runtime.Object
 	ownerName       string
@@ -120,6 +130,10 @@ func (p *policiesCfg) addRateLimitConfig(
 	l := nl.LoggerFromContext(p.Context)
 
 	rlZoneName := rfc1123ToSnake(fmt.Sprintf("pol_rl_%v_%v_%v_%v_%v", policy.Namespace, policy.Name, ownerDetails.parentNamespace, ownerDetails.parentName, ownerDetails.parentType))
+	if rateLimit.Shared {
+		// a shared zone is named after the policy only, so that all the resources that reference the policy use it.
+		rlZoneName = rfc1123ToSnake(fmt.Sprintf("pol_rl_%v_%v", policy.Namespace, policy.Name))
+	}
 	if zoneSync {
 		rlZoneName = fmt.Sprintf("%v_sync", rlZoneName)
 	}
@@ -138,7 +152,11 @@ func (p *policiesCfg) addRateLimitConfig(
 		if warningText != "" {
 			nl.Warn(l, warningText)
 		}
-		p.RateLimit.Zones = append(p.RateLimit.Zones, lrz)
+		if rateLimit.Shared {
+			p.RateLimit.SharedZones = append(p.RateLimit.SharedZones, lrz)
+		} else {
+			p.RateLimit.Zones = append(p.RateLimit.Zones, lrz)
+		}
 	}
 
 	p.RateLimit.Reqs = append(p.RateLimit.Reqs, generateLimitReq(rlZoneName, rateLimit))
@@ -970,6 +988,86 @@ func (p *policiesCfg) addCORSConfig(
 	return res
 }
 
+func (p *policiesCfg) addHeadersConfig(headers *conf_v1.Headers) *validationResults {
+	res := newValidationResults()
+
+	var cfg headersCfg
+	if headers.Request != nil {
+		for _, h := range headers.Request.Set {
+			cfg.Set = append(cfg.Set, version2.Header{Name: h.Name, Value: h.Value})
+		}
+	}
+	if headers.Response != nil {
+		cfg.Hide = headers.Response.Hide
+		cfg.Pass = headers.Response.Pass
+		for _, h := range headers.Response.Add {
+			cfg.Add = append(cfg.Add, version2.AddHeader{
+				Header: version2.Header{Name: h.Name, Value: h.Value},
+				Always: h.Always,
+			})
+		}
+	}
+
+	// the Headers policies are applied in the order of the references
+	p.Headers = mergeHeadersCfg(p.Headers, cfg)
+
+	return res
+}
+
+// mergeHeadersCfg merges two headers configurations.
+// The headers of the override take precedence over the headers of the base with the same name.
+func mergeHeadersCfg(base headersCfg, override headersCfg) headersCfg {
+	var result headersCfg
+
+	for _, h := range base.Set {
+		if !hasHeader(override.Set, h.Name) {
+			result.Set = append(result.Set, h)
+		}
+	}
+	result.Set = append(result.Set, override.Set...)
+
+	for _, h := range base.Add {
+		if !hasAddHeader(override.Add, h.Name) {
+			result.Add = append(result.Add, h)
+		}
+	}
+	result.Add = append(result.Add, override.Add...)
+
+	for _, h := range base.Hide {
+		if !containsHeaderName(override.Pass, h) && !containsHeaderName(override.Hide, h) {
+			result.Hide = append(result.Hide, h)
+		}
+	}
+	result.Hide = append(result.Hide, override.Hide...)
+
+	for _, h := range base.Pass {
+		if !containsHeaderName(override.Hide, h) && !containsHeaderName(override.Pass, h) {
+			result.Pass = append(result.Pass, h)
+		}
+	}
+	result.Pass = append(result.Pass, override.Pass...)
+
+	return result
+}
+
+func hasAddHeader(headers []version2.AddHeader, name string) bool {
+	for _, h := range headers {
+		if strings.EqualFold(h.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsHeaderName(names []string, name string) bool {
+	for _, n := range names {
+		if strings.EqualFold(n, name) {
+			return true
+		}
+	}
+	return false
+}
+
 // nolint:gocyclo
 func generatePolicies(
 	ctx context.Context,
@@ -1032,6 +1130,8 @@ func generatePolicies(
 				res = config.addCacheConfig(pol.Spec.Cache, key, ownerDetails)
 			case pol.Spec.CORS != nil:
 				res = config.addCORSConfig(pol.Spec.CORS, key, ownerDetails)
+			case pol.Spec.Headers != nil:
+				res = config.addHeadersConfig(pol.Spec.Headers)
 			default:
 				res = newValidationResults()
 			}
@@ -368,6 +368,54 @@ func TestGeneratePolicies(t *testing.T) {
 			},
 			msg: "rate limit reference with scale",
 		},
+		{
+			policyRefs: []conf_v1.PolicyReference{
+				{
+					Name:      "rateLimit-shared-policy",
+					Namespace: "default",
+				},
+			},
+			policies: map[string]*conf_v1.Policy{
+				"default/rateLimit-shared-policy": {
+					ObjectMeta: meta_v1.ObjectMeta{
+						Name:      "rateLimit-shared-policy",
+						Namespace: "default",
+					},
+					Spec: conf_v1.PolicySpec{
+						RateLimit: &conf_v1.RateLimit{
+							Key:      "test",
+							ZoneSize: "10M",
+							Rate:     "10r/s",
+							Scale:    true,
+							Shared:   true,
+						},
+					},
+				},
+			},
+			expected: policiesCfg{
+				Context: ctx,
+				RateLimit: rateLimit{
+					SharedZones: []version2.LimitReqZone{
+						{
+							Key:      "test",
+							ZoneSize: "10M",
+							Rate:     "5r/s",
+							ZoneName: "pol_rl_default_rateLimit_shared_policy",
+						},
+					},
+					Options: version2.LimitReqOptions{
+						LogLevel:   "error",
+						RejectCode: 503,
+					},
+					Reqs: []version2.LimitReq{
+						{
+							ZoneName: "pol_rl_default_rateLimit_shared_policy",
+						},
+					},
+				},
+			},
+			msg: "shared rate limit reference",
+		},
 		{
 			policyRefs: []conf_v1.PolicyReference{
 				{
@@ -1476,6 +1524,40 @@ func TestAddCORSConfig(t *testing.T) {
 	}
 }
 
+func TestAddHeadersConfig(t *testing.T) {
+	t.Parallel()
+
+	cfg := policiesCfg{}
+	cfg.addHeadersConfig(&conf_v1.Headers{
+		Request: &conf_v1.HeadersRequest{
+			Set: []conf_v1.Header{{Name: "X-Forwarded-Prefix", Value: "/api"}, {Name: "X-Version", Value: "v1"}},
+		},
+		Response: &conf_v1.HeadersResponse{
+			Hide: []string{"Server", "X-Debug"},
+			Add:  []conf_v1.AddHeader{{Header: conf_v1.Header{Name: "X-Frame-Options", Value: "DENY"}, Always: true}},
+		},
+	})
+	cfg.addHeadersConfig(&conf_v1.Headers{
+		Request: &conf_v1.HeadersRequest{
+			Set: []conf_v1.Header{{Name: "x-version", Value: "v2"}},
+		},
+		Response: &conf_v1.HeadersResponse{
+			Pass: []string{"X-Debug"},
+		},
+	})
+
+	expected := headersCfg{
+		Set:  []version2.Header{{Name: "X-Forwarded-Prefix", Value: "/api"}, {Name: "x-version", Value: "v2"}},
+		Hide: []string{"Server"},
+		Pass: []string{"X-Debug"},
+		Add:  []version2.AddHeader{{Header: version2.Header{Name: "X-Frame-Options", Value: "DENY"}, Always: true}},
+	}
+
+	if diff := cmp.Diff(expected, cfg.Headers); diff != "" {
+		t.Errorf("addHeadersConfig() mismatch (-want +got):\n%s", diff)
+	}
+}
+
 func TestGenerateCORSPolicy(t *testing.T) {
 	t.Parallel()
 
@@ -3,12 +3,14 @@ package configs
 import (
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 
 	api_v1 "k8s.io/api/core/v1"
 
 	"github.com/nginx/kubernetes-ingress/internal/configs/version2"
 	"github.com/nginx/kubernetes-ingress/internal/k8s/secrets"
+	"github.com/nginx/kubernetes-ingress/internal/nginx"
 	conf_v1 "github.com/nginx/kubernetes-ingress/pkg/apis/configuration/v1"
 )
 
@@ -16,15 +18,17 @@ const nginxNonExistingUnixSocket = "unix:/var/lib/nginx/non-existing-unix-socket
 
 // TransportServerEx holds a TransportServer along with the resources referenced by it.
 type TransportServerEx struct {
-	ListenerPort     int
-	TransportServer  *conf_v1.TransportServer
-	Endpoints        map[string][]string
-	PodsByIP         map[string]string
-	ExternalNameSvcs map[string]bool
-	DisableIPV6      bool
-	SecretRefs       map[string]*secrets.SecretReference
-	IPv4             string
-	IPv6             string
+	ListenerPort        int
+	TransportServer     *conf_v1.TransportServer
+	Endpoints           map[string][]string
+	PodsByIP            map[string]string
+	ExternalNameSvcs    map[string]bool
+	DisableIPV6         bool
+	SecretRefs          map[string]*secrets.SecretReference
+	IPv4                string
+	IPv6                string
+	AcceptProxyProtocol bool
+	TrustedCIDRs        []string
 }
 
 func (tsEx *TransportServerEx) String() string {
@@ -50,6 +54,8 @@ type transportServerConfigParams struct {
 	isResolverConfigured   bool
 	isDynamicReloadEnabled bool
 	staticSSLPath          string
+	// downServers returns the servers of an upstream that are marked down by the health probes of the Ingress Controller.
+	downServers func(upstream string) map[string]bool
 }
 
 // generateTransportServerConfig generates a full configuration for a TransportServer.
@@ -60,14 +66,38 @@ func generateTransportServerConfig(p transportServerConfigParams) (*version2.Tra
 
 	upstreams, w := generateStreamUpstreams(p.transportServerEx, upstreamNamer, p.isPlus, p.isResolverConfigured)
 	warnings.Add(w)
+	if p.downServers != nil {
+		markDownStreamUpstreamServers(upstreams, p.downServers)
+	}
 
-	healthCheck, match := generateTransportServerHealthCheck(p.transportServerEx.TransportServer.Spec.Action.Pass,
-		upstreamNamer.GetNameForUpstream(p.transportServerEx.TransportServer.Spec.Action.Pass),
-		p.transportServerEx.TransportServer.Spec.Upstreams)
+	var healthCheck *version2.StreamHealthCheck
+	var match *version2.Match
+	var healthCheckServers []version2.StreamHealthCheckServer
+	var healthCheckMatches []version2.Match
+	if isTransportServerActionRouted(p.transportServerEx.TransportServer) {
+		healthCheckServers, healthCheckMatches = generateTransportServerHealthCheckServers(p.transportServerEx.TransportServer, upstreamNamer)
+	} else {
+		healthCheck, match = generateTransportServerHealthCheck(p.transportServerEx.TransportServer.Spec.Action.Pass,
+			upstreamNamer.GetNameForUpstream(p.transportServerEx.TransportServer.Spec.Action.Pass),
+			p.transportServerEx.TransportServer.Spec.Upstreams)
+	}
 
 	sslConfig, w := generateSSLConfig(p.transportServerEx.TransportServer, p.transportServerEx.TransportServer.Spec.TLS, p.transportServerEx.TransportServer.Namespace, p.transportServerEx.SecretRefs)
 	warnings.Add(w)
 
+	proxyPass, splitClients, maps := generateTransportServerActionRouting(p.transportServerEx.TransportServer, upstreamNamer)
+	ok, w := addSSLClientVerification(sslConfig, p.transportServerEx.TransportServer, p.transportServerEx.TransportServer.Spec.TLS, p.transportServerEx.SecretRefs)
+	warnings.Add(w)
+	if !ok {
+		proxyPass = nginxNonExistingUnixSocket
+	}
+
+	proxySSL, ok, w := generateStreamProxySSL(p.transportServerEx.TransportServer, p.transportServerEx.SecretRefs)
+	warnings.Add(w)
+	if !ok {
+		proxyPass = nginxNonExistingUnixSocket
+	}
+
 	var proxyRequests, proxyResponses *int
 	var connectTimeout, nextUpstreamTimeout string
 	var nextUpstream bool
@@ -85,11 +115,18 @@ func generateTransportServerConfig(p transportServerConfigParams) (*version2.Tra
 		connectTimeout = p.transportServerEx.TransportServer.Spec.UpstreamParameters.ConnectTimeout
 	}
 
+	var upstreamProxyProtocol bool
+	if upstream := getTransportServerActionUpstream(p.transportServerEx.TransportServer); upstream != nil {
+		upstreamProxyProtocol = upstream.ProxyProtocol
+	}
+
 	var proxyTimeout string
 	if p.transportServerEx.TransportServer.Spec.SessionParameters != nil {
 		proxyTimeout = p.transportServerEx.TransportServer.Spec.SessionParameters.Timeout
 	}
 
+	accessLog, logFormat, logMaps := generateTransportServerAccessLog(p.transportServerEx.TransportServer)
+
 	serverSnippets := generateSnippets(true, p.transportServerEx.TransportServer.Spec.ServerSnippets, []string{})
 
 	streamSnippets := generateSnippets(true, p.transportServerEx.TransportServer.Spec.StreamSnippets, []string{})
@@ -113,7 +150,7 @@ func generateTransportServerConfig(p transportServerConfigParams) (*version2.Tra
 			StatusZone:               statusZone,
 			ProxyRequests:            proxyRequests,
 			ProxyResponses:           proxyResponses,
-			ProxyPass:                upstreamNamer.GetNameForUpstream(p.transportServerEx.TransportServer.Spec.Action.Pass),
+			ProxyPass:                proxyPass,
 			Name:                     p.transportServerEx.TransportServer.Name,
 			Namespace:                p.transportServerEx.TransportServer.Namespace,
 			ProxyConnectTimeout:      generateTimeWithDefault(connectTimeout, "60s"),
@@ -125,14 +162,25 @@ func generateTransportServerConfig(p transportServerConfigParams) (*version2.Tra
 			ServerSnippets:           serverSnippets,
 			DisableIPV6:              p.transportServerEx.DisableIPV6,
 			SSL:                      sslConfig,
+			ProxySSL:                 proxySSL,
+			ProxyProtocol:            p.transportServerEx.AcceptProxyProtocol,
+			SetRealIPFrom:            p.transportServerEx.TrustedCIDRs,
+			UpstreamProxyProtocol:    upstreamProxyProtocol,
+			SSLPreread:               len(maps) > 0,
 			IPv4:                     p.transportServerEx.IPv4,
 			IPv6:                     p.transportServerEx.IPv6,
+			AccessLog:                accessLog,
 		},
 		Match:                   match,
+		LogFormat:               logFormat,
 		Upstreams:               upstreams,
+		SplitClients:            splitClients,
+		Maps:                    append(maps, logMaps...),
 		StreamSnippets:          streamSnippets,
 		DynamicSSLReloadEnabled: p.isDynamicReloadEnabled,
 		StaticSSLPath:           p.staticSSLPath,
+		HealthCheckServers:      healthCheckServers,
+		HealthCheckMatches:      healthCheckMatches,
 	}
 	return tsConfig, warnings
 }
@@ -177,6 +225,323 @@ func generateSSLConfig(ts *conf_v1.TransportServer, tls *conf_v1.TransportServer
 	return &ssl, warnings
 }
 
+// generateTransportServerActionRouting generates the proxy_pass value of a TransportServer along with the split clients and maps
+// for the splits and the server name matches of its action.
+func generateTransportServerActionRouting(ts *conf_v1.TransportServer, upstreamNamer *upstreamNamer) (string, []version2.SplitClient, []version2.Map) {
+	action := ts.Spec.Action
+	if action == nil {
+		return "", nil, nil
+	}
+
+	variablePrefix := strings.NewReplacer("-", "_", ".", "_").Replace(fmt.Sprintf("$ts_%s_%s", ts.Namespace, ts.Name))
+
+	proxyPass := upstreamNamer.GetNameForUpstream(action.Pass)
+
+	var splitClients []version2.SplitClient
+	if len(action.Splits) > 0 {
+		variable := variablePrefix + "_splits"
+		splitClients = append(splitClients, version2.SplitClient{
+			Source:        "$remote_addr$remote_port",
+			Variable:      variable,
+			Distributions: generateTransportServerDistributions(action.Splits, upstreamNamer),
+		})
+		proxyPass = variable
+	}
+
+	if len(action.Matches) == 0 {
+		return proxyPass, splitClients, nil
+	}
+
+	var params []version2.Parameter
+	for _, m := range action.Matches {
+		for _, name := range m.ServerNames {
+			params = append(params, version2.Parameter{
+				Value:  name,
+				Result: upstreamNamer.GetNameForUpstream(m.Pass),
+			})
+		}
+	}
+	params = append(params, version2.Parameter{
+		Value:  "default",
+		Result: proxyPass,
+	})
+
+	variable := variablePrefix + "_server_name"
+	maps := []version2.Map{
+		{
+			Source:     "$ssl_preread_server_name",
+			Variable:   variable,
+			Hostnames:  true,
+			Parameters: params,
+		},
+	}
+
+	return variable, splitClients, maps
+}
+
+// isTransportServerActionRouted returns true if the action of the TransportServer routes the connections through a variable,
+// which is the case for splits and matches.
+func isTransportServerActionRouted(ts *conf_v1.TransportServer) bool {
+	return ts.Spec.Action != nil && (len(ts.Spec.Action.Splits) > 0 || len(ts.Spec.Action.Matches) > 0)
+}
+
+// getTransportServerActionUpstreamNames returns the names of the upstreams referenced by the action of the TransportServer.
+func getTransportServerActionUpstreamNames(ts *conf_v1.TransportServer) []string {
+	action := ts.Spec.Action
+	if action == nil {
+		return nil
+	}
+
+	var names []string
+	seen := make(map[string]bool)
+	add := func(name string) {
+		if name != "" && !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+
+	add(action.Pass)
+	for _, s := range action.Splits {
+		add(s.Pass)
+	}
+	for _, m := range action.Matches {
+		add(m.Pass)
+	}
+
+	return names
+}
+
+// generateTransportServerHealthCheckServers generates the health checks of the upstreams referenced by a routed action.
+// health_check requires proxy_pass with an upstream group, so every health check runs in its own server
+// that listens on a unix socket and doesn't receive any traffic.
+func generateTransportServerHealthCheckServers(ts *conf_v1.TransportServer, upstreamNamer *upstreamNamer) ([]version2.StreamHealthCheckServer, []version2.Match) {
+	var servers []version2.StreamHealthCheckServer
+	var matches []version2.Match
+
+	for _, name := range getTransportServerActionUpstreamNames(ts) {
+		upstreamName := upstreamNamer.GetNameForUpstream(name)
+		hc, match := generateTransportServerHealthCheck(name, upstreamName, ts.Spec.Upstreams)
+		if hc == nil {
+			continue
+		}
+
+		servers = append(servers, version2.StreamHealthCheckServer{
+			UnixSocket:  fmt.Sprintf("unix:/var/lib/nginx/health-check-%s.sock", upstreamName),
+			ProxyPass:   upstreamName,
+			HealthCheck: hc,
+		})
+		if match != nil {
+			matches = append(matches, *match)
+		}
+	}
+
+	return servers, matches
+}
+
+// generateTransportServerAccessLog generates the access log of a TransportServer along with its log format
+// and the map for the conditional logging by the status.
+func generateTransportServerAccessLog(ts *conf_v1.TransportServer) (*version2.StreamAccessLog, *version2.StreamLogFormat, []version2.Map) {
+	accessLog := ts.Spec.AccessLog
+	if accessLog == nil {
+		return nil, nil, nil
+	}
+	if !accessLog.Enable {
+		return &version2.StreamAccessLog{Off: true}, nil, nil
+	}
+
+	prefix := strings.NewReplacer("-", "_", ".", "_").Replace(fmt.Sprintf("ts_%s_%s", ts.Namespace, ts.Name))
+
+	al := &version2.StreamAccessLog{
+		Destination: "/dev/stdout",
+		Format:      "stream-main",
+	}
+	if accessLog.Destination != "" {
+		al.Destination = accessLog.Destination
+	}
+
+	var logFormat *version2.StreamLogFormat
+	if accessLog.Format != "" {
+		logFormat = &version2.StreamLogFormat{
+			Name:   prefix,
+			Format: accessLog.Format,
+		}
+		al.Format = logFormat.Name
+	}
+
+	if len(accessLog.Statuses) == 0 {
+		return al, logFormat, nil
+	}
+
+	var params []version2.Parameter
+	for _, status := range accessLog.Statuses {
+		params = append(params, version2.Parameter{
+			Value:  strconv.Itoa(status),
+			Result: "1",
+		})
+	}
+	params = append(params, version2.Parameter{
+		Value:  "default",
+		Result: "0",
+	})
+
+	al.Condition = "$" + prefix + "_loggable"
+	maps := []version2.Map{
+		{
+			Source:     "$status",
+			Variable:   al.Condition,
+			Parameters: params,
+		},
+	}
+
+	return al, logFormat, maps
+}
+
+func generateTransportServerDistributions(splits []conf_v1.TransportServerSplit, upstreamNamer *upstreamNamer) []version2.Distribution {
+	var distributions []version2.Distribution
+
+	for _, s := range splits {
+		if s.Weight == 0 {
+			continue
+		}
+		distributions = append(distributions, version2.Distribution{
+			Weight: fmt.Sprintf("%d%%", s.Weight),
+			Value:  upstreamNamer.GetNameForUpstream(s.Pass),
+		})
+	}
+
+	return distributions
+}
+
+// getTransportServerActionUpstream returns the upstream that the action of a TransportServer passes connections to.
+func getTransportServerActionUpstream(ts *conf_v1.TransportServer) *conf_v1.TransportServerUpstream {
+	if ts.Spec.Action == nil {
+		return nil
+	}
+	for i := range ts.Spec.Upstreams {
+		if ts.Spec.Upstreams[i].Name == ts.Spec.Action.Pass {
+			return &ts.Spec.Upstreams[i]
+		}
+	}
+	return nil
+}
+
+// addSSLClientVerification adds the client certificate verification configuration to the SSL configuration of a TransportServer.
+// If the referenced CA secret is invalid, it returns false so that the connections are not proxied to the upstream at all.
+func addSSLClientVerification(ssl *version2.StreamSSL, ts *conf_v1.TransportServer, tls *conf_v1.TransportServerTLS, secretRefs map[string]*secrets.SecretReference) (bool, Warnings) {
+	if !ssl.Enabled || tls == nil || tls.ClientCertSecret == "" {
+		return true, nil
+	}
+
+	warnings := newWarnings()
+
+	secretKey := fmt.Sprintf("%s/%s", ts.Namespace, tls.ClientCertSecret)
+	secretRef := secretRefs[secretKey]
+	var secretType api_v1.SecretType
+	if secretRef.Secret != nil {
+		secretType = secretRef.Secret.Type
+	}
+	if secretType != "" && secretType != secrets.SecretTypeCA {
+		warnings.AddWarningf(ts, "Client certificate secret %s is of a wrong type '%s', must be '%s'. Connections will not be proxied.", secretKey, secretType, secrets.SecretTypeCA)
+		return false, warnings
+	} else if secretRef.Error != nil {
+		warnings.AddWarningf(ts, "Client certificate secret %s is invalid: %v. Connections will not be proxied.", secretKey, secretRef.Error)
+		return false, warnings
+	}
+
+	caFields := strings.Fields(secretRef.Path)
+	if len(caFields) == 0 {
+		warnings.AddWarningf(ts, "Client certificate secret %s has no CA certificate. Connections will not be proxied.", secretKey)
+		return false, warnings
+	}
+
+	ssl.ClientCert = caFields[0]
+	ssl.VerifyClient = generateString(tls.VerifyClient, "on")
+	ssl.VerifyDepth = generateIntFromPointer(tls.VerifyDepth, 1)
+
+	var hasCrlKey bool
+	if secretRef.Secret != nil {
+		_, hasCrlKey = secretRef.Secret.Data[CACrlKey]
+	}
+	if tls.CrlFileName != "" {
+		if hasCrlKey {
+			warnings.AddWarningf(ts, "Both ca.crl in the Secret and tls.crlFileName fields cannot be used. ca.crl in %s will be ignored and %s will be applied", secretKey, tls.CrlFileName)
+		}
+		ssl.ClientCrl = fmt.Sprintf("%s/%s", DefaultSecretPath, tls.CrlFileName)
+	} else if hasCrlKey && len(caFields) > 1 {
+		ssl.ClientCrl = caFields[1]
+	}
+
+	return true, warnings
+}
+
+// generateStreamProxySSL generates the TLS configuration for connections to the upstream of the action of a TransportServer.
+// If a referenced secret is invalid, it returns false so that the connections are not proxied to the upstream at all.
+func generateStreamProxySSL(ts *conf_v1.TransportServer, secretRefs map[string]*secrets.SecretReference) (*version2.StreamProxySSL, bool, Warnings) {
+	upstream := getTransportServerActionUpstream(ts)
+	if upstream == nil || upstream.TLS == nil || !upstream.TLS.Enable {
+		return nil, true, nil
+	}
+	tls := upstream.TLS
+
+	warnings := newWarnings()
+
+	var tlsSecretPath string
+	if tls.TLSSecret != "" {
+		tlsSecret := fmt.Sprintf("%s/%s", ts.Namespace, tls.TLSSecret)
+
+		secretRef := secretRefs[tlsSecret]
+		var secretType api_v1.SecretType
+		if secretRef.Secret != nil {
+			secretType = secretRef.Secret.Type
+		}
+		if secretType != "" && secretType != api_v1.SecretTypeTLS {
+			warnings.AddWarningf(ts, "Upstream TLS secret %s is of a wrong type '%s', must be '%s'. Connections will not be proxied.", tlsSecret, secretType, api_v1.SecretTypeTLS)
+			return nil, false, warnings
+		} else if secretRef.Error != nil {
+			warnings.AddWarningf(ts, "Upstream TLS secret %s is invalid: %v. Connections will not be proxied.", tlsSecret, secretRef.Error)
+			return nil, false, warnings
+		}
+
+		tlsSecretPath = secretRef.Path
+	}
+
+	var trustedSecretPath string
+	if tls.TrustedCertSecret != "" {
+		trustedCertSecret := fmt.Sprintf("%s/%s", ts.Namespace, tls.TrustedCertSecret)
+
+		secretRef := secretRefs[trustedCertSecret]
+		var secretType api_v1.SecretType
+		if secretRef.Secret != nil {
+			secretType = secretRef.Secret.Type
+		}
+		if secretType != "" && secretType != secrets.SecretTypeCA {
+			warnings.AddWarningf(ts, "Upstream trusted CA secret %s is of a wrong type '%s', must be '%s'. Connections will not be proxied.", trustedCertSecret, secretType, secrets.SecretTypeCA)
+			return nil, false, warnings
+		} else if secretRef.Error != nil {
+			warnings.AddWarningf(ts, "Upstream trusted CA secret %s is invalid: %v. Connections will not be proxied.", trustedCertSecret, secretRef.Error)
+			return nil, false, warnings
+		}
+
+		if caFields := strings.Fields(secretRef.Path); len(caFields) > 0 {
+			trustedSecretPath = caFields[0]
+		}
+	}
+
+	return &version2.StreamProxySSL{
+		Certificate:    tlsSecretPath,
+		CertificateKey: tlsSecretPath,
+		TrustedCert:    trustedSecretPath,
+		VerifyServer:   tls.VerifyServer,
+		VerifyDepth:    generateIntFromPointer(tls.VerifyDepth, 1),
+		Protocols:      tls.Protocols,
+		Ciphers:        tls.Ciphers,
+		SessionReuse:   generateBool(tls.SessionReuse, true),
+		ServerName:     tls.ServerName,
+		SSLName:        tls.SSLName,
+	}, true, warnings
+}
+
 func generateStreamUpstreams(transportServerEx *TransportServerEx, upstreamNamer *upstreamNamer, isPlus bool, isResolverConfigured bool) ([]version2.StreamUpstream, Warnings) {
 	warnings := newWarnings()
 	var upstreams []version2.StreamUpstream
@@ -260,6 +625,62 @@ func generateTransportServerHealthCheck(upstreamName string, generatedUpstreamNa
 	return hc, match
 }
 
+// createStreamProbeConfigs creates the health probe configs and the servers of the upstreams of the TransportServer
+// with health checks enabled.
+func createStreamProbeConfigs(transportServerEx *TransportServerEx) (map[string]nginx.StreamProbeConfig, map[string][]string) {
+	configs := make(map[string]nginx.StreamProbeConfig)
+	servers := make(map[string][]string)
+
+	ts := transportServerEx.TransportServer
+	upstreamNamer := newUpstreamNamerForTransportServer(ts)
+	for _, u := range ts.Spec.Upstreams {
+		if u.HealthCheck == nil || !u.HealthCheck.Enabled {
+			continue
+		}
+		name := upstreamNamer.GetNameForUpstream(u.Name)
+		configs[name] = generateStreamProbeConfig(u.HealthCheck, ts.Spec.Listener.Protocol == "UDP")
+		servers[name] = transportServerEx.Endpoints[GenerateEndpointsKey(ts.Namespace, u.Service, nil, uint16(u.Port))]
+	}
+
+	return configs, servers
+}
+
+func generateStreamProbeConfig(hc *conf_v1.TransportServerHealthCheck, isUDP bool) nginx.StreamProbeConfig {
+	defaults := generateTransportServerHealthCheckWithDefaults()
+
+	cfg := nginx.StreamProbeConfig{
+		UDP:    isUDP,
+		Port:   hc.Port,
+		Fails:  defaults.Fails,
+		Passes: defaults.Passes,
+	}
+	if hc.Fails > 0 {
+		cfg.Fails = hc.Fails
+	}
+	if hc.Passes > 0 {
+		cfg.Passes = hc.Passes
+	}
+
+	interval, err := ParseTimeToDuration(generateTimeWithDefault(hc.Interval, defaults.Interval))
+	if err != nil {
+		interval, _ = ParseTimeToDuration(defaults.Interval)
+	}
+	cfg.Interval = interval
+
+	timeout, err := ParseTimeToDuration(generateTimeWithDefault(hc.Timeout, defaults.Timeout))
+	if err != nil {
+		timeout, _ = ParseTimeToDuration(defaults.Timeout)
+	}
+	cfg.Timeout = timeout
+
+	if hc.Match != nil {
+		cfg.Send = hc.Match.Send
+		cfg.Expect = hc.Match.Expect
+	}
+
+	return cfg
+}
+
 func generateTransportServerHealthCheckWithDefaults() *version2.StreamHealthCheck {
 	return &version2.StreamHealthCheck{
 		Enabled:  false,
@@ -339,11 +760,28 @@ func generateStreamUpstream(upstream conf_v1.TransportServerUpstream, upstreamNa
 	return version2.StreamUpstream{
 		Name:                name,
 		Servers:             upsServers,
-		LoadBalancingMethod: generateLoadBalancingMethod(upstream.LoadBalancingMethod),
+		LoadBalancingMethod: generateStreamLoadBalancingMethod(upstream),
 		BackupServers:       upsBackups,
 	}
 }
 
+// markDownStreamUpstreamServers marks the servers of the upstreams that are down.
+func markDownStreamUpstreamServers(upstreams []version2.StreamUpstream, downServers func(upstream string) map[string]bool) {
+	for i := range upstreams {
+		down := downServers(upstreams[i].Name)
+		for j := range upstreams[i].Servers {
+			upstreams[i].Servers[j].Down = down[upstreams[i].Servers[j].Address]
+		}
+	}
+}
+
+func generateStreamLoadBalancingMethod(upstream conf_v1.TransportServerUpstream) string {
+	if upstream.SessionAffinity == "ClientIP" {
+		return "hash $remote_addr consistent"
+	}
+	return generateLoadBalancingMethod(upstream.LoadBalancingMethod)
+}
+
 func generateLoadBalancingMethod(method string) string {
 	if method == "" {
 		// By default, if unspecified, Nginx uses the 'round_robin' load balancing method.
@@ -4,10 +4,12 @@ import (
 	"errors"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/nginx/kubernetes-ingress/internal/configs/version2"
 	"github.com/nginx/kubernetes-ingress/internal/k8s/secrets"
+	"github.com/nginx/kubernetes-ingress/internal/nginx"
 	conf_v1 "github.com/nginx/kubernetes-ingress/pkg/apis/configuration/v1"
 	api_v1 "k8s.io/api/core/v1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -353,6 +355,94 @@ func TestGenerateTransportServerConfigForTCP(t *testing.T) {
 	}
 }
 
+func TestGenerateTransportServerConfigForTCPWithProxyProtocol(t *testing.T) {
+	t.Parallel()
+	transportServerEx := TransportServerEx{
+		TransportServer: &conf_v1.TransportServer{
+			ObjectMeta: meta_v1.ObjectMeta{
+				Name:      "tcp-server",
+				Namespace: "default",
+			},
+			Spec: conf_v1.TransportServerSpec{
+				Listener: conf_v1.TransportServerListener{
+					Name:     "tcp-listener",
+					Protocol: "TCP",
+				},
+				Upstreams: []conf_v1.TransportServerUpstream{
+					{
+						Name:          "tcp-app",
+						Service:       "tcp-app-svc",
+						Port:          5001,
+						ProxyProtocol: true,
+					},
+				},
+				Action: &conf_v1.TransportServerAction{
+					Pass: "tcp-app",
+				},
+			},
+		},
+		Endpoints: map[string][]string{
+			"default/tcp-app-svc:5001": {
+				"10.0.0.20:5001",
+			},
+		},
+		AcceptProxyProtocol: true,
+		TrustedCIDRs:        []string{"10.0.0.0/8"},
+	}
+
+	expected := &version2.TransportServerConfig{
+		Upstreams: []version2.StreamUpstream{
+			{
+				Name: "ts_default_tcp-server_tcp-app",
+				Servers: []version2.StreamUpstreamServer{
+					{
+						Address:     "10.0.0.20:5001",
+						MaxFails:    1,
+						FailTimeout: "10s",
+					},
+				},
+				UpstreamLabels: version2.UpstreamLabels{
+					ResourceName:      "tcp-server",
+					ResourceType:      "transportserver",
+					ResourceNamespace: "default",
+					Service:           "tcp-app-svc",
+				},
+				LoadBalancingMethod: "random two least_conn",
+			},
+		},
+		Server: version2.StreamServer{
+			Port:                     2020,
+			StatusZone:               "tcp-listener",
+			ProxyPass:                "ts_default_tcp-server_tcp-app",
+			Name:                     "tcp-server",
+			Namespace:                "default",
+			ProxyConnectTimeout:      "60s",
+			ProxyNextUpstreamTimeout: "0s",
+			ProxyTimeout:             "10m",
+			ServerSnippets:           []string{},
+			SSL:                      &version2.StreamSSL{},
+			ProxyProtocol:            true,
+			SetRealIPFrom:            []string{"10.0.0.0/8"},
+			UpstreamProxyProtocol:    true,
+		},
+		StreamSnippets: []string{},
+		StaticSSLPath:  "/etc/nginx/secret",
+	}
+
+	result, warnings := generateTransportServerConfig(transportServerConfigParams{
+		transportServerEx: &transportServerEx,
+		listenerPort:      2020,
+		isPlus:            true,
+		staticSSLPath:     "/etc/nginx/secret",
+	})
+	if len(warnings) != 0 {
+		t.Errorf("want no warnings, got %v", warnings)
+	}
+	if !cmp.Equal(expected, result) {
+		t.Errorf("generateTransportServerConfig() mismatch (-want +got):\n%s", cmp.Diff(expected, result))
+	}
+}
+
 func TestGenerateTransportServerConfigForTCPMaxConnections(t *testing.T) {
 	t.Parallel()
 	transportServerEx := TransportServerEx{
@@ -1796,6 +1886,264 @@ func TestGenerateHealthCheckMatch(t *testing.T) {
 	}
 }
 
+func TestGenerateTransportServerAccessLog(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		accessLog         *conf_v1.TransportServerAccessLog
+		expectedAccessLog *version2.StreamAccessLog
+		expectedLogFormat *version2.StreamLogFormat
+		expectedMaps      []version2.Map
+		msg               string
+	}{
+		{
+			accessLog: nil,
+			msg:       "no access log",
+		},
+		{
+			accessLog:         &conf_v1.TransportServerAccessLog{Enable: false},
+			expectedAccessLog: &version2.StreamAccessLog{Off: true},
+			msg:               "disabled access log",
+		},
+		{
+			accessLog: &conf_v1.TransportServerAccessLog{Enable: true},
+			expectedAccessLog: &version2.StreamAccessLog{
+				Destination: "/dev/stdout",
+				Format:      "stream-main",
+			},
+			msg: "default access log",
+		},
+		{
+			accessLog: &conf_v1.TransportServerAccessLog{
+				Enable:      true,
+				Format:      "$remote_addr $status",
+				Destination: "syslog:server=10.0.0.1:514,tag=tcp_app",
+				Statuses:    []int{502, 503},
+			},
+			expectedAccessLog: &version2.StreamAccessLog{
+				Destination: "syslog:server=10.0.0.1:514,tag=tcp_app",
+				Format:      "ts_default_tcp_server",
+				Condition:   "$ts_default_tcp_server_loggable",
+			},
+			expectedLogFormat: &version2.StreamLogFormat{
+				Name:   "ts_default_tcp_server",
+				Format: "$remote_addr $status",
+			},
+			expectedMaps: []version2.Map{
+				{
+					Source:   "$status",
+					Variable: "$ts_default_tcp_server_loggable",
+					Parameters: []version2.Parameter{
+						{Value: "502", Result: "1"},
+						{Value: "503", Result: "1"},
+						{Value: "default", Result: "0"},
+					},
+				},
+			},
+			msg: "custom format, syslog destination and statuses",
+		},
+	}
+
+	for _, test := range tests {
+		ts := &conf_v1.TransportServer{
+			ObjectMeta: meta_v1.ObjectMeta{
+				Name:      "tcp-server",
+				Namespace: "default",
+			},
+			Spec: conf_v1.TransportServerSpec{
+				AccessLog: test.accessLog,
+			},
+		}
+
+		accessLog, logFormat, maps := generateTransportServerAccessLog(ts)
+		if diff := cmp.Diff(test.expectedAccessLog, accessLog); diff != "" {
+			t.Errorf("generateTransportServerAccessLog() access log mismatch for the case of %s (-want +got):\n%s", test.msg, diff)
+		}
+		if diff := cmp.Diff(test.expectedLogFormat, logFormat); diff != "" {
+			t.Errorf("generateTransportServerAccessLog() log format mismatch for the case of %s (-want +got):\n%s", test.msg, diff)
+		}
+		if diff := cmp.Diff(test.expectedMaps, maps); diff != "" {
+			t.Errorf("generateTransportServerAccessLog() maps mismatch for the case of %s (-want +got):\n%s", test.msg, diff)
+		}
+	}
+}
+
+func TestGenerateStreamLoadBalancingMethod(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		upstream conf_v1.TransportServerUpstream
+		expected string
+		msg      string
+	}{
+		{
+			upstream: conf_v1.TransportServerUpstream{},
+			expected: "random two least_conn",
+			msg:      "default method",
+		},
+		{
+			upstream: conf_v1.TransportServerUpstream{LoadBalancingMethod: "least_conn"},
+			expected: "least_conn",
+			msg:      "load balancing method",
+		},
+		{
+			upstream: conf_v1.TransportServerUpstream{SessionAffinity: "None", LoadBalancingMethod: "least_conn"},
+			expected: "least_conn",
+			msg:      "session affinity None",
+		},
+		{
+			upstream: conf_v1.TransportServerUpstream{SessionAffinity: "ClientIP"},
+			expected: "hash $remote_addr consistent",
+			msg:      "session affinity ClientIP",
+		},
+	}
+
+	for _, test := range tests {
+		result := generateStreamLoadBalancingMethod(test.upstream)
+		if result != test.expected {
+			t.Errorf("generateStreamLoadBalancingMethod() returned %q but expected %q for the case of %s", result, test.expected, test.msg)
+		}
+	}
+}
+
+func TestGenerateTransportServerConfig_MarksDownServers(t *testing.T) {
+	t.Parallel()
+	transportServerEx := TransportServerEx{
+		TransportServer: &conf_v1.TransportServer{
+			ObjectMeta: meta_v1.ObjectMeta{
+				Name:      "tcp-server",
+				Namespace: "default",
+			},
+			Spec: conf_v1.TransportServerSpec{
+				Listener: conf_v1.TransportServerListener{
+					Name:     "tcp-listener",
+					Protocol: "TCP",
+				},
+				Upstreams: []conf_v1.TransportServerUpstream{
+					{
+						Name:    "tcp-app",
+						Service: "tcp-app-svc",
+						Port:    5001,
+						HealthCheck: &conf_v1.TransportServerHealthCheck{
+							Enabled: true,
+						},
+					},
+				},
+				Action: &conf_v1.TransportServerAction{
+					Pass: "tcp-app",
+				},
+			},
+		},
+		Endpoints: map[string][]string{
+			"default/tcp-app-svc:5001": {
+				"10.0.0.20:5001",
+				"10.0.0.21:5001",
+			},
+		},
+	}
+
+	downServers := func(upstream string) map[string]bool {
+		if upstream != "ts_default_tcp-server_tcp-app" {
+			return nil
+		}
+		return map[string]bool{"10.0.0.21:5001": true}
+	}
+
+	result, _ := generateTransportServerConfig(transportServerConfigParams{
+		transportServerEx: &transportServerEx,
+		listenerPort:      2020,
+		staticSSLPath:     "/etc/nginx/secret",
+		downServers:       downServers,
+	})
+
+	expected := []version2.StreamUpstreamServer{
+		{
+			Address:     "10.0.0.20:5001",
+			MaxFails:    1,
+			FailTimeout: "10s",
+		},
+		{
+			Address:     "10.0.0.21:5001",
+			MaxFails:    1,
+			FailTimeout: "10s",
+			Down:        true,
+		},
+	}
+	if diff := cmp.Diff(expected, result.Upstreams[0].Servers); diff != "" {
+		t.Errorf("generateTransportServerConfig() servers mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestCreateStreamProbeConfigs(t *testing.T) {
+	t.Parallel()
+	transportServerEx := TransportServerEx{
+		TransportServer: &conf_v1.TransportServer{
+			ObjectMeta: meta_v1.ObjectMeta{
+				Name:      "dns-server",
+				Namespace: "default",
+			},
+			Spec: conf_v1.TransportServerSpec{
+				Listener: conf_v1.TransportServerListener{
+					Name:     "dns-udp",
+					Protocol: "UDP",
+				},
+				Upstreams: []conf_v1.TransportServerUpstream{
+					{
+						Name:    "dns-app",
+						Service: "dns-svc",
+						Port:    53,
+						HealthCheck: &conf_v1.TransportServerHealthCheck{
+							Enabled:  true,
+							Interval: "10s",
+							Fails:    3,
+							Port:     8053,
+							Match: &conf_v1.TransportServerMatch{
+								Send:   `\x00`,
+								Expect: "~.*",
+							},
+						},
+					},
+					{
+						Name:    "dns-app-no-hc",
+						Service: "dns-svc",
+						Port:    53,
+					},
+				},
+				Action: &conf_v1.TransportServerAction{
+					Pass: "dns-app",
+				},
+			},
+		},
+		Endpoints: map[string][]string{
+			"default/dns-svc:53": {
+				"10.0.0.20:53",
+			},
+		},
+	}
+
+	expectedConfigs := map[string]nginx.StreamProbeConfig{
+		"ts_default_dns-server_dns-app": {
+			UDP:      true,
+			Port:     8053,
+			Interval: 10 * time.Second,
+			Timeout:  5 * time.Second,
+			Fails:    3,
+			Passes:   1,
+			Send:     `\x00`,
+			Expect:   "~.*",
+		},
+	}
+	expectedServers := map[string][]string{
+		"ts_default_dns-server_dns-app": {"10.0.0.20:53"},
+	}
+
+	configs, servers := createStreamProbeConfigs(&transportServerEx)
+	if diff := cmp.Diff(expectedConfigs, configs); diff != "" {
+		t.Errorf("createStreamProbeConfigs() configs mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(expectedServers, servers); diff != "" {
+		t.Errorf("createStreamProbeConfigs() servers mismatch (-want +got):\n%s", diff)
+	}
+}
+
 func intPointer(value int) *int {
 	return &value
 }
@@ -1901,3 +2249,492 @@ func TestGenerateTsSSLConfig(t *testing.T) {
 		}
 	}
 }
+
+func TestGenerateStreamProxySSL(t *testing.T) {
+	t.Parallel()
+	newTS := func(tls *conf_v1.TransportServerUpstreamTLS) *conf_v1.TransportServer {
+		return &conf_v1.TransportServer{
+			ObjectMeta: meta_v1.ObjectMeta{
+				Name:      "tcp-server",
+				Namespace: "default",
+			},
+			Spec: conf_v1.TransportServerSpec{
+				Upstreams: []conf_v1.TransportServerUpstream{
+					{
+						Name: "tcp-app",
+						TLS:  tls,
+					},
+				},
+				Action: &conf_v1.TransportServerAction{
+					Pass: "tcp-app",
+				},
+			},
+		}
+	}
+	secretRefs := map[string]*secrets.SecretReference{
+		"default/client-secret": {
+			Secret: &api_v1.Secret{
+				Type: api_v1.SecretTypeTLS,
+			},
+			Path: "/etc/nginx/secrets/default-client-secret",
+		},
+		"default/ca-secret": {
+			Secret: &api_v1.Secret{
+				Type: secrets.SecretTypeCA,
+			},
+			Path: "/etc/nginx/secrets/default-ca-secret-ca.crt /etc/nginx/secrets/default-ca-secret-ca.crl",
+		},
+	}
+
+	tests := []struct {
+		tls      *conf_v1.TransportServerUpstreamTLS
+		expected *version2.StreamProxySSL
+		msg      string
+	}{
+		{
+			tls:      nil,
+			expected: nil,
+			msg:      "no tls",
+		},
+		{
+			tls:      &conf_v1.TransportServerUpstreamTLS{Enable: false, TLSSecret: "client-secret"},
+			expected: nil,
+			msg:      "tls disabled",
+		},
+		{
+			tls: &conf_v1.TransportServerUpstreamTLS{Enable: true},
+			expected: &version2.StreamProxySSL{
+				VerifyDepth:  1,
+				SessionReuse: true,
+			},
+			msg: "tls with defaults",
+		},
+		{
+			tls: &conf_v1.TransportServerUpstreamTLS{
+				Enable:            true,
+				TLSSecret:         "client-secret",
+				VerifyServer:      true,
+				VerifyDepth:       createPointerFromInt(2),
+				Protocols:         "TLSv1.2 TLSv1.3",
+				Ciphers:           "HIGH:!aNULL:!MD5",
+				SessionReuse:      createPointerFromBool(false),
+				TrustedCertSecret: "ca-secret",
+				ServerName:        true,
+				SSLName:           "db.example.com",
+			},
+			expected: &version2.StreamProxySSL{
+				Certificate:    "/etc/nginx/secrets/default-client-secret",
+				CertificateKey: "/etc/nginx/secrets/default-client-secret",
+				TrustedCert:    "/etc/nginx/secrets/default-ca-secret-ca.crt",
+				VerifyServer:   true,
+				VerifyDepth:    2,
+				Protocols:      "TLSv1.2 TLSv1.3",
+				Ciphers:        "HIGH:!aNULL:!MD5",
+				SessionReuse:   false,
+				ServerName:     true,
+				SSLName:        "db.example.com",
+			},
+			msg: "mutual tls with verification",
+		},
+	}
+
+	for _, test := range tests {
+		result, ok, warnings := generateStreamProxySSL(newTS(test.tls), secretRefs)
+		if !ok {
+			t.Errorf("generateStreamProxySSL() returned false for the case of %s", test.msg)
+		}
+		if diff := cmp.Diff(test.expected, result); diff != "" {
+			t.Errorf("generateStreamProxySSL() mismatch for the case of %s (-want +got):\n%s", test.msg, diff)
+		}
+		if len(warnings) != 0 {
+			t.Errorf("generateStreamProxySSL() returned unexpected warnings %v for the case of %s", warnings, test.msg)
+		}
+	}
+}
+
+func TestGenerateStreamProxySSL_FailsOnInvalidSecrets(t *testing.T) {
+	t.Parallel()
+	secretRefs := map[string]*secrets.SecretReference{
+		"default/missing": {
+			Error: errors.New("secret doesn't exist"),
+		},
+		"default/ca-secret": {
+			Secret: &api_v1.Secret{
+				Type: secrets.SecretTypeCA,
+			},
+			Path: "/etc/nginx/secrets/default-ca-secret-ca.crt",
+		},
+		"default/tls-secret": {
+			Secret: &api_v1.Secret{
+				Type: api_v1.SecretTypeTLS,
+			},
+			Path: "/etc/nginx/secrets/default-tls-secret",
+		},
+	}
+
+	tests := []struct {
+		tls *conf_v1.TransportServerUpstreamTLS
+		msg string
+	}{
+		{
+			tls: &conf_v1.TransportServerUpstreamTLS{Enable: true, TLSSecret: "missing"},
+			msg: "missing client secret",
+		},
+		{
+			tls: &conf_v1.TransportServerUpstreamTLS{Enable: true, TLSSecret: "ca-secret"},
+			msg: "client secret of a wrong type",
+		},
+		{
+			tls: &conf_v1.TransportServerUpstreamTLS{Enable: true, VerifyServer: true, TrustedCertSecret: "missing"},
+			msg: "missing trusted cert secret",
+		},
+		{
+			tls: &conf_v1.TransportServerUpstreamTLS{Enable: true, VerifyServer: true, TrustedCertSecret: "tls-secret"},
+			msg: "trusted cert secret of a wrong type",
+		},
+	}
+
+	for _, test := range tests {
+		ts := &conf_v1.TransportServer{
+			ObjectMeta: meta_v1.ObjectMeta{
+				Name:      "tcp-server",
+				Namespace: "default",
+			},
+			Spec: conf_v1.TransportServerSpec{
+				Upstreams: []conf_v1.TransportServerUpstream{
+					{
+						Name: "tcp-app",
+						TLS:  test.tls,
+					},
+				},
+				Action: &conf_v1.TransportServerAction{
+					Pass: "tcp-app",
+				},
+			},
+		}
+
+		result, ok, warnings := generateStreamProxySSL(ts, secretRefs)
+		if ok {
+			t.Errorf("generateStreamProxySSL() returned true for the case of %s", test.msg)
+		}
+		if result != nil {
+			t.Errorf("generateStreamProxySSL() returned %v for the case of %s", result, test.msg)
+		}
+		if len(warnings) == 0 {
+			t.Errorf("generateStreamProxySSL() returned no warnings for the case of %s", test.msg)
+		}
+	}
+}
+
+func TestAddSSLClientVerification(t *testing.T) {
+	t.Parallel()
+	ts := &conf_v1.TransportServer{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      "mqtt",
+			Namespace: "default",
+		},
+	}
+	secretRefs := map[string]*secrets.SecretReference{
+		"default/ca-secret": {
+			Secret: &api_v1.Secret{
+				Type: secrets.SecretTypeCA,
+			},
+			Path: "/etc/nginx/secrets/default-ca-secret-ca.crt",
+		},
+		"default/ca-crl-secret": {
+			Secret: &api_v1.Secret{
+				Type: secrets.SecretTypeCA,
+				Data: map[string][]byte{
+					CACrlKey: nil,
+				},
+			},
+			Path: "/etc/nginx/secrets/default-ca-crl-secret-ca.crt /etc/nginx/secrets/default-ca-crl-secret-ca.crl",
+		},
+	}
+
+	tests := []struct {
+		tls      *conf_v1.TransportServerTLS
+		expected *version2.StreamSSL
+		msg      string
+	}{
+		{
+			tls: &conf_v1.TransportServerTLS{Secret: "tls-secret"},
+			expected: &version2.StreamSSL{
+				Enabled: true,
+			},
+			msg: "no client verification",
+		},
+		{
+			tls: &conf_v1.TransportServerTLS{Secret: "tls-secret", ClientCertSecret: "ca-secret"},
+			expected: &version2.StreamSSL{
+				Enabled:      true,
+				ClientCert:   "/etc/nginx/secrets/default-ca-secret-ca.crt",
+				VerifyClient: "on",
+				VerifyDepth:  1,
+			},
+			msg: "client verification with defaults",
+		},
+		{
+			tls: &conf_v1.TransportServerTLS{
+				Secret:           "tls-secret",
+				ClientCertSecret: "ca-crl-secret",
+				VerifyClient:     "optional",
+				VerifyDepth:      createPointerFromInt(3),
+			},
+			expected: &version2.StreamSSL{
+				Enabled:      true,
+				ClientCert:   "/etc/nginx/secrets/default-ca-crl-secret-ca.crt",
+				ClientCrl:    "/etc/nginx/secrets/default-ca-crl-secret-ca.crl",
+				VerifyClient: "optional",
+				VerifyDepth:  3,
+			},
+			msg: "client verification with crl in the secret",
+		},
+		{
+			tls: &conf_v1.TransportServerTLS{
+				Secret:           "tls-secret",
+				ClientCertSecret: "ca-secret",
+				CrlFileName:      "clients.crl",
+			},
+			expected: &version2.StreamSSL{
+				Enabled:      true,
+				ClientCert:   "/etc/nginx/secrets/default-ca-secret-ca.crt",
+				ClientCrl:    "/etc/nginx/secrets/clients.crl",
+				VerifyClient: "on",
+				VerifyDepth:  1,
+			},
+			msg: "client verification with crl file name",
+		},
+	}
+
+	for _, test := range tests {
+		ssl := &version2.StreamSSL{Enabled: true}
+		ok, warnings := addSSLClientVerification(ssl, ts, test.tls, secretRefs)
+		if !ok {
+			t.Errorf("addSSLClientVerification() returned false for the case of %s", test.msg)
+		}
+		if diff := cmp.Diff(test.expected, ssl); diff != "" {
+			t.Errorf("addSSLClientVerification() mismatch for the case of %s (-want +got):\n%s", test.msg, diff)
+		}
+		if len(warnings) != 0 {
+			t.Errorf("addSSLClientVerification() returned unexpected warnings %v for the case of %s", warnings, test.msg)
+		}
+	}
+}
+
+func TestAddSSLClientVerification_FailsOnInvalidSecret(t *testing.T) {
+	t.Parallel()
+	ts := &conf_v1.TransportServer{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      "mqtt",
+			Namespace: "default",
+		},
+	}
+	secretRefs := map[string]*secrets.SecretReference{
+		"default/missing": {
+			Error: errors.New("secret doesn't exist"),
+		},
+		"default/tls-secret": {
+			Secret: &api_v1.Secret{
+				Type: api_v1.SecretTypeTLS,
+			},
+			Path: "/etc/nginx/secrets/default-tls-secret",
+		},
+	}
+
+	for _, name := range []string{"missing", "tls-secret"} {
+		ssl := &version2.StreamSSL{Enabled: true}
+		ok, warnings := addSSLClientVerification(ssl, ts, &conf_v1.TransportServerTLS{Secret: "tls-secret", ClientCertSecret: name}, secretRefs)
+		if ok {
+			t.Errorf("addSSLClientVerification() returned true for secret %s", name)
+		}
+		if len(warnings) == 0 {
+			t.Errorf("addSSLClientVerification() returned no warnings for secret %s", name)
+		}
+		if ssl.ClientCert != "" {
+			t.Errorf("addSSLClientVerification() set client cert %q for secret %s", ssl.ClientCert, name)
+		}
+	}
+}
+
+func TestGenerateTransportServerActionRouting(t *testing.T) {
+	t.Parallel()
+	newTS := func(action *conf_v1.TransportServerAction) *conf_v1.TransportServer {
+		return &conf_v1.TransportServer{
+			ObjectMeta: meta_v1.ObjectMeta{
+				Name:      "tcp-server",
+				Namespace: "default",
+			},
+			Spec: conf_v1.TransportServerSpec{
+				Action: action,
+			},
+		}
+	}
+
+	tests := []struct {
+		action               *conf_v1.TransportServerAction
+		expectedProxyPass    string
+		expectedSplitClients []version2.SplitClient
+		expectedMaps         []version2.Map
+		msg                  string
+	}{
+		{
+			action:            &conf_v1.TransportServerAction{Pass: "stable"},
+			expectedProxyPass: "ts_default_tcp-server_stable",
+			msg:               "pass",
+		},
+		{
+			action: &conf_v1.TransportServerAction{
+				Splits: []conf_v1.TransportServerSplit{
+					{Weight: 90, Pass: "stable"},
+					{Weight: 10, Pass: "canary"},
+					{Weight: 0, Pass: "disabled"},
+				},
+			},
+			expectedProxyPass: "$ts_default_tcp_server_splits",
+			expectedSplitClients: []version2.SplitClient{
+				{
+					Source:   "$remote_addr$remote_port",
+					Variable: "$ts_default_tcp_server_splits",
+					Distributions: []version2.Distribution{
+						{Weight: "90%", Value: "ts_default_tcp-server_stable"},
+						{Weight: "10%", Value: "ts_default_tcp-server_canary"},
+					},
+				},
+			},
+			msg: "splits",
+		},
+		{
+			action: &conf_v1.TransportServerAction{
+				Splits: []conf_v1.TransportServerSplit{
+					{Weight: 50, Pass: "stable"},
+					{Weight: 50, Pass: "canary"},
+				},
+				Matches: []conf_v1.TransportServerActionMatch{
+					{ServerNames: []string{"a.example.com", "*.a.example.com"}, Pass: "db-a"},
+				},
+			},
+			expectedProxyPass: "$ts_default_tcp_server_server_name",
+			expectedSplitClients: []version2.SplitClient{
+				{
+					Source:   "$remote_addr$remote_port",
+					Variable: "$ts_default_tcp_server_splits",
+					Distributions: []version2.Distribution{
+						{Weight: "50%", Value: "ts_default_tcp-server_stable"},
+						{Weight: "50%", Value: "ts_default_tcp-server_canary"},
+					},
+				},
+			},
+			expectedMaps: []version2.Map{
+				{
+					Source:    "$ssl_preread_server_name",
+					Variable:  "$ts_default_tcp_server_server_name",
+					Hostnames: true,
+					Parameters: []version2.Parameter{
+						{Value: "a.example.com", Result: "ts_default_tcp-server_db-a"},
+						{Value: "*.a.example.com", Result: "ts_default_tcp-server_db-a"},
+						{Value: "default", Result: "$ts_default_tcp_server_splits"},
+					},
+				},
+			},
+			msg: "matches with splits",
+		},
+	}
+
+	for _, test := range tests {
+		ts := newTS(test.action)
+		proxyPass, splitClients, maps := generateTransportServerActionRouting(ts, newUpstreamNamerForTransportServer(ts))
+		if proxyPass != test.expectedProxyPass {
+			t.Errorf("generateTransportServerActionRouting() returned proxy pass %q but expected %q for the case of %s", proxyPass, test.expectedProxyPass, test.msg)
+		}
+		if diff := cmp.Diff(test.expectedSplitClients, splitClients); diff != "" {
+			t.Errorf("generateTransportServerActionRouting() split clients mismatch for the case of %s (-want +got):\n%s", test.msg, diff)
+		}
+		if diff := cmp.Diff(test.expectedMaps, maps); diff != "" {
+			t.Errorf("generateTransportServerActionRouting() maps mismatch for the case of %s (-want +got):\n%s", test.msg, diff)
+		}
+	}
+}
+
+func TestGenerateTransportServerHealthCheckServers(t *testing.T) {
+	t.Parallel()
+	ts := &conf_v1.TransportServer{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      "tcp-server",
+			Namespace: "default",
+		},
+		Spec: conf_v1.TransportServerSpec{
+			Upstreams: []conf_v1.TransportServerUpstream{
+				{
+					Name: "stable",
+					HealthCheck: &conf_v1.TransportServerHealthCheck{
+						Enabled:  true,
+						Interval: "10s",
+						Match: &conf_v1.TransportServerMatch{
+							Send:   "GET / HTTP/1.0\\r\\nHost: localhost\\r\\n\\r\\n",
+							Expect: "~*200 OK",
+						},
+					},
+				},
+				{
+					Name:        "canary",
+					HealthCheck: &conf_v1.TransportServerHealthCheck{Enabled: true},
+				},
+				{
+					Name: "disabled",
+				},
+			},
+			Action: &conf_v1.TransportServerAction{
+				Splits: []conf_v1.TransportServerSplit{
+					{Weight: 80, Pass: "stable"},
+					{Weight: 10, Pass: "canary"},
+					{Weight: 10, Pass: "disabled"},
+				},
+			},
+		},
+	}
+
+	expectedServers := []version2.StreamHealthCheckServer{
+		{
+			UnixSocket: "unix:/var/lib/nginx/health-check-ts_default_tcp-server_stable.sock",
+			ProxyPass:  "ts_default_tcp-server_stable",
+			HealthCheck: &version2.StreamHealthCheck{
+				Enabled:  true,
+				Timeout:  "5s",
+				Jitter:   "0s",
+				Interval: "10s",
+				Passes:   1,
+				Fails:    1,
+				Match:    "match_ts_default_tcp-server_stable",
+			},
+		},
+		{
+			UnixSocket: "unix:/var/lib/nginx/health-check-ts_default_tcp-server_canary.sock",
+			ProxyPass:  "ts_default_tcp-server_canary",
+			HealthCheck: &version2.StreamHealthCheck{
+				Enabled:  true,
+				Timeout:  "5s",
+				Jitter:   "0s",
+				Interval: "5s",
+				Passes:   1,
+				Fails:    1,
+			},
+		},
+	}
+	expectedMatches := []version2.Match{
+		{
+			Name:                "match_ts_default_tcp-server_stable",
+			Send:                "GET / HTTP/1.0\\r\\nHost: localhost\\r\\n\\r\\n",
+			ExpectRegexModifier: "~*",
+			Expect:              "200 OK",
+		},
+	}
+
+	servers, matches := generateTransportServerHealthCheckServers(ts, newUpstreamNamerForTransportServer(ts))
+	if diff := cmp.Diff(expectedServers, servers); diff != "" {
+		t.Errorf("generateTransportServerHealthCheckServers() servers mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(expectedMatches, matches); diff != "" {
+		t.Errorf("generateTransportServerHealthCheckServers() matches mismatch (-want +got):\n%s", diff)
+	}
+}
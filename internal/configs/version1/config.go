@@ -19,12 +19,14 @@ type IngressNginxConfig struct {
 	Servers                 []Server
 	Keepalive               string
 	Maps                    []version2.Map
+	SplitClients            []version2.SplitClient
 	CORSHeaders             []version2.AddHeader
 	Ingress                 Ingress
 	SpiffeClientCerts       bool
 	DynamicSSLReloadEnabled bool
 	StaticSSLPath           string
 	LimitReqZones           []LimitReqZone
+	SharedLimitReqZones     []version2.LimitReqZone
 }
 
 // Ingress holds information about an Ingress resource.
@@ -139,6 +141,10 @@ type Server struct {
 	DisableIPV6 bool
 
 	AppRoot string
+
+	RequestID *version2.RequestID
+
+	DefaultBackendAddress string
 }
 
 // JWTRedirectLocation describes a location for redirecting client requests to a login URL for JWT Authentication.
@@ -176,7 +182,9 @@ type LimitReq struct {
 type Location struct {
 	LocationSnippets     []string
 	Path                 string
+	PathRegex            string
 	Upstream             Upstream
+	UpstreamVariable     string
 	ProxyConnectTimeout  string
 	ProxyReadTimeout     string
 	ProxySendTimeout     string
@@ -201,6 +209,11 @@ type Location struct {
 	LimitReq             *LimitReq
 	CORSEnabled          bool
 
+	// PolicyProxySetHeaders are the request headers set by the Headers policies.
+	PolicyProxySetHeaders []version2.Header
+	ProxyHideHeaders      []string
+	ProxyPassHeaders      []string
+
 	MinionIngress *Ingress
 
 	ProxyNextUpstream        string
@@ -258,6 +271,8 @@ type MainConfig struct {
 	AccessLog                          string
 	DefaultServerAccessLogOff          bool
 	DefaultServerReturn                string
+	DefaultBackendAddress              string
+	DefaultErrorPages                  []version2.DefaultErrorPage
 	DisableIPV6                        bool
 	DefaultHTTPListenerPort            int
 	DefaultHTTPSListenerPort           int
@@ -269,6 +284,7 @@ type MainConfig struct {
 	KeepaliveRequests                  int64
 	KeepaliveTimeout                   string
 	LogFormat                          []string
+	LogFormatRequestID                 bool
 	LogFormatEscaping                  string
 	MainSnippets                       []string
 	MGMTConfig                         MGMTConfig
@@ -329,6 +345,7 @@ type MainConfig struct {
 	InternalRouteServer                bool
 	InternalRouteServerName            string
 	LatencyMetrics                     bool
+	RequestID                          *version2.RequestID
 	ZoneSyncConfig                     ZoneSyncConfig
 	OIDC                               OIDCConfig
 	DynamicSSLReloadEnabled            bool
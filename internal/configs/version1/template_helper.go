@@ -25,7 +25,14 @@ func trim(s string) string {
 //
 // Annotations 'path-regex' are set only on Minions. If set on Master Ingress,
 // they are ignored and have no effect.
+//
+// The regex modifier of the path from the 'path-regex-map' annotation
+// takes precedence over the 'path-regex' annotation.
 func makeLocationPath(loc *Location, ingressAnnotations map[string]string) string {
+	if loc.PathRegex != "" {
+		return makePathWithRegex(loc.Path, loc.PathRegex)
+	}
+
 	if loc.MinionIngress != nil {
 		// Case when annotation 'path-regex' set on Location's Minion.
 		ingressType, isMergeable := loc.MinionIngress.Annotations["nginx.org/mergeable-ingress-type"]
@@ -206,11 +213,10 @@ func makeResolver(resolverAddresses []string, resolverValid string, resolverIPV6
 // a rewrite pattern that matches the location pattern used.
 // This ensures the rewrite regex matches the same requests as the location.
 func makeRewritePattern(loc *Location, ingressAnnotations map[string]string) string {
-	var regexType string
-	var hasRegex bool
+	// Check for path-regex-map and path-regex annotations (same logic as makeLocationPath)
+	regexType, hasRegex := loc.PathRegex, loc.PathRegex != ""
 
-	// Check for path-regex annotation (same logic as makeLocationPath)
-	if loc.MinionIngress != nil {
+	if !hasRegex && loc.MinionIngress != nil {
 		ingressType, isMergeable := loc.MinionIngress.Annotations["nginx.org/mergeable-ingress-type"]
 		regexType, hasRegex = loc.MinionIngress.Annotations["nginx.org/path-regex"]
 		if !isMergeable || ingressType != "minion" || !hasRegex {
@@ -191,6 +191,25 @@ func TestMakeLocationPath_SetOnMinionTakesPrecedenceOverMaster(t *testing.T) {
 	}
 }
 
+func TestMakeLocationPath_PathRegexOfLocationTakesPrecedenceOverAnnotation(t *testing.T) {
+	t.Parallel()
+
+	want := "~* \"^/coffee/(.*)\""
+	got := makeLocationPath(
+		&Location{
+			Path:      "/coffee/(.*)",
+			PathRegex: "case_insensitive",
+		},
+		map[string]string{
+			"nginx.org/path-regex": "case_sensitive",
+		},
+	)
+
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
 func TestMakeLocationPath_PathRegexSetOnMasterDoesNotModifyMinionWithoutPathRegexAnnotation(t *testing.T) {
 	t.Parallel()
 
@@ -940,6 +959,19 @@ func TestMakeRewritePattern_WithRegexCaseSensitiveModifier(t *testing.T) {
 	}
 }
 
+func TestMakeRewritePattern_WithPathRegexOfLocation(t *testing.T) {
+	t.Parallel()
+
+	want := "(?i)^/coffee/(.*)"
+	got := makeRewritePattern(
+		&Location{Path: "/coffee/(.*)", PathRegex: "case_insensitive"},
+		map[string]string{},
+	)
+	if got != want {
+		t.Errorf("makeRewritePattern() = %q; want %q", got, want)
+	}
+}
+
 func TestMakeRewritePattern_WithRegexCaseInsensitiveModifier(t *testing.T) {
 	t.Parallel()
 
@@ -212,6 +212,76 @@ func TestExecuteTemplate_ForIngressWithCORS(t *testing.T) {
 	}
 }
 
+func TestExecuteTemplate_ForIngressWithCanary(t *testing.T) {
+	t.Parallel()
+
+	ingressCfgWithCanary := IngressNginxConfig{
+		Servers: []Server{
+			{
+				Name:         "test.example.com",
+				ServerTokens: "off",
+				StatusZone:   "test.example.com",
+				Locations: []Location{
+					{
+						Path:             "/tea",
+						Upstream:         testUpstream,
+						UpstreamVariable: "$canary_default_cafe_ingress_0_header",
+					},
+				},
+			},
+		},
+		Upstreams: []Upstream{testUpstream},
+		SplitClients: []version2.SplitClient{
+			{
+				Source:   "$request_id",
+				Variable: "$canary_default_cafe_ingress_0_weight",
+				Distributions: []version2.Distribution{
+					{Weight: "20%", Value: "default-cafe-ingress-canary-test.example.com-tea-canary-svc-80"},
+					{Weight: "*", Value: testUpstream.Name},
+				},
+			},
+		},
+		Maps: []version2.Map{
+			{
+				Source:   "$http_x_canary",
+				Variable: "$canary_default_cafe_ingress_0_header",
+				Parameters: []version2.Parameter{
+					{Value: "always", Result: "default-cafe-ingress-canary-test.example.com-tea-canary-svc-80"},
+					{Value: "default", Result: "$canary_default_cafe_ingress_0_weight"},
+				},
+			},
+		},
+		Ingress: Ingress{
+			Name:      "cafe-ingress",
+			Namespace: "default",
+		},
+	}
+
+	for _, newTmpl := range []func(t *testing.T) *template.Template{newNGINXIngressTmpl, newNGINXPlusIngressTmpl} {
+		tmpl := newTmpl(t)
+		buf := &bytes.Buffer{}
+
+		err := tmpl.Execute(buf, ingressCfgWithCanary)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		cfg := buf.String()
+		wantedStrings := []string{
+			"split_clients $request_id $canary_default_cafe_ingress_0_weight {",
+			"20% default-cafe-ingress-canary-test.example.com-tea-canary-svc-80;",
+			"map $http_x_canary $canary_default_cafe_ingress_0_header {",
+			"proxy_pass http://$canary_default_cafe_ingress_0_header;",
+		}
+
+		for _, want := range wantedStrings {
+			if !strings.Contains(cfg, want) {
+				t.Errorf("want %q in generated config", want)
+			}
+		}
+	}
+}
+
 func TestExecuteTemplate_ForIngressWithHeadersOnlyNoCORS(t *testing.T) {
 	t.Parallel()
 
@@ -256,6 +326,59 @@ func TestExecuteTemplate_ForIngressWithHeadersOnlyNoCORS(t *testing.T) {
 	}
 }
 
+func TestExecuteTemplate_ForIngressWithHeadersPolicy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		newTmpl func(t *testing.T) *template.Template
+	}{
+		{
+			name:    "nginx",
+			newTmpl: newNGINXIngressTmpl,
+		},
+		{
+			name:    "nginx-plus",
+			newTmpl: newNGINXPlusIngressTmpl,
+		},
+	}
+
+	server := ingressCfg.Servers[0]
+	server.Locations = append([]Location(nil), server.Locations...)
+	server.Locations[0].PolicyProxySetHeaders = []version2.Header{{Name: "X-Forwarded-Prefix", Value: "/tea"}}
+	server.Locations[0].ProxyHideHeaders = []string{"Server"}
+	server.Locations[0].ProxyPassHeaders = []string{"X-Accel-Date"}
+	cfgWithHeaders := ingressCfg
+	cfgWithHeaders.Servers = []Server{server}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			tmpl := test.newTmpl(t)
+			buf := &bytes.Buffer{}
+
+			err := tmpl.Execute(buf, cfgWithHeaders)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cfg := buf.String()
+			wantedStrings := []string{
+				`proxy_set_header X-Forwarded-Prefix "/tea";`,
+				"proxy_hide_header Server;",
+				"proxy_pass_header X-Accel-Date;",
+			}
+			for _, want := range wantedStrings {
+				if !strings.Contains(cfg, want) {
+					t.Errorf("want %q in generated config", want)
+				}
+			}
+		})
+	}
+}
+
 func TestExecuteTemplate_ForIngressForNGINXWithACPolicyAllow(t *testing.T) {
 	t.Parallel()
 
@@ -1505,6 +1628,182 @@ func TestExecuteTemplate_ForMainForNGINXWithOtel(t *testing.T) {
 	snaps.MatchSnapshot(t, buf.String())
 }
 
+func TestExecuteTemplate_ForMainForNGINXWithRequestID(t *testing.T) {
+	t.Parallel()
+
+	cfg := mainCfgWithOTel
+	cfg.LatencyMetrics = true
+	cfg.RequestID = &version2.RequestID{
+		Header:           "X-Request-ID",
+		IncomingVariable: "$http_x_request_id",
+		Variable:         "$ingress_request_id_incoming",
+	}
+
+	for _, tmpl := range []*template.Template{newNGINXMainTmpl(t), newNGINXPlusMainTmpl(t)} {
+		buf := &bytes.Buffer{}
+		err := tmpl.Execute(buf, cfg)
+		if err != nil {
+			t.Fatalf("Failed to write template %v", err)
+		}
+
+		wantDirectives := []string{
+			`' "$ingress_request_id"';`,
+			"map $http_x_request_id $ingress_request_id_incoming {",
+			"default $http_x_request_id;",
+			"'' $request_id;",
+			`"requestID":"$ingress_request_id"`,
+			"otel_span_attr request.id $ingress_request_id;",
+			"set $ingress_request_id $ingress_request_id_incoming;",
+		}
+
+		mainConf := buf.String()
+		for _, want := range wantDirectives {
+			if !strings.Contains(mainConf, want) {
+				t.Errorf("want %q in generated config", want)
+			}
+		}
+	}
+}
+
+func TestExecuteTemplate_ForMainForNGINXWithRequestIDAndCustomLogFormat(t *testing.T) {
+	t.Parallel()
+
+	cfg := mainCfg
+	cfg.LogFormat = []string{"$remote_addr", "$status"}
+	cfg.LogFormatRequestID = true
+	cfg.RequestID = &version2.RequestID{
+		Header:   "X-Request-ID",
+		Variable: "$request_id",
+	}
+
+	for _, tmpl := range []*template.Template{newNGINXMainTmpl(t), newNGINXPlusMainTmpl(t)} {
+		buf := &bytes.Buffer{}
+		err := tmpl.Execute(buf, cfg)
+		if err != nil {
+			t.Fatalf("Failed to write template %v", err)
+		}
+
+		mainConf := buf.String()
+		want := `' $status'
+                     ' "$ingress_request_id"';`
+		if !strings.Contains(mainConf, want) {
+			t.Errorf("want %q in generated config", want)
+		}
+	}
+}
+
+func TestExecuteTemplate_ForMainForNGINXWithDefaultBackendAndErrorPages(t *testing.T) {
+	t.Parallel()
+
+	cfg := mainCfg
+	cfg.DefaultServerReturn = "404"
+	cfg.DefaultBackendAddress = "10.0.0.10:8080"
+	cfg.DefaultErrorPages = []version2.DefaultErrorPage{
+		{
+			Code:     404,
+			Location: "/_default_error_page_404",
+			File:     "/etc/nginx/secrets/default_error_page_404.html",
+		},
+	}
+
+	for _, tmpl := range []*template.Template{newNGINXMainTmpl(t), newNGINXPlusMainTmpl(t)} {
+		buf := &bytes.Buffer{}
+		err := tmpl.Execute(buf, cfg)
+		if err != nil {
+			t.Fatalf("Failed to write template %v", err)
+		}
+
+		wantDirectives := []string{
+			"error_page 404 /_default_error_page_404;",
+			"proxy_pass http://10.0.0.10:8080;",
+			"location = /_default_error_page_404 {",
+			"alias /etc/nginx/secrets/default_error_page_404.html;",
+		}
+
+		mainConf := buf.String()
+		for _, want := range wantDirectives {
+			if !strings.Contains(mainConf, want) {
+				t.Errorf("want %q in generated config", want)
+			}
+		}
+		if strings.Contains(mainConf, "return 404;") {
+			t.Error("want no default server return when the default backend is set")
+		}
+	}
+}
+
+func TestExecuteTemplate_ForIngressWithRequestID(t *testing.T) {
+	t.Parallel()
+
+	cfg := ingressCfg
+	server := cfg.Servers[0]
+	server.RequestID = &version2.RequestID{
+		Header:   "X-Correlation-ID",
+		Variable: "$request_id",
+		Echo:     true,
+	}
+	cfg.Servers = []Server{server}
+
+	for _, tmpl := range []*template.Template{newNGINXIngressTmpl(t), newNGINXPlusIngressTmpl(t)} {
+		buf := &bytes.Buffer{}
+		err := tmpl.Execute(buf, cfg)
+		if err != nil {
+			t.Fatalf("Failed to write template %v", err)
+		}
+
+		wantDirectives := []string{
+			"set $ingress_request_id $request_id;",
+			"add_header X-Correlation-ID $ingress_request_id always;",
+			"proxy_set_header X-Correlation-ID $ingress_request_id;",
+		}
+
+		ingConf := buf.String()
+		for _, want := range wantDirectives {
+			if !strings.Contains(ingConf, want) {
+				t.Errorf("want %q in generated config", want)
+			}
+		}
+
+		// add_header of a server is not inherited by the locations with their own add_header directives
+		echo := "add_header X-Correlation-ID $ingress_request_id always;"
+		if got := strings.Count(ingConf, echo); got != len(server.Locations) {
+			t.Errorf("want %q in each of the %d locations, got %d", echo, len(server.Locations), got)
+		}
+		if strings.Index(ingConf, echo) < strings.Index(ingConf, "location ") {
+			t.Errorf("want %q only in locations", echo)
+		}
+	}
+}
+
+func TestExecuteTemplate_ForIngressWithDefaultBackend(t *testing.T) {
+	t.Parallel()
+
+	cfg := ingressCfg
+	server := cfg.Servers[0]
+	server.DefaultBackendAddress = "10.0.0.100:8080"
+	cfg.Servers = []Server{server}
+
+	for _, tmpl := range []*template.Template{newNGINXIngressTmpl(t), newNGINXPlusIngressTmpl(t)} {
+		buf := &bytes.Buffer{}
+		err := tmpl.Execute(buf, cfg)
+		if err != nil {
+			t.Fatalf("Failed to write template %v", err)
+		}
+
+		wantDirectives := []string{
+			"location / {",
+			"proxy_pass http://10.0.0.100:8080;",
+		}
+
+		ingConf := buf.String()
+		for _, want := range wantDirectives {
+			if !strings.Contains(ingConf, want) {
+				t.Errorf("want %q in generated config", want)
+			}
+		}
+	}
+}
+
 func TestExecuteTemplate_ForIngressForNGINXWithProxySetHeadersAnnotationWithDefaultValue(t *testing.T) {
 	t.Parallel()
 
@@ -20,6 +20,7 @@ type VirtualServerConfig struct {
 	KeyValZones             []KeyValZone
 	KeyVals                 []KeyVal
 	LimitReqZones           []LimitReqZone
+	SharedLimitReqZones     []LimitReqZone
 	Maps                    []Map
 	AuthJWTClaimSets        []AuthJWTClaimSet
 	CacheZones              []CacheZone
@@ -31,6 +32,7 @@ type VirtualServerConfig struct {
 	Upstreams               []Upstream
 	DynamicSSLReloadEnabled bool
 	StaticSSLPath           string
+	Maintenances            []Maintenance
 }
 
 // AuthJWTClaimSet defines the values for the `auth_jwt_claim_set` directive
@@ -66,6 +68,7 @@ type UpstreamServer struct {
 // Server defines a server.
 type Server struct {
 	ServerName                string
+	ServerAliases             []string
 	StatusZone                string
 	CustomListeners           bool
 	HTTPIPv4                  string
@@ -77,6 +80,8 @@ type Server struct {
 	ProxyProtocol             bool
 	SSL                       *SSL
 	ServerTokens              string
+	ClientHeaderTimeout       string
+	KeepaliveTimeout          string
 	RealIPHeader              string
 	SetRealIPFrom             []string
 	RealIPRecursive           bool
@@ -110,6 +115,10 @@ type Server struct {
 	DisableIPV6               bool
 	Gunzip                    bool
 	NGINXDebugLevel           string
+	RequestID                 *RequestID
+	Maintenance               *Maintenance
+	DefaultErrorPages         []DefaultErrorPage
+	DefaultBackendAddress     string
 }
 
 // SSL defines SSL configuration for a server.
@@ -118,6 +127,8 @@ type SSL struct {
 	Certificate     string
 	CertificateKey  string
 	RejectHandshake bool
+	Protocols       string
+	Ciphers         string
 }
 
 // IngressMTLS defines TLS configuration for a server. This is a subset of TLS specifically for clients auth.
@@ -219,6 +230,7 @@ type Location struct {
 	ProxyPassHeaders         []string
 	ProxyIgnoreHeaders       string
 	ProxyPassRewrite         string
+	ResponseBodyRewrite      *ResponseBodyRewrite
 	AddHeaders               []AddHeader
 	Rewrites                 []string
 	HasKeepalive             bool
@@ -244,6 +256,7 @@ type Location struct {
 	VSRNamespace             string
 	GRPCPass                 string
 	CORSEnabled              bool
+	Maintenance              *Maintenance
 }
 
 // ReturnLocation defines a location for returning a fixed response.
@@ -274,6 +287,14 @@ type ErrorPage struct {
 	ResponseCode int
 }
 
+// DefaultErrorPage defines a cluster-wide error page that is served from a file.
+type DefaultErrorPage struct {
+	Code int
+	// Location is the internal location that serves the File.
+	Location string
+	File     string
+}
+
 // ErrorPageLocation defines a named location for an error_page directive.
 type ErrorPageLocation struct {
 	Name        string
@@ -294,6 +315,49 @@ type AddHeader struct {
 	Always bool
 }
 
+// ResponseBodyRewrite defines the string replacements in the bodies of the responses of a location.
+type ResponseBodyRewrite struct {
+	SubFilters []SubFilter
+	Types      string
+	Once       bool
+}
+
+// SubFilter defines a string replacement in a response body.
+type SubFilter struct {
+	Match       string
+	Replacement string
+}
+
+// Maintenance describes the maintenance mode of a server or a location.
+type Maintenance struct {
+	// Variable is the variable that is set to 1 when the maintenance response must be returned.
+	Variable string
+	// Location is the internal location that returns the maintenance response.
+	Location string
+	// PageLocation is the internal location that serves the PageFile.
+	PageLocation string
+	// PageFile is the file with the page of the maintenance response.
+	PageFile       string
+	Code           int
+	DefaultType    string
+	Body           string
+	RetryAfter     string
+	BypassVariable string
+	BypassAllow    []string
+}
+
+// RequestID describes how the ID of a request is obtained and propagated.
+type RequestID struct {
+	// Header is the name of the header that carries the ID.
+	Header string
+	// IncomingVariable is the variable of the incoming header. It is empty when the incoming ID is not trusted.
+	IncomingVariable string
+	// Variable is the variable that holds the ID of the request.
+	Variable string
+	// Echo adds the ID to the response headers.
+	Echo bool
+}
+
 // HealthCheck defines a HealthCheck for an upstream in a Server.
 type HealthCheck struct {
 	Name                string
@@ -352,6 +416,7 @@ type InternalRedirectLocation struct {
 type Map struct {
 	Source     string
 	Variable   string
+	Hostnames  bool
 	Parameters []Parameter
 }
 
@@ -414,6 +479,9 @@ func (rlz LimitReqZone) String() string {
 	)
 }
 
+// SharedLimitReqZonesConfig defines the rate limit zones shared by multiple resources.
+type SharedLimitReqZonesConfig []LimitReqZone
+
 // LimitReq defines a rate limit.
 type LimitReq struct {
 	ZoneName string
@@ -4,11 +4,17 @@ package version2
 type TransportServerConfig struct {
 	Server                  StreamServer
 	Upstreams               []StreamUpstream
+	SplitClients            []SplitClient
+	Maps                    []Map
 	StreamSnippets          []string
 	Match                   *Match
+	LogFormat               *StreamLogFormat
 	DisableIPV6             bool
 	DynamicSSLReloadEnabled bool
 	StaticSSLPath           string
+
+	HealthCheckServers []StreamHealthCheckServer
+	HealthCheckMatches []Match
 }
 
 // StreamUpstream defines a stream upstream.
@@ -27,6 +33,7 @@ type StreamUpstreamServer struct {
 	MaxFails       int
 	FailTimeout    string
 	MaxConnections int
+	Down           bool
 }
 
 // StreamUpstreamBackupServer represents Backup Server address
@@ -57,8 +64,28 @@ type StreamServer struct {
 	ServerSnippets           []string
 	DisableIPV6              bool
 	SSL                      *StreamSSL
+	ProxySSL                 *StreamProxySSL
+	ProxyProtocol            bool
+	SetRealIPFrom            []string
+	UpstreamProxyProtocol    bool
+	SSLPreread               bool
 	IPv4                     string
 	IPv6                     string
+	AccessLog                *StreamAccessLog
+}
+
+// StreamLogFormat defines a log format in the stream context.
+type StreamLogFormat struct {
+	Name   string
+	Format string
+}
+
+// StreamAccessLog defines the access log of a stream server.
+type StreamAccessLog struct {
+	Off         bool
+	Destination string
+	Format      string
+	Condition   string
 }
 
 // StreamSSL defines SSL configuration for a server.
@@ -66,6 +93,24 @@ type StreamSSL struct {
 	Enabled        bool
 	Certificate    string
 	CertificateKey string
+	ClientCert     string
+	ClientCrl      string
+	VerifyClient   string
+	VerifyDepth    int
+}
+
+// StreamProxySSL defines SSL configuration for connections to the upstream servers.
+type StreamProxySSL struct {
+	Certificate    string
+	CertificateKey string
+	TrustedCert    string
+	VerifyServer   bool
+	VerifyDepth    int
+	Protocols      string
+	Ciphers        string
+	SessionReuse   bool
+	ServerName     bool
+	SSLName        string
 }
 
 // StreamHealthCheck defines a health check for a StreamUpstream in a StreamServer.
@@ -80,6 +125,14 @@ type StreamHealthCheck struct {
 	Match    string
 }
 
+// StreamHealthCheckServer defines a server that runs the health check of an upstream that isn't proxied directly,
+// for example an upstream referenced by the splits of a TransportServer action.
+type StreamHealthCheckServer struct {
+	UnixSocket  string
+	ProxyPass   string
+	HealthCheck *StreamHealthCheck
+}
+
 // Match defines a match block for a health check
 type Match struct {
 	Name                string
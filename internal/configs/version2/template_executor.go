@@ -13,6 +13,12 @@ const tlsPassthroughHostsTemplateString = `# mapping between TLS Passthrough hos
 {{ end }}
 `
 
+const sharedLimitReqZonesTemplateString = `# rate limit zones shared by the resources that reference shared rate limit policies
+{{- range $z := . }}
+limit_req_zone {{ $z.Key }} zone={{ $z.ZoneName }}:{{ $z.ZoneSize }} rate={{ $z.Rate }}{{- if $z.Sync }} sync{{- end }};
+{{- end }}
+`
+
 // TemplateExecutor executes NGINX configuration templates.
 type TemplateExecutor struct {
 	originalVirtualServerTemplate  *template.Template
@@ -20,6 +26,7 @@ type TemplateExecutor struct {
 	virtualServerTemplate          *template.Template
 	transportServerTemplate        *template.Template
 	tlsPassthroughHostsTemplate    *template.Template
+	sharedLimitReqZonesTemplate    *template.Template
 	oidcTemplate                   *template.Template
 }
 
@@ -42,6 +49,11 @@ func NewTemplateExecutor(virtualServerTemplatePath string, transportServerTempla
 		return nil, err
 	}
 
+	sharedLimitReqZonesTemplate, err := template.New("sharedLimitReqZones").Parse(sharedLimitReqZonesTemplateString)
+	if err != nil {
+		return nil, err
+	}
+
 	var oidcTemplate *template.Template
 	if oidcTemplatePath != "" {
 		oidcTemplate, err = template.New(path.Base(oidcTemplatePath)).Funcs(helperFunctions).ParseFiles(oidcTemplatePath)
@@ -55,6 +67,7 @@ func NewTemplateExecutor(virtualServerTemplatePath string, transportServerTempla
 		virtualServerTemplate:          vsTemplate,
 		transportServerTemplate:        tsTemplate,
 		tlsPassthroughHostsTemplate:    tlsPassthroughHostsTemplate,
+		sharedLimitReqZonesTemplate:    sharedLimitReqZonesTemplate,
 		oidcTemplate:                   oidcTemplate,
 	}, nil
 }
@@ -119,6 +132,16 @@ func (te *TemplateExecutor) ExecuteTLSPassthroughHostsTemplate(cfg *TLSPassthrou
 	return configBuffer.Bytes(), nil
 }
 
+// ExecuteSharedLimitReqZonesTemplate generates the content of an NGINX configuration file for the rate limit zones
+// shared by multiple resources.
+func (te *TemplateExecutor) ExecuteSharedLimitReqZonesTemplate(cfg *SharedLimitReqZonesConfig) ([]byte, error) {
+	var configBuffer bytes.Buffer
+	if err := te.sharedLimitReqZonesTemplate.Execute(&configBuffer, cfg); err != nil {
+		return nil, err
+	}
+	return configBuffer.Bytes(), nil
+}
+
 // ExecuteOIDCTemplate generates the content of an OIDC configuration file.
 func (te *TemplateExecutor) ExecuteOIDCTemplate(cfg *OIDC) ([]byte, error) {
 	var configBuffer bytes.Buffer
@@ -175,6 +175,170 @@ func TestExecuteVirtualServerTemplate_RendersTemplateWithServerGunzipNotSet(t *t
 	t.Log(string(got))
 }
 
+func TestExecuteVirtualServerTemplate_RendersTemplateWithRequestID(t *testing.T) {
+	t.Parallel()
+	cfg := virtualServerCfgWithGunzipOff
+	cfg.Server.RequestID = &RequestID{
+		Header:   "X-Request-ID",
+		Variable: "$request_id",
+	}
+
+	for _, executor := range []*TemplateExecutor{newTmplExecutorNGINX(t), newTmplExecutorNGINXPlus(t)} {
+		got, err := executor.ExecuteVirtualServerTemplate(&cfg)
+		if err != nil {
+			t.Error(err)
+		}
+		if !bytes.Contains(got, []byte("set $ingress_request_id $request_id;")) {
+			t.Error("want `set $ingress_request_id $request_id;` directive, got no directive")
+		}
+	}
+}
+
+func TestExecuteVirtualServerTemplate_RendersTemplateWithDefaultBackend(t *testing.T) {
+	t.Parallel()
+	cfg := virtualServerCfgWithGunzipOff
+	cfg.Server.DefaultBackendAddress = "10.0.0.100:8080"
+
+	for _, executor := range []*TemplateExecutor{newTmplExecutorNGINX(t), newTmplExecutorNGINXPlus(t)} {
+		got, err := executor.ExecuteVirtualServerTemplate(&cfg)
+		if err != nil {
+			t.Error(err)
+		}
+		wantDirectives := []string{
+			"location / {",
+			"proxy_pass http://10.0.0.100:8080;",
+		}
+		for _, want := range wantDirectives {
+			if !bytes.Contains(got, []byte(want)) {
+				t.Errorf("want %q in generated config", want)
+			}
+		}
+	}
+}
+
+func TestExecuteVirtualServerTemplate_RendersTemplateWithDefaultErrorPages(t *testing.T) {
+	t.Parallel()
+	cfg := virtualServerCfgWithGunzipOff
+	cfg.Server.DefaultErrorPages = []DefaultErrorPage{
+		{
+			Code:     502,
+			Location: "/_default_error_page_502",
+			File:     "/etc/nginx/secrets/default_error_page_502.html",
+		},
+	}
+
+	for _, executor := range []*TemplateExecutor{newTmplExecutorNGINX(t), newTmplExecutorNGINXPlus(t)} {
+		got, err := executor.ExecuteVirtualServerTemplate(&cfg)
+		if err != nil {
+			t.Error(err)
+		}
+		wantDirectives := []string{
+			"error_page 502 /_default_error_page_502;",
+			"location = /_default_error_page_502 {",
+			"alias /etc/nginx/secrets/default_error_page_502.html;",
+		}
+		for _, want := range wantDirectives {
+			if !bytes.Contains(got, []byte(want)) {
+				t.Errorf("want %q in generated config", want)
+			}
+		}
+	}
+}
+
+func TestExecuteVirtualServerTemplate_RendersTemplateWithServerAliases(t *testing.T) {
+	t.Parallel()
+	cfg := virtualServerCfgWithGunzipOff
+	cfg.Server.ServerName = "cafe.example.com"
+	cfg.Server.ServerAliases = []string{"www.cafe.example.com", "coffee.example.com"}
+
+	for _, executor := range []*TemplateExecutor{newTmplExecutorNGINX(t), newTmplExecutorNGINXPlus(t)} {
+		got, err := executor.ExecuteVirtualServerTemplate(&cfg)
+		if err != nil {
+			t.Error(err)
+		}
+		if !bytes.Contains(got, []byte("server_name cafe.example.com www.cafe.example.com coffee.example.com;")) {
+			t.Error("want `server_name cafe.example.com www.cafe.example.com coffee.example.com;` directive, got no directive")
+		}
+	}
+}
+
+func TestExecuteVirtualServerTemplate_RendersTemplateWithMaintenance(t *testing.T) {
+	t.Parallel()
+	cfg := virtualServerCfgWithGunzipOff
+	cfg.Server.Maintenance = &Maintenance{
+		Variable:       "$vs_default_cafe_maintenance_0",
+		Location:       "/internal_location_maintenance_0",
+		PageLocation:   "/internal_location_maintenance_0_page",
+		PageFile:       "/etc/nginx/secrets/maintenance_default_cafe_default_page_index.html",
+		Code:           503,
+		DefaultType:    "text/html",
+		RetryAfter:     "120",
+		BypassVariable: "$vs_default_cafe_maintenance_bypass_0",
+		BypassAllow:    []string{"10.0.0.0/8"},
+	}
+	cfg.Maintenances = []Maintenance{*cfg.Server.Maintenance}
+
+	wantedStrings := []string{
+		"geo $vs_default_cafe_maintenance_bypass_0 {",
+		"10.0.0.0/8 1;",
+		"if ($vs_default_cafe_maintenance_0) {",
+		"rewrite ^ /internal_location_maintenance_0 last;",
+		"location /internal_location_maintenance_0 {",
+		`add_header Retry-After "120" always;`,
+		"error_page 503 /internal_location_maintenance_0_page;",
+		"location = /internal_location_maintenance_0_page {",
+		"alias /etc/nginx/secrets/maintenance_default_cafe_default_page_index.html;",
+	}
+	for _, executor := range []*TemplateExecutor{newTmplExecutorNGINX(t), newTmplExecutorNGINXPlus(t)} {
+		got, err := executor.ExecuteVirtualServerTemplate(&cfg)
+		if err != nil {
+			t.Error(err)
+		}
+		for _, value := range wantedStrings {
+			if !bytes.Contains(got, []byte(value)) {
+				t.Errorf("didn't get `%s`", value)
+			}
+		}
+	}
+}
+
+func TestExecuteVirtualServerTemplate_RendersTemplateWithResponseBodyRewrite(t *testing.T) {
+	t.Parallel()
+	cfg := virtualServerCfgWithGunzipOff
+	cfg.Server.Locations = []Location{
+		{
+			Path:      "/",
+			ProxyPass: "http://test-upstream",
+			ResponseBodyRewrite: &ResponseBodyRewrite{
+				SubFilters: []SubFilter{
+					{
+						Match:       "http://backend.internal",
+						Replacement: "https://$host",
+					},
+				},
+				Types: "text/css application/javascript",
+			},
+		},
+	}
+
+	wantedStrings := []string{
+		`sub_filter "http://backend.internal" "https://$host";`,
+		"sub_filter_once off;",
+		"sub_filter_types text/css application/javascript;",
+	}
+	for _, executor := range []*TemplateExecutor{newTmplExecutorNGINX(t), newTmplExecutorNGINXPlus(t)} {
+		got, err := executor.ExecuteVirtualServerTemplate(&cfg)
+		if err != nil {
+			t.Error(err)
+		}
+		for _, value := range wantedStrings {
+			if !bytes.Contains(got, []byte(value)) {
+				t.Errorf("didn't get `%s`", value)
+			}
+		}
+	}
+}
+
 func TestExecuteVirtualServerTemplate_RendersTemplateWithRateLimitJWTClaim(t *testing.T) {
 	t.Parallel()
 	executor := newTmplExecutorNGINXPlus(t)
@@ -292,6 +456,42 @@ func TestExecuteVirtualServerTemplate_RendersTemplateWithCustomListenerIP(t *tes
 	t.Log(string(got))
 }
 
+func TestExecuteVirtualServerTemplate_RendersTemplateWithCustomListenerTLSAndTimeouts(t *testing.T) {
+	t.Parallel()
+	vsCfg := virtualServerCfgWithCustomListener
+	vsCfg.Server.SSL = &SSL{
+		Certificate:    "default-cert.pem",
+		CertificateKey: "default-cert.pem",
+		Protocols:      "TLSv1.2 TLSv1.3",
+		Ciphers:        "HIGH:!aNULL:!MD5",
+	}
+	vsCfg.Server.ClientHeaderTimeout = "10s"
+	vsCfg.Server.KeepaliveTimeout = "30s"
+
+	wantStrings := []string{
+		"listen 8443 ssl",
+		"ssl_protocols TLSv1.2 TLSv1.3;",
+		"ssl_ciphers HIGH:!aNULL:!MD5;",
+		"client_header_timeout 10s;",
+		"keepalive_timeout 30s;",
+	}
+
+	for _, executor := range []*TemplateExecutor{newTmplExecutorNGINXPlus(t), newTmplExecutorNGINX(t)} {
+		got, err := executor.ExecuteVirtualServerTemplate(&vsCfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, want := range wantStrings {
+			if !bytes.Contains(got, []byte(want)) {
+				t.Errorf("want `%s` in generated template", want)
+			}
+		}
+		if bytes.Contains(got, []byte("http2 on;")) {
+			t.Errorf("unexpected `http2 on;` in generated template")
+		}
+	}
+}
+
 func TestExecuteVirtualServerTemplate_RendersTemplateWithCustomListenerHTTPIPV4Only(t *testing.T) {
 	t.Parallel()
 	vsCfg := virtualServerCfgWithCustomListenerIP
@@ -699,6 +899,284 @@ func TestExecuteTemplateForTransportServerWithTCPIPListener(t *testing.T) {
 	t.Log(string(got))
 }
 
+func TestExecuteTemplateForTransportServerWithHealthCheckServers(t *testing.T) {
+	t.Parallel()
+	executor := newTmplExecutorNGINXPlus(t)
+	tsCfg := transportServerCfg
+	tsCfg.Server.ProxyPass = "$ts_default_tcp_server_splits"
+	tsCfg.Server.HealthCheck = nil
+	tsCfg.Server.UDP = false
+	tsCfg.HealthCheckServers = []StreamHealthCheckServer{
+		{
+			UnixSocket: "unix:/var/lib/nginx/health-check-ts_default_tcp-server_canary.sock",
+			ProxyPass:  "ts_default_tcp-server_canary",
+			HealthCheck: &StreamHealthCheck{
+				Enabled:  true,
+				Interval: "5s",
+				Passes:   1,
+				Jitter:   "0s",
+				Fails:    1,
+				Timeout:  "5s",
+				Match:    "match_ts_default_tcp-server_canary",
+			},
+		},
+	}
+	tsCfg.HealthCheckMatches = []Match{
+		{
+			Name:   "match_ts_default_tcp-server_canary",
+			Expect: "OK",
+		},
+	}
+
+	got, err := executor.ExecuteTransportServerTemplate(&tsCfg)
+	if err != nil {
+		t.Error(err)
+	}
+	wantStrings := []string{
+		"match match_ts_default_tcp-server_canary {",
+		`expect  "OK";`,
+		"listen unix:/var/lib/nginx/health-check-ts_default_tcp-server_canary.sock;",
+		"proxy_pass ts_default_tcp-server_canary;",
+		"health_check interval=5s passes=1 jitter=0s fails=1 match=match_ts_default_tcp-server_canary;",
+		"health_check_timeout 5s;",
+	}
+	for _, want := range wantStrings {
+		if !bytes.Contains(got, []byte(want)) {
+			t.Errorf("want `%s` in generated template", want)
+		}
+	}
+}
+
+func TestExecuteTemplateForTransportServerWithUpstreamTLS(t *testing.T) {
+	t.Parallel()
+	cfg := transportServerCfg
+	cfg.Server.ProxySSL = &StreamProxySSL{
+		Certificate:    "/etc/nginx/secrets/default-client-secret",
+		CertificateKey: "/etc/nginx/secrets/default-client-secret",
+		TrustedCert:    "/etc/nginx/secrets/default-ca-secret-ca.crt",
+		VerifyServer:   true,
+		VerifyDepth:    2,
+		Protocols:      "TLSv1.2 TLSv1.3",
+		Ciphers:        "HIGH:!aNULL:!MD5",
+		SessionReuse:   true,
+		ServerName:     true,
+		SSLName:        "db.example.com",
+	}
+
+	wantStrings := []string{
+		"proxy_ssl on;",
+		"proxy_ssl_certificate /etc/nginx/secrets/default-client-secret;",
+		"proxy_ssl_certificate_key /etc/nginx/secrets/default-client-secret;",
+		"proxy_ssl_trusted_certificate /etc/nginx/secrets/default-ca-secret-ca.crt;",
+		"proxy_ssl_verify on;",
+		"proxy_ssl_verify_depth 2;",
+		"proxy_ssl_protocols TLSv1.2 TLSv1.3;",
+		"proxy_ssl_ciphers HIGH:!aNULL:!MD5;",
+		"proxy_ssl_session_reuse on;",
+		"proxy_ssl_server_name on;",
+		"proxy_ssl_name db.example.com;",
+	}
+
+	for _, executor := range []*TemplateExecutor{newTmplExecutorNGINX(t), newTmplExecutorNGINXPlus(t)} {
+		got, err := executor.ExecuteTransportServerTemplate(&cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, want := range wantStrings {
+			if !bytes.Contains(got, []byte(want)) {
+				t.Errorf("want `%s` in generated template", want)
+			}
+		}
+	}
+}
+
+func TestExecuteTemplateForTransportServerWithClientCertVerification(t *testing.T) {
+	t.Parallel()
+	cfg := transportServerCfgWithSSL
+	ssl := *cfg.Server.SSL
+	ssl.ClientCert = "/etc/nginx/secrets/default-ca-secret-ca.crt"
+	ssl.ClientCrl = "/etc/nginx/secrets/default-ca-secret-ca.crl"
+	ssl.VerifyClient = "on"
+	ssl.VerifyDepth = 2
+	cfg.Server.SSL = &ssl
+
+	wantStrings := []string{
+		"ssl_client_certificate /etc/nginx/secrets/default-ca-secret-ca.crt;",
+		"ssl_crl /etc/nginx/secrets/default-ca-secret-ca.crl;",
+		"ssl_verify_client on;",
+		"ssl_verify_depth 2;",
+	}
+
+	for _, executor := range []*TemplateExecutor{newTmplExecutorNGINX(t), newTmplExecutorNGINXPlus(t)} {
+		got, err := executor.ExecuteTransportServerTemplate(&cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, want := range wantStrings {
+			if !bytes.Contains(got, []byte(want)) {
+				t.Errorf("want `%s` in generated template", want)
+			}
+		}
+	}
+}
+
+func TestExecuteTemplateForTransportServerWithProxyProtocol(t *testing.T) {
+	t.Parallel()
+	cfg := transportServerCfgWithSSL
+	cfg.Server.SSL = &StreamSSL{}
+	cfg.Server.UDP = false
+	cfg.Server.ProxyProtocol = true
+	cfg.Server.SetRealIPFrom = []string{"10.0.0.0/8", "192.168.1.10"}
+	cfg.Server.UpstreamProxyProtocol = true
+
+	wantStrings := []string{
+		"listen 1234 proxy_protocol;",
+		"set_real_ip_from 10.0.0.0/8;",
+		"set_real_ip_from 192.168.1.10;",
+		"proxy_protocol on;",
+	}
+
+	for _, executor := range []*TemplateExecutor{newTmplExecutorNGINX(t), newTmplExecutorNGINXPlus(t)} {
+		got, err := executor.ExecuteTransportServerTemplate(&cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, want := range wantStrings {
+			if !bytes.Contains(got, []byte(want)) {
+				t.Errorf("want `%s` in generated template", want)
+			}
+		}
+	}
+}
+
+func TestExecuteTemplateForTransportServerWithSplitsAndMatches(t *testing.T) {
+	t.Parallel()
+	cfg := transportServerCfg
+	cfg.SplitClients = []SplitClient{
+		{
+			Source:   "$remote_addr$remote_port",
+			Variable: "$ts_default_tcp_server_splits",
+			Distributions: []Distribution{
+				{Weight: "90%", Value: "ts_default_tcp-server_stable"},
+				{Weight: "10%", Value: "ts_default_tcp-server_canary"},
+			},
+		},
+	}
+	cfg.Maps = []Map{
+		{
+			Source:    "$ssl_preread_server_name",
+			Variable:  "$ts_default_tcp_server_server_name",
+			Hostnames: true,
+			Parameters: []Parameter{
+				{Value: "*.example.com", Result: "ts_default_tcp-server_db"},
+				{Value: "default", Result: "$ts_default_tcp_server_splits"},
+			},
+		},
+	}
+	cfg.Server.SSLPreread = true
+	cfg.Server.ProxyPass = "$ts_default_tcp_server_server_name"
+
+	wantStrings := []string{
+		"split_clients $remote_addr$remote_port $ts_default_tcp_server_splits {",
+		"90% ts_default_tcp-server_stable;",
+		"10% ts_default_tcp-server_canary;",
+		"map $ssl_preread_server_name $ts_default_tcp_server_server_name {",
+		"hostnames;",
+		"*.example.com ts_default_tcp-server_db;",
+		"default $ts_default_tcp_server_splits;",
+		"ssl_preread on;",
+		"proxy_pass $ts_default_tcp_server_server_name;",
+	}
+
+	for _, executor := range []*TemplateExecutor{newTmplExecutorNGINX(t), newTmplExecutorNGINXPlus(t)} {
+		got, err := executor.ExecuteTransportServerTemplate(&cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, want := range wantStrings {
+			if !bytes.Contains(got, []byte(want)) {
+				t.Errorf("want `%s` in generated template", want)
+			}
+		}
+	}
+}
+
+func TestExecuteTemplateForTransportServerWithAccessLog(t *testing.T) {
+	t.Parallel()
+	cfg := transportServerCfg
+	cfg.LogFormat = &StreamLogFormat{
+		Name:   "ts_default_tcp_server",
+		Format: "$remote_addr $status",
+	}
+	cfg.Server.AccessLog = &StreamAccessLog{
+		Destination: "syslog:server=10.0.0.1:514",
+		Format:      "ts_default_tcp_server",
+		Condition:   "$ts_default_tcp_server_loggable",
+	}
+
+	wantStrings := []string{
+		`log_format ts_default_tcp_server "$remote_addr $status";`,
+		"access_log syslog:server=10.0.0.1:514 ts_default_tcp_server if=$ts_default_tcp_server_loggable;",
+	}
+
+	for _, executor := range []*TemplateExecutor{newTmplExecutorNGINX(t), newTmplExecutorNGINXPlus(t)} {
+		got, err := executor.ExecuteTransportServerTemplate(&cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, want := range wantStrings {
+			if !bytes.Contains(got, []byte(want)) {
+				t.Errorf("want `%s` in generated template", want)
+			}
+		}
+	}
+}
+
+func TestExecuteTemplateForTransportServerWithAccessLogOff(t *testing.T) {
+	t.Parallel()
+	cfg := transportServerCfg
+	cfg.Server.AccessLog = &StreamAccessLog{Off: true}
+
+	for _, executor := range []*TemplateExecutor{newTmplExecutorNGINX(t), newTmplExecutorNGINXPlus(t)} {
+		got, err := executor.ExecuteTransportServerTemplate(&cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Contains(got, []byte("access_log off;")) {
+			t.Errorf("want `access_log off;` in generated template")
+		}
+	}
+}
+
+func TestExecuteTemplateForTransportServerWithDownServers(t *testing.T) {
+	t.Parallel()
+	executor := newTmplExecutorNGINX(t)
+	cfg := transportServerCfg
+	cfg.Upstreams = []StreamUpstream{
+		{
+			Name: "udp-upstream",
+			Servers: []StreamUpstreamServer{
+				{Address: "10.0.0.20:5001", MaxFails: 1, FailTimeout: "10s"},
+				{Address: "10.0.0.21:5001", MaxFails: 1, FailTimeout: "10s", Down: true},
+			},
+		},
+	}
+
+	got, err := executor.ExecuteTransportServerTemplate(&cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantStrings := []string{
+		"server 10.0.0.20:5001 max_fails=1 fail_timeout=10s max_conns=0;",
+		"server 10.0.0.21:5001 max_fails=1 fail_timeout=10s max_conns=0 down;",
+	}
+	for _, want := range wantStrings {
+		if !bytes.Contains(got, []byte(want)) {
+			t.Errorf("want `%s` in generated template", want)
+		}
+	}
+}
+
 func TestExecuteTemplateForTransportServerWithUDPIPListener(t *testing.T) {
 	t.Parallel()
 	executor := newTmplExecutorNGINXPlus(t)
@@ -818,6 +1296,30 @@ func TestTLSPassthroughHosts(t *testing.T) {
 	t.Log(string(data))
 }
 
+func TestSharedLimitReqZones(t *testing.T) {
+	t.Parallel()
+	executor := newTmplExecutorNGINXPlus(t)
+
+	sharedLimitReqZonesCfg := SharedLimitReqZonesConfig{
+		{ZoneName: "pol_rl_default_tenant_a", Key: "$binary_remote_addr", ZoneSize: "10M", Rate: "10r/s"},
+		{ZoneName: "pol_rl_default_tenant_b_sync", Key: "$binary_remote_addr", ZoneSize: "10M", Rate: "20r/s", Sync: true},
+	}
+
+	got, err := executor.ExecuteSharedLimitReqZonesTemplate(&sharedLimitReqZonesCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantStrings := []string{
+		"limit_req_zone $binary_remote_addr zone=pol_rl_default_tenant_a:10M rate=10r/s;",
+		"limit_req_zone $binary_remote_addr zone=pol_rl_default_tenant_b_sync:10M rate=20r/s sync;",
+	}
+	for _, want := range wantStrings {
+		if !bytes.Contains(got, []byte(want)) {
+			t.Errorf("want `%s` in generated template", want)
+		}
+	}
+}
+
 func TestExecuteVirtualServerTemplateWithJWKSWithToken(t *testing.T) {
 	t.Parallel()
 	executor := newTmplExecutorNGINXPlus(t)
@@ -30,6 +30,13 @@ const (
 	splitClientsKeyValZoneSize                      = "100k"
 	splitClientAmountWhenWeightChangesDynamicReload = 101
 	defaultLogOutput                                = "syslog:server=localhost:514"
+	defaultCircuitBreakerConsecutive5xx             = 5
+	defaultCircuitBreakerEjectDuration              = "30s"
+	defaultCircuitBreakerMaxEjectionPercent         = 10
+	maintenanceKeyValZoneSize                       = "100k"
+	defaultMaintenanceCode                          = 503
+	defaultMaintenanceType                          = "text/html"
+	defaultMaintenanceConfigMapKey                  = "index.html"
 )
 
 var grpcConflictingErrors = map[int]bool{
@@ -88,6 +95,8 @@ type VirtualServerEx struct {
 	HTTPIPv6                    string
 	HTTPSIPv4                   string
 	HTTPSIPv6                   string
+	HTTPListener                *conf_v1.Listener
+	HTTPSListener               *conf_v1.Listener
 	Endpoints                   map[string][]string
 	VirtualServerRoutes         []*conf_v1.VirtualServerRoute
 	VirtualServerSelectorRoutes map[string][]string
@@ -100,6 +109,10 @@ type VirtualServerEx struct {
 	DosProtectedRefs            map[string]*unstructured.Unstructured
 	DosProtectedEx              map[string]*DosEx
 	ZoneSync                    bool
+	// ServerAliases are the server aliases of the VirtualServer that are not taken by other resources.
+	ServerAliases []string
+	// MaintenancePages holds the pages of the maintenance ConfigMaps, keyed by GetMaintenancePageKey.
+	MaintenancePages map[string]string
 }
 
 func (vsx *VirtualServerEx) String() string {
@@ -245,6 +258,36 @@ func (namer *VariableNamer) GetNameForSplitClientVariable(index int) string {
 	return fmt.Sprintf("$vs_%s_splits_%d", namer.safeNsName, index)
 }
 
+// GetNameForRequestIDVariable gets the name of the variable that holds the ID of the request.
+func (namer *VariableNamer) GetNameForRequestIDVariable() string {
+	return fmt.Sprintf("$vs_%s_request_id", namer.safeNsName)
+}
+
+// GetNameOfKeyvalZoneForMaintenance returns a unique name for the keyval zone for the maintenance mode.
+func (namer *VariableNamer) GetNameOfKeyvalZoneForMaintenance() string {
+	return fmt.Sprintf("vs_%s_keyval_zone_maintenance", namer.safeNsName)
+}
+
+// GetNameOfKeyvalKeyForMaintenance returns a unique name for a keyval key for the maintenance mode of a server, a route or a subroute.
+func (namer *VariableNamer) GetNameOfKeyvalKeyForMaintenance(id string) string {
+	return fmt.Sprintf("\"vs_%s_keyval_key_maintenance_%s\"", namer.safeNsName, id)
+}
+
+// GetNameOfKeyvalForMaintenanceIndex returns a unique name for a keyval for the maintenance mode.
+func (namer *VariableNamer) GetNameOfKeyvalForMaintenanceIndex(index int) string {
+	return fmt.Sprintf("$vs_%s_keyval_maintenance_%d", namer.safeNsName, index)
+}
+
+// GetNameForMaintenanceVariable gets the name of the variable that enables the maintenance response for a particular index.
+func (namer *VariableNamer) GetNameForMaintenanceVariable(index int) string {
+	return fmt.Sprintf("$vs_%s_maintenance_%d", namer.safeNsName, index)
+}
+
+// GetNameForMaintenanceBypassVariable gets the name of the variable that marks the clients that bypass the maintenance mode.
+func (namer *VariableNamer) GetNameForMaintenanceBypassVariable(index int) string {
+	return fmt.Sprintf("$vs_%s_maintenance_bypass_%d", namer.safeNsName, index)
+}
+
 // GetNameForVariableForMatchesRouteMap gets the name of a matches route map
 func (namer *VariableNamer) GetNameForVariableForMatchesRouteMap(
 	matchesIndex int,
@@ -254,6 +297,16 @@ func (namer *VariableNamer) GetNameForVariableForMatchesRouteMap(
 	return fmt.Sprintf("$vs_%s_matches_%d_match_%d_cond_%d", namer.safeNsName, matchesIndex, matchIndex, conditionIndex)
 }
 
+// GetNameForVariableForMatchesRouteMapAlternative gets the name of a matches route map for an alternative of an anyOf condition
+func (namer *VariableNamer) GetNameForVariableForMatchesRouteMapAlternative(
+	matchesIndex int,
+	matchIndex int,
+	conditionIndex int,
+	alternativeIndex int,
+) string {
+	return fmt.Sprintf("$vs_%s_matches_%d_match_%d_cond_%d_any_%d", namer.safeNsName, matchesIndex, matchIndex, conditionIndex, alternativeIndex)
+}
+
 // GetNameForVariableForMatchesRouteMainMap gets the name of a matches route main map
 func (namer *VariableNamer) GetNameForVariableForMatchesRouteMainMap(matchesIndex int) string {
 	return fmt.Sprintf("$vs_%s_matches_%d", namer.safeNsName, matchesIndex)
@@ -301,6 +354,7 @@ type virtualServerConfigurator struct {
 	DynamicWeightChangesReload bool
 	bundleValidator            bundleValidator
 	IngressControllerReplicas  int
+	maintenancePageFiles       map[string]string
 }
 
 func (vsc *virtualServerConfigurator) addWarningf(obj runtime.Object, msgFmt string, args ...interface{}) {
@@ -412,7 +466,12 @@ func (vsc *virtualServerConfigurator) GenerateVirtualServerConfig(
 		useCustomListeners = true
 	}
 
-	sslConfig := vsc.generateSSLConfig(vsEx.VirtualServer, vsEx.VirtualServer.Spec.TLS, vsEx.VirtualServer.Namespace, vsEx.SecretRefs, vsc.cfgParams)
+	sslConfig := vsc.generateSSLConfigForListener(vsEx.VirtualServer, vsEx.VirtualServer.Spec.TLS, vsEx.HTTPSListener, vsEx.SecretRefs, vsc.cfgParams)
+	if sslConfig == nil {
+		sslConfig = vsc.generateSSLConfig(vsEx.VirtualServer, vsEx.VirtualServer.Spec.TLS, vsEx.VirtualServer.Namespace, vsEx.SecretRefs, vsc.cfgParams)
+	}
+	applyListenerTLSSettings(sslConfig, vsEx.HTTPSListener)
+	clientHeaderTimeout, keepaliveTimeout := generateListenerTimeouts(vsEx.HTTPListener, vsEx.HTTPSListener)
 	tlsRedirectConfig := generateTLSRedirectConfig(vsEx.VirtualServer.Spec.TLS)
 
 	policyOpts := policyOptions{
@@ -482,10 +541,12 @@ func (vsc *virtualServerConfigurator) GenerateVirtualServerConfig(
 	var statusMatches []version2.StatusMatch
 	var healthChecks []version2.HealthCheck
 	var limitReqZones []version2.LimitReqZone
+	var sharedLimitReqZones []version2.LimitReqZone
 	var authJWTClaimSets []version2.AuthJWTClaimSet
 	var cacheZones []version2.CacheZone
 
 	limitReqZones = append(limitReqZones, policiesCfg.RateLimit.Zones...)
+	sharedLimitReqZones = append(sharedLimitReqZones, policiesCfg.RateLimit.SharedZones...)
 	authJWTClaimSets = append(authJWTClaimSets, policiesCfg.RateLimit.AuthJWTClaimSets...)
 
 	// Add cache zone from global policy if present
@@ -527,6 +588,12 @@ func (vsc *virtualServerConfigurator) GenerateVirtualServerConfig(
 		}
 	}
 
+	affinityMaps, affinityClaimSets := generateAffinityConfig(crUpstreams)
+	// the JWT claims are populated only in the locations where a JWT policy validates the token
+	jwtAuthEnabled := policiesCfg.JWTAuth.Auth != nil
+	maps = append(maps, affinityMaps...)
+	authJWTClaimSets = append(authJWTClaimSets, affinityClaimSets...)
+
 	var locations []version2.Location
 	var internalRedirectLocations []version2.InternalRedirectLocation
 	var returnLocations []version2.ReturnLocation
@@ -544,8 +611,16 @@ func (vsc *virtualServerConfigurator) GenerateVirtualServerConfig(
 
 	VariableNamer := NewVSVariableNamer(vsEx.VirtualServer)
 
+	var maintenanceCfgs []*maintenanceCfg
+	serverMaintenanceCfg := vsc.generateMaintenance(vsEx.VirtualServer, vsEx.VirtualServer.Namespace, vsEx.VirtualServer.Spec.Maintenance,
+		maintenanceServerID, len(maintenanceCfgs), VariableNamer)
+	if serverMaintenanceCfg != nil {
+		maintenanceCfgs = append(maintenanceCfgs, serverMaintenanceCfg)
+	}
+	vsrMaintenancesFromVs := getVSRMaintenancesFromVS(vsEx)
+
 	// generates config for VirtualServer routes
-	for _, r := range vsEx.VirtualServer.Spec.Routes {
+	for routeIndex, r := range vsEx.VirtualServer.Spec.Routes {
 		errorPages := generateErrorPageDetails(r.ErrorPages, errorPageLocations, vsEx.VirtualServer)
 		errorPageLocations = append(errorPageLocations, generateErrorPageLocations(errorPages.index, errorPages.pages)...)
 
@@ -628,6 +703,9 @@ func (vsc *virtualServerConfigurator) GenerateVirtualServerConfig(
 			routePoliciesCfg.CORSHeaders = policiesCfg.CORSHeaders
 		}
 
+		// Spec-level headers apply first, the route-level headers take precedence
+		routePoliciesCfg.Headers = mergeHeadersCfg(policiesCfg.Headers, routePoliciesCfg.Headers)
+
 		if len(warnings) > 0 {
 			vsc.mergeWarnings(warnings)
 		}
@@ -646,6 +724,9 @@ func (vsc *virtualServerConfigurator) GenerateVirtualServerConfig(
 				routePoliciesCfg.OIDC = policiesCfg.OIDC
 			}
 		}
+		if routePoliciesCfg.JWTAuth.Auth != nil {
+			jwtAuthEnabled = true
+		}
 		if routePoliciesCfg.JWTAuth.JWKSEnabled {
 			policiesCfg.JWTAuth.JWKSEnabled = routePoliciesCfg.JWTAuth.JWKSEnabled
 
@@ -682,6 +763,7 @@ func (vsc *virtualServerConfigurator) GenerateVirtualServerConfig(
 		}
 
 		limitReqZones = append(limitReqZones, routePoliciesCfg.RateLimit.Zones...)
+		sharedLimitReqZones = append(sharedLimitReqZones, routePoliciesCfg.RateLimit.SharedZones...)
 
 		authJWTClaimSets = append(authJWTClaimSets, routePoliciesCfg.RateLimit.AuthJWTClaimSets...)
 
@@ -689,6 +771,7 @@ func (vsc *virtualServerConfigurator) GenerateVirtualServerConfig(
 		addCacheZone(&cacheZones, routePoliciesCfg.Cache)
 
 		dosRouteCfg := generateDosCfg(dosResources[r.Path])
+		routeLocationsStart := len(locations)
 
 		if len(r.Matches) > 0 {
 			cfg := generateMatchesConfig(
@@ -750,13 +833,20 @@ func (vsc *virtualServerConfigurator) GenerateVirtualServerConfig(
 				returnLocations = append(returnLocations, *returnLoc)
 			}
 		}
+
+		routeMaintenanceCfg := vsc.generateMaintenance(vsEx.VirtualServer, vsEx.VirtualServer.Namespace, r.Maintenance,
+			getMaintenanceIDForRoute(routeIndex), len(maintenanceCfgs), VariableNamer)
+		if routeMaintenanceCfg != nil {
+			addMaintenanceToLocations(routeMaintenanceCfg.Maintenance, locations[routeLocationsStart:])
+			maintenanceCfgs = append(maintenanceCfgs, routeMaintenanceCfg)
+		}
 	}
 
 	// generate config for subroutes of each VirtualServerRoute
 	for _, vsr := range vsEx.VirtualServerRoutes {
 		isVSR := true
 		upstreamNamer := NewUpstreamNamerForVirtualServerRoute(vsEx.VirtualServer, vsr)
-		for _, r := range vsr.Spec.Subroutes {
+		for subrouteIndex, r := range vsr.Spec.Subroutes {
 			errorPages := generateErrorPageDetails(r.ErrorPages, errorPageLocations, vsr)
 			errorPageLocations = append(errorPageLocations, generateErrorPageLocations(errorPages.index, errorPages.pages)...)
 			vsrNamespaceName := fmt.Sprintf("%v/%v", vsr.Namespace, vsr.Name)
@@ -811,6 +901,9 @@ func (vsc *virtualServerConfigurator) GenerateVirtualServerConfig(
 				routePoliciesCfg.CORSHeaders = policiesCfg.CORSHeaders
 			}
 
+			// Spec-level headers apply first, the subroute-level headers take precedence
+			routePoliciesCfg.Headers = mergeHeadersCfg(policiesCfg.Headers, routePoliciesCfg.Headers)
+
 			if policiesCfg.OIDC != nil || routePoliciesCfg.OIDC != nil {
 				// Store the OIDC policy name for conflict checking in further calls to generatePolicies for subroutes
 				if routePoliciesCfg.OIDC != nil {
@@ -826,6 +919,9 @@ func (vsc *virtualServerConfigurator) GenerateVirtualServerConfig(
 					routePoliciesCfg.OIDC = policiesCfg.OIDC
 				}
 			}
+			if routePoliciesCfg.JWTAuth.Auth != nil {
+				jwtAuthEnabled = true
+			}
 			if routePoliciesCfg.JWTAuth.JWKSEnabled {
 				policiesCfg.JWTAuth.JWKSEnabled = routePoliciesCfg.JWTAuth.JWKSEnabled
 
@@ -862,6 +958,7 @@ func (vsc *virtualServerConfigurator) GenerateVirtualServerConfig(
 			}
 
 			limitReqZones = append(limitReqZones, routePoliciesCfg.RateLimit.Zones...)
+			sharedLimitReqZones = append(sharedLimitReqZones, routePoliciesCfg.RateLimit.SharedZones...)
 
 			authJWTClaimSets = append(authJWTClaimSets, routePoliciesCfg.RateLimit.AuthJWTClaimSets...)
 
@@ -869,6 +966,7 @@ func (vsc *virtualServerConfigurator) GenerateVirtualServerConfig(
 			addCacheZone(&cacheZones, routePoliciesCfg.Cache)
 
 			dosRouteCfg := generateDosCfg(dosResources[r.Path])
+			routeLocationsStart := len(locations)
 
 			if len(r.Matches) > 0 {
 				cfg := generateMatchesConfig(
@@ -931,13 +1029,64 @@ func (vsc *virtualServerConfigurator) GenerateVirtualServerConfig(
 					returnLocations = append(returnLocations, *returnLoc)
 				}
 			}
+
+			// use the VirtualServer route maintenance mode if the subroute does not define its own
+			var maintenanceOwner runtime.Object = vsr
+			maintenanceNamespace := vsr.Namespace
+			maintenance := r.Maintenance
+			if maintenance == nil {
+				maintenanceOwner = vsEx.VirtualServer
+				maintenanceNamespace = vsEx.VirtualServer.Namespace
+				maintenance = vsrMaintenancesFromVs[vsrNamespaceName]
+			}
+			routeMaintenanceCfg := vsc.generateMaintenance(maintenanceOwner, maintenanceNamespace, maintenance,
+				getMaintenanceIDForSubroute(vsr, subrouteIndex), len(maintenanceCfgs), VariableNamer)
+			if routeMaintenanceCfg != nil {
+				addMaintenanceToLocations(routeMaintenanceCfg.Maintenance, locations[routeLocationsStart:])
+				maintenanceCfgs = append(maintenanceCfgs, routeMaintenanceCfg)
+			}
+		}
+	}
+
+	if !jwtAuthEnabled {
+		for _, u := range getUpstreamsWithJWTClaimAffinity(crUpstreams) {
+			vsc.addWarningf(vsEx.VirtualServer, "The affinity of upstream %s uses the JWT claim %s, but no JWT policy is applied to the VirtualServer or its routes, so all requests are passed to the same upstream server",
+				u.Name, u.Affinity.JWTClaim)
 		}
 	}
 
+	var maintenances []version2.Maintenance
+	for _, cfg := range maintenanceCfgs {
+		maintenances = append(maintenances, *cfg.Maintenance)
+		maps = append(maps, cfg.Map)
+		if cfg.KeyVal != nil {
+			keyVals = append(keyVals, *cfg.KeyVal)
+		}
+	}
+	if vsc.isPlus && len(maintenanceCfgs) > 0 {
+		kvZoneName := VariableNamer.GetNameOfKeyvalZoneForMaintenance()
+		keyValZones = append(keyValZones, version2.KeyValZone{
+			Name:  kvZoneName,
+			Size:  maintenanceKeyValZoneSize,
+			State: fmt.Sprintf("%s/%s.json", keyvalZoneBasePath, kvZoneName),
+		})
+	}
+
+	var serverMaintenance *version2.Maintenance
+	if serverMaintenanceCfg != nil {
+		serverMaintenance = serverMaintenanceCfg.Maintenance
+	}
+
 	for mapName, apiKeyClients := range policiesCfg.APIKey.ClientMap {
 		maps = append(maps, *generateAPIKeyClientMap(mapName, apiKeyClients))
 	}
 
+	requestID, requestIDMap := generateVSRequestID(vsEx.VirtualServer.Spec.RequestID, vsc.cfgParams.RequestID, VariableNamer)
+	if requestIDMap != nil {
+		maps = append(maps, *requestIDMap)
+	}
+	addRequestIDToLocations(requestID, locations)
+
 	httpSnippets := generateSnippets(vsc.enableSnippets, vsEx.VirtualServer.Spec.HTTPSnippets, []string{})
 	serverSnippets := generateSnippets(
 		vsc.enableSnippets,
@@ -960,6 +1109,7 @@ func (vsc *virtualServerConfigurator) GenerateVirtualServerConfig(
 		HTTPSnippets:     httpSnippets,
 		Server: version2.Server{
 			ServerName:                vsEx.VirtualServer.Spec.Host,
+			ServerAliases:             vsEx.ServerAliases,
 			Gunzip:                    vsEx.VirtualServer.Spec.Gunzip,
 			StatusZone:                vsEx.VirtualServer.Spec.Host,
 			HTTPPort:                  vsEx.HTTPPort,
@@ -972,6 +1122,8 @@ func (vsc *virtualServerConfigurator) GenerateVirtualServerConfig(
 			ProxyProtocol:             vsc.cfgParams.ProxyProtocol,
 			SSL:                       sslConfig,
 			ServerTokens:              vsc.cfgParams.ServerTokens,
+			ClientHeaderTimeout:       clientHeaderTimeout,
+			KeepaliveTimeout:          keepaliveTimeout,
 			SetRealIPFrom:             vsc.cfgParams.SetRealIPFrom,
 			RealIPHeader:              vsc.cfgParams.RealIPHeader,
 			RealIPRecursive:           vsc.cfgParams.RealIPRecursive,
@@ -1004,6 +1156,10 @@ func (vsc *virtualServerConfigurator) GenerateVirtualServerConfig(
 			VSName:                    vsEx.VirtualServer.Name,
 			DisableIPV6:               vsc.isIPV6Disabled,
 			NGINXDebugLevel:           vsc.cfgParams.MainErrorLogLevel,
+			RequestID:                 requestID,
+			Maintenance:               serverMaintenance,
+			DefaultErrorPages:         generateDefaultErrorPages(vsc.cfgParams.DefaultErrorPageFiles),
+			DefaultBackendAddress:     generateDefaultBackendAddress(vsEx.VirtualServer.Spec.Routes, vsc.cfgParams.DefaultBackendAddress),
 		},
 		SpiffeCerts:             enabledInternalRoutes,
 		SpiffeClientCerts:       vsc.spiffeCerts && !enabledInternalRoutes,
@@ -1012,11 +1168,299 @@ func (vsc *virtualServerConfigurator) GenerateVirtualServerConfig(
 		KeyValZones:             keyValZones,
 		KeyVals:                 keyVals,
 		TwoWaySplitClients:      twoWaySplitClients,
+		Maintenances:            maintenances,
+	}
+	if len(sharedLimitReqZones) > 0 {
+		// the shared zones are defined once for all the resources in the shared rate limit zones config.
+		vsCfg.SharedLimitReqZones = removeDuplicateLimitReqZones(sharedLimitReqZones)
 	}
 
 	return vsCfg, vsc.warnings
 }
 
+// generateVSRequestID merges the request ID configuration of a VirtualServer with the ConfigMap one.
+// When the VirtualServer trusts the incoming ID, it also returns the map that holds the ID of the request.
+func generateVSRequestID(requestID *conf_v1.RequestID, cfgParams RequestID, variableNamer *VariableNamer) (*version2.RequestID, *version2.Map) {
+	if requestID == nil {
+		return generateRequestID(cfgParams, requestIDIncomingVariable), nil
+	}
+
+	params := RequestID{
+		Enable: requestID.Enable,
+		Header: cfgParams.Header,
+		Mode:   cfgParams.Mode,
+		Echo:   generateBool(requestID.Echo, cfgParams.Echo),
+	}
+	if requestID.Header != "" {
+		params.Header = requestID.Header
+	}
+	if requestID.Mode != "" {
+		params.Mode = requestID.Mode
+	}
+
+	cfg := generateRequestID(params, variableNamer.GetNameForRequestIDVariable())
+	if cfg == nil || cfg.IncomingVariable == "" {
+		return cfg, nil
+	}
+
+	return cfg, &version2.Map{
+		Source:   cfg.IncomingVariable,
+		Variable: cfg.Variable,
+		Parameters: []version2.Parameter{
+			{
+				Value:  "default",
+				Result: cfg.IncomingVariable,
+			},
+			{
+				Value:  "''",
+				Result: "$request_id",
+			},
+		},
+	}
+}
+
+// addRequestIDToLocations passes the ID of the request to the upstreams and, if enabled, to the clients.
+// A request header set by the user for the same header name takes precedence.
+func addRequestIDToLocations(requestID *version2.RequestID, locations []version2.Location) {
+	if requestID == nil {
+		return
+	}
+
+	for i := range locations {
+		if !hasHeader(locations[i].ProxySetHeaders, requestID.Header) {
+			locations[i].ProxySetHeaders = append(locations[i].ProxySetHeaders, version2.Header{
+				Name:  requestID.Header,
+				Value: "$ingress_request_id",
+			})
+		}
+		if requestID.Echo {
+			locations[i].AddHeaders = append(locations[i].AddHeaders, version2.AddHeader{
+				Header: version2.Header{
+					Name:  requestID.Header,
+					Value: "$ingress_request_id",
+				},
+				Always: true,
+			})
+		}
+	}
+}
+
+func hasHeader(headers []version2.Header, name string) bool {
+	for _, h := range headers {
+		if strings.EqualFold(h.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+const maintenanceServerID = "server"
+
+// maintenanceCfg holds the configuration of the maintenance mode of a server, a route or a subroute.
+type maintenanceCfg struct {
+	Maintenance *version2.Maintenance
+	Map         version2.Map
+	KeyVal      *version2.KeyVal
+}
+
+// GetMaintenanceConfigMapKey returns the key of the maintenance ConfigMap that holds the page.
+func GetMaintenanceConfigMapKey(configMap *conf_v1.MaintenanceConfigMap) string {
+	if configMap.Key == "" {
+		return defaultMaintenanceConfigMapKey
+	}
+	return configMap.Key
+}
+
+// GetMaintenancePageKey returns the key of the page of a maintenance ConfigMap in the MaintenancePages of a VirtualServerEx.
+func GetMaintenancePageKey(namespace string, configMap *conf_v1.MaintenanceConfigMap) string {
+	return fmt.Sprintf("%s/%s/%s", namespace, configMap.Name, GetMaintenanceConfigMapKey(configMap))
+}
+
+func getMaintenanceIDForRoute(index int) string {
+	return fmt.Sprintf("route_%d", index)
+}
+
+func getMaintenanceIDForSubroute(vsr *conf_v1.VirtualServerRoute, index int) string {
+	return fmt.Sprintf("vsr_%s_%s_subroute_%d", vsr.Namespace, vsr.Name, index)
+}
+
+// getVSRMaintenancesFromVS returns the maintenance mode of the VirtualServer routes that reference VirtualServerRoutes,
+// keyed by the namespace and the name of the VirtualServerRoute.
+func getVSRMaintenancesFromVS(vsEx *VirtualServerEx) map[string]*conf_v1.Maintenance {
+	maintenances := make(map[string]*conf_v1.Maintenance)
+
+	for _, r := range vsEx.VirtualServer.Spec.Routes {
+		if r.Maintenance == nil {
+			continue
+		}
+
+		if r.Route != "" {
+			name := r.Route
+			if !nsutils.HasNamespace(name) {
+				name = fmt.Sprintf("%v/%v", vsEx.VirtualServer.Namespace, r.Route)
+			}
+			maintenances[name] = r.Maintenance
+		} else if r.RouteSelector != nil {
+			sel, err := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{MatchLabels: r.RouteSelector.MatchLabels})
+			if err != nil {
+				continue
+			}
+			for _, name := range vsEx.VirtualServerSelectorRoutes[sel.String()] {
+				maintenances[name] = r.Maintenance
+			}
+		}
+	}
+
+	return maintenances
+}
+
+// generateMaintenance generates the configuration of the maintenance mode.
+// In NGINX Plus, the configuration is generated even if the maintenance mode is disabled, so that it can be toggled through the keyval zone.
+func (vsc *virtualServerConfigurator) generateMaintenance(
+	owner runtime.Object,
+	namespace string,
+	maintenance *conf_v1.Maintenance,
+	id string,
+	index int,
+	variableNamer *VariableNamer,
+) *maintenanceCfg {
+	if maintenance == nil || (!vsc.isPlus && !maintenance.Enable) {
+		return nil
+	}
+
+	m := &version2.Maintenance{
+		Variable:    variableNamer.GetNameForMaintenanceVariable(index),
+		Location:    fmt.Sprintf("/%vmaintenance_%d", internalLocationPrefix, index),
+		Code:        defaultMaintenanceCode,
+		DefaultType: defaultMaintenanceType,
+		Body:        maintenance.Body,
+		RetryAfter:  maintenance.RetryAfter,
+	}
+	if maintenance.Code != 0 {
+		m.Code = maintenance.Code
+	}
+	if maintenance.Type != "" {
+		m.DefaultType = maintenance.Type
+	}
+
+	if maintenance.ConfigMap != nil {
+		pageKey := GetMaintenancePageKey(namespace, maintenance.ConfigMap)
+		if pageFile, exists := vsc.maintenancePageFiles[pageKey]; exists {
+			m.PageFile = pageFile
+			m.PageLocation = m.Location + "_page"
+		} else {
+			vsc.addWarningf(owner, "The maintenance page %s doesn't exist, the maintenance response will have no body", pageKey)
+		}
+	}
+
+	cfg := &maintenanceCfg{
+		Maintenance: m,
+	}
+
+	enable := "1"
+	if vsc.isPlus {
+		enable = variableNamer.GetNameOfKeyvalForMaintenanceIndex(index)
+		cfg.KeyVal = &version2.KeyVal{
+			Key:      variableNamer.GetNameOfKeyvalKeyForMaintenance(id),
+			Variable: enable,
+			ZoneName: variableNamer.GetNameOfKeyvalZoneForMaintenance(),
+		}
+	}
+
+	bypass := "0"
+	if maintenance.Bypass != nil && len(maintenance.Bypass.Allow) > 0 {
+		m.BypassVariable = variableNamer.GetNameForMaintenanceBypassVariable(index)
+		m.BypassAllow = maintenance.Bypass.Allow
+		bypass = m.BypassVariable
+	}
+
+	cfg.Map = version2.Map{
+		Source:   fmt.Sprintf("\"%s:%s\"", enable, bypass),
+		Variable: m.Variable,
+		Parameters: []version2.Parameter{
+			{
+				Value:  "default",
+				Result: "0",
+			},
+			{
+				Value:  "\"1:0\"",
+				Result: "1",
+			},
+		},
+	}
+
+	if maintenance.Bypass != nil && maintenance.Bypass.Header != "" {
+		header := strings.ReplaceAll(strings.ToLower(maintenance.Bypass.Header), "-", "_")
+		cfg.Map.Source = fmt.Sprintf("\"%s:%s:$http_%s\"", enable, bypass, header)
+		cfg.Map.Parameters = []version2.Parameter{
+			{
+				Value:  "default",
+				Result: "0",
+			},
+			{
+				Value:  fmt.Sprintf("\"1:0:%s\"", maintenance.Bypass.HeaderValue),
+				Result: "0",
+			},
+			{
+				Value:  "~^1:0:",
+				Result: "1",
+			},
+		}
+	}
+
+	return cfg
+}
+
+func addMaintenanceToLocations(maintenance *version2.Maintenance, locations []version2.Location) {
+	for i := range locations {
+		locations[i].Maintenance = maintenance
+	}
+}
+
+// GenerateMaintenanceKeyValUpdates generates the updates of the keyval zone that toggle the maintenance mode
+// of a VirtualServer and its VirtualServerRoutes in NGINX Plus.
+func GenerateMaintenanceKeyValUpdates(vsEx *VirtualServerEx) []WeightUpdate {
+	variableNamer := NewVSVariableNamer(vsEx.VirtualServer)
+	zoneName := variableNamer.GetNameOfKeyvalZoneForMaintenance()
+
+	var updates []WeightUpdate
+	addUpdate := func(maintenance *conf_v1.Maintenance, id string) {
+		if maintenance == nil {
+			return
+		}
+		value := "0"
+		if maintenance.Enable {
+			value = "1"
+		}
+		updates = append(updates, WeightUpdate{
+			Zone:  zoneName,
+			Key:   variableNamer.GetNameOfKeyvalKeyForMaintenance(id),
+			Value: value,
+		})
+	}
+
+	addUpdate(vsEx.VirtualServer.Spec.Maintenance, maintenanceServerID)
+
+	for i, r := range vsEx.VirtualServer.Spec.Routes {
+		if r.Route == "" && r.RouteSelector == nil {
+			addUpdate(r.Maintenance, getMaintenanceIDForRoute(i))
+		}
+	}
+
+	vsrMaintenancesFromVs := getVSRMaintenancesFromVS(vsEx)
+	for _, vsr := range vsEx.VirtualServerRoutes {
+		for i, r := range vsr.Spec.Subroutes {
+			maintenance := r.Maintenance
+			if maintenance == nil {
+				maintenance = vsrMaintenancesFromVs[fmt.Sprintf("%v/%v", vsr.Namespace, vsr.Name)]
+			}
+			addUpdate(maintenance, getMaintenanceIDForSubroute(vsr, i))
+		}
+	}
+
+	return updates
+}
+
 func (vsc *virtualServerConfigurator) mergeWarnings(routeWarnings Warnings) {
 	for obj, msgs := range routeWarnings {
 		vsc.addWarnings(obj, msgs)
@@ -1199,6 +1643,36 @@ func addPoliciesCfgToLocation(cfg policiesCfg, location *version2.Location) {
 		location.AddHeaders = append(location.AddHeaders, cfg.CORSHeaders...)
 		location.CORSEnabled = true
 	}
+
+	addHeadersCfgToLocation(cfg.Headers, location)
+}
+
+// addHeadersCfgToLocation adds the headers of the Headers policies to a location.
+// The headers configured in the action of the location take precedence.
+func addHeadersCfgToLocation(cfg headersCfg, location *version2.Location) {
+	for _, h := range cfg.Set {
+		if !hasHeader(location.ProxySetHeaders, h.Name) {
+			location.ProxySetHeaders = append(location.ProxySetHeaders, h)
+		}
+	}
+
+	for _, h := range cfg.Hide {
+		if !containsHeaderName(location.ProxyPassHeaders, h) && !containsHeaderName(location.ProxyHideHeaders, h) {
+			location.ProxyHideHeaders = append(location.ProxyHideHeaders, h)
+		}
+	}
+
+	for _, h := range cfg.Pass {
+		if !containsHeaderName(location.ProxyHideHeaders, h) && !containsHeaderName(location.ProxyPassHeaders, h) {
+			location.ProxyPassHeaders = append(location.ProxyPassHeaders, h)
+		}
+	}
+
+	for _, h := range cfg.Add {
+		if !hasAddHeader(location.AddHeaders, h.Name) {
+			location.AddHeaders = append(location.AddHeaders, h)
+		}
+	}
 }
 
 func addPoliciesCfgToLocations(cfg policiesCfg, locations []version2.Location) {
@@ -1265,6 +1739,9 @@ func (vsc *virtualServerConfigurator) generateUpstream(
 	})
 
 	lbMethod := generateLBMethod(upstream.LBMethod, vsc.cfgParams.LBMethod)
+	if upstream.Affinity != nil {
+		lbMethod = generateAffinityLBMethod(upstreamName, upstream.Affinity)
+	}
 
 	upstreamLabels := getUpstreamResourceLabels(owner)
 	upstreamLabels.Service = upstream.Service
@@ -1288,6 +1765,9 @@ func (vsc *virtualServerConfigurator) generateUpstream(
 		ups.SlowStart = vsc.generateSlowStartForPlus(owner, upstream, lbMethod)
 		ups.Queue = generateQueueForPlus(upstream.Queue, "60s")
 		ups.NTLM = upstream.NTLM
+	} else if isCircuitBreakerEnabled(upstream.CircuitBreaker) {
+		ups.MaxFails = generateIntFromPointer(upstream.CircuitBreaker.Consecutive5xx, defaultCircuitBreakerConsecutive5xx)
+		ups.FailTimeout = generateTimeWithDefault(upstream.CircuitBreaker.EjectDuration, defaultCircuitBreakerEjectDuration)
 	}
 
 	return ups
@@ -1402,6 +1882,174 @@ func generateSessionCookie(sc *conf_v1.SessionCookie) *version2.SessionCookie {
 	}
 }
 
+func generateAffinityVariable(upstreamName string) string {
+	return fmt.Sprintf("$%s_affinity", strings.ReplaceAll(upstreamName, "-", "_"))
+}
+
+func generateAffinityCookieVariable(upstreamName string) string {
+	return fmt.Sprintf("%s_cookie", generateAffinityVariable(upstreamName))
+}
+
+// generateAffinityKey returns the NGINX variable used as the hash key for the affinity of an upstream.
+// JWT claims and route cookies are hashed using a variable generated for the upstream.
+func generateAffinityKey(upstreamName string, affinity *conf_v1.UpstreamAffinity) string {
+	if affinity.Header != "" {
+		return fmt.Sprintf("$http_%s", strings.ToLower(strings.ReplaceAll(affinity.Header, "-", "_")))
+	}
+
+	if affinity.Cookie != "" {
+		return fmt.Sprintf("$cookie_%s", affinity.Cookie)
+	}
+
+	return generateAffinityVariable(upstreamName)
+}
+
+func generateAffinityLBMethod(upstreamName string, affinity *conf_v1.UpstreamAffinity) string {
+	lbMethod := fmt.Sprintf("hash %s", generateAffinityKey(upstreamName, affinity))
+	if generateBool(affinity.Consistent, true) {
+		lbMethod += " consistent"
+	}
+	return lbMethod
+}
+
+// generateAffinityConfig generates the maps and the JWT claim sets that populate the affinity variables of the upstreams.
+func generateAffinityConfig(crUpstreams map[string]conf_v1.Upstream) ([]version2.Map, []version2.AuthJWTClaimSet) {
+	var maps []version2.Map
+	var claimSets []version2.AuthJWTClaimSet
+
+	upstreamNames := make([]string, 0, len(crUpstreams))
+	for name := range crUpstreams {
+		upstreamNames = append(upstreamNames, name)
+	}
+	sort.Strings(upstreamNames)
+
+	for _, name := range upstreamNames {
+		affinity := crUpstreams[name].Affinity
+		if affinity == nil {
+			continue
+		}
+
+		if affinity.JWTClaim != "" {
+			claimSets = append(claimSets, version2.AuthJWTClaimSet{
+				Variable: generateAffinityVariable(name),
+				Claim:    generateAuthJwtClaimSetClaim(affinity.JWTClaim),
+			})
+		}
+
+		if affinity.RouteCookie != nil {
+			maps = append(maps, generateAffinityRouteCookieMaps(name, affinity.RouteCookie)...)
+		}
+	}
+
+	return maps, claimSets
+}
+
+// getUpstreamsWithJWTClaimAffinity returns the upstreams whose affinity uses a JWT claim, sorted by name.
+func getUpstreamsWithJWTClaimAffinity(crUpstreams map[string]conf_v1.Upstream) []conf_v1.Upstream {
+	var upstreams []conf_v1.Upstream
+	for _, u := range crUpstreams {
+		if u.Affinity != nil && u.Affinity.JWTClaim != "" {
+			upstreams = append(upstreams, u)
+		}
+	}
+	sort.Slice(upstreams, func(i, j int) bool {
+		return upstreams[i].Name < upstreams[j].Name
+	})
+	return upstreams
+}
+
+// generateAffinityRouteCookieMaps generates the maps for a route cookie.
+// Clients without the cookie are hashed by the request ID, which is then returned to them in the cookie,
+// so that their subsequent requests are passed to the same upstream server.
+func generateAffinityRouteCookieMaps(upstreamName string, rc *conf_v1.AffinityRouteCookie) []version2.Map {
+	source := fmt.Sprintf("$cookie_%s", rc.Name)
+
+	return []version2.Map{
+		{
+			Source:   source,
+			Variable: generateAffinityVariable(upstreamName),
+			Parameters: []version2.Parameter{
+				{Value: `""`, Result: "$request_id"},
+				{Value: "default", Result: source},
+			},
+		},
+		{
+			Source:   source,
+			Variable: generateAffinityCookieVariable(upstreamName),
+			Parameters: []version2.Parameter{
+				{Value: `""`, Result: fmt.Sprintf(`"%s"`, generateAffinityRouteCookieValue(rc))},
+				{Value: "default", Result: `""`},
+			},
+		},
+	}
+}
+
+func generateAffinityRouteCookieValue(rc *conf_v1.AffinityRouteCookie) string {
+	attrs := []string{
+		fmt.Sprintf("%s=$request_id", rc.Name),
+		fmt.Sprintf("Path=%s", generateString(rc.Path, "/")),
+	}
+	if rc.MaxAge != nil {
+		attrs = append(attrs, fmt.Sprintf("Max-Age=%d", *rc.MaxAge))
+	}
+	if rc.Domain != "" {
+		attrs = append(attrs, fmt.Sprintf("Domain=%s", rc.Domain))
+	}
+	if rc.HTTPOnly {
+		attrs = append(attrs, "HttpOnly")
+	}
+	if rc.Secure {
+		attrs = append(attrs, "Secure")
+	}
+	if rc.SameSite != "" {
+		attrs = append(attrs, fmt.Sprintf("SameSite=%s", strings.ToLower(rc.SameSite)))
+	}
+	return strings.Join(attrs, "; ")
+}
+
+// generateAffinityAddHeaders returns the header that sets the route cookie of the upstream.
+// The header is empty, and therefore not sent, for clients that already present the cookie.
+func generateAffinityAddHeaders(upstreamName string, upstream conf_v1.Upstream) []version2.AddHeader {
+	if upstream.Affinity == nil || upstream.Affinity.RouteCookie == nil {
+		return nil
+	}
+
+	return []version2.AddHeader{
+		{
+			Header: version2.Header{
+				Name:  "Set-Cookie",
+				Value: generateAffinityCookieVariable(upstreamName),
+			},
+		},
+	}
+}
+
+func isCircuitBreakerEnabled(cb *conf_v1.CircuitBreaker) bool {
+	return cb != nil && cb.Enable
+}
+
+// generateCircuitBreakerConfig returns the configuration of the circuit breaker that ejects the servers of the upstream through the NGINX Plus API.
+func generateCircuitBreakerConfig(cb *conf_v1.CircuitBreaker) nginx.CircuitBreakerConfig {
+	cfg := nginx.CircuitBreakerConfig{
+		Consecutive5xx:     generateIntFromPointer(cb.Consecutive5xx, defaultCircuitBreakerConsecutive5xx),
+		MaxEjectionPercent: generateIntFromPointer(cb.MaxEjectionPercent, defaultCircuitBreakerMaxEjectionPercent),
+	}
+
+	ejectDuration, err := ParseTimeToDuration(generateString(cb.EjectDuration, defaultCircuitBreakerEjectDuration))
+	if err != nil {
+		ejectDuration, _ = ParseTimeToDuration(defaultCircuitBreakerEjectDuration)
+	}
+	cfg.EjectDuration = ejectDuration
+
+	if cb.LatencyThreshold != "" {
+		if latencyThreshold, err := ParseTimeToDuration(cb.LatencyThreshold); err == nil {
+			cfg.LatencyThreshold = latencyThreshold
+		}
+	}
+
+	return cfg
+}
+
 func generateStatusMatchName(upstreamName string) string {
 	return fmt.Sprintf("%s_match", upstreamName)
 }
@@ -1492,6 +2140,17 @@ func generateProxyPassRewrite(path string, proxy *conf_v1.ActionProxy, internal
 	return ""
 }
 
+// generateDefaultBackendAddress returns the address of the default backend service for a VirtualServer without a route
+// for the / path, so that the requests for the paths that no route matches are passed to the default backend.
+func generateDefaultBackendAddress(routes []conf_v1.Route, address string) string {
+	for _, r := range routes {
+		if r.Path == "/" {
+			return ""
+		}
+	}
+	return address
+}
+
 func generateProxyPass(tlsEnabled bool, upstreamName string, internal bool, proxy *conf_v1.ActionProxy) string {
 	proxyPass := fmt.Sprintf("%v://%v", generateProxyPassProtocol(tlsEnabled), upstreamName)
 
@@ -1628,6 +2287,7 @@ func generateProxySetHeaders(proxy *conf_v1.ActionProxy) []version2.Header {
 	var headers []version2.Header
 
 	hasHostHeader := false
+	hasAcceptEncodingHeader := false
 
 	if proxy != nil && proxy.RequestHeaders != nil {
 		for _, h := range proxy.RequestHeaders.Set {
@@ -1636,8 +2296,11 @@ func generateProxySetHeaders(proxy *conf_v1.ActionProxy) []version2.Header {
 				Value: h.Value,
 			})
 
-			if strings.ToLower(h.Name) == "host" {
+			switch strings.ToLower(h.Name) {
+			case "host":
 				hasHostHeader = true
+			case "accept-encoding":
+				hasAcceptEncodingHeader = true
 			}
 		}
 	}
@@ -1646,9 +2309,34 @@ func generateProxySetHeaders(proxy *conf_v1.ActionProxy) []version2.Header {
 		headers = append(headers, version2.Header{Name: "Host", Value: "$host"})
 	}
 
+	// the strings can't be replaced in compressed responses
+	if generateResponseBodyRewrite(proxy) != nil && !hasAcceptEncodingHeader {
+		headers = append(headers, version2.Header{Name: "Accept-Encoding", Value: ""})
+	}
+
 	return headers
 }
 
+func generateResponseBodyRewrite(proxy *conf_v1.ActionProxy) *version2.ResponseBodyRewrite {
+	if proxy == nil || proxy.ResponseBody == nil || len(proxy.ResponseBody.Rewrite) == 0 {
+		return nil
+	}
+
+	var subFilters []version2.SubFilter
+	for _, r := range proxy.ResponseBody.Rewrite {
+		subFilters = append(subFilters, version2.SubFilter{
+			Match:       r.Match,
+			Replacement: r.Replacement,
+		})
+	}
+
+	return &version2.ResponseBodyRewrite{
+		SubFilters: subFilters,
+		Types:      strings.Join(proxy.ResponseBody.Types, " "),
+		Once:       proxy.ResponseBody.Once,
+	}
+}
+
 func generateProxyPassRequestHeaders(proxy *conf_v1.ActionProxy) bool {
 	if proxy == nil || proxy.RequestHeaders == nil {
 		return true
@@ -1732,7 +2420,8 @@ func generateLocationForProxying(path string, upstreamName string, upstream conf
 		ProxyHideHeaders:         generateProxyHideHeaders(proxy),
 		ProxyPassHeaders:         generateProxyPassHeaders(proxy),
 		ProxyIgnoreHeaders:       generateProxyIgnoreHeaders(proxy),
-		AddHeaders:               generateProxyAddHeaders(proxy),
+		ResponseBodyRewrite:      generateResponseBodyRewrite(proxy),
+		AddHeaders:               append(generateProxyAddHeaders(proxy), generateAffinityAddHeaders(upstreamName, upstream)...),
 		ProxyPassRewrite:         generateProxyPassRewrite(path, proxy, internal),
 		Rewrites:                 generateRewrites(path, proxy, internal, originalPath, isGRPC(upstream.Type)),
 		HasKeepalive:             upstreamHasKeepalive(upstream, cfgParams),
@@ -2033,21 +2722,40 @@ func generateMatchesConfig(route conf_v1.Route, upstreamNamer *upstreamNamer, cr
 
 	for i, m := range route.Matches {
 		for j, c := range m.Conditions {
-			source := getNameForSourceForMatchesRouteMapFromCondition(c)
 			variable := VariableNamer.GetNameForVariableForMatchesRouteMap(index, i, j)
 			successfulResult := "1"
 			if j < len(m.Conditions)-1 {
 				successfulResult = VariableNamer.GetNameForVariableForMatchesRouteMap(index, i, j+1)
 			}
 
-			params := generateParametersForMatchesRouteMap(c.Value, successfulResult)
+			if len(c.AnyOf) == 0 {
+				maps = append(maps, version2.Map{
+					Source:     getNameForSourceForMatchesRouteMapFromCondition(c),
+					Variable:   variable,
+					Parameters: generateParametersForMatchesRouteMapFromCondition(c, successfulResult, "0"),
+				})
+				continue
+			}
+
+			// every alternative falls through to the next one, so the condition fails only if all alternatives fail
+			for k, alt := range c.AnyOf {
+				altCondition := conditionFromAlternative(alt)
 
-			matchMap := version2.Map{
-				Source:     source,
-				Variable:   variable,
-				Parameters: params,
+				altVariable := variable
+				if k > 0 {
+					altVariable = VariableNamer.GetNameForVariableForMatchesRouteMapAlternative(index, i, j, k)
+				}
+				failedResult := "0"
+				if k < len(c.AnyOf)-1 {
+					failedResult = VariableNamer.GetNameForVariableForMatchesRouteMapAlternative(index, i, j, k+1)
+				}
+
+				maps = append(maps, version2.Map{
+					Source:     getNameForSourceForMatchesRouteMapFromCondition(altCondition),
+					Variable:   altVariable,
+					Parameters: generateParametersForMatchesRouteMapFromCondition(altCondition, successfulResult, failedResult),
+				})
 			}
-			maps = append(maps, matchMap)
 		}
 	}
 
@@ -2240,13 +2948,13 @@ func generateValueForMatchesRouteMap(matchedValue string) (value string, isNegat
 	return fmt.Sprintf(`"%s"`, matchedValue), isNegative
 }
 
-func generateParametersForMatchesRouteMap(matchedValue string, successfulResult string) []version2.Parameter {
+func generateParametersForMatchesRouteMap(matchedValue string, successfulResult string, failedResult string) []version2.Parameter {
 	value, isNegative := generateValueForMatchesRouteMap(matchedValue)
 
 	valueResult := successfulResult
-	defaultResult := "0"
+	defaultResult := failedResult
 	if isNegative {
-		valueResult = "0"
+		valueResult = failedResult
 		defaultResult = successfulResult
 	}
 
@@ -2264,7 +2972,57 @@ func generateParametersForMatchesRouteMap(matchedValue string, successfulResult
 	return params
 }
 
+func generateParametersForMatchesRouteMapFromCondition(condition conf_v1.Condition, successfulResult string, failedResult string) []version2.Parameter {
+	switch {
+	case condition.Method != "":
+		return generateParametersForMatchesRouteMap(condition.Method, successfulResult, failedResult)
+	case condition.Operator == "present":
+		return generateParametersForMatchesRouteMap("!", successfulResult, failedResult)
+	case condition.Operator == "absent":
+		return generateParametersForMatchesRouteMap("", successfulResult, failedResult)
+	case condition.Operator == "regex":
+		regex, isNegative := strings.CutPrefix(condition.Value, "!")
+
+		valueResult := successfulResult
+		defaultResult := failedResult
+		if isNegative {
+			valueResult = failedResult
+			defaultResult = successfulResult
+		}
+
+		return []version2.Parameter{
+			{
+				Value:  fmt.Sprintf(`"~%s"`, regex),
+				Result: valueResult,
+			},
+			{
+				Value:  "default",
+				Result: defaultResult,
+			},
+		}
+	}
+
+	return generateParametersForMatchesRouteMap(condition.Value, successfulResult, failedResult)
+}
+
+// conditionFromAlternative converts an alternative of an anyOf condition into a condition.
+func conditionFromAlternative(alt conf_v1.ConditionAlternative) conf_v1.Condition {
+	return conf_v1.Condition{
+		Header:   alt.Header,
+		Cookie:   alt.Cookie,
+		Argument: alt.Argument,
+		Variable: alt.Variable,
+		Method:   alt.Method,
+		Value:    alt.Value,
+		Operator: alt.Operator,
+	}
+}
+
 func getNameForSourceForMatchesRouteMapFromCondition(condition conf_v1.Condition) string {
+	if condition.Method != "" {
+		return "$request_method"
+	}
+
 	if condition.Header != "" {
 		return fmt.Sprintf("$http_%s", strings.ReplaceAll(condition.Header, "-", "_"))
 	}
@@ -2300,7 +3058,44 @@ func (vsc *virtualServerConfigurator) generateSSLConfig(owner runtime.Object, tl
 		return nil
 	}
 
-	secretRef := secretRefs[fmt.Sprintf("%s/%s", namespace, tls.Secret)]
+	return vsc.generateSSLConfigForSecret(owner, tls.Secret, secretRefs[fmt.Sprintf("%s/%s", namespace, tls.Secret)], cfgParams)
+}
+
+// generateSSLConfigForListener generates the SSL config from the default TLS settings of the HTTPS listener.
+// It returns nil if the VirtualServer specifies its own TLS Secret or if the listener has no default TLS settings.
+func (vsc *virtualServerConfigurator) generateSSLConfigForListener(owner runtime.Object, tls *conf_v1.TLS, listener *conf_v1.Listener,
+	secretRefs map[string]*secrets.SecretReference, cfgParams *ConfigParams,
+) *version2.SSL {
+	if listener == nil || listener.TLS == nil || (tls != nil && tls.Secret != "") {
+		return nil
+	}
+
+	if listener.TLS.RejectHandshake {
+		return &version2.SSL{
+			HTTP2:           cfgParams.HTTP2,
+			RejectHandshake: true,
+		}
+	}
+
+	if listener.TLS.Secret == "" {
+		return nil
+	}
+
+	secretRef, exists := secretRefs[listener.TLS.Secret]
+	if !exists {
+		vsc.addWarningf(owner, "TLS secret %s of listener %s doesn't exist", listener.TLS.Secret, listener.Name)
+		return &version2.SSL{
+			HTTP2:           cfgParams.HTTP2,
+			RejectHandshake: true,
+		}
+	}
+
+	return vsc.generateSSLConfigForSecret(owner, listener.TLS.Secret, secretRef, cfgParams)
+}
+
+func (vsc *virtualServerConfigurator) generateSSLConfigForSecret(owner runtime.Object, secret string,
+	secretRef *secrets.SecretReference, cfgParams *ConfigParams,
+) *version2.SSL {
 	var secretType api_v1.SecretType
 	if secretRef.Secret != nil {
 		secretType = secretRef.Secret.Type
@@ -2309,10 +3104,10 @@ func (vsc *virtualServerConfigurator) generateSSLConfig(owner runtime.Object, tl
 	var rejectHandshake bool
 	if secretType != "" && secretType != api_v1.SecretTypeTLS {
 		rejectHandshake = true
-		vsc.addWarningf(owner, "TLS secret %s is of a wrong type '%s', must be '%s'", tls.Secret, secretType, api_v1.SecretTypeTLS)
+		vsc.addWarningf(owner, "TLS secret %s is of a wrong type '%s', must be '%s'", secret, secretType, api_v1.SecretTypeTLS)
 	} else if secretRef.Error != nil {
 		rejectHandshake = true
-		vsc.addWarningf(owner, "TLS secret %s is invalid: %v", tls.Secret, secretRef.Error)
+		vsc.addWarningf(owner, "TLS secret %s is invalid: %v", secret, secretRef.Error)
 	} else {
 		name = secretRef.Path
 	}
@@ -2327,6 +3122,39 @@ func (vsc *virtualServerConfigurator) generateSSLConfig(owner runtime.Object, tl
 	return &ssl
 }
 
+// applyListenerTLSSettings applies the TLS protocols, ciphers and HTTP/2 setting of the HTTPS listener to the SSL config.
+func applyListenerTLSSettings(ssl *version2.SSL, listener *conf_v1.Listener) {
+	if ssl == nil || listener == nil {
+		return
+	}
+
+	if listener.HTTP2 != nil {
+		ssl.HTTP2 = *listener.HTTP2
+	}
+
+	if listener.TLS != nil {
+		ssl.Protocols = listener.TLS.Protocols
+		ssl.Ciphers = listener.TLS.Ciphers
+	}
+}
+
+// generateListenerTimeouts returns the client header and keepalive timeouts of the listeners.
+// The HTTPS listener takes precedence, as both listeners share the same server.
+func generateListenerTimeouts(httpListener *conf_v1.Listener, httpsListener *conf_v1.Listener) (clientHeaderTimeout string, keepaliveTimeout string) {
+	for _, l := range []*conf_v1.Listener{httpListener, httpsListener} {
+		if l == nil {
+			continue
+		}
+		if l.ClientHeaderTimeout != "" {
+			clientHeaderTimeout = l.ClientHeaderTimeout
+		}
+		if l.KeepaliveTimeout != "" {
+			keepaliveTimeout = l.KeepaliveTimeout
+		}
+	}
+	return clientHeaderTimeout, keepaliveTimeout
+}
+
 func generateTLSRedirectConfig(tls *conf_v1.TLS) *version2.TLSRedirect {
 	if tls == nil || tls.Redirect == nil || !tls.Redirect.Enable {
 		return nil
@@ -2420,6 +3248,51 @@ func createUpstreamsForPlus(
 	return upstreams
 }
 
+// createCircuitBreakerConfigsForPlus returns the circuit breaker configs of the upstreams of the VirtualServer and its VirtualServerRoutes.
+func createCircuitBreakerConfigsForPlus(virtualServerEx *VirtualServerEx) map[string]nginx.CircuitBreakerConfig {
+	configs := make(map[string]nginx.CircuitBreakerConfig)
+
+	upstreamNamer := NewUpstreamNamerForVirtualServer(virtualServerEx.VirtualServer)
+	for _, u := range virtualServerEx.VirtualServer.Spec.Upstreams {
+		if isCircuitBreakerEnabled(u.CircuitBreaker) {
+			configs[upstreamNamer.GetNameForUpstream(u.Name)] = generateCircuitBreakerConfig(u.CircuitBreaker)
+		}
+	}
+
+	for _, vsr := range virtualServerEx.VirtualServerRoutes {
+		upstreamNamer = NewUpstreamNamerForVirtualServerRoute(virtualServerEx.VirtualServer, vsr)
+		for _, u := range vsr.Spec.Upstreams {
+			if isCircuitBreakerEnabled(u.CircuitBreaker) {
+				configs[upstreamNamer.GetNameForUpstream(u.Name)] = generateCircuitBreakerConfig(u.CircuitBreaker)
+			}
+		}
+	}
+
+	return configs
+}
+
+// generateEjectedPeers returns the ejected servers of the upstreams sorted by the upstream and the server.
+func generateEjectedPeers(configs map[string]nginx.CircuitBreakerConfig, ejected map[string]map[string]bool) []conf_v1.EjectedPeer {
+	var ejectedPeers []conf_v1.EjectedPeer
+	for upstream := range configs {
+		for server := range ejected[upstream] {
+			ejectedPeers = append(ejectedPeers, conf_v1.EjectedPeer{
+				Upstream: upstream,
+				Server:   server,
+			})
+		}
+	}
+
+	sort.Slice(ejectedPeers, func(i, j int) bool {
+		if ejectedPeers[i].Upstream != ejectedPeers[j].Upstream {
+			return ejectedPeers[i].Upstream < ejectedPeers[j].Upstream
+		}
+		return ejectedPeers[i].Server < ejectedPeers[j].Server
+	})
+
+	return ejectedPeers
+}
+
 func createUpstreamServersConfigForPlus(upstream version2.Upstream) nginx.ServerConfig {
 	if len(upstream.Servers) == 0 {
 		return nginx.ServerConfig{}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/nginx/kubernetes-ingress/internal/configs/version2"
@@ -732,6 +733,48 @@ func TestGenerateUpstreamWithNTLM(t *testing.T) {
 	}
 }
 
+func TestGenerateDefaultBackendAddress(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		routes   []conf_v1.Route
+		address  string
+		expected string
+		msg      string
+	}{
+		{
+			routes:   []conf_v1.Route{{Path: "/coffee"}, {Path: "/tea"}},
+			address:  "10.0.0.100:8080",
+			expected: "10.0.0.100:8080",
+			msg:      "no route for /",
+		},
+		{
+			routes:   nil,
+			address:  "10.0.0.100:8080",
+			expected: "10.0.0.100:8080",
+			msg:      "no routes",
+		},
+		{
+			routes:   []conf_v1.Route{{Path: "/coffee"}, {Path: "/"}},
+			address:  "10.0.0.100:8080",
+			expected: "",
+			msg:      "route for /",
+		},
+		{
+			routes:   []conf_v1.Route{{Path: "/coffee"}},
+			address:  "",
+			expected: "",
+			msg:      "no default backend",
+		},
+	}
+
+	for _, test := range tests {
+		result := generateDefaultBackendAddress(test.routes, test.address)
+		if result != test.expected {
+			t.Errorf("generateDefaultBackendAddress() returned %q but expected %q for the case of %s", result, test.expected, test.msg)
+		}
+	}
+}
+
 func TestGenerateProxyPass(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -1445,6 +1488,163 @@ func TestGenerateSSLConfig(t *testing.T) {
 	}
 }
 
+func TestGenerateSSLConfigForListener(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		inputTLS         *conf_v1.TLS
+		inputListener    *conf_v1.Listener
+		inputSecretRefs  map[string]*secrets.SecretReference
+		expectedSSL      *version2.SSL
+		expectedWarnings Warnings
+		msg              string
+	}{
+		{
+			inputTLS:         nil,
+			inputListener:    nil,
+			inputSecretRefs:  map[string]*secrets.SecretReference{},
+			expectedSSL:      nil,
+			expectedWarnings: Warnings{},
+			msg:              "no listener",
+		},
+		{
+			inputTLS: nil,
+			inputListener: &conf_v1.Listener{
+				Name: "https-listener",
+				TLS:  &conf_v1.ListenerTLS{Protocols: "TLSv1.3"},
+			},
+			inputSecretRefs:  map[string]*secrets.SecretReference{},
+			expectedSSL:      nil,
+			expectedWarnings: Warnings{},
+			msg:              "listener without a default secret",
+		},
+		{
+			inputTLS: &conf_v1.TLS{Secret: "secret"},
+			inputListener: &conf_v1.Listener{
+				Name: "https-listener",
+				TLS:  &conf_v1.ListenerTLS{Secret: "nginx-ingress/default-cert"},
+			},
+			inputSecretRefs:  map[string]*secrets.SecretReference{},
+			expectedSSL:      nil,
+			expectedWarnings: Warnings{},
+			msg:              "VirtualServer with its own secret",
+		},
+		{
+			inputTLS: &conf_v1.TLS{},
+			inputListener: &conf_v1.Listener{
+				Name: "https-listener",
+				TLS:  &conf_v1.ListenerTLS{Secret: "nginx-ingress/default-cert"},
+			},
+			inputSecretRefs: map[string]*secrets.SecretReference{
+				"nginx-ingress/default-cert": {
+					Secret: &api_v1.Secret{
+						Type: api_v1.SecretTypeTLS,
+					},
+					Path: "default-cert.pem",
+				},
+			},
+			expectedSSL: &version2.SSL{
+				Certificate:    "default-cert.pem",
+				CertificateKey: "default-cert.pem",
+			},
+			expectedWarnings: Warnings{},
+			msg:              "listener default secret",
+		},
+		{
+			inputTLS: nil,
+			inputListener: &conf_v1.Listener{
+				Name: "https-listener",
+				TLS:  &conf_v1.ListenerTLS{Secret: "nginx-ingress/default-cert"},
+			},
+			inputSecretRefs: map[string]*secrets.SecretReference{},
+			expectedSSL: &version2.SSL{
+				RejectHandshake: true,
+			},
+			expectedWarnings: Warnings{
+				nil: []string{"TLS secret nginx-ingress/default-cert of listener https-listener doesn't exist"},
+			},
+			msg: "missing listener default secret",
+		},
+		{
+			inputTLS: nil,
+			inputListener: &conf_v1.Listener{
+				Name: "https-listener",
+				TLS:  &conf_v1.ListenerTLS{RejectHandshake: true},
+			},
+			inputSecretRefs: map[string]*secrets.SecretReference{},
+			expectedSSL: &version2.SSL{
+				RejectHandshake: true,
+			},
+			expectedWarnings: Warnings{},
+			msg:              "listener reject handshake",
+		},
+	}
+
+	for _, test := range tests {
+		vsc := newVirtualServerConfigurator(&ConfigParams{Context: context.Background()}, false, false, &StaticConfigParams{}, false, &fakeBV)
+
+		// it is ok to use nil as the owner
+		result := vsc.generateSSLConfigForListener(nil, test.inputTLS, test.inputListener, test.inputSecretRefs, &ConfigParams{Context: context.Background()})
+		if !reflect.DeepEqual(result, test.expectedSSL) {
+			t.Errorf("generateSSLConfigForListener() returned %v but expected %v for the case of %s", result, test.expectedSSL, test.msg)
+		}
+		if !reflect.DeepEqual(vsc.warnings, test.expectedWarnings) {
+			t.Errorf("generateSSLConfigForListener() returned warnings of \n%v but expected \n%v for the case of %s", vsc.warnings, test.expectedWarnings, test.msg)
+		}
+	}
+}
+
+func TestApplyListenerTLSSettings(t *testing.T) {
+	t.Parallel()
+	ssl := &version2.SSL{
+		HTTP2:          true,
+		Certificate:    "secret.pem",
+		CertificateKey: "secret.pem",
+	}
+	listener := &conf_v1.Listener{
+		Name:  "https-listener",
+		HTTP2: createPointerFromBool(false),
+		TLS: &conf_v1.ListenerTLS{
+			Protocols: "TLSv1.2 TLSv1.3",
+			Ciphers:   "HIGH:!aNULL:!MD5",
+		},
+	}
+	expected := &version2.SSL{
+		HTTP2:          false,
+		Certificate:    "secret.pem",
+		CertificateKey: "secret.pem",
+		Protocols:      "TLSv1.2 TLSv1.3",
+		Ciphers:        "HIGH:!aNULL:!MD5",
+	}
+
+	applyListenerTLSSettings(ssl, listener)
+	if diff := cmp.Diff(expected, ssl); diff != "" {
+		t.Errorf("applyListenerTLSSettings() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestGenerateListenerTimeouts(t *testing.T) {
+	t.Parallel()
+	httpListener := &conf_v1.Listener{
+		Name:                "http-listener",
+		ClientHeaderTimeout: "10s",
+		KeepaliveTimeout:    "30s",
+	}
+	httpsListener := &conf_v1.Listener{
+		Name:             "https-listener",
+		KeepaliveTimeout: "60s",
+	}
+
+	clientHeaderTimeout, keepaliveTimeout := generateListenerTimeouts(httpListener, httpsListener)
+	if clientHeaderTimeout != "10s" || keepaliveTimeout != "60s" {
+		t.Errorf("generateListenerTimeouts() returned %q, %q but expected %q, %q", clientHeaderTimeout, keepaliveTimeout, "10s", "60s")
+	}
+
+	clientHeaderTimeout, keepaliveTimeout = generateListenerTimeouts(nil, nil)
+	if clientHeaderTimeout != "" || keepaliveTimeout != "" {
+		t.Errorf("generateListenerTimeouts() returned %q, %q for no listeners", clientHeaderTimeout, keepaliveTimeout)
+	}
+}
+
 func TestGenerateRedirectConfig(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -2585,6 +2785,611 @@ func TestGenerateSessionCookie(t *testing.T) {
 	}
 }
 
+func TestGenerateAffinityLBMethod(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		affinity *conf_v1.UpstreamAffinity
+		expected string
+		msg      string
+	}{
+		{
+			affinity: &conf_v1.UpstreamAffinity{Header: "X-User-ID"},
+			expected: "hash $http_x_user_id consistent",
+			msg:      "header",
+		},
+		{
+			affinity: &conf_v1.UpstreamAffinity{Cookie: "session_id", Consistent: createPointerFromBool(false)},
+			expected: "hash $cookie_session_id",
+			msg:      "cookie without consistent hashing",
+		},
+		{
+			affinity: &conf_v1.UpstreamAffinity{JWTClaim: "user.id"},
+			expected: "hash $vs_default_cafe_tea_app_affinity consistent",
+			msg:      "jwt claim",
+		},
+		{
+			affinity: &conf_v1.UpstreamAffinity{RouteCookie: &conf_v1.AffinityRouteCookie{Name: "route"}},
+			expected: "hash $vs_default_cafe_tea_app_affinity consistent",
+			msg:      "route cookie",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			result := generateAffinityLBMethod("vs_default_cafe_tea-app", test.affinity)
+			if result != test.expected {
+				t.Errorf("generateAffinityLBMethod() returned %q but expected %q", result, test.expected)
+			}
+		})
+	}
+}
+
+func TestGenerateAffinityConfig(t *testing.T) {
+	t.Parallel()
+	crUpstreams := map[string]conf_v1.Upstream{
+		"vs_default_cafe_tea": {
+			Affinity: &conf_v1.UpstreamAffinity{
+				RouteCookie: &conf_v1.AffinityRouteCookie{Name: "route", MaxAge: createPointerFromInt(3600), HTTPOnly: true, SameSite: "Lax"},
+			},
+		},
+		"vs_default_cafe_coffee": {
+			Affinity: &conf_v1.UpstreamAffinity{JWTClaim: "user.id"},
+		},
+		"vs_default_cafe_juice": {},
+	}
+
+	expectedMaps := []version2.Map{
+		{
+			Source:   "$cookie_route",
+			Variable: "$vs_default_cafe_tea_affinity",
+			Parameters: []version2.Parameter{
+				{Value: `""`, Result: "$request_id"},
+				{Value: "default", Result: "$cookie_route"},
+			},
+		},
+		{
+			Source:   "$cookie_route",
+			Variable: "$vs_default_cafe_tea_affinity_cookie",
+			Parameters: []version2.Parameter{
+				{Value: `""`, Result: `"route=$request_id; Path=/; Max-Age=3600; HttpOnly; SameSite=lax"`},
+				{Value: "default", Result: `""`},
+			},
+		},
+	}
+	expectedClaimSets := []version2.AuthJWTClaimSet{
+		{
+			Variable: "$vs_default_cafe_coffee_affinity",
+			Claim:    "user id",
+		},
+	}
+
+	maps, claimSets := generateAffinityConfig(crUpstreams)
+	if !cmp.Equal(expectedMaps, maps) {
+		t.Error(cmp.Diff(expectedMaps, maps))
+	}
+	if !cmp.Equal(expectedClaimSets, claimSets) {
+		t.Error(cmp.Diff(expectedClaimSets, claimSets))
+	}
+}
+
+func TestGenerateAffinityAddHeaders(t *testing.T) {
+	t.Parallel()
+	upstream := conf_v1.Upstream{
+		Affinity: &conf_v1.UpstreamAffinity{RouteCookie: &conf_v1.AffinityRouteCookie{Name: "route"}},
+	}
+	expected := []version2.AddHeader{
+		{Header: version2.Header{Name: "Set-Cookie", Value: "$vs_default_cafe_tea_affinity_cookie"}},
+	}
+
+	result := generateAffinityAddHeaders("vs_default_cafe_tea", upstream)
+	if !cmp.Equal(expected, result) {
+		t.Error(cmp.Diff(expected, result))
+	}
+
+	result = generateAffinityAddHeaders("vs_default_cafe_tea", conf_v1.Upstream{Affinity: &conf_v1.UpstreamAffinity{Header: "X-User-ID"}})
+	if result != nil {
+		t.Errorf("generateAffinityAddHeaders() returned %v but expected nil for affinity without a route cookie", result)
+	}
+}
+
+func TestGenerateUpstreamWithCircuitBreaker(t *testing.T) {
+	t.Parallel()
+	name := "test-upstream"
+	endpoints := []string{"192.168.10.10:8080"}
+	cfgParams := ConfigParams{MaxFails: 1, FailTimeout: "10s"}
+
+	tests := []struct {
+		upstream            conf_v1.Upstream
+		isPlus              bool
+		expectedMaxFails    int
+		expectedFailTimeout string
+		msg                 string
+	}{
+		{
+			upstream: conf_v1.Upstream{
+				Service:        name,
+				CircuitBreaker: &conf_v1.CircuitBreaker{Enable: true},
+			},
+			expectedMaxFails:    5,
+			expectedFailTimeout: "30s",
+			msg:                 "circuit breaker with defaults",
+		},
+		{
+			upstream: conf_v1.Upstream{
+				Service: name,
+				CircuitBreaker: &conf_v1.CircuitBreaker{
+					Enable:         true,
+					Consecutive5xx: createPointerFromInt(3),
+					EjectDuration:  "1m",
+				},
+			},
+			expectedMaxFails:    3,
+			expectedFailTimeout: "1m",
+			msg:                 "circuit breaker with custom values",
+		},
+		{
+			upstream: conf_v1.Upstream{
+				Service:        name,
+				CircuitBreaker: &conf_v1.CircuitBreaker{Consecutive5xx: createPointerFromInt(3)},
+			},
+			expectedMaxFails:    1,
+			expectedFailTimeout: "10s",
+			msg:                 "disabled circuit breaker",
+		},
+		{
+			upstream: conf_v1.Upstream{
+				Service:        name,
+				CircuitBreaker: &conf_v1.CircuitBreaker{Enable: true},
+			},
+			isPlus:              true,
+			expectedMaxFails:    1,
+			expectedFailTimeout: "10s",
+			msg:                 "circuit breaker in NGINX Plus",
+		},
+	}
+
+	for _, test := range tests {
+		vsc := newVirtualServerConfigurator(&cfgParams, test.isPlus, false, &StaticConfigParams{}, false, &fakeBV)
+		result := vsc.generateUpstream(nil, name, test.upstream, false, endpoints, nil)
+		if result.MaxFails != test.expectedMaxFails {
+			t.Errorf("generateUpstream() returned max fails %v but expected %v for the case of %v", result.MaxFails, test.expectedMaxFails, test.msg)
+		}
+		if result.FailTimeout != test.expectedFailTimeout {
+			t.Errorf("generateUpstream() returned fail timeout %q but expected %q for the case of %v", result.FailTimeout, test.expectedFailTimeout, test.msg)
+		}
+	}
+}
+
+func TestCreateCircuitBreakerConfigsForPlus(t *testing.T) {
+	t.Parallel()
+	virtualServerEx := VirtualServerEx{
+		VirtualServer: &conf_v1.VirtualServer{
+			ObjectMeta: meta_v1.ObjectMeta{
+				Name:      "cafe",
+				Namespace: "default",
+			},
+			Spec: conf_v1.VirtualServerSpec{
+				Upstreams: []conf_v1.Upstream{
+					{
+						Name:    "tea",
+						Service: "tea-svc",
+						CircuitBreaker: &conf_v1.CircuitBreaker{
+							Enable:             true,
+							Consecutive5xx:     createPointerFromInt(3),
+							LatencyThreshold:   "500ms",
+							EjectDuration:      "1m",
+							MaxEjectionPercent: createPointerFromInt(50),
+						},
+					},
+					{
+						Name:    "mocha",
+						Service: "mocha-svc",
+					},
+				},
+			},
+		},
+		VirtualServerRoutes: []*conf_v1.VirtualServerRoute{
+			{
+				ObjectMeta: meta_v1.ObjectMeta{
+					Name:      "coffee",
+					Namespace: "default",
+				},
+				Spec: conf_v1.VirtualServerRouteSpec{
+					Upstreams: []conf_v1.Upstream{
+						{
+							Name:           "coffee",
+							Service:        "coffee-svc",
+							CircuitBreaker: &conf_v1.CircuitBreaker{Enable: true},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expected := map[string]nginx.CircuitBreakerConfig{
+		"vs_default_cafe_tea": {
+			Consecutive5xx:     3,
+			LatencyThreshold:   500 * time.Millisecond,
+			EjectDuration:      time.Minute,
+			MaxEjectionPercent: 50,
+		},
+		"vs_default_cafe_vsr_default_coffee_coffee": {
+			Consecutive5xx:     5,
+			EjectDuration:      30 * time.Second,
+			MaxEjectionPercent: 10,
+		},
+	}
+
+	result := createCircuitBreakerConfigsForPlus(&virtualServerEx)
+	if !cmp.Equal(expected, result) {
+		t.Errorf("createCircuitBreakerConfigsForPlus() mismatch (-want +got):\n%s", cmp.Diff(expected, result))
+	}
+}
+
+func TestGenerateEjectedPeers(t *testing.T) {
+	t.Parallel()
+	configs := map[string]nginx.CircuitBreakerConfig{
+		"vs_default_cafe_tea":    {},
+		"vs_default_cafe_coffee": {},
+	}
+	ejected := map[string]map[string]bool{
+		"vs_default_cafe_tea":    {"10.0.0.2:80": true, "10.0.0.1:80": true},
+		"vs_default_cafe_coffee": {"10.0.0.3:80": true},
+		"vs_default_other_tea":   {"10.0.0.4:80": true},
+	}
+	expected := []conf_v1.EjectedPeer{
+		{Upstream: "vs_default_cafe_coffee", Server: "10.0.0.3:80"},
+		{Upstream: "vs_default_cafe_tea", Server: "10.0.0.1:80"},
+		{Upstream: "vs_default_cafe_tea", Server: "10.0.0.2:80"},
+	}
+
+	result := generateEjectedPeers(configs, ejected)
+	if !cmp.Equal(expected, result) {
+		t.Errorf("generateEjectedPeers() mismatch (-want +got):\n%s", cmp.Diff(expected, result))
+	}
+}
+
+func TestGenerateVSRequestID(t *testing.T) {
+	t.Parallel()
+	variableNamer := NewVSVariableNamer(&conf_v1.VirtualServer{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      "cafe",
+			Namespace: "default",
+		},
+	})
+	enabledCfgParams := RequestID{
+		Enable: true,
+		Header: "X-Request-ID",
+		Mode:   "trust",
+	}
+
+	tests := []struct {
+		requestID   *conf_v1.RequestID
+		cfgParams   RequestID
+		expected    *version2.RequestID
+		expectedMap *version2.Map
+		msg         string
+	}{
+		{
+			requestID: nil,
+			cfgParams: RequestID{Header: "X-Request-ID", Mode: "trust"},
+			expected:  nil,
+			msg:       "disabled in ConfigMap",
+		},
+		{
+			requestID: nil,
+			cfgParams: enabledCfgParams,
+			expected: &version2.RequestID{
+				Header:           "X-Request-ID",
+				IncomingVariable: "$http_x_request_id",
+				Variable:         "$ingress_request_id_incoming",
+			},
+			msg: "enabled in ConfigMap",
+		},
+		{
+			requestID: &conf_v1.RequestID{Enable: false},
+			cfgParams: enabledCfgParams,
+			expected:  nil,
+			msg:       "disabled in VirtualServer",
+		},
+		{
+			requestID: &conf_v1.RequestID{Enable: true, Header: "X-Correlation-ID", Echo: createPointerFromBool(true)},
+			cfgParams: RequestID{Header: "X-Request-ID", Mode: "trust"},
+			expected: &version2.RequestID{
+				Header:           "X-Correlation-ID",
+				IncomingVariable: "$http_x_correlation_id",
+				Variable:         "$vs_default_cafe_request_id",
+				Echo:             true,
+			},
+			expectedMap: &version2.Map{
+				Source:   "$http_x_correlation_id",
+				Variable: "$vs_default_cafe_request_id",
+				Parameters: []version2.Parameter{
+					{
+						Value:  "default",
+						Result: "$http_x_correlation_id",
+					},
+					{
+						Value:  "''",
+						Result: "$request_id",
+					},
+				},
+			},
+			msg: "custom header in VirtualServer",
+		},
+		{
+			requestID: &conf_v1.RequestID{Enable: true, Mode: "generate"},
+			cfgParams: enabledCfgParams,
+			expected: &version2.RequestID{
+				Header:   "X-Request-ID",
+				Variable: "$request_id",
+			},
+			msg: "generate mode in VirtualServer",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			result, resultMap := generateVSRequestID(test.requestID, test.cfgParams, variableNamer)
+			if !cmp.Equal(test.expected, result) {
+				t.Error(cmp.Diff(test.expected, result))
+			}
+			if !cmp.Equal(test.expectedMap, resultMap) {
+				t.Error(cmp.Diff(test.expectedMap, resultMap))
+			}
+		})
+	}
+}
+
+func TestGenerateMaintenance(t *testing.T) {
+	t.Parallel()
+	vs := &conf_v1.VirtualServer{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      "cafe",
+			Namespace: "default",
+		},
+	}
+	variableNamer := NewVSVariableNamer(vs)
+
+	tests := []struct {
+		maintenance *conf_v1.Maintenance
+		isPlus      bool
+		expected    *maintenanceCfg
+		msg         string
+	}{
+		{
+			maintenance: nil,
+			isPlus:      true,
+			expected:    nil,
+			msg:         "no maintenance",
+		},
+		{
+			maintenance: &conf_v1.Maintenance{Enable: false},
+			isPlus:      false,
+			expected:    nil,
+			msg:         "disabled maintenance in NGINX OSS",
+		},
+		{
+			maintenance: &conf_v1.Maintenance{Enable: true, Body: "Down for maintenance", RetryAfter: "120"},
+			isPlus:      false,
+			expected: &maintenanceCfg{
+				Maintenance: &version2.Maintenance{
+					Variable:    "$vs_default_cafe_maintenance_1",
+					Location:    "/internal_location_maintenance_1",
+					Code:        503,
+					DefaultType: "text/html",
+					Body:        "Down for maintenance",
+					RetryAfter:  "120",
+				},
+				Map: version2.Map{
+					Source:   `"1:0"`,
+					Variable: "$vs_default_cafe_maintenance_1",
+					Parameters: []version2.Parameter{
+						{Value: "default", Result: "0"},
+						{Value: `"1:0"`, Result: "1"},
+					},
+				},
+			},
+			msg: "enabled maintenance in NGINX OSS",
+		},
+		{
+			maintenance: &conf_v1.Maintenance{
+				Enable:    false,
+				Code:      502,
+				ConfigMap: &conf_v1.MaintenanceConfigMap{Name: "maintenance-page"},
+				Bypass: &conf_v1.MaintenanceBypass{
+					Allow:       []string{"10.0.0.0/8"},
+					Header:      "X-Maintenance-Bypass",
+					HeaderValue: "s3cr3t",
+				},
+			},
+			isPlus: true,
+			expected: &maintenanceCfg{
+				Maintenance: &version2.Maintenance{
+					Variable:       "$vs_default_cafe_maintenance_1",
+					Location:       "/internal_location_maintenance_1",
+					PageLocation:   "/internal_location_maintenance_1_page",
+					PageFile:       "/etc/nginx/secrets/maintenance_default_cafe_default_maintenance-page_index.html",
+					Code:           502,
+					DefaultType:    "text/html",
+					BypassVariable: "$vs_default_cafe_maintenance_bypass_1",
+					BypassAllow:    []string{"10.0.0.0/8"},
+				},
+				Map: version2.Map{
+					Source:   `"$vs_default_cafe_keyval_maintenance_1:$vs_default_cafe_maintenance_bypass_1:$http_x_maintenance_bypass"`,
+					Variable: "$vs_default_cafe_maintenance_1",
+					Parameters: []version2.Parameter{
+						{Value: "default", Result: "0"},
+						{Value: `"1:0:s3cr3t"`, Result: "0"},
+						{Value: "~^1:0:", Result: "1"},
+					},
+				},
+				KeyVal: &version2.KeyVal{
+					Key:      `"vs_default_cafe_keyval_key_maintenance_route_0"`,
+					Variable: "$vs_default_cafe_keyval_maintenance_1",
+					ZoneName: "vs_default_cafe_keyval_zone_maintenance",
+				},
+			},
+			msg: "disabled maintenance with page and bypass in NGINX Plus",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			vsc := newVirtualServerConfigurator(&ConfigParams{}, test.isPlus, false, &StaticConfigParams{}, false, &fakeBV)
+			vsc.maintenancePageFiles = map[string]string{
+				"default/maintenance-page/index.html": "/etc/nginx/secrets/maintenance_default_cafe_default_maintenance-page_index.html",
+			}
+			result := vsc.generateMaintenance(vs, vs.Namespace, test.maintenance, getMaintenanceIDForRoute(0), 1, variableNamer)
+			if !cmp.Equal(test.expected, result) {
+				t.Error(cmp.Diff(test.expected, result))
+			}
+			if len(vsc.warnings) != 0 {
+				t.Errorf("generateMaintenance() returned unexpected warnings %v", vsc.warnings)
+			}
+		})
+	}
+}
+
+func TestGenerateMaintenanceWarnsOnMissingPage(t *testing.T) {
+	t.Parallel()
+	vs := &conf_v1.VirtualServer{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      "cafe",
+			Namespace: "default",
+		},
+	}
+	maintenance := &conf_v1.Maintenance{Enable: true, ConfigMap: &conf_v1.MaintenanceConfigMap{Name: "maintenance-page"}}
+
+	vsc := newVirtualServerConfigurator(&ConfigParams{}, false, false, &StaticConfigParams{}, false, &fakeBV)
+	result := vsc.generateMaintenance(vs, vs.Namespace, maintenance, maintenanceServerID, 0, NewVSVariableNamer(vs))
+	if result == nil || result.Maintenance.PageFile != "" {
+		t.Errorf("generateMaintenance() returned %v, expected the maintenance without a page", result)
+	}
+	if len(vsc.warnings[vs]) != 1 {
+		t.Errorf("generateMaintenance() returned warnings %v, expected one warning", vsc.warnings)
+	}
+}
+
+func TestGenerateMaintenanceKeyValUpdates(t *testing.T) {
+	t.Parallel()
+	vsEx := &VirtualServerEx{
+		VirtualServer: &conf_v1.VirtualServer{
+			ObjectMeta: meta_v1.ObjectMeta{
+				Name:      "cafe",
+				Namespace: "default",
+			},
+			Spec: conf_v1.VirtualServerSpec{
+				Maintenance: &conf_v1.Maintenance{Enable: false},
+				Routes: []conf_v1.Route{
+					{
+						Path: "/tea",
+					},
+					{
+						Path:        "/coffee",
+						Maintenance: &conf_v1.Maintenance{Enable: true},
+					},
+					{
+						Path:        "/juice",
+						Route:       "juice",
+						Maintenance: &conf_v1.Maintenance{Enable: true},
+					},
+				},
+			},
+		},
+		VirtualServerRoutes: []*conf_v1.VirtualServerRoute{
+			{
+				ObjectMeta: meta_v1.ObjectMeta{
+					Name:      "juice",
+					Namespace: "default",
+				},
+				Spec: conf_v1.VirtualServerRouteSpec{
+					Subroutes: []conf_v1.Route{
+						{
+							Path: "/juice/orange",
+						},
+						{
+							Path:        "/juice/apple",
+							Maintenance: &conf_v1.Maintenance{Enable: false},
+						},
+					},
+				},
+			},
+		},
+	}
+	zone := "vs_default_cafe_keyval_zone_maintenance"
+	expected := []WeightUpdate{
+		{Zone: zone, Key: `"vs_default_cafe_keyval_key_maintenance_server"`, Value: "0"},
+		{Zone: zone, Key: `"vs_default_cafe_keyval_key_maintenance_route_1"`, Value: "1"},
+		{Zone: zone, Key: `"vs_default_cafe_keyval_key_maintenance_vsr_default_juice_subroute_0"`, Value: "1"},
+		{Zone: zone, Key: `"vs_default_cafe_keyval_key_maintenance_vsr_default_juice_subroute_1"`, Value: "0"},
+	}
+
+	result := GenerateMaintenanceKeyValUpdates(vsEx)
+	if !cmp.Equal(expected, result) {
+		t.Error(cmp.Diff(expected, result))
+	}
+}
+
+func TestAddRequestIDToLocations(t *testing.T) {
+	t.Parallel()
+	locations := []version2.Location{
+		{
+			Path: "/tea",
+		},
+		{
+			Path: "/coffee",
+			ProxySetHeaders: []version2.Header{
+				{
+					Name:  "x-request-id",
+					Value: "${request_id}-coffee",
+				},
+			},
+		},
+	}
+	requestID := &version2.RequestID{
+		Header:   "X-Request-ID",
+		Variable: "$request_id",
+		Echo:     true,
+	}
+	echoHeaders := []version2.AddHeader{
+		{
+			Header: version2.Header{
+				Name:  "X-Request-ID",
+				Value: "$ingress_request_id",
+			},
+			Always: true,
+		},
+	}
+	expected := []version2.Location{
+		{
+			Path: "/tea",
+			ProxySetHeaders: []version2.Header{
+				{
+					Name:  "X-Request-ID",
+					Value: "$ingress_request_id",
+				},
+			},
+			AddHeaders: echoHeaders,
+		},
+		{
+			Path: "/coffee",
+			ProxySetHeaders: []version2.Header{
+				{
+					Name:  "x-request-id",
+					Value: "${request_id}-coffee",
+				},
+			},
+			AddHeaders: echoHeaders,
+		},
+	}
+
+	addRequestIDToLocations(requestID, locations)
+	if !cmp.Equal(expected, locations) {
+		t.Error(cmp.Diff(expected, locations))
+	}
+}
+
 func TestGeneratePath(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -3181,6 +3986,60 @@ func TestGenerateProxySetHeaders(t *testing.T) {
 			},
 			msg: "set headers with host in mixed case",
 		},
+		{
+			proxy: &conf_v1.ActionProxy{
+				ResponseBody: &conf_v1.ProxyResponseBody{
+					Rewrite: []conf_v1.ResponseBodyRewrite{
+						{
+							Match:       "backend.internal",
+							Replacement: "cafe.example.com",
+						},
+					},
+				},
+			},
+			expected: []version2.Header{
+				{
+					Name:  "Host",
+					Value: "$host",
+				},
+				{
+					Name:  "Accept-Encoding",
+					Value: "",
+				},
+			},
+			msg: "response body rewrite",
+		},
+		{
+			proxy: &conf_v1.ActionProxy{
+				RequestHeaders: &conf_v1.ProxyRequestHeaders{
+					Set: []conf_v1.Header{
+						{
+							Name:  "Accept-Encoding",
+							Value: "identity",
+						},
+					},
+				},
+				ResponseBody: &conf_v1.ProxyResponseBody{
+					Rewrite: []conf_v1.ResponseBodyRewrite{
+						{
+							Match:       "backend.internal",
+							Replacement: "cafe.example.com",
+						},
+					},
+				},
+			},
+			expected: []version2.Header{
+				{
+					Name:  "Accept-Encoding",
+					Value: "identity",
+				},
+				{
+					Name:  "Host",
+					Value: "$host",
+				},
+			},
+			msg: "response body rewrite with accept-encoding set",
+		},
 		{
 			proxy: &conf_v1.ActionProxy{
 				RequestHeaders: &conf_v1.ProxyRequestHeaders{
@@ -3376,6 +4235,68 @@ func TestGenerateProxyIgnoreHeaders(t *testing.T) {
 	}
 }
 
+func TestGenerateResponseBodyRewrite(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		proxy    *conf_v1.ActionProxy
+		expected *version2.ResponseBodyRewrite
+		msg      string
+	}{
+		{
+			proxy:    nil,
+			expected: nil,
+			msg:      "no action proxy",
+		},
+		{
+			proxy: &conf_v1.ActionProxy{
+				ResponseBody: &conf_v1.ProxyResponseBody{
+					Types: []string{"text/css"},
+				},
+			},
+			expected: nil,
+			msg:      "no rewrites",
+		},
+		{
+			proxy: &conf_v1.ActionProxy{
+				ResponseBody: &conf_v1.ProxyResponseBody{
+					Rewrite: []conf_v1.ResponseBodyRewrite{
+						{
+							Match:       "http://backend.internal",
+							Replacement: "https://$host",
+						},
+						{
+							Match: "backend.internal",
+						},
+					},
+					Types: []string{"text/css", "application/javascript"},
+					Once:  true,
+				},
+			},
+			expected: &version2.ResponseBodyRewrite{
+				SubFilters: []version2.SubFilter{
+					{
+						Match:       "http://backend.internal",
+						Replacement: "https://$host",
+					},
+					{
+						Match: "backend.internal",
+					},
+				},
+				Types: "text/css application/javascript",
+				Once:  true,
+			},
+			msg: "rewrites with types",
+		},
+	}
+
+	for _, test := range tests {
+		result := generateResponseBodyRewrite(test.proxy)
+		if !cmp.Equal(test.expected, result) {
+			t.Errorf("generateResponseBodyRewrite() mismatch for the case of %v (-want +got):\n%s", test.msg, cmp.Diff(test.expected, result))
+		}
+	}
+}
+
 func TestGenerateProxyAddHeaders(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -292,6 +292,94 @@ func TestGenerateVirtualServerConfigJWKSPolicy(t *testing.T) {
 	}
 }
 
+func TestGenerateVirtualServerConfigWarnsAboutJWTClaimAffinityWithoutJWTPolicy(t *testing.T) {
+	t.Parallel()
+
+	jwtPolicyRefs := []conf_v1.PolicyReference{{Name: "jwt-policy"}}
+	tests := []struct {
+		vsPolicies    []conf_v1.PolicyReference
+		routePolicies []conf_v1.PolicyReference
+		expected      []string
+		msg           string
+	}{
+		{
+			expected: []string{
+				"The affinity of upstream tea uses the JWT claim sub, but no JWT policy is applied to the VirtualServer or its routes, so all requests are passed to the same upstream server",
+			},
+			msg: "no JWT policy",
+		},
+		{
+			vsPolicies: jwtPolicyRefs,
+			expected:   nil,
+			msg:        "JWT policy of the VirtualServer",
+		},
+		{
+			routePolicies: jwtPolicyRefs,
+			expected:      nil,
+			msg:           "JWT policy of the route",
+		},
+	}
+
+	for _, test := range tests {
+		virtualServerEx := VirtualServerEx{
+			VirtualServer: &conf_v1.VirtualServer{
+				ObjectMeta: meta_v1.ObjectMeta{
+					Name:      "cafe",
+					Namespace: "default",
+				},
+				Spec: conf_v1.VirtualServerSpec{
+					Host:     "cafe.example.com",
+					Policies: test.vsPolicies,
+					Upstreams: []conf_v1.Upstream{
+						{
+							Name:     "tea",
+							Service:  "tea-svc",
+							Port:     80,
+							Affinity: &conf_v1.UpstreamAffinity{JWTClaim: "sub"},
+						},
+					},
+					Routes: []conf_v1.Route{
+						{
+							Path:     "/tea",
+							Policies: test.routePolicies,
+							Action: &conf_v1.Action{
+								Pass: "tea",
+							},
+						},
+					},
+				},
+			},
+			Policies: map[string]*conf_v1.Policy{
+				"default/jwt-policy": {
+					ObjectMeta: meta_v1.ObjectMeta{
+						Name:      "jwt-policy",
+						Namespace: "default",
+					},
+					Spec: conf_v1.PolicySpec{
+						JWTAuth: &conf_v1.JWTAuth{
+							Realm:    "Cafe API",
+							JwksURI:  "https://idp.example.com:443/keys",
+							KeyCache: "1h",
+						},
+					},
+				},
+			},
+			Endpoints: map[string][]string{
+				"default/tea-svc:80": {
+					"10.0.0.20:80",
+				},
+			},
+		}
+
+		vsc := newVirtualServerConfigurator(&baseCfgParams, true, false, &StaticConfigParams{}, false, &fakeBV)
+		_, warnings := vsc.GenerateVirtualServerConfig(&virtualServerEx, nil, nil)
+
+		if diff := cmp.Diff(test.expected, warnings[virtualServerEx.VirtualServer]); diff != "" {
+			t.Errorf("GenerateVirtualServerConfig() returned unexpected warnings for the case of %s (-want +got):\n%s", test.msg, diff)
+		}
+	}
+}
+
 func TestGenerateVirtualServerConfigJWTSSLVerifyDepth(t *testing.T) {
 	t.Parallel()
 
@@ -4406,6 +4406,179 @@ func TestGenerateMatchesConfigWithMultipleSplits(t *testing.T) {
 	}
 }
 
+func TestGenerateMatchesConfigWithConditionOperators(t *testing.T) {
+	t.Parallel()
+	route := conf_v1.Route{
+		Path: "/",
+		Matches: []conf_v1.Match{
+			{
+				Conditions: []conf_v1.Condition{
+					{
+						Method: "POST",
+					},
+					{
+						Header:   "x-version",
+						Operator: "present",
+					},
+					{
+						Argument: "debug",
+						Operator: "absent",
+					},
+					{
+						Header:   "user-agent",
+						Value:    "!^curl/",
+						Operator: "regex",
+					},
+					{
+						AnyOf: []conf_v1.ConditionAlternative{
+							{
+								Cookie: "user",
+								Value:  "john",
+							},
+							{
+								Argument: "user",
+								Value:    "^j",
+								Operator: "regex",
+							},
+						},
+					},
+				},
+				Action: &conf_v1.Action{
+					Pass: "coffee",
+				},
+			},
+		},
+		Action: &conf_v1.Action{
+			Pass: "tea",
+		},
+	}
+	virtualServer := conf_v1.VirtualServer{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      "cafe",
+			Namespace: "default",
+		},
+	}
+
+	expected := []version2.Map{
+		{
+			Source:   "$request_method",
+			Variable: "$vs_default_cafe_matches_0_match_0_cond_0",
+			Parameters: []version2.Parameter{
+				{Value: `"POST"`, Result: "$vs_default_cafe_matches_0_match_0_cond_1"},
+				{Value: "default", Result: "0"},
+			},
+		},
+		{
+			Source:   "$http_x_version",
+			Variable: "$vs_default_cafe_matches_0_match_0_cond_1",
+			Parameters: []version2.Parameter{
+				{Value: `""`, Result: "0"},
+				{Value: "default", Result: "$vs_default_cafe_matches_0_match_0_cond_2"},
+			},
+		},
+		{
+			Source:   "$arg_debug",
+			Variable: "$vs_default_cafe_matches_0_match_0_cond_2",
+			Parameters: []version2.Parameter{
+				{Value: `""`, Result: "$vs_default_cafe_matches_0_match_0_cond_3"},
+				{Value: "default", Result: "0"},
+			},
+		},
+		{
+			Source:   "$http_user_agent",
+			Variable: "$vs_default_cafe_matches_0_match_0_cond_3",
+			Parameters: []version2.Parameter{
+				{Value: `"~^curl/"`, Result: "0"},
+				{Value: "default", Result: "$vs_default_cafe_matches_0_match_0_cond_4"},
+			},
+		},
+		{
+			Source:   "$cookie_user",
+			Variable: "$vs_default_cafe_matches_0_match_0_cond_4",
+			Parameters: []version2.Parameter{
+				{Value: `"john"`, Result: "1"},
+				{Value: "default", Result: "$vs_default_cafe_matches_0_match_0_cond_4_any_1"},
+			},
+		},
+		{
+			Source:   "$arg_user",
+			Variable: "$vs_default_cafe_matches_0_match_0_cond_4_any_1",
+			Parameters: []version2.Parameter{
+				{Value: `"~^j"`, Result: "1"},
+				{Value: "default", Result: "0"},
+			},
+		},
+		{
+			Source:   "$vs_default_cafe_matches_0_match_0_cond_0",
+			Variable: "$vs_default_cafe_matches_0",
+			Parameters: []version2.Parameter{
+				{Value: "~^1", Result: "/internal_location_matches_0_match_0"},
+				{Value: "default", Result: "/internal_location_matches_0_default"},
+			},
+		},
+	}
+
+	cfgParams := ConfigParams{Context: context.Background()}
+	crUpstreams := map[string]conf_v1.Upstream{
+		"vs_default_cafe_coffee": {Service: "coffee"},
+		"vs_default_cafe_tea":    {Service: "tea"},
+	}
+
+	result := generateMatchesConfig(
+		route,
+		NewUpstreamNamerForVirtualServer(&virtualServer),
+		crUpstreams,
+		NewVSVariableNamer(&virtualServer),
+		0,
+		0,
+		&cfgParams,
+		errorPageDetails{},
+		"",
+		false,
+		0,
+		false,
+		"",
+		"",
+		Warnings{},
+		false,
+	)
+	if diff := cmp.Diff(expected, result.Maps); diff != "" {
+		t.Errorf("generateMatchesConfig() returned unexpected maps (-want +got):\n%s", diff)
+	}
+}
+
+func TestAddHeadersCfgToLocation(t *testing.T) {
+	t.Parallel()
+
+	cfg := headersCfg{
+		Set:  []version2.Header{{Name: "X-Forwarded-Prefix", Value: "/api"}, {Name: "X-Version", Value: "v1"}},
+		Hide: []string{"Server", "X-Debug"},
+		Pass: []string{"X-Accel-Date"},
+		Add:  []version2.AddHeader{{Header: version2.Header{Name: "X-Frame-Options", Value: "DENY"}, Always: true}},
+	}
+	location := version2.Location{
+		ProxySetHeaders:  []version2.Header{{Name: "x-version", Value: "v2"}, {Name: "Host", Value: "$host"}},
+		ProxyPassHeaders: []string{"X-Debug"},
+		AddHeaders:       []version2.AddHeader{{Header: version2.Header{Name: "X-Frame-Options", Value: "SAMEORIGIN"}}},
+	}
+
+	expected := version2.Location{
+		ProxySetHeaders: []version2.Header{
+			{Name: "x-version", Value: "v2"},
+			{Name: "Host", Value: "$host"},
+			{Name: "X-Forwarded-Prefix", Value: "/api"},
+		},
+		ProxyHideHeaders: []string{"Server"},
+		ProxyPassHeaders: []string{"X-Debug", "X-Accel-Date"},
+		AddHeaders:       []version2.AddHeader{{Header: version2.Header{Name: "X-Frame-Options", Value: "SAMEORIGIN"}}},
+	}
+
+	addHeadersCfgToLocation(cfg, &location)
+	if diff := cmp.Diff(expected, location); diff != "" {
+		t.Errorf("addHeadersCfgToLocation() mismatch (-want +got):\n%s", diff)
+	}
+}
+
 func TestGenerateValueForMatchesRouteMap(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -4509,7 +4682,7 @@ func TestGenerateParametersForMatchesRouteMap(t *testing.T) {
 	}
 
 	for _, test := range tests {
-		result := generateParametersForMatchesRouteMap(test.inputMatchedValue, test.inputSuccessfulResult)
+		result := generateParametersForMatchesRouteMap(test.inputMatchedValue, test.inputSuccessfulResult, "0")
 		if !reflect.DeepEqual(result, test.expected) {
 			t.Errorf("generateParametersForMatchesRouteMap(%q, %q) returned %v but expected %v", test.inputMatchedValue, test.inputSuccessfulResult, result, test.expected)
 		}
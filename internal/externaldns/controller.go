@@ -56,6 +56,7 @@ type ExtDNSOpts struct {
 	client        k8s_nginx.Interface
 	resyncPeriod  time.Duration
 	isDynamicNs   bool
+	serverAliases ServerAliasesFn
 }
 
 // NewController takes external dns config and return a new External DNS Controller.
@@ -83,7 +84,7 @@ func NewController(opts *ExtDNSOpts) *ExtDNSController {
 		c.newNamespacedInformer(ns)
 	}
 
-	c.sync = SyncFnFor(c.recorder, c.client, c.informerGroup)
+	c.sync = SyncFnFor(c.recorder, c.client, c.informerGroup, opts.serverAliases)
 	return c
 }
 
@@ -227,7 +228,7 @@ func externalDNSHandler(queue workqueue.TypedRateLimitingInterface[types.Namespa
 }
 
 // BuildOpts builds the externalDNS controller options
-func BuildOpts(ctx context.Context, ns []string, rdr record.EventRecorder, client k8s_nginx.Interface, resync time.Duration, idn bool) *ExtDNSOpts {
+func BuildOpts(ctx context.Context, ns []string, rdr record.EventRecorder, client k8s_nginx.Interface, resync time.Duration, idn bool, sa ServerAliasesFn) *ExtDNSOpts {
 	return &ExtDNSOpts{
 		context:       ctx,
 		namespace:     ns,
@@ -235,9 +236,15 @@ func BuildOpts(ctx context.Context, ns []string, rdr record.EventRecorder, clien
 		client:        client,
 		resyncPeriod:  resync,
 		isDynamicNs:   idn,
+		serverAliases: sa,
 	}
 }
 
+// EnqueueVirtualServer adds the VirtualServer to the queue, so that its DNSEndpoint is synced again.
+func (c *ExtDNSController) EnqueueVirtualServer(namespace string, name string) {
+	c.queue.Add(types.NamespacedName{Namespace: namespace, Name: name})
+}
+
 func getNamespacedInformer(ns string, ig map[string]*namespacedInformer) *namespacedInformer {
 	var nsi *namespacedInformer
 	var isGlobalNs bool
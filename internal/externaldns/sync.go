@@ -33,6 +33,9 @@ var vsGVK = vsapi.SchemeGroupVersion.WithKind("VirtualServer")
 // SyncFn is the reconciliation function passed to externaldns controller.
 type SyncFn func(context.Context, *vsapi.VirtualServer) error
 
+// ServerAliasesFn returns the server aliases of the VirtualServer that are not taken by other resources.
+type ServerAliasesFn func(*vsapi.VirtualServer) []string
+
 // DNSTarget describes a single DNS target: address and record type.
 type DNSTarget struct {
 	Type    string
@@ -40,7 +43,7 @@ type DNSTarget struct {
 }
 
 // SyncFnFor knows how to reconcile VirtualServer DNSEndpoint object.
-func SyncFnFor(rec record.EventRecorder, client clientset.Interface, ig map[string]*namespacedInformer) SyncFn {
+func SyncFnFor(rec record.EventRecorder, client clientset.Interface, ig map[string]*namespacedInformer, serverAliases ServerAliasesFn) SyncFn {
 	return func(ctx context.Context, vs *vsapi.VirtualServer) error {
 		// Do nothing if ExternalDNS is not present (nil) in VS or is not enabled.
 		if !vs.Spec.ExternalDNS.Enable {
@@ -63,7 +66,12 @@ func SyncFnFor(rec record.EventRecorder, client clientset.Interface, ig map[stri
 
 		nsi := getNamespacedInformer(vs.Namespace, ig)
 
-		newDNSEndpoint, updateDNSEndpoint, err := buildDNSEndpoint(ctx, nsi.extdnslister, vs, targets)
+		var aliases []string
+		if serverAliases != nil {
+			aliases = serverAliases(vs)
+		}
+
+		newDNSEndpoint, updateDNSEndpoint, err := buildDNSEndpoint(ctx, nsi.extdnslister, vs, targets, aliases)
 		if err != nil {
 			nl.Errorf(l, "incorrect DNSEndpoint config for VirtualServer resource: %s", err)
 			rec.Eventf(vs, corev1.EventTypeWarning, nl.EventReasonBadConfig, "Incorrect DNSEndpoint config for VirtualServer resource: %s", err)
@@ -148,7 +156,7 @@ func getValidTargets(ctx context.Context, endpoints []vsapi.ExternalEndpoint) ([
 	return targets, nil
 }
 
-func buildDNSEndpoint(ctx context.Context, extdnsLister extdnslisters.DNSEndpointLister, vs *vsapi.VirtualServer, targets []DNSTarget) (*extdnsapi.DNSEndpoint, *extdnsapi.DNSEndpoint, error) {
+func buildDNSEndpoint(ctx context.Context, extdnsLister extdnslisters.DNSEndpointLister, vs *vsapi.VirtualServer, targets []DNSTarget, aliases []string) (*extdnsapi.DNSEndpoint, *extdnsapi.DNSEndpoint, error) {
 	var updateDNSEndpoint *extdnsapi.DNSEndpoint
 	var newDNSEndpoint *extdnsapi.DNSEndpoint
 	var existingDNSEndpoint *extdnsapi.DNSEndpoint
@@ -175,22 +183,24 @@ func buildDNSEndpoint(ctx context.Context, extdnsLister extdnslisters.DNSEndpoin
 	}
 
 	endpoints := make([]*extdnsapi.Endpoint, 0)
-	for recordType, filteredTargets := range collatedTargets {
-		listOfTargets := make([]string, len(filteredTargets))
+	for _, host := range append([]string{vs.Spec.Host}, aliases...) {
+		for recordType, filteredTargets := range collatedTargets {
+			listOfTargets := make([]string, len(filteredTargets))
 
-		for i, t := range filteredTargets {
-			listOfTargets[i] = t.Address
-		}
+			for i, t := range filteredTargets {
+				listOfTargets[i] = t.Address
+			}
 
-		endpoint := &extdnsapi.Endpoint{
-			DNSName:          vs.Spec.Host,
-			Targets:          listOfTargets,
-			RecordType:       buildRecordType(vs.Spec.ExternalDNS, recordType),
-			RecordTTL:        recordTTL,
-			Labels:           labels,
-			ProviderSpecific: providerSpecific,
+			endpoint := &extdnsapi.Endpoint{
+				DNSName:          host,
+				Targets:          listOfTargets,
+				RecordType:       buildRecordType(vs.Spec.ExternalDNS, recordType),
+				RecordTTL:        recordTTL,
+				Labels:           labels,
+				ProviderSpecific: providerSpecific,
+			}
+			endpoints = append(endpoints, endpoint)
 		}
-		endpoints = append(endpoints, endpoint)
 	}
 
 	dnsEndpoint := &extdnsapi.DNSEndpoint{
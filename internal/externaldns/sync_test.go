@@ -12,6 +12,7 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
 )
 
 // EventRecorder implements EventRecorder interface.
@@ -161,7 +162,7 @@ func TestSync_NotRunningOnExternalDNSDisabled(t *testing.T) {
 			},
 		},
 	}
-	fn := SyncFnFor(nil, nil, nil)
+	fn := SyncFnFor(nil, nil, nil, nil)
 	err := fn(context.TODO(), vs)
 	if err != nil {
 		t.Errorf("want nil got %v", err)
@@ -180,7 +181,7 @@ func TestSync_ReturnsErrorOnNilExternalEndpoints(t *testing.T) {
 	}
 
 	rec := EventRecorder{}
-	fn := SyncFnFor(rec, nil, nil)
+	fn := SyncFnFor(rec, nil, nil, nil)
 	err := fn(context.TODO(), vs)
 	if err == nil {
 		t.Errorf("want error got nil")
@@ -268,7 +269,7 @@ func TestSync_ReturnsErrorOnInvalidTargetsInExternalEndpoints(t *testing.T) {
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
 			rec := EventRecorder{}
-			fn := SyncFnFor(rec, nil, nil)
+			fn := SyncFnFor(rec, nil, nil, nil)
 			err := fn(context.TODO(), tc.input)
 			if err == nil {
 				t.Error("want error, got nil")
@@ -340,7 +341,7 @@ func TestSync_ReturnsErrorOnFailure(t *testing.T) {
 			ig := make(map[string]*namespacedInformer)
 			nsi := namespacedInformer{extdnslister: DNSEPLister{}}
 			ig[""] = &nsi
-			fn := SyncFnFor(rec, nil, ig)
+			fn := SyncFnFor(rec, nil, ig, nil)
 			err := fn(context.TODO(), tc.input)
 			if err == nil {
 				t.Error("want error, got nil")
@@ -348,3 +349,34 @@ func TestSync_ReturnsErrorOnFailure(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildDNSEndpoint_UsesHeldServerAliases(t *testing.T) {
+	t.Parallel()
+
+	vs := &vsapi.VirtualServer{
+		ObjectMeta: v1.ObjectMeta{Name: "cafe", Namespace: "default"},
+		Spec: vsapi.VirtualServerSpec{
+			Host:          "cafe.example.com",
+			ServerAliases: []string{"www.cafe.example.com", "menu.cafe.example.com"},
+			ExternalDNS: vsapi.ExternalDNS{
+				Enable: true,
+			},
+		},
+	}
+	targets := []DNSTarget{{Address: "10.0.0.1", Type: recordTypeA}}
+	lister := extdnsclient.NewDNSEndpointLister(cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{}))
+
+	newDNSEndpoint, _, err := buildDNSEndpoint(context.Background(), lister, vs, targets, []string{"www.cafe.example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var hosts []string
+	for _, endpoint := range newDNSEndpoint.Spec.Endpoints {
+		hosts = append(hosts, endpoint.DNSName)
+	}
+	wantHosts := []string{"cafe.example.com", "www.cafe.example.com"}
+	if !cmp.Equal(wantHosts, hosts) {
+		t.Error(cmp.Diff(wantHosts, hosts))
+	}
+}
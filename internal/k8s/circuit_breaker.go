@@ -0,0 +1,31 @@
+package k8s
+
+import (
+	nl "github.com/nginx/kubernetes-ingress/internal/logger"
+)
+
+// syncCircuitBreakers ejects the failing upstream servers of the VirtualServers with the circuit breaker enabled
+// and reports the ejected servers in the status of the VirtualServers.
+func (lbc *LoadBalancerController) syncCircuitBreakers() {
+	if !lbc.isNginxReady {
+		return
+	}
+
+	ejectedPeers, err := lbc.configurator.UpdateCircuitBreakers()
+	if err != nil {
+		nl.Errorf(lbc.Logger, "Error updating circuit breakers: %v", err)
+		return
+	}
+
+	if !lbc.reportStatusEnabled() {
+		return
+	}
+
+	for _, vsEjectedPeers := range ejectedPeers {
+		err := lbc.statusUpdater.UpdateVirtualServerEjectedPeers(vsEjectedPeers.VirtualServer, vsEjectedPeers.EjectedPeers)
+		if err != nil {
+			nl.Errorf(lbc.Logger, "Error updating the ejected peers of VirtualServer %v/%v: %v",
+				vsEjectedPeers.VirtualServer.Namespace, vsEjectedPeers.VirtualServer.Name, err)
+		}
+	}
+}
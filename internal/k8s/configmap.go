@@ -87,6 +87,16 @@ func (lbc *LoadBalancerController) syncConfigMap(task task) {
 		return
 	}
 
+	if key == lbc.hostOwnershipConfigMapName {
+		lbc.syncHostOwnershipConfigMap(task)
+		return
+	}
+
+	if key != lbc.nginxConfigMapName && key != lbc.mgmtConfigMapName {
+		lbc.syncMaintenanceConfigMap(task)
+		return
+	}
+
 	switch key {
 	case lbc.nginxConfigMapName:
 		obj, configExists, err := lbc.configMapLister.GetByKey(key)
@@ -121,7 +131,7 @@ func (lbc *LoadBalancerController) syncConfigMap(task task) {
 		return
 	}
 
-	if lbc.batchSyncEnabled {
+	if lbc.isBatchSyncEnabled() {
 		nl.Debugf(lbc.Logger, "Skipping ConfigMap update because batch sync is on")
 		return
 	}
@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"maps"
 	"reflect"
+	"slices"
 	"sort"
+	"strings"
 	"sync"
 
 	"github.com/nginx/kubernetes-ingress/internal/configs"
@@ -18,6 +20,7 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	gateway_v1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
 const (
@@ -50,7 +53,20 @@ type Resource interface {
 	IsEqual(resource Resource) bool
 }
 
+// chooseObjectMetaWinner checks if the first resource wins a host conflict against the second one.
+// A standby resource loses to any other resource, then the resource with the higher host priority wins.
+// If neither annotation decides the conflict, the oldest resource wins.
 func chooseObjectMetaWinner(meta1 *metav1.ObjectMeta, meta2 *metav1.ObjectMeta) bool {
+	standby1, standby2 := isHostStandby(meta1), isHostStandby(meta2)
+	if standby1 != standby2 {
+		return standby2
+	}
+
+	priority1, priority2 := getHostPriority(meta1), getHostPriority(meta2)
+	if priority1 != priority2 {
+		return priority1 > priority2
+	}
+
 	if meta1.CreationTimestamp.Equal(&meta2.CreationTimestamp) {
 		return meta1.UID > meta2.UID
 	}
@@ -58,6 +74,33 @@ func chooseObjectMetaWinner(meta1 *metav1.ObjectMeta, meta2 *metav1.ObjectMeta)
 	return meta1.CreationTimestamp.Before(&meta2.CreationTimestamp)
 }
 
+// getHostPriority returns the host priority of the resource. Resources without a valid priority have the priority 0.
+func getHostPriority(meta *metav1.ObjectMeta) int {
+	priority, err := configs.ParseInt(meta.Annotations[hostPriorityAnnotation])
+	if err != nil {
+		return 0
+	}
+	return priority
+}
+
+// isHostStandby checks if the resource is a standby for its hosts.
+func isHostStandby(meta *metav1.ObjectMeta) bool {
+	standby, err := configs.ParseBool(meta.Annotations[hostStandbyAnnotation])
+	return err == nil && standby
+}
+
+// isHostConflictOverridden checks if a host conflict between the resources is decided
+// by the host priority or standby annotations rather than by the creation timestamps.
+func isHostConflictOverridden(meta1 *metav1.ObjectMeta, meta2 *metav1.ObjectMeta) bool {
+	return isHostStandby(meta1) != isHostStandby(meta2) || getHostPriority(meta1) != getHostPriority(meta2)
+}
+
+// describeResource returns the kind and the key of the resource, for example "VirtualServer default/cafe".
+func describeResource(r Resource) string {
+	kind, key, _ := strings.Cut(r.GetKeyWithKind(), "/")
+	return fmt.Sprintf("%s %s", kind, key)
+}
+
 // ResourceChange represents a change of the resource that needs to be reflected in the NGINX config.
 type ResourceChange struct {
 	// Op is an operation that needs be performed on the resource.
@@ -103,6 +146,8 @@ type IngressConfiguration struct {
 	Warnings []string
 	// ChildWarnings includes the warnings of the minions. The key is the namespace/name.
 	ChildWarnings map[string][]string
+	// Canaries contains the canary Ingresses that share a host with a regular Ingress.
+	Canaries []*networking.Ingress
 }
 
 type listenerHostKey struct {
@@ -186,6 +231,16 @@ func (ic *IngressConfiguration) IsEqual(resource Resource) bool {
 		}
 	}
 
+	if len(ic.Canaries) != len(ingConfig.Canaries) {
+		return false
+	}
+
+	for i := range ic.Canaries {
+		if !compareObjectMetasWithAnnotations(&ic.Canaries[i].ObjectMeta, &ingConfig.Canaries[i].ObjectMeta) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -219,6 +274,12 @@ type VirtualServerConfiguration struct {
 	HTTPIPv6                    string
 	HTTPSIPv4                   string
 	HTTPSIPv6                   string
+	// HTTPListener and HTTPSListener are the GlobalConfiguration listeners bound by the VirtualServer.
+	// The TLS Secret of a listener is qualified with the namespace of the GlobalConfiguration.
+	HTTPListener  *conf_v1.Listener
+	HTTPSListener *conf_v1.Listener
+	// ServerAliases are the server aliases of the VirtualServer that are not taken by other resources.
+	ServerAliases []string
 }
 
 // NewVirtualServerConfiguration creates a VirtualServerConfiguration.
@@ -274,6 +335,10 @@ func (vsc *VirtualServerConfiguration) IsEqual(resource Resource) bool {
 		}
 	}
 
+	if !slices.Equal(vsc.ServerAliases, vsConfig.ServerAliases) {
+		return false
+	}
+
 	// Check VirtualServerRouteSelectors maps for equality
 	if len(vsc.VirtualServerRouteSelectors) != len(vsConfig.VirtualServerRouteSelectors) {
 		return false
@@ -307,11 +372,13 @@ func (vsc *VirtualServerConfiguration) IsEqual(resource Resource) bool {
 
 // TransportServerConfiguration holds a TransportServer resource.
 type TransportServerConfiguration struct {
-	ListenerPort    int
-	IPv4            string
-	IPv6            string
-	TransportServer *conf_v1.TransportServer
-	Warnings        []string
+	ListenerPort        int
+	IPv4                string
+	IPv6                string
+	AcceptProxyProtocol bool
+	TrustedCIDRs        []string
+	TransportServer     *conf_v1.TransportServer
+	Warnings            []string
 }
 
 // NewTransportServerConfiguration creates a new TransportServerConfiguration.
@@ -350,7 +417,10 @@ func (tsc *TransportServerConfiguration) IsEqual(resource Resource) bool {
 		return false
 	}
 
-	return compareObjectMetas(tsc.GetObjectMeta(), resource.GetObjectMeta()) && tsc.ListenerPort == tsConfig.ListenerPort
+	return compareObjectMetas(tsc.GetObjectMeta(), resource.GetObjectMeta()) &&
+		tsc.ListenerPort == tsConfig.ListenerPort &&
+		tsc.AcceptProxyProtocol == tsConfig.AcceptProxyProtocol &&
+		slices.Equal(tsc.TrustedCIDRs, tsConfig.TrustedCIDRs)
 }
 
 func compareObjectMetas(meta1 *metav1.ObjectMeta, meta2 *metav1.ObjectMeta) bool {
@@ -400,6 +470,7 @@ type Configuration struct {
 	serviceReferenceChecker    *serviceReferenceChecker
 	endpointReferenceChecker   *serviceReferenceChecker
 	policyReferenceChecker     *policyReferenceChecker
+	configMapReferenceChecker  *maintenanceConfigMapReferenceChecker
 	appPolicyReferenceChecker  *appProtectResourceReferenceChecker
 	appLogConfReferenceChecker *appProtectResourceReferenceChecker
 	appDosProtectedChecker     *dosResourceReferenceChecker
@@ -414,6 +485,16 @@ type Configuration struct {
 	isIPV6Disabled               bool
 	isDirectiveAutoadjustEnabled bool
 
+	hostOwnershipRules hostOwnershipRules
+
+	// standbyVirtualServers holds the standby VirtualServers that do not hold their host.
+	// They are validated and can be pre-rendered, so that they can take over their host in a single reload.
+	standbyVirtualServers map[string]standbyVirtualServer
+
+	// gatewayAPITranslation holds the VirtualServers and TransportServers translated from the Gateway API resources.
+	// They are also stored in virtualServers and transportServers.
+	gatewayAPITranslation *gatewayAPITranslation
+
 	lock sync.RWMutex
 }
 
@@ -449,6 +530,7 @@ func NewConfiguration(
 		serviceReferenceChecker:      newServiceReferenceChecker(false),
 		endpointReferenceChecker:     newServiceReferenceChecker(true),
 		policyReferenceChecker:       newPolicyReferenceChecker(),
+		configMapReferenceChecker:    newMaintenanceConfigMapReferenceChecker(),
 		appPolicyReferenceChecker:    newAppProtectResourceReferenceChecker(configs.AppProtectPolicyAnnotation),
 		appLogConfReferenceChecker:   newAppProtectResourceReferenceChecker(configs.AppProtectLogConfAnnotation),
 		appDosProtectedChecker:       newDosResourceReferenceChecker(configs.AppProtectDosProtectedAnnotation),
@@ -542,6 +624,9 @@ func (c *Configuration) AddOrUpdateVirtualServer(vs *conf_v1.VirtualServer) ([]R
 		delete(c.virtualServers, key)
 	} else {
 		validationError = c.virtualServerValidator.ValidateVirtualServer(vs)
+		if validationError == nil {
+			validationError = validateHostAnnotations(vs.Annotations)
+		}
 		if validationError != nil {
 			delete(c.virtualServers, key)
 		} else {
@@ -682,6 +767,116 @@ func (c *Configuration) AddOrUpdateGlobalConfiguration(gc *conf_v1.GlobalConfigu
 	return changes, problems, validationErr
 }
 
+// SetHostOwnershipRules sets the rules that restrict the namespaces allowed to claim hosts.
+// If the rules are nil, any namespace can claim any host.
+func (c *Configuration) SetHostOwnershipRules(rules hostOwnershipRules) ([]ResourceChange, []ConfigurationProblem) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.hostOwnershipRules = rules
+
+	return c.rebuildHosts()
+}
+
+// SetGatewayAPIResources translates the Gateway API resources into VirtualServers and TransportServers
+// and replaces the resources translated previously.
+func (c *Configuration) SetGatewayAPIResources(translator *gatewayAPITranslator, resources gatewayAPIResources) ([]ResourceChange, []ConfigurationProblem) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.gatewayAPITranslation != nil {
+		for key := range c.gatewayAPITranslation.virtualServers {
+			delete(c.virtualServers, key)
+		}
+		for key := range c.gatewayAPITranslation.transportServers {
+			delete(c.transportServers, key)
+		}
+	}
+
+	translation := translator.translate(resources, c.listenerMap, c.isTLSPassthroughEnabled)
+
+	for key, vs := range translation.virtualServers {
+		if err := c.virtualServerValidator.ValidateVirtualServer(vs); err != nil {
+			translation.rejectRoute(&vs.ObjectMeta, gateway_v1.RouteReasonUnsupportedValue, err.Error())
+			delete(translation.virtualServers, key)
+			continue
+		}
+		c.virtualServers[key] = vs
+	}
+
+	for key, ts := range translation.transportServers {
+		if err := c.transportServerValidator.ValidateTransportServer(ts); err != nil {
+			translation.rejectRoute(&ts.ObjectMeta, gateway_v1.RouteReasonUnsupportedValue, err.Error())
+			delete(translation.transportServers, key)
+			continue
+		}
+		c.transportServers[key] = ts
+	}
+
+	c.gatewayAPITranslation = translation
+
+	changes, problems := c.rebuildListenerHosts()
+
+	hostChanges, hostProblems := c.rebuildHosts()
+	changes = append(changes, hostChanges...)
+	problems = append(problems, hostProblems...)
+
+	return changes, problems
+}
+
+// GetGatewayAPIStatuses returns the statuses of the Gateways and of the route parents handled by the Ingress Controller.
+// The routes whose translated resources lost a host or a listener to other resources are not accepted.
+func (c *Configuration) GetGatewayAPIStatuses() (map[string]gateway_v1.GatewayStatus, map[string][]gateway_v1.RouteParentStatus) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	gatewayStatuses := make(map[string]gateway_v1.GatewayStatus)
+	routeParentStatuses := make(map[string][]gateway_v1.RouteParentStatus)
+	if c.gatewayAPITranslation == nil {
+		return gatewayStatuses, routeParentStatuses
+	}
+
+	for key, status := range c.gatewayAPITranslation.gatewayStatuses {
+		gatewayStatuses[key] = *status.DeepCopy()
+	}
+	for key, parents := range c.gatewayAPITranslation.routeParentStatuses {
+		for _, p := range parents {
+			routeParentStatuses[key] = append(routeParentStatuses[key], *p.DeepCopy())
+		}
+	}
+
+	isActive := func(r Resource, key string) bool {
+		return r != nil && r.GetKeyWithKind() == key
+	}
+
+	for _, vs := range c.gatewayAPITranslation.virtualServers {
+		key := getResourceKeyWithKind(virtualServerKind, &vs.ObjectMeta)
+		if !isActive(c.hosts[vs.Spec.Host], key) {
+			routeKey, _ := gatewayAPIRouteKey(&vs.ObjectMeta)
+			rejectRouteParents(routeParentStatuses[routeKey], routeReasonHostnameConflict, fmt.Sprintf("Host %s is taken by another resource", vs.Spec.Host))
+		}
+	}
+
+	for _, ts := range c.gatewayAPITranslation.transportServers {
+		key := getResourceKeyWithKind(transportServerKind, &ts.ObjectMeta)
+
+		var holder Resource
+		if ts.Spec.Listener.Protocol == conf_v1.TLSPassthroughListenerProtocol {
+			holder = c.hosts[ts.Spec.Host]
+		} else if tsc, exists := c.listenerHosts[listenerHostKey{ListenerName: ts.Spec.Listener.Name, Host: ts.Spec.Host}]; exists {
+			holder = tsc
+		}
+
+		if !isActive(holder, key) {
+			routeKey, _ := gatewayAPIRouteKey(&ts.ObjectMeta)
+			rejectRouteParents(routeParentStatuses[routeKey], routeReasonHostnameConflict,
+				fmt.Sprintf("Listener %s and host %s are taken by another resource", ts.Spec.Listener.Name, ts.Spec.Host))
+		}
+	}
+
+	return gatewayStatuses, routeParentStatuses
+}
+
 // DeleteGlobalConfiguration deletes GlobalConfiguration.
 func (c *Configuration) DeleteGlobalConfiguration() ([]ResourceChange, []ConfigurationProblem) {
 	c.lock.Lock()
@@ -723,6 +918,9 @@ func (c *Configuration) AddOrUpdateTransportServer(ts *conf_v1.TransportServer)
 		delete(c.transportServers, key)
 	} else {
 		validationErr = c.transportServerValidator.ValidateTransportServer(ts)
+		if validationErr == nil {
+			validationErr = validateHostAnnotations(ts.Annotations)
+		}
 		if validationErr != nil {
 			delete(c.transportServers, key)
 		} else {
@@ -850,13 +1048,15 @@ func (c *Configuration) buildListenerHostsAndTSConfigurations() (map[listenerHos
 			}
 		}
 
-		if !found {
+		if !found || !isListenerAllowedForNamespace(listener, ts.Namespace) {
 			continue
 		}
 
 		tsc.ListenerPort = listener.Port
 		tsc.IPv4 = listener.IPv4
 		tsc.IPv6 = listener.IPv6
+		tsc.AcceptProxyProtocol = listener.AcceptProxyProtocol
+		tsc.TrustedCIDRs = listener.TrustedCIDRs
 
 		host := ts.Spec.Host
 		listenerKey := listenerHostKey{ListenerName: listener.Name, Host: host}
@@ -887,16 +1087,28 @@ func (c *Configuration) buildListenersForVSConfiguration(vsc *VirtualServerConfi
 		return
 	}
 
-	assignListener := func(listenerName string, isSSL bool, port *int, ipv4 *string, ipv6 *string) {
-		if gcListener, ok := c.listenerMap[listenerName]; ok && gcListener.Protocol == conf_v1.HTTPProtocol && gcListener.Ssl == isSSL {
+	assignListener := func(listenerName string, isSSL bool, port *int, ipv4 *string, ipv6 *string, listener **conf_v1.Listener) {
+		if gcListener, ok := c.listenerMap[listenerName]; ok && gcListener.Protocol == conf_v1.HTTPProtocol && gcListener.Ssl == isSSL &&
+			isListenerAllowedForNamespace(gcListener, vs.Namespace) {
 			*port = gcListener.Port
 			*ipv4 = gcListener.IPv4
 			*ipv6 = gcListener.IPv6
+
+			l := gcListener.DeepCopy()
+			if l.TLS != nil && l.TLS.Secret != "" {
+				l.TLS.Secret = fmt.Sprintf("%s/%s", c.globalConfiguration.Namespace, l.TLS.Secret)
+			}
+			*listener = l
 		}
 	}
 
-	assignListener(vs.Spec.Listener.HTTP, false, &vsc.HTTPPort, &vsc.HTTPIPv4, &vsc.HTTPIPv6)
-	assignListener(vs.Spec.Listener.HTTPS, true, &vsc.HTTPSPort, &vsc.HTTPSIPv4, &vsc.HTTPSIPv6)
+	assignListener(vs.Spec.Listener.HTTP, false, &vsc.HTTPPort, &vsc.HTTPIPv4, &vsc.HTTPIPv6, &vsc.HTTPListener)
+	assignListener(vs.Spec.Listener.HTTPS, true, &vsc.HTTPSPort, &vsc.HTTPSIPv4, &vsc.HTTPSIPv6, &vsc.HTTPSListener)
+}
+
+// isListenerAllowedForNamespace checks if resources from the namespace are allowed to bind the listener.
+func isListenerAllowedForNamespace(listener conf_v1.Listener, namespace string) bool {
+	return len(listener.AllowedNamespaces) == 0 || slices.Contains(listener.AllowedNamespaces, namespace)
 }
 
 // GetResources returns all configuration resources.
@@ -952,6 +1164,20 @@ func (c *Configuration) GetResourcesWithFilter(filter resourceFilter) []Resource
 	return result
 }
 
+// GetServerAliasesForVirtualServer returns the server aliases held by the VirtualServer.
+// No aliases are returned if the VirtualServer doesn't hold its host.
+func (c *Configuration) GetServerAliasesForVirtualServer(vs *conf_v1.VirtualServer) []string {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	holder, exists := c.hosts[vs.Spec.Host]
+	if !exists || holder.GetKeyWithKind() != getResourceKeyWithKind(virtualServerKind, &vs.ObjectMeta) {
+		return nil
+	}
+
+	return slices.Clone(holder.(*VirtualServerConfiguration).ServerAliases)
+}
+
 // FindResourcesForService finds resources that reference the specified service.
 func (c *Configuration) FindResourcesForService(svcNamespace string, svcName string) []Resource {
 	return c.findResourcesForResourceReference(svcNamespace, svcName, c.serviceReferenceChecker)
@@ -965,7 +1191,37 @@ func (c *Configuration) FindResourcesForEndpoints(endpointsNamespace string, end
 
 // FindResourcesForSecret finds resources that reference the specified secret.
 func (c *Configuration) FindResourcesForSecret(secretNamespace string, secretName string) []Resource {
-	return c.findResourcesForResourceReference(secretNamespace, secretName, c.secretReferenceChecker)
+	result := c.findResourcesForResourceReference(secretNamespace, secretName, c.secretReferenceChecker)
+	return append(result, c.findVirtualServersForListenerSecret(secretNamespace, secretName, result)...)
+}
+
+// findVirtualServersForListenerSecret finds VirtualServers that bind a listener with the specified default TLS Secret,
+// skipping the already found resources.
+func (c *Configuration) findVirtualServersForListenerSecret(secretNamespace string, secretName string, found []Resource) []Resource {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	foundKeys := make(map[string]bool)
+	for _, r := range found {
+		foundKeys[r.GetKeyWithKind()] = true
+	}
+
+	secretKey := fmt.Sprintf("%s/%s", secretNamespace, secretName)
+
+	var result []Resource
+
+	for _, h := range getSortedResourceKeys(c.hosts) {
+		vsc, ok := c.hosts[h].(*VirtualServerConfiguration)
+		if !ok || foundKeys[vsc.GetKeyWithKind()] {
+			continue
+		}
+		if vsc.HTTPSListener != nil && vsc.HTTPSListener.TLS != nil && vsc.HTTPSListener.TLS.Secret == secretKey {
+			result = append(result, vsc)
+			foundKeys[vsc.GetKeyWithKind()] = true
+		}
+	}
+
+	return result
 }
 
 // FindResourcesForPolicy finds resources that reference the specified policy.
@@ -973,6 +1229,11 @@ func (c *Configuration) FindResourcesForPolicy(policyNamespace string, policyNam
 	return c.findResourcesForResourceReference(policyNamespace, policyName, c.policyReferenceChecker)
 }
 
+// FindResourcesForMaintenanceConfigMap finds VirtualServers that reference the specified maintenance ConfigMap.
+func (c *Configuration) FindResourcesForMaintenanceConfigMap(configMapNamespace string, configMapName string) []Resource {
+	return c.findResourcesForResourceReference(configMapNamespace, configMapName, c.configMapReferenceChecker)
+}
+
 // FindResourcesForAppProtectPolicyAnnotation finds resources that reference the specified AppProtect policy via annotation.
 func (c *Configuration) FindResourcesForAppProtectPolicyAnnotation(policyNamespace string, policyName string) []Resource {
 	return c.findResourcesForResourceReference(policyNamespace, policyName, c.appPolicyReferenceChecker)
@@ -1015,6 +1276,13 @@ func (c *Configuration) findResourcesForResourceReference(namespace string, name
 					break
 				}
 			}
+
+			for _, canary := range impl.Canaries {
+				if checker.IsReferencedByIngress(namespace, name, canary) {
+					result = append(result, r)
+					break
+				}
+			}
 		case *VirtualServerConfiguration:
 			if checker.IsReferencedByVirtualServer(namespace, name, impl.VirtualServer) {
 				result = append(result, r)
@@ -1055,6 +1323,7 @@ func getResourceKey(meta *metav1.ObjectMeta) string {
 func (c *Configuration) rebuildHosts() ([]ResourceChange, []ConfigurationProblem) {
 	newHosts, newResources := c.buildHostsAndResources()
 
+	updateServerAliasesForVirtualServers(newHosts, newResources)
 	updateActiveHostsForIngresses(newHosts, newResources)
 
 	removedHosts, updatedHosts, addedHosts := detectChangesInHosts(c.hosts, newHosts)
@@ -1062,6 +1331,7 @@ func (c *Configuration) rebuildHosts() ([]ResourceChange, []ConfigurationProblem
 
 	// safe to update hosts
 	c.hosts = newHosts
+	c.standbyVirtualServers = findStandbyVirtualServers(newHosts, newResources)
 
 	changes = squashResourceChanges(changes)
 
@@ -1078,6 +1348,7 @@ func (c *Configuration) rebuildHosts() ([]ResourceChange, []ConfigurationProblem
 
 	c.addProblemsForResourcesWithoutActiveHost(newResources, newProblems)
 	c.addProblemsForOrphanMinions(newProblems)
+	c.addProblemsForOrphanCanaries(newProblems)
 	c.addProblemsForOrphanOrIgnoredVsrs(newProblems)
 	c.addWarningsForVirtualServersWithMissConfiguredListeners(newResources)
 
@@ -1089,6 +1360,51 @@ func (c *Configuration) rebuildHosts() ([]ResourceChange, []ConfigurationProblem
 	return changes, newOrUpdatedProblems
 }
 
+// standbyVirtualServer is a standby VirtualServer together with the resource that holds its host.
+type standbyVirtualServer struct {
+	config *VirtualServerConfiguration
+	holder Resource
+}
+
+// findStandbyVirtualServers finds the standby VirtualServers that do not hold their host.
+func findStandbyVirtualServers(hosts map[string]Resource, resources map[string]Resource) map[string]standbyVirtualServer {
+	standbyVirtualServers := make(map[string]standbyVirtualServer)
+
+	for key, r := range resources {
+		vsc, ok := r.(*VirtualServerConfiguration)
+		if !ok || !isHostStandby(&vsc.VirtualServer.ObjectMeta) {
+			continue
+		}
+
+		if holder, exists := hosts[vsc.VirtualServer.Spec.Host]; exists && holder.GetKeyWithKind() != key {
+			standbyVirtualServers[getResourceKey(&vsc.VirtualServer.ObjectMeta)] = standbyVirtualServer{
+				config: vsc,
+				holder: holder,
+			}
+		}
+	}
+
+	return standbyVirtualServers
+}
+
+// getHostProblem returns the host problem of the resource with the key with kind.
+func (c *Configuration) getHostProblem(keyWithKind string) (ConfigurationProblem, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	p, exists := c.hostProblems[keyWithKind]
+	return p, exists
+}
+
+// getStandbyVirtualServer returns the standby VirtualServer with the key, if it does not hold its host.
+func (c *Configuration) getStandbyVirtualServer(key string) (standbyVirtualServer, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	standby, exists := c.standbyVirtualServers[key]
+	return standby, exists
+}
+
 func updateActiveHostsForIngresses(hosts map[string]Resource, resources map[string]Resource) {
 	for _, r := range resources {
 		ingConfig, ok := r.(*IngressConfiguration)
@@ -1097,8 +1413,25 @@ func updateActiveHostsForIngresses(hosts map[string]Resource, resources map[stri
 		}
 
 		for _, rule := range ingConfig.Ingress.Spec.Rules {
-			res := hosts[rule.Host]
-			ingConfig.ValidHosts[rule.Host] = res.GetKeyWithKind() == r.GetKeyWithKind()
+			res, exists := hosts[rule.Host]
+			ingConfig.ValidHosts[rule.Host] = exists && res.GetKeyWithKind() == r.GetKeyWithKind()
+		}
+	}
+}
+
+// updateServerAliasesForVirtualServers sets the server aliases the VirtualServers hold.
+func updateServerAliasesForVirtualServers(hosts map[string]Resource, resources map[string]Resource) {
+	for _, r := range resources {
+		vsConfig, ok := r.(*VirtualServerConfiguration)
+		if !ok {
+			continue
+		}
+
+		for _, alias := range vsConfig.VirtualServer.Spec.ServerAliases {
+			holder, exists := hosts[alias]
+			if exists && holder.GetKeyWithKind() == r.GetKeyWithKind() {
+				vsConfig.ServerAliases = append(vsConfig.ServerAliases, alias)
+			}
 		}
 	}
 }
@@ -1137,11 +1470,15 @@ func (c *Configuration) addProblemsForTSConfigsWithoutActiveListener(
 		key := listenerHostKey{ListenerName: listenerName, Host: host}
 		holder, exists := c.listenerHosts[key]
 		if !exists {
+			msg := fmt.Sprintf("Listener %s doesn't exist", listenerName)
+			if l, ok := c.listenerMap[listenerName]; ok && !isListenerAllowedForNamespace(l, tsc.TransportServer.Namespace) {
+				msg = fmt.Sprintf("Listener %s doesn't allow resources from namespace %s", listenerName, tsc.TransportServer.Namespace)
+			}
 			p := ConfigurationProblem{
 				Object:  tsc.TransportServer,
 				IsError: false,
 				Reason:  nl.EventReasonRejected,
-				Message: fmt.Sprintf("Listener %s doesn't exist", listenerName),
+				Message: msg,
 			}
 			problems[tsc.GetKeyWithKind()] = p
 			continue
@@ -1152,13 +1489,21 @@ func (c *Configuration) addProblemsForTSConfigsWithoutActiveListener(
 				Object:  tsc.TransportServer,
 				IsError: false,
 				Reason:  nl.EventReasonRejected,
-				Message: fmt.Sprintf("Listener %s with host %s is taken by another resource", listenerName, hostDescription),
+				Message: getListenerTakenMessage(tsc, holder, listenerName, hostDescription),
 			}
 			problems[tsc.GetKeyWithKind()] = p
 		}
 	}
 }
 
+// getListenerTakenMessage returns the message for a TransportServer that lost its listener and host to the holder.
+func getListenerTakenMessage(tsc *TransportServerConfiguration, holder Resource, listenerName string, hostDescription string) string {
+	if isHostConflictOverridden(tsc.GetObjectMeta(), holder.GetObjectMeta()) {
+		return fmt.Sprintf("Listener %s with host %s is superseded by %s", listenerName, hostDescription, describeResource(holder))
+	}
+	return fmt.Sprintf("Listener %s with host %s is taken by another resource", listenerName, hostDescription)
+}
+
 func (c *Configuration) addProblemsForResourcesWithoutActiveHost(resources map[string]Resource, problems map[string]ConfigurationProblem) {
 	for _, r := range resources {
 		switch impl := r.(type) {
@@ -1171,35 +1516,63 @@ func (c *Configuration) addProblemsForResourcesWithoutActiveHost(resources map[s
 				}
 			}
 			if !atLeastOneValidHost {
+				msg := "All hosts are taken by other resources"
+				if !c.isAnyIngressHostAllowed(impl.Ingress) {
+					msg = fmt.Sprintf("All hosts are not allowed for namespace %s", impl.Ingress.Namespace)
+				} else if holders := c.findSupersedingHolders(impl); len(holders) > 0 {
+					msg = fmt.Sprintf("All hosts are superseded by %s", strings.Join(holders, ", "))
+				}
 				p := ConfigurationProblem{
 					Object:  impl.Ingress,
 					IsError: false,
 					Reason:  nl.EventReasonRejected,
-					Message: "All hosts are taken by other resources",
+					Message: msg,
 				}
 				problems[r.GetKeyWithKind()] = p
 			}
 		case *VirtualServerConfiguration:
-			res := c.hosts[impl.VirtualServer.Spec.Host]
+			vs := impl.VirtualServer
+			if !c.hostOwnershipRules.isHostAllowedForNamespace(vs.Spec.Host, vs.Namespace) {
+				problems[r.GetKeyWithKind()] = ConfigurationProblem{
+					Object:  vs,
+					IsError: false,
+					Reason:  nl.EventReasonRejected,
+					Message: fmt.Sprintf("Host %s is not allowed for namespace %s", vs.Spec.Host, vs.Namespace),
+				}
+				continue
+			}
+
+			res := c.hosts[vs.Spec.Host]
 
 			if res.GetKeyWithKind() != r.GetKeyWithKind() {
 				p := ConfigurationProblem{
 					Object:  impl.VirtualServer,
 					IsError: false,
 					Reason:  nl.EventReasonRejected,
-					Message: "Host is taken by another resource",
+					Message: getHostTakenMessage(r, res),
 				}
 				problems[r.GetKeyWithKind()] = p
 			}
 		case *TransportServerConfiguration:
-			res := c.hosts[impl.TransportServer.Spec.Host]
+			ts := impl.TransportServer
+			if !c.hostOwnershipRules.isHostAllowedForNamespace(ts.Spec.Host, ts.Namespace) {
+				problems[r.GetKeyWithKind()] = ConfigurationProblem{
+					Object:  ts,
+					IsError: false,
+					Reason:  nl.EventReasonRejected,
+					Message: fmt.Sprintf("Host %s is not allowed for namespace %s", ts.Spec.Host, ts.Namespace),
+				}
+				continue
+			}
+
+			res := c.hosts[ts.Spec.Host]
 
 			if res.GetKeyWithKind() != r.GetKeyWithKind() {
 				p := ConfigurationProblem{
 					Object:  impl.TransportServer,
 					IsError: false,
 					Reason:  nl.EventReasonRejected,
-					Message: "Host is taken by another resource",
+					Message: getHostTakenMessage(r, res),
 				}
 				problems[r.GetKeyWithKind()] = p
 			}
@@ -1207,12 +1580,53 @@ func (c *Configuration) addProblemsForResourcesWithoutActiveHost(resources map[s
 	}
 }
 
+// getHostTakenMessage returns the message for a resource that lost its host to the holder.
+func getHostTakenMessage(r Resource, holder Resource) string {
+	if isHostConflictOverridden(r.GetObjectMeta(), holder.GetObjectMeta()) {
+		return fmt.Sprintf("Host is superseded by %s", describeResource(holder))
+	}
+	return "Host is taken by another resource"
+}
+
+// findSupersedingHolders returns the descriptions of the resources holding the hosts of the Ingress,
+// if all of them won their hosts because of the host priority or standby annotations.
+func (c *Configuration) findSupersedingHolders(ingConfig *IngressConfiguration) []string {
+	var holders []string
+
+	for _, host := range slices.Sorted(maps.Keys(ingConfig.ValidHosts)) {
+		holder, exists := c.hosts[host]
+		if !exists || !isHostConflictOverridden(ingConfig.GetObjectMeta(), holder.GetObjectMeta()) {
+			return nil
+		}
+
+		description := describeResource(holder)
+		if !slices.Contains(holders, description) {
+			holders = append(holders, description)
+		}
+	}
+
+	return holders
+}
+
+// isAnyIngressHostAllowed checks if any host of the Ingress is allowed for its namespace by the host ownership rules.
+func (c *Configuration) isAnyIngressHostAllowed(ing *networking.Ingress) bool {
+	for _, rule := range ing.Spec.Rules {
+		if c.hostOwnershipRules.isHostAllowedForNamespace(rule.Host, ing.Namespace) {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *Configuration) addWarningsForVirtualServersWithMissConfiguredListeners(resources map[string]Resource) {
 	for _, r := range resources {
 		vsc, ok := r.(*VirtualServerConfiguration)
 		if !ok {
 			continue
 		}
+		if _, exists := c.hosts[vsc.VirtualServer.Spec.Host]; !exists {
+			continue
+		}
 		if vsc.VirtualServer.Spec.Listener != nil {
 			if c.globalConfiguration == nil {
 				warningMsg := "Listeners defined, but no GlobalConfiguration is deployed"
@@ -1251,6 +1665,13 @@ func (c *Configuration) addWarningsForVirtualServersWithMissConfiguredListeners(
 					continue
 				}
 			}
+
+			for _, listenerName := range []string{vsc.VirtualServer.Spec.Listener.HTTP, vsc.VirtualServer.Spec.Listener.HTTPS} {
+				if l, exists := c.listenerMap[listenerName]; exists && !isListenerAllowedForNamespace(l, vsc.VirtualServer.Namespace) {
+					warningMsg := fmt.Sprintf("Listener %s doesn't allow resources from namespace %s", listenerName, vsc.VirtualServer.Namespace)
+					c.hosts[vsc.VirtualServer.Spec.Host].AddWarning(warningMsg)
+				}
+			}
 		}
 	}
 }
@@ -1286,6 +1707,37 @@ func (c *Configuration) addProblemsForOrphanMinions(problems map[string]Configur
 	}
 }
 
+func (c *Configuration) addProblemsForOrphanCanaries(problems map[string]ConfigurationProblem) {
+	for _, key := range getSortedIngressKeys(c.ingresses) {
+		ing := c.ingresses[key]
+
+		if !isCanary(ing) {
+			continue
+		}
+
+		paired := false
+		for _, rule := range ing.Spec.Rules {
+			r, exists := c.hosts[rule.Host]
+			ingressConf, ok := r.(*IngressConfiguration)
+			if exists && ok && slices.Contains(ingressConf.Canaries, ing) {
+				paired = true
+				break
+			}
+		}
+
+		if !paired {
+			p := ConfigurationProblem{
+				Object:  ing,
+				IsError: false,
+				Reason:  nl.EventReasonNoPrimaryIngressFound,
+				Message: "Primary Ingress for the canary is invalid or doesn't exist",
+			}
+			k := getResourceKeyWithKind(ingressKind, &ing.ObjectMeta)
+			problems[k] = p
+		}
+	}
+}
+
 func (c *Configuration) addProblemsForOrphanOrIgnoredVsrs(problems map[string]ConfigurationProblem) {
 	for _, key := range getSortedVirtualServerRouteKeys(c.virtualServerRoutes) {
 		vsr := c.virtualServerRoutes[key]
@@ -1474,7 +1926,7 @@ func (c *Configuration) buildHostsAndResources() (newHosts map[string]Resource,
 	for _, key := range getSortedIngressKeys(c.ingresses) {
 		ing := c.ingresses[key]
 
-		if isMinion(ing) {
+		if isMinion(ing) || isCanary(ing) {
 			continue
 		}
 
@@ -1493,11 +1945,17 @@ func (c *Configuration) buildHostsAndResources() (newHosts map[string]Resource,
 			resource = NewMasterIngressConfiguration(ing, minions, childWarnings)
 		} else {
 			resource = NewRegularIngressConfiguration(ing)
+			resource.Canaries = c.buildCanaries(ing)
 		}
 
 		newResources[resource.GetKeyWithKind()] = resource
 
 		for _, rule := range ing.Spec.Rules {
+			if !c.hostOwnershipRules.isHostAllowedForNamespace(rule.Host, ing.Namespace) {
+				resource.AddWarning(fmt.Sprintf("host %s is not allowed for namespace %s", rule.Host, ing.Namespace))
+				continue
+			}
+
 			holder, exists := newHosts[rule.Host]
 			if !exists {
 				newHosts[rule.Host] = resource
@@ -1522,16 +1980,21 @@ func (c *Configuration) buildHostsAndResources() (newHosts map[string]Resource,
 
 		vsrs, vsrSelectors, warnings := c.buildVirtualServerRoutes(vs)
 		for _, vsr := range challengesVSR {
-			if vs.Spec.Host == vsr.Spec.Host {
+			if vs.Spec.Host == vsr.Spec.Host || slices.Contains(vs.Spec.ServerAliases, vsr.Spec.Host) {
 				vsrs = append(vsrs, vsr)
 			}
 		}
 		resource := NewVirtualServerConfiguration(vs, vsrs, vsrSelectors, warnings)
 
 		c.buildListenersForVSConfiguration(resource)
+		c.gatewayAPITranslation.applyHTTPSListener(resource)
 
 		newResources[resource.GetKeyWithKind()] = resource
 
+		if !c.hostOwnershipRules.isHostAllowedForNamespace(vs.Spec.Host, vs.Namespace) {
+			continue
+		}
+
 		holder, exists := newHosts[vs.Spec.Host]
 		if !exists {
 			newHosts[vs.Spec.Host] = resource
@@ -1561,6 +2024,10 @@ func (c *Configuration) buildHostsAndResources() (newHosts map[string]Resource,
 			resource := NewTransportServerConfiguration(ts)
 			newResources[resource.GetKeyWithKind()] = resource
 
+			if !c.hostOwnershipRules.isHostAllowedForNamespace(ts.Spec.Host, ts.Namespace) {
+				continue
+			}
+
 			holder, exists := newHosts[ts.Spec.Host]
 			if !exists {
 				newHosts[ts.Spec.Host] = resource
@@ -1578,6 +2045,61 @@ func (c *Configuration) buildHostsAndResources() (newHosts map[string]Resource,
 		}
 	}
 
+	// Step 4 - Build hosts from the server aliases of VirtualServer resources
+	// The aliases are claimed after all primary hosts, in the order of the VirtualServers priority,
+	// so that the aliases of a VirtualServer that doesn't hold its host are left to the next contender.
+
+	var vsConfigs []*VirtualServerConfiguration
+	for _, key := range getSortedVirtualServerKeys(c.virtualServers) {
+		vs := c.virtualServers[key]
+		if len(vs.Spec.ServerAliases) == 0 {
+			continue
+		}
+		vsConfigs = append(vsConfigs, newResources[getResourceKeyWithKind(virtualServerKind, &vs.ObjectMeta)].(*VirtualServerConfiguration))
+	}
+
+	slices.SortStableFunc(vsConfigs, func(a, b *VirtualServerConfiguration) int {
+		switch {
+		case a.Wins(b):
+			return -1
+		case b.Wins(a):
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	for _, resource := range vsConfigs {
+		vs := resource.VirtualServer
+
+		holder, exists := newHosts[vs.Spec.Host]
+		if !exists || holder.GetKeyWithKind() != resource.GetKeyWithKind() {
+			continue
+		}
+
+		for _, alias := range vs.Spec.ServerAliases {
+			if !c.hostOwnershipRules.isHostAllowedForNamespace(alias, vs.Namespace) {
+				resource.AddWarning(fmt.Sprintf("host %s is not allowed for namespace %s", alias, vs.Namespace))
+				continue
+			}
+
+			holder, exists := newHosts[alias]
+			if !exists {
+				newHosts[alias] = resource
+				continue
+			}
+
+			warning := fmt.Sprintf("host %s is taken by another resource", alias)
+
+			if !holder.Wins(resource) {
+				newHosts[alias] = resource
+				holder.AddWarning(warning)
+			} else {
+				resource.AddWarning(warning)
+			}
+		}
+	}
+
 	return newHosts, newResources
 }
 
@@ -1626,7 +2148,7 @@ func (c *Configuration) convertIngressToVSR(ing *networking.Ingress) *conf_v1.Vi
 func (c *Configuration) isChallengeIngressOwnerVs(host string) bool {
 	for _, key := range getSortedVirtualServerKeys(c.virtualServers) {
 		vs := c.virtualServers[key]
-		if host == vs.Spec.Host {
+		if host == vs.Spec.Host || slices.Contains(vs.Spec.ServerAliases, host) {
 			return true
 		}
 	}
@@ -1680,6 +2202,36 @@ func (c *Configuration) buildMinionConfigs(masterHost string) ([]*MinionConfigur
 	return minionConfigs, childWarnings
 }
 
+// buildCanaries returns the canary Ingresses in the namespace of the primary Ingress that share a host with it.
+func (c *Configuration) buildCanaries(primary *networking.Ingress) []*networking.Ingress {
+	var canaries []*networking.Ingress
+
+	for _, key := range getSortedIngressKeys(c.ingresses) {
+		ing := c.ingresses[key]
+
+		if !isCanary(ing) || ing.Namespace != primary.Namespace {
+			continue
+		}
+
+		if sharesIngressHost(primary, ing) {
+			canaries = append(canaries, ing)
+		}
+	}
+
+	return canaries
+}
+
+func sharesIngressHost(ing1 *networking.Ingress, ing2 *networking.Ingress) bool {
+	for _, rule1 := range ing1.Spec.Rules {
+		for _, rule2 := range ing2.Spec.Rules {
+			if rule1.Host == rule2.Host {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (c *Configuration) validateVSRs(r *conf_v1.Route, vsHost, vsNamespace string) ([]*conf_v1.VirtualServerRoute, []string) {
 	var vsrs []*conf_v1.VirtualServerRoute
 	var warnings []string
@@ -2015,6 +2567,9 @@ func detectChangesInHosts(oldHosts map[string]Resource, newHosts map[string]Reso
 			updatedHosts = append(updatedHosts, h)
 		}
 
+		if !reflect.DeepEqual(newVsc.HTTPListener, oldVsc.HTTPListener) || !reflect.DeepEqual(newVsc.HTTPSListener, oldVsc.HTTPSListener) {
+			updatedHosts = append(updatedHosts, h)
+		}
 	}
 
 	return removedHosts, updatedHosts, addedHosts
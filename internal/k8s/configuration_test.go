@@ -14,6 +14,7 @@ import (
 	"github.com/nginx/kubernetes-ingress/pkg/apis/configuration/validation"
 	networking "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gateway_v1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
 func createTestConfiguration() *Configuration {
@@ -298,6 +299,83 @@ func TestDeleteNonExistingIngress(t *testing.T) {
 	}
 }
 
+func TestAddIngressForCanaryIngress(t *testing.T) {
+	t.Parallel()
+	configuration := createTestConfiguration()
+
+	// Add a canary Ingress without a primary Ingress
+
+	canary := createTestIngressCanary("canary", "foo.example.com")
+
+	var expectedChanges []ResourceChange
+	expectedProblems := []ConfigurationProblem{
+		{
+			Object:  canary,
+			IsError: false,
+			Reason:  nl.EventReasonNoPrimaryIngressFound,
+			Message: "Primary Ingress for the canary is invalid or doesn't exist",
+		},
+	}
+
+	changes, problems := configuration.AddOrUpdateIngress(canary)
+	if diff := cmp.Diff(expectedChanges, changes); diff != "" {
+		t.Errorf("AddOrUpdateIngress() returned unexpected result (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(expectedProblems, problems); diff != "" {
+		t.Errorf("AddOrUpdateIngress() returned unexpected result (-want +got):\n%s", diff)
+	}
+
+	// Add the primary Ingress
+
+	ing := createTestIngress("ingress", "foo.example.com")
+
+	expectedChanges = []ResourceChange{
+		{
+			Op: AddOrUpdate,
+			Resource: &IngressConfiguration{
+				Ingress: ing,
+				ValidHosts: map[string]bool{
+					"foo.example.com": true,
+				},
+				ChildWarnings: map[string][]string{},
+				Canaries:      []*networking.Ingress{canary},
+			},
+		},
+	}
+	expectedProblems = nil
+
+	changes, problems = configuration.AddOrUpdateIngress(ing)
+	if diff := cmp.Diff(expectedChanges, changes); diff != "" {
+		t.Errorf("AddOrUpdateIngress() returned unexpected result (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(expectedProblems, problems); diff != "" {
+		t.Errorf("AddOrUpdateIngress() returned unexpected result (-want +got):\n%s", diff)
+	}
+
+	// Delete the canary Ingress
+
+	expectedChanges = []ResourceChange{
+		{
+			Op: AddOrUpdate,
+			Resource: &IngressConfiguration{
+				Ingress: ing,
+				ValidHosts: map[string]bool{
+					"foo.example.com": true,
+				},
+				ChildWarnings: map[string][]string{},
+			},
+		},
+	}
+
+	changes, problems = configuration.DeleteIngress("default/canary")
+	if diff := cmp.Diff(expectedChanges, changes); diff != "" {
+		t.Errorf("DeleteIngress() returned unexpected result (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(expectedProblems, problems); diff != "" {
+		t.Errorf("DeleteIngress() returned unexpected result (-want +got):\n%s", diff)
+	}
+}
+
 func TestAddIngressForMergeableIngresses(t *testing.T) {
 	configuration := createTestConfiguration()
 
@@ -2553,6 +2631,364 @@ func TestAddTransportServerWithNonExistingListener(t *testing.T) {
 	}
 }
 
+func TestAddTransportServerForListenerWithAllowedNamespaces(t *testing.T) {
+	configuration := createTestConfiguration()
+
+	listeners := []conf_v1.Listener{
+		{
+			Name:              "tcp-7777",
+			Port:              7777,
+			Protocol:          "TCP",
+			AllowedNamespaces: []string{"tenant-a"},
+		},
+	}
+
+	addOrUpdateGlobalConfiguration(t, configuration, listeners, noChanges, noProblems)
+
+	ts := createTestTransportServer("transportserver", "tcp-7777", "TCP")
+
+	expectedProblems := []ConfigurationProblem{
+		{
+			Object:  ts,
+			IsError: false,
+			Reason:  nl.EventReasonRejected,
+			Message: "Listener tcp-7777 doesn't allow resources from namespace default",
+		},
+	}
+	var expectedChanges []ResourceChange
+
+	changes, problems := configuration.AddOrUpdateTransportServer(ts)
+	if diff := cmp.Diff(expectedChanges, changes); diff != "" {
+		t.Errorf("AddOrUpdateTransportServer() returned unexpected result (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(expectedProblems, problems); diff != "" {
+		t.Errorf("AddOrUpdateTransportServer() returned unexpected result (-want +got):\n%s", diff)
+	}
+
+	// Allow the namespace of the TransportServer
+
+	listeners[0].AllowedNamespaces = []string{"tenant-a", "default"}
+
+	expectedChanges = []ResourceChange{
+		{
+			Op: AddOrUpdate,
+			Resource: &TransportServerConfiguration{
+				ListenerPort:    7777,
+				TransportServer: ts,
+			},
+		},
+	}
+
+	addOrUpdateGlobalConfiguration(t, configuration, listeners, expectedChanges, noProblems)
+}
+
+func TestAddVirtualServerWithCustomListenersFromNotAllowedNamespace(t *testing.T) {
+	t.Parallel()
+	configuration := createTestConfiguration()
+
+	listeners := []conf_v1.Listener{
+		customHTTPAndHTTPSListeners[0],
+		customHTTPAndHTTPSListeners[1],
+	}
+	listeners[1].AllowedNamespaces = []string{"tenant-a"}
+
+	addOrUpdateGlobalConfiguration(t, configuration, listeners, noChanges, noProblems)
+
+	virtualServer := createTestVirtualServerWithListeners(
+		"cafe",
+		"cafe.example.com",
+		"http-8082",
+		"https-8442")
+
+	expectedChanges := []ResourceChange{
+		{
+			Op: AddOrUpdate,
+			Resource: &VirtualServerConfiguration{
+				VirtualServer:               virtualServer,
+				VirtualServerRouteSelectors: map[string][]string{},
+				HTTPPort:                    8082,
+				HTTPListener:                expectedHTTPListener,
+				Warnings:                    []string{"Listener https-8442 doesn't allow resources from namespace default"},
+			},
+		},
+	}
+
+	addOrUpdateVirtualServer(t, configuration, virtualServer, expectedChanges, noProblems)
+}
+
+func TestAddVirtualServerWithServerAliases(t *testing.T) {
+	t.Parallel()
+	configuration := createTestConfiguration()
+
+	vs := createTestVirtualServer("cafe", "cafe.example.com")
+	vs.Spec.ServerAliases = []string{"www.cafe.example.com"}
+	expectedChanges := []ResourceChange{
+		{
+			Op: AddOrUpdate,
+			Resource: &VirtualServerConfiguration{
+				VirtualServer:               vs,
+				VirtualServerRouteSelectors: map[string][]string{},
+				ServerAliases:               []string{"www.cafe.example.com"},
+			},
+		},
+	}
+	addOrUpdateVirtualServer(t, configuration, vs, expectedChanges, noProblems)
+
+	squatter := createTestVirtualServer("squatter", "www.cafe.example.com")
+	squatter.CreationTimestamp = metav1.NewTime(vs.CreationTimestamp.Add(time.Second))
+	expectedProblems := []ConfigurationProblem{
+		{
+			Object:  squatter,
+			IsError: false,
+			Reason:  nl.EventReasonRejected,
+			Message: "Host is taken by another resource",
+		},
+	}
+
+	changes, problems := configuration.AddOrUpdateVirtualServer(squatter)
+	if diff := cmp.Diff([]ResourceChange(nil), changes); diff != "" {
+		t.Errorf("AddOrUpdateVirtualServer() returned unexpected result (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(expectedProblems, problems); diff != "" {
+		t.Errorf("AddOrUpdateVirtualServer() returned unexpected result (-want +got):\n%s", diff)
+	}
+}
+
+func TestServerAliasesOfVirtualServerWithoutHostAreHandedBack(t *testing.T) {
+	t.Parallel()
+	configuration := createTestConfiguration()
+
+	vs := createTestVirtualServer("cafe", "cafe.example.com")
+	configuration.AddOrUpdateVirtualServer(vs)
+
+	// The VirtualServer loses its host to an older one, so its server aliases are left to the next contenders.
+	loser := createTestVirtualServer("loser", "cafe.example.com")
+	loser.CreationTimestamp = metav1.NewTime(vs.CreationTimestamp.Add(time.Second))
+	loser.Spec.ServerAliases = []string{"www.cafe.example.com", "menu.cafe.example.com"}
+	configuration.AddOrUpdateVirtualServer(loser)
+
+	contender := createTestVirtualServer("contender", "tea.example.com")
+	contender.CreationTimestamp = metav1.NewTime(vs.CreationTimestamp.Add(2 * time.Second))
+	contender.Spec.ServerAliases = []string{"www.cafe.example.com"}
+	configuration.AddOrUpdateVirtualServer(contender)
+
+	menu := createTestVirtualServer("menu", "menu.cafe.example.com")
+	menu.CreationTimestamp = metav1.NewTime(vs.CreationTimestamp.Add(3 * time.Second))
+	configuration.AddOrUpdateVirtualServer(menu)
+
+	if aliases := configuration.GetServerAliasesForVirtualServer(loser); aliases != nil {
+		t.Errorf("GetServerAliasesForVirtualServer() returned %v for a VirtualServer without its host", aliases)
+	}
+
+	expectedAliases := []string{"www.cafe.example.com"}
+	if diff := cmp.Diff(expectedAliases, configuration.GetServerAliasesForVirtualServer(contender)); diff != "" {
+		t.Errorf("GetServerAliasesForVirtualServer() returned unexpected result (-want +got):\n%s", diff)
+	}
+
+	expectedHolders := map[string]string{
+		"cafe.example.com":      "VirtualServer/default/cafe",
+		"tea.example.com":       "VirtualServer/default/contender",
+		"www.cafe.example.com":  "VirtualServer/default/contender",
+		"menu.cafe.example.com": "VirtualServer/default/menu",
+	}
+	holders := make(map[string]string)
+	for host, r := range configuration.hosts {
+		holders[host] = r.GetKeyWithKind()
+	}
+	if diff := cmp.Diff(expectedHolders, holders); diff != "" {
+		t.Errorf("buildHostsAndResources() returned unexpected hosts (-want +got):\n%s", diff)
+	}
+}
+
+func TestHostTakeoverByStandbyVirtualServer(t *testing.T) {
+	t.Parallel()
+	configuration := createTestConfiguration()
+
+	vs := createTestVirtualServer("virtualserver", "foo.example.com")
+	standbyVS := createTestVirtualServer("virtualserver-standby", "foo.example.com")
+	standbyVS.Annotations = map[string]string{
+		"nginx.org/host-standby": "true",
+	}
+
+	configuration.AddOrUpdateVirtualServer(vs)
+
+	// Add standby VirtualServer
+
+	var expectedChanges []ResourceChange
+	expectedProblems := []ConfigurationProblem{
+		{
+			Object:  standbyVS,
+			IsError: false,
+			Reason:  nl.EventReasonRejected,
+			Message: "Host is superseded by VirtualServer default/virtualserver",
+		},
+	}
+
+	changes, problems := configuration.AddOrUpdateVirtualServer(standbyVS)
+	if diff := cmp.Diff(expectedChanges, changes); diff != "" {
+		t.Errorf("AddOrUpdateVirtualServer() returned unexpected result (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(expectedProblems, problems); diff != "" {
+		t.Errorf("AddOrUpdateVirtualServer() returned unexpected result (-want +got):\n%s", diff)
+	}
+
+	if _, exists := configuration.getStandbyVirtualServer("default/virtualserver-standby"); !exists {
+		t.Errorf("getStandbyVirtualServer() returned no standby VirtualServer")
+	}
+
+	// Promote standby VirtualServer
+
+	promotedVS := standbyVS.DeepCopy()
+	promotedVS.Annotations = map[string]string{
+		"nginx.org/host-priority": "10",
+	}
+
+	expectedChanges = []ResourceChange{
+		{
+			Op: Delete,
+			Resource: &VirtualServerConfiguration{
+				VirtualServer:               vs,
+				VirtualServerRouteSelectors: map[string][]string{},
+				Warnings:                    []string{"host foo.example.com is taken by another resource"},
+			},
+		},
+		{
+			Op: AddOrUpdate,
+			Resource: &VirtualServerConfiguration{
+				VirtualServer:               promotedVS,
+				VirtualServerRouteSelectors: map[string][]string{},
+			},
+		},
+	}
+	expectedProblems = []ConfigurationProblem{
+		{
+			Object:  vs,
+			IsError: false,
+			Reason:  nl.EventReasonRejected,
+			Message: "Host is superseded by VirtualServer default/virtualserver-standby",
+		},
+	}
+
+	changes, problems = configuration.AddOrUpdateVirtualServer(promotedVS)
+	if diff := cmp.Diff(expectedChanges, changes); diff != "" {
+		t.Errorf("AddOrUpdateVirtualServer() returned unexpected result (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(expectedProblems, problems); diff != "" {
+		t.Errorf("AddOrUpdateVirtualServer() returned unexpected result (-want +got):\n%s", diff)
+	}
+
+	if _, exists := configuration.getStandbyVirtualServer("default/virtualserver-standby"); exists {
+		t.Errorf("getStandbyVirtualServer() returned the promoted VirtualServer")
+	}
+}
+
+func TestAddVirtualServerWithInvalidHostPriority(t *testing.T) {
+	t.Parallel()
+	configuration := createTestConfiguration()
+
+	vs := createTestVirtualServer("virtualserver", "foo.example.com")
+	vs.Annotations = map[string]string{
+		"nginx.org/host-priority": "high",
+	}
+
+	var expectedChanges []ResourceChange
+	expectedProblems := []ConfigurationProblem{
+		{
+			Object:  vs,
+			IsError: true,
+			Reason:  nl.EventReasonRejected,
+			Message: `VirtualServer default/virtualserver was rejected with error: metadata.annotations.nginx.org/host-priority: Invalid value: "high": must be an integer`,
+		},
+	}
+
+	changes, problems := configuration.AddOrUpdateVirtualServer(vs)
+	if diff := cmp.Diff(expectedChanges, changes); diff != "" {
+		t.Errorf("AddOrUpdateVirtualServer() returned unexpected result (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(expectedProblems, problems); diff != "" {
+		t.Errorf("AddOrUpdateVirtualServer() returned unexpected result (-want +got):\n%s", diff)
+	}
+}
+
+func TestSetHostOwnershipRules(t *testing.T) {
+	t.Parallel()
+	configuration := createTestConfiguration()
+
+	squatter := createTestVirtualServer("squatter", "cafe.example.com")
+	expectedChanges := []ResourceChange{
+		{
+			Op: AddOrUpdate,
+			Resource: &VirtualServerConfiguration{
+				VirtualServer:               squatter,
+				VirtualServerRouteSelectors: map[string][]string{},
+			},
+		},
+	}
+	addOrUpdateVirtualServer(t, configuration, squatter, expectedChanges, noProblems)
+
+	expectedChanges = []ResourceChange{
+		{
+			Op: Delete,
+			Resource: &VirtualServerConfiguration{
+				VirtualServer:               squatter,
+				VirtualServerRouteSelectors: map[string][]string{},
+			},
+		},
+	}
+	expectedProblems := []ConfigurationProblem{
+		{
+			Object:  squatter,
+			IsError: false,
+			Reason:  nl.EventReasonRejected,
+			Message: "Host cafe.example.com is not allowed for namespace default",
+		},
+	}
+
+	changes, problems := configuration.SetHostOwnershipRules(hostOwnershipRules{"*.example.com": {"cafe"}})
+	if diff := cmp.Diff(expectedChanges, changes); diff != "" {
+		t.Errorf("SetHostOwnershipRules() returned unexpected result for the changes (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(expectedProblems, problems); diff != "" {
+		t.Errorf("SetHostOwnershipRules() returned unexpected result for the problems (-want +got):\n%s", diff)
+	}
+
+	owner := createTestVirtualServer("cafe", "cafe.example.com")
+	owner.Namespace = "cafe"
+	expectedChanges = []ResourceChange{
+		{
+			Op: AddOrUpdate,
+			Resource: &VirtualServerConfiguration{
+				VirtualServer:               owner,
+				VirtualServerRouteSelectors: map[string][]string{},
+			},
+		},
+	}
+	addOrUpdateVirtualServer(t, configuration, owner, expectedChanges, noProblems)
+
+	ingress := createTestIngress("tea", "tea.example.com", "tea.example.org")
+	expectedChanges = []ResourceChange{
+		{
+			Op: AddOrUpdate,
+			Resource: &IngressConfiguration{
+				Ingress: ingress,
+				ValidHosts: map[string]bool{
+					"tea.example.com": false,
+					"tea.example.org": true,
+				},
+				ChildWarnings: map[string][]string{},
+				Warnings:      []string{"host tea.example.com is not allowed for namespace default"},
+			},
+		},
+	}
+	changes, problems = configuration.AddOrUpdateIngress(ingress)
+	if diff := cmp.Diff(expectedChanges, changes); diff != "" {
+		t.Errorf("AddOrUpdateIngress() returned unexpected result (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(noProblems, problems); diff != "" {
+		t.Errorf("AddOrUpdateIngress() returned unexpected result (-want +got):\n%s", diff)
+	}
+}
+
 func TestDeleteNonExistingTransportServer(t *testing.T) {
 	configuration := createTestConfiguration()
 
@@ -2887,12 +3323,87 @@ func TestAddGlobalConfigurationThenAddVirtualServerWithValidCustomListeners(t *t
 				VirtualServer:               virtualServer,
 				VirtualServerRouteSelectors: map[string][]string{},
 				HTTPPort:                    8082,
+				HTTPListener:                expectedHTTPListener,
+				HTTPSPort:                   8442,
+				HTTPSListener:               expectedHTTPSListener,
+			},
+		},
+	}
+
+	addOrUpdateVirtualServer(t, configuration, virtualServer, expectedChanges, noProblems)
+}
+
+func TestAddGlobalConfigurationWithListenerTLSForVirtualServerWithCustomListeners(t *testing.T) {
+	t.Parallel()
+	configuration := createTestConfiguration()
+
+	addOrUpdateGlobalConfiguration(t, configuration, customHTTPAndHTTPSListeners, noChanges, noProblems)
+
+	virtualServer := createTestVirtualServerWithListeners(
+		"cafe",
+		"cafe.example.com",
+		"http-8082",
+		"https-8442")
+
+	expectedChanges := []ResourceChange{
+		{
+			Op: AddOrUpdate,
+			Resource: &VirtualServerConfiguration{
+				VirtualServer:               virtualServer,
+				VirtualServerRouteSelectors: map[string][]string{},
+				HTTPPort:                    8082,
+				HTTPListener:                expectedHTTPListener,
 				HTTPSPort:                   8442,
+				HTTPSListener:               expectedHTTPSListener,
 			},
 		},
 	}
 
 	addOrUpdateVirtualServer(t, configuration, virtualServer, expectedChanges, noProblems)
+
+	listeners := []conf_v1.Listener{
+		customHTTPAndHTTPSListeners[0],
+		{
+			Name:     "https-8442",
+			Port:     8442,
+			Protocol: "HTTP",
+			Ssl:      true,
+			TLS: &conf_v1.ListenerTLS{
+				Secret:    "default-cert",
+				Protocols: "TLSv1.3",
+			},
+		},
+	}
+
+	expectedChanges = []ResourceChange{
+		{
+			Op: AddOrUpdate,
+			Resource: &VirtualServerConfiguration{
+				VirtualServer:               virtualServer,
+				VirtualServerRouteSelectors: map[string][]string{},
+				HTTPPort:                    8082,
+				HTTPListener:                expectedHTTPListener,
+				HTTPSPort:                   8442,
+				HTTPSListener: &conf_v1.Listener{
+					Name:     "https-8442",
+					Port:     8442,
+					Protocol: "HTTP",
+					Ssl:      true,
+					TLS: &conf_v1.ListenerTLS{
+						Secret:    "nginx-ingress/default-cert",
+						Protocols: "TLSv1.3",
+					},
+				},
+			},
+		},
+	}
+
+	addOrUpdateGlobalConfiguration(t, configuration, listeners, expectedChanges, noProblems)
+
+	resources := configuration.FindResourcesForSecret("nginx-ingress", "default-cert")
+	if diff := cmp.Diff([]Resource{expectedChanges[0].Resource}, resources); diff != "" {
+		t.Errorf("FindResourcesForSecret() returned unexpected result (-want +got):\n%s", diff)
+	}
 }
 
 func TestAddVirtualServerWithValidCustomListenersFirstThenAddGlobalConfiguration(t *testing.T) {
@@ -2927,7 +3438,9 @@ func TestAddVirtualServerWithValidCustomListenersFirstThenAddGlobalConfiguration
 				VirtualServer:               virtualServer,
 				VirtualServerRouteSelectors: map[string][]string{},
 				HTTPPort:                    8082,
+				HTTPListener:                expectedHTTPListener,
 				HTTPSPort:                   8442,
+				HTTPSListener:               expectedHTTPSListener,
 			},
 		},
 	}
@@ -2981,6 +3494,7 @@ func TestAddVirtualServerWithCustomHttpListenerThatDoNotExistInGlobalConfigurati
 				VirtualServerRouteSelectors: map[string][]string{},
 				HTTPPort:                    0,
 				HTTPSPort:                   8442,
+				HTTPSListener:               expectedHTTPSListener,
 				Warnings:                    []string{"Listener http-bogus is not defined in GlobalConfiguration"},
 			},
 		},
@@ -3008,6 +3522,7 @@ func TestAddVirtualServerWithCustomHttpsListenerThatDoNotExistInGlobalConfigurat
 				VirtualServer:               virtualServer,
 				VirtualServerRouteSelectors: map[string][]string{},
 				HTTPPort:                    8082,
+				HTTPListener:                expectedHTTPListener,
 				HTTPSPort:                   0,
 				Warnings:                    []string{"Listener https-bogus is not defined in GlobalConfiguration"},
 			},
@@ -3036,7 +3551,9 @@ func TestDeleteHttpListenerFromExistingGlobalConfigurationWithVirtualServerDeplo
 				VirtualServer:               virtualServer,
 				VirtualServerRouteSelectors: map[string][]string{},
 				HTTPPort:                    8082,
+				HTTPListener:                expectedHTTPListener,
 				HTTPSPort:                   8442,
+				HTTPSListener:               expectedHTTPSListener,
 			},
 		},
 	}
@@ -3051,6 +3568,7 @@ func TestDeleteHttpListenerFromExistingGlobalConfigurationWithVirtualServerDeplo
 				VirtualServerRouteSelectors: map[string][]string{},
 				HTTPPort:                    0,
 				HTTPSPort:                   8442,
+				HTTPSListener:               expectedHTTPSListener,
 				Warnings:                    []string{"Listener http-8082 is not defined in GlobalConfiguration"},
 			},
 		},
@@ -3078,7 +3596,9 @@ func TestDeleteHttpsListenerFromExistingGlobalConfigurationWithVirtualServerDepl
 				VirtualServer:               virtualServer,
 				VirtualServerRouteSelectors: map[string][]string{},
 				HTTPPort:                    8082,
+				HTTPListener:                expectedHTTPListener,
 				HTTPSPort:                   8442,
+				HTTPSListener:               expectedHTTPSListener,
 			},
 		},
 	}
@@ -3091,6 +3611,7 @@ func TestDeleteHttpsListenerFromExistingGlobalConfigurationWithVirtualServerDepl
 				VirtualServer:               virtualServer,
 				VirtualServerRouteSelectors: map[string][]string{},
 				HTTPPort:                    8082,
+				HTTPListener:                expectedHTTPListener,
 				HTTPSPort:                   0,
 				Warnings:                    []string{"Listener https-8442 is not defined in GlobalConfiguration"},
 			},
@@ -3118,7 +3639,9 @@ func TestDeleteGlobalConfigurationWithVirtualServerDeployedWithValidCustomListen
 				VirtualServer:               virtualServer,
 				VirtualServerRouteSelectors: map[string][]string{},
 				HTTPPort:                    8082,
+				HTTPListener:                expectedHTTPListener,
 				HTTPSPort:                   8442,
+				HTTPSListener:               expectedHTTPSListener,
 			},
 		},
 	}
@@ -3154,7 +3677,9 @@ func TestRenameHttpListenerInExistingGlobalConfigurationWithVirtualServerDeploye
 				VirtualServer:               virtualServer,
 				VirtualServerRouteSelectors: map[string][]string{},
 				HTTPPort:                    8082,
+				HTTPListener:                expectedHTTPListener,
 				HTTPSPort:                   8442,
+				HTTPSListener:               expectedHTTPSListener,
 			},
 		},
 	}
@@ -3169,6 +3694,7 @@ func TestRenameHttpListenerInExistingGlobalConfigurationWithVirtualServerDeploye
 				VirtualServerRouteSelectors: map[string][]string{},
 				HTTPPort:                    0,
 				HTTPSPort:                   8442,
+				HTTPSListener:               expectedHTTPSListener,
 				Warnings:                    []string{"Listener http-8082 is not defined in GlobalConfiguration"},
 			},
 		},
@@ -3192,7 +3718,9 @@ func TestRenameHttpsListenerInExistingGlobalConfigurationWithVirtualServerDeploy
 				VirtualServer:               virtualServer,
 				VirtualServerRouteSelectors: map[string][]string{},
 				HTTPPort:                    8082,
+				HTTPListener:                expectedHTTPListener,
 				HTTPSPort:                   8442,
+				HTTPSListener:               expectedHTTPSListener,
 			},
 		},
 	}
@@ -3205,6 +3733,7 @@ func TestRenameHttpsListenerInExistingGlobalConfigurationWithVirtualServerDeploy
 				VirtualServer:               virtualServer,
 				VirtualServerRouteSelectors: map[string][]string{},
 				HTTPPort:                    8082,
+				HTTPListener:                expectedHTTPListener,
 				HTTPSPort:                   0,
 				Warnings:                    []string{"Listener https-8442 is not defined in GlobalConfiguration"},
 			},
@@ -3234,6 +3763,7 @@ func TestAddVirtualServerWithCustomHttpListenerInHttpsBlock(t *testing.T) {
 				VirtualServer:               virtualServer,
 				VirtualServerRouteSelectors: map[string][]string{},
 				HTTPPort:                    8082,
+				HTTPListener:                expectedHTTPListener,
 				HTTPSPort:                   0,
 				Warnings:                    []string{expectedWarningMsg},
 			},
@@ -3265,6 +3795,7 @@ func TestAddVirtualServerWithCustomHttpsListenerInHttpBlock(t *testing.T) {
 				VirtualServerRouteSelectors: map[string][]string{},
 				HTTPPort:                    0,
 				HTTPSPort:                   8442,
+				HTTPSListener:               expectedHTTPSListener,
 				Warnings:                    []string{expectedWarningMsg},
 			},
 		},
@@ -3292,6 +3823,7 @@ func TestAddVirtualServerWithNoHttpsListener(t *testing.T) {
 				VirtualServer:               virtualServer,
 				VirtualServerRouteSelectors: map[string][]string{},
 				HTTPPort:                    8082,
+				HTTPListener:                expectedHTTPListener,
 				HTTPSPort:                   0,
 			},
 		},
@@ -3320,6 +3852,7 @@ func TestAddVirtualServerWithNoHttpListener(t *testing.T) {
 				VirtualServerRouteSelectors: map[string][]string{},
 				HTTPPort:                    0,
 				HTTPSPort:                   8442,
+				HTTPSListener:               expectedHTTPSListener,
 			},
 		},
 	}
@@ -3373,7 +3906,9 @@ func TestAddVirtualServerWithValidCustomListenersAndChangeValueOfSslToFalseInGlo
 				VirtualServer:               virtualServer,
 				VirtualServerRouteSelectors: map[string][]string{},
 				HTTPPort:                    8082,
+				HTTPListener:                expectedHTTPListener,
 				HTTPSPort:                   8442,
+				HTTPSListener:               expectedHTTPSListener,
 			},
 		},
 	}
@@ -3389,6 +3924,7 @@ func TestAddVirtualServerWithValidCustomListenersAndChangeValueOfSslToFalseInGlo
 				VirtualServer:               virtualServer,
 				VirtualServerRouteSelectors: map[string][]string{},
 				HTTPPort:                    8082,
+				HTTPListener:                expectedHTTPListener,
 				HTTPSPort:                   0,
 				Warnings:                    []string{expectedWarningMsg},
 			},
@@ -3417,7 +3953,9 @@ func TestAddVirtualServerWithValidCustomListenersAndChangeValueOfSslToTrueInGlob
 				VirtualServer:               virtualServer,
 				VirtualServerRouteSelectors: map[string][]string{},
 				HTTPPort:                    8082,
+				HTTPListener:                expectedHTTPListener,
 				HTTPSPort:                   8442,
+				HTTPSListener:               expectedHTTPSListener,
 			},
 		},
 	}
@@ -3434,6 +3972,7 @@ func TestAddVirtualServerWithValidCustomListenersAndChangeValueOfSslToTrueInGlob
 				VirtualServerRouteSelectors: map[string][]string{},
 				HTTPPort:                    0,
 				HTTPSPort:                   8442,
+				HTTPSListener:               expectedHTTPSListener,
 				Warnings:                    []string{expectedWarningMsg},
 			},
 		},
@@ -3467,7 +4006,9 @@ func TestAddMultipleVirtualServersWithTheSameCustomListeners(t *testing.T) {
 				VirtualServer:               virtualServerCafe,
 				VirtualServerRouteSelectors: map[string][]string{},
 				HTTPPort:                    8082,
+				HTTPListener:                expectedHTTPListener,
 				HTTPSPort:                   8442,
+				HTTPSListener:               expectedHTTPSListener,
 			},
 		},
 	}
@@ -3480,7 +4021,9 @@ func TestAddMultipleVirtualServersWithTheSameCustomListeners(t *testing.T) {
 				VirtualServer:               virtualServerFoo,
 				VirtualServerRouteSelectors: map[string][]string{},
 				HTTPPort:                    8082,
+				HTTPListener:                expectedHTTPListener,
 				HTTPSPort:                   8442,
+				HTTPSListener:               expectedHTTPSListener,
 			},
 		},
 	}
@@ -3839,6 +4382,12 @@ func createTestIngressMinion(name string, host string, path string) *networking.
 	return ing
 }
 
+func createTestIngressCanary(name string, host string) *networking.Ingress {
+	ing := createTestIngress(name, host)
+	ing.Annotations["nginx.org/canary"] = "true"
+	return ing
+}
+
 func createTestIngress(name string, hosts ...string) *networking.Ingress {
 	var rules []networking.IngressRule
 
@@ -4120,6 +4669,70 @@ func TestChooseObjectMetaWinner(t *testing.T) {
 			msg:      "both not older, but second wins",
 			expected: false,
 		},
+		{
+			meta1: &metav1.ObjectMeta{
+				UID:               "a",
+				CreationTimestamp: afterNow,
+				Annotations: map[string]string{
+					"nginx.org/host-priority": "10",
+				},
+			},
+			meta2: &metav1.ObjectMeta{
+				UID:               "b",
+				CreationTimestamp: now,
+			},
+			msg:      "first has higher priority",
+			expected: true,
+		},
+		{
+			meta1: &metav1.ObjectMeta{
+				UID:               "a",
+				CreationTimestamp: now,
+				Annotations: map[string]string{
+					"nginx.org/host-priority": "-1",
+				},
+			},
+			meta2: &metav1.ObjectMeta{
+				UID:               "b",
+				CreationTimestamp: afterNow,
+			},
+			msg:      "first has lower priority",
+			expected: false,
+		},
+		{
+			meta1: &metav1.ObjectMeta{
+				UID:               "a",
+				CreationTimestamp: now,
+				Annotations: map[string]string{
+					"nginx.org/host-priority": "10",
+					"nginx.org/host-standby":  "true",
+				},
+			},
+			meta2: &metav1.ObjectMeta{
+				UID:               "b",
+				CreationTimestamp: afterNow,
+			},
+			msg:      "first is standby",
+			expected: false,
+		},
+		{
+			meta1: &metav1.ObjectMeta{
+				UID:               "a",
+				CreationTimestamp: afterNow,
+				Annotations: map[string]string{
+					"nginx.org/host-standby": "true",
+				},
+			},
+			meta2: &metav1.ObjectMeta{
+				UID:               "b",
+				CreationTimestamp: now,
+				Annotations: map[string]string{
+					"nginx.org/host-standby": "true",
+				},
+			},
+			msg:      "both standby, second is older",
+			expected: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -4762,6 +5375,58 @@ func TestIsEqualForVirtualServers(t *testing.T) {
 	}
 }
 
+func TestIsEqualForTransportServers(t *testing.T) {
+	t.Parallel()
+	ts := createTestTransportServer("transportserver", "tcp-7777", "TCP")
+
+	newTSC := func(port int, acceptProxyProtocol bool, trustedCIDRs []string) *TransportServerConfiguration {
+		tsc := NewTransportServerConfiguration(ts)
+		tsc.ListenerPort = port
+		tsc.AcceptProxyProtocol = acceptProxyProtocol
+		tsc.TrustedCIDRs = trustedCIDRs
+		return tsc
+	}
+
+	tests := []struct {
+		tsConfig1 *TransportServerConfiguration
+		tsConfig2 *TransportServerConfiguration
+		expected  bool
+		msg       string
+	}{
+		{
+			tsConfig1: newTSC(7777, true, []string{"10.0.0.0/8"}),
+			tsConfig2: newTSC(7777, true, []string{"10.0.0.0/8"}),
+			expected:  true,
+			msg:       "equal transport servers",
+		},
+		{
+			tsConfig1: newTSC(7777, false, nil),
+			tsConfig2: newTSC(7778, false, nil),
+			expected:  false,
+			msg:       "transport servers with different listener ports",
+		},
+		{
+			tsConfig1: newTSC(7777, false, nil),
+			tsConfig2: newTSC(7777, true, nil),
+			expected:  false,
+			msg:       "transport servers with different proxy protocol settings",
+		},
+		{
+			tsConfig1: newTSC(7777, true, []string{"10.0.0.0/8"}),
+			tsConfig2: newTSC(7777, true, []string{"192.168.0.0/16"}),
+			expected:  false,
+			msg:       "transport servers with different trusted CIDRs",
+		},
+	}
+
+	for _, test := range tests {
+		result := test.tsConfig1.IsEqual(test.tsConfig2)
+		if result != test.expected {
+			t.Errorf("IsEqual() returned %v but expected %v for the case of %s", result, test.expected, test.msg)
+		}
+	}
+}
+
 func TestIsEqualForDifferentResources(t *testing.T) {
 	t.Parallel()
 	ingConfig := NewRegularIngressConfiguration(createTestIngress("ingress", "foo.example.com"))
@@ -4868,6 +5533,19 @@ var (
 	noProblems []ConfigurationProblem
 
 	// customHTTPAndHTTPSListeners defines a custom HTTP and HTTPS listener on port 8082 and 8442
+	expectedHTTPListener = &conf_v1.Listener{
+		Name:     "http-8082",
+		Port:     8082,
+		Protocol: "HTTP",
+	}
+
+	expectedHTTPSListener = &conf_v1.Listener{
+		Name:     "https-8442",
+		Port:     8442,
+		Protocol: "HTTP",
+		Ssl:      true,
+	}
+
 	customHTTPAndHTTPSListeners = []conf_v1.Listener{
 		{
 			Name:     "http-8082",
@@ -6052,3 +6730,41 @@ func TestValidateDuplicateVSRs(t *testing.T) {
 		})
 	}
 }
+
+func TestSetGatewayAPIResourcesWithHostConflict(t *testing.T) {
+	t.Parallel()
+	configuration := createTestConfiguration()
+
+	vs := createTestVirtualServer("cafe", "cafe.example.com")
+	configuration.AddOrUpdateVirtualServer(vs)
+
+	gw := createTestGateway(gateway_v1.Listener{Name: "http", Port: 80, Protocol: gateway_v1.HTTPProtocolType})
+	route := createTestHTTPRoute("default", "cafe", "cafe.example.com")
+	route.Spec.Rules = []gateway_v1.HTTPRouteRule{{BackendRefs: []gateway_v1.HTTPBackendRef{{BackendRef: createTestBackendRef("tea", 80, 1)}}}}
+	translator := newGatewayAPITranslator("nginx.org/ingress-controller", 443)
+
+	changes, _ := configuration.SetGatewayAPIResources(translator, gatewayAPIResources{
+		Gateways:   []*gateway_v1.Gateway{gw},
+		HTTPRoutes: []*gateway_v1.HTTPRoute{route},
+	})
+	if len(changes) != 0 {
+		t.Errorf("SetGatewayAPIResources() returned %d changes but expected none", len(changes))
+	}
+
+	_, routeParentStatuses := configuration.GetGatewayAPIStatuses()
+	cond := getAcceptedCondition(routeParentStatuses["HTTPRoute/default/cafe"])
+	if cond == nil || cond.Reason != string(routeReasonHostnameConflict) {
+		t.Errorf("GetGatewayAPIStatuses() returned the Accepted condition %v but expected the reason %s", cond, routeReasonHostnameConflict)
+	}
+
+	changes, _ = configuration.DeleteVirtualServer("default/cafe")
+	if len(changes) != 2 || changes[1].Op != AddOrUpdate || changes[1].Resource.GetObjectMeta().Name != "httproute_cafe" {
+		t.Errorf("DeleteVirtualServer() returned %v but expected the translated VirtualServer to take the host", changes)
+	}
+
+	_, routeParentStatuses = configuration.GetGatewayAPIStatuses()
+	cond = getAcceptedCondition(routeParentStatuses["HTTPRoute/default/cafe"])
+	if cond == nil || cond.Status != metav1.ConditionTrue {
+		t.Errorf("GetGatewayAPIStatuses() returned the Accepted condition %v but expected True", cond)
+	}
+}
@@ -44,10 +44,12 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/leaderelection"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
 
 	cm_controller "github.com/nginx/kubernetes-ingress/internal/certmanager"
 	"github.com/nginx/kubernetes-ingress/internal/configs"
@@ -70,6 +72,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/dynamic/dynamicinformer"
+	gateway_client "sigs.k8s.io/gateway-api/pkg/client/clientset/versioned"
+	gateway_informers "sigs.k8s.io/gateway-api/pkg/client/informers/externalversions"
 )
 
 const (
@@ -80,6 +84,11 @@ const (
 	typeKeyword                                     = "type"
 	helmReleaseType                                 = "helm.sh/release.v1"
 	splitClientAmountWhenWeightChangesDynamicReload = 101
+	circuitBreakerTaskKey                           = "circuit-breaker"
+	circuitBreakerInterval                          = 5 * time.Second
+	streamHealthProbeTaskKey                        = "stream-health-probe"
+	streamHealthProbeInterval                       = time.Second
+	reloadFlushInterval                             = 100 * time.Millisecond
 )
 
 var (
@@ -121,15 +130,19 @@ type LoadBalancerController struct {
 	client                        kubernetes.Interface
 	confClient                    k8s_nginx.Interface
 	dynClient                     dynamic.Interface
+	gatewayClient                 gateway_client.Interface
 	restConfig                    *rest.Config
 	cacheSyncs                    []cache.InformerSynced
 	namespacedInformers           map[string]*namespacedInformer
 	configMapController           cache.Controller
 	mgmtConfigMapController       cache.Controller
+	hostOwnershipController       cache.Controller
 	globalConfigurationController cache.Controller
 	ingressLinkInformer           cache.SharedIndexInformer
+	gatewayClassInformer          cache.SharedIndexInformer
 	configMapLister               storeToConfigMapLister
 	mgmtConfigMapLister           storeToConfigMapLister
+	hostOwnershipLister           storeToConfigMapLister
 	globalConfigurationLister     cache.Store
 	ingressLinkLister             cache.Store
 	namespaceLabeledLister        cache.Store
@@ -140,6 +153,7 @@ type LoadBalancerController struct {
 	configurator                  *configs.Configurator
 	watchNginxConfigMaps          bool
 	watchMGMTConfigMap            bool
+	watchHostOwnership            bool
 	watchGlobalConfiguration      bool
 	watchIngressLink              bool
 	isNginxPlus                   bool
@@ -169,6 +183,8 @@ type LoadBalancerController struct {
 	isPrometheusEnabled           bool
 	isLatencyMetricsEnabled       bool
 	configuration                 *Configuration
+	gatewayAPITranslator          *gatewayAPITranslator
+	gatewayAPIV1alpha2Kinds       map[string]bool
 	secretStore                   secrets.SecretStore
 	appProtectConfiguration       appprotect.Configuration
 	dosConfiguration              *appprotectdos.Configuration
@@ -179,13 +195,20 @@ type LoadBalancerController struct {
 	batchSyncEnabled              bool
 	updateAllConfigsOnBatch       bool
 	enableBatchReload             bool
+	batchLock                     sync.Mutex
 	isIPV6Disabled                bool
 	namespaceWatcherController    cache.Controller
 	telemetryCollector            *telemetry.Collector
 	telemetryChan                 chan struct{}
 	weightChangesDynamicReload    bool
+	streamHealthProbesEnabled     bool
+	reloadCoalescingEnabled       bool
+	pendingReloadResources        map[string]Resource
+	pendingReloadLock             sync.Mutex
 	nginxConfigMapName            string
 	mgmtConfigMapName             string
+	hostOwnershipConfigMapName    string
+	defaultBackendRefs            defaultBackendReferences
 	ShuttingDown                  bool
 }
 
@@ -196,6 +219,7 @@ type NewLoadBalancerControllerInput struct {
 	KubeClient                   kubernetes.Interface
 	ConfClient                   k8s_nginx.Interface
 	DynClient                    dynamic.Interface
+	GatewayClient                gateway_client.Interface
 	RestConfig                   *rest.Config
 	Recorder                     record.EventRecorder
 	ResyncPeriod                 time.Duration
@@ -219,8 +243,11 @@ type NewLoadBalancerControllerInput struct {
 	WildcardTLSSecret            string
 	ConfigMaps                   string
 	MGMTConfigMap                string
+	HostOwnershipConfigMap       string
 	GlobalConfiguration          string
 	AreCustomResourcesEnabled    bool
+	EnableGatewayAPI             bool
+	GatewayControllerName        string
 	EnableOIDC                   bool
 	MetricsCollector             collectors.ControllerCollector
 	GlobalConfigurationValidator *validation.GlobalConfigurationValidator
@@ -243,6 +270,10 @@ type NewLoadBalancerControllerInput struct {
 	BuildOS                      string
 	NICVersion                   string
 	DynamicWeightChangesReload   bool
+	StreamHealthProbesEnabled    bool
+	ReloadCoalescingEnabled      bool
+	SyncWorkers                  int
+	WorkQueueMetricsProvider     workqueue.MetricsProvider
 	InstallationFlags            []string
 	ShuttingDown                 bool
 }
@@ -288,12 +319,15 @@ func NewLoadBalancerController(input NewLoadBalancerControllerInput) *LoadBalanc
 		isLatencyMetricsEnabled:      input.IsLatencyMetricsEnabled,
 		isIPV6Disabled:               input.IsIPV6Disabled,
 		weightChangesDynamicReload:   input.DynamicWeightChangesReload,
+		streamHealthProbesEnabled:    input.StreamHealthProbesEnabled,
+		reloadCoalescingEnabled:      input.ReloadCoalescingEnabled,
 		nginxConfigMapName:           input.ConfigMaps,
 		mgmtConfigMapName:            input.MGMTConfigMap,
+		hostOwnershipConfigMapName:   input.HostOwnershipConfigMap,
 		ShuttingDown:                 input.ShuttingDown,
 	}
 
-	lbc.syncQueue = newTaskQueue(lbc.Logger, lbc.sync)
+	lbc.syncQueue = newTaskQueue(lbc.Logger, lbc.sync, input.SyncWorkers, input.WorkQueueMetricsProvider)
 	var err error
 	if input.SpireAgentAddress != "" {
 		lbc.spiffeCertFetcher, err = spiffe.NewX509CertFetcher(input.SpireAgentAddress, nil)
@@ -302,6 +336,12 @@ func NewLoadBalancerController(input NewLoadBalancerControllerInput) *LoadBalanc
 		}
 	}
 
+	if input.EnableGatewayAPI {
+		lbc.gatewayClient = input.GatewayClient
+		lbc.gatewayAPITranslator = newGatewayAPITranslator(input.GatewayControllerName, input.TLSPassthroughPort)
+		lbc.recorder = gatewayAPIEventRecorder{EventRecorder: input.Recorder}
+	}
+
 	isDynamicNs := input.WatchNamespaceLabel != ""
 
 	if isDynamicNs {
@@ -309,11 +349,11 @@ func NewLoadBalancerController(input NewLoadBalancerControllerInput) *LoadBalanc
 	}
 
 	if input.CertManagerEnabled {
-		lbc.certManagerController = cm_controller.NewCmController(cm_controller.BuildOpts(input.LoggerContext, lbc.restConfig, lbc.client, lbc.namespaceList, lbc.recorder, lbc.confClient, isDynamicNs))
+		lbc.certManagerController = cm_controller.NewCmController(cm_controller.BuildOpts(input.LoggerContext, lbc.restConfig, lbc.client, lbc.namespaceList, lbc.recorder, lbc.confClient, isDynamicNs, lbc.getServerAliasesForVirtualServer))
 	}
 
 	if input.ExternalDNSEnabled {
-		lbc.externalDNSController = ed_controller.NewController(ed_controller.BuildOpts(input.LoggerContext, lbc.namespaceList, lbc.recorder, lbc.confClient, input.ResyncPeriod, isDynamicNs))
+		lbc.externalDNSController = ed_controller.NewController(ed_controller.BuildOpts(input.LoggerContext, lbc.namespaceList, lbc.recorder, lbc.confClient, input.ResyncPeriod, isDynamicNs, lbc.getServerAliasesForVirtualServer))
 	}
 
 	nl.Debugf(lbc.Logger, "Nginx Ingress Controller has class: %v", input.IngressClass)
@@ -333,6 +373,11 @@ func NewLoadBalancerController(input NewLoadBalancerControllerInput) *LoadBalanc
 			ns, name, _ := ParseNamespaceName(input.GlobalConfiguration)
 			lbc.addGlobalConfigurationHandler(createGlobalConfigurationHandlers(lbc), ns, name)
 		}
+
+		if input.EnableGatewayAPI {
+			lbc.gatewayAPIV1alpha2Kinds = lbc.discoverGatewayAPIV1alpha2Kinds()
+			lbc.addGatewayClassHandler(createGatewayAPIHandlers(lbc))
+		}
 	}
 
 	if input.ConfigMaps != "" {
@@ -355,6 +400,16 @@ func NewLoadBalancerController(input NewLoadBalancerControllerInput) *LoadBalanc
 		}
 	}
 
+	if input.HostOwnershipConfigMap != "" {
+		hostOwnershipConfigMapNS, hostOwnershipConfigMapName, err := ParseNamespaceName(input.HostOwnershipConfigMap)
+		if err != nil {
+			nl.Warn(lbc.Logger, err)
+		} else {
+			lbc.watchHostOwnership = true
+			lbc.addHostOwnershipConfigMapHandler(createConfigMapHandlers(lbc, hostOwnershipConfigMapName), hostOwnershipConfigMapNS)
+		}
+	}
+
 	if input.IngressLink != "" {
 		lbc.watchIngressLink = true
 		lbc.addIngressLinkHandler(createIngressLinkHandlers(lbc), input.IngressLink)
@@ -452,6 +507,7 @@ type namespacedInformer struct {
 	confSharedInformerFactory    k8s_nginx_informers.SharedInformerFactory
 	secretInformerFactory        informers.SharedInformerFactory
 	dynInformerFactory           dynamicinformer.DynamicSharedInformerFactory
+	gatewaySharedInformerFactory gateway_informers.SharedInformerFactory
 	ingressLister                storeToIngressLister
 	svcLister                    cache.Store
 	endpointSliceLister          storeToEndpointSliceLister
@@ -467,10 +523,16 @@ type namespacedInformer struct {
 	appProtectUserSigLister      cache.Store
 	transportServerLister        cache.Store
 	policyLister                 cache.Store
+	configMapLister              cache.Store
+	gatewayLister                cache.Store
+	httpRouteLister              cache.Store
+	tlsRouteLister               cache.Store
+	tcpRouteLister               cache.Store
 	isSecretsEnabledNamespace    bool
 	areCustomResourcesEnabled    bool
 	appProtectEnabled            bool
 	appProtectDosEnabled         bool
+	isGatewayAPIEnabled          bool
 	stopCh                       chan struct{}
 	lock                         sync.RWMutex
 	cacheSyncs                   []cache.InformerSynced
@@ -487,6 +549,7 @@ func (lbc *LoadBalancerController) newNamespacedInformer(ns string) *namespacedI
 	nsi.addServiceHandler(createServiceHandlers(lbc))
 	nsi.addEndpointSliceHandler(createEndpointSliceHandlers(lbc))
 	nsi.addPodHandler()
+	nsi.addDefaultErrorPagesConfigMapHandler(createDefaultErrorPagesConfigMapHandlers(lbc))
 
 	secretsTweakListOptionsFunc := func(options *meta_v1.ListOptions) {
 		// Filter for helm release secrets.
@@ -518,7 +581,13 @@ func (lbc *LoadBalancerController) newNamespacedInformer(ns string) *namespacedI
 		nsi.addVirtualServerRouteHandler(createVirtualServerRouteHandlers(lbc))
 		nsi.addTransportServerHandler(createTransportServerHandlers(lbc))
 		nsi.addPolicyHandler(createPolicyHandlers(lbc))
+		nsi.addMaintenanceConfigMapHandler(createMaintenanceConfigMapHandlers(lbc))
 
+		if lbc.gatewayClient != nil {
+			nsi.isGatewayAPIEnabled = true
+			nsi.gatewaySharedInformerFactory = gateway_informers.NewSharedInformerFactoryWithOptions(lbc.gatewayClient, lbc.resync, gateway_informers.WithNamespace(ns))
+			nsi.addGatewayAPIHandlers(createGatewayAPIHandlers(lbc), lbc.gatewayAPIV1alpha2Kinds)
+		}
 	}
 
 	if lbc.appProtectEnabled || lbc.appProtectDosEnabled {
@@ -659,12 +728,19 @@ func (lbc *LoadBalancerController) Run() {
 		go lbc.mgmtConfigMapController.Run(lbc.ctx.Done())
 	}
 
+	if lbc.watchHostOwnership {
+		go lbc.hostOwnershipController.Run(lbc.ctx.Done())
+	}
+
 	if lbc.watchGlobalConfiguration {
 		go lbc.globalConfigurationController.Run(lbc.ctx.Done())
 	}
 	if lbc.watchIngressLink {
 		go lbc.ingressLinkInformer.Run(lbc.ctx.Done())
 	}
+	if lbc.gatewayClassInformer != nil {
+		go lbc.gatewayClassInformer.Run(lbc.ctx.Done())
+	}
 
 	totalCacheSyncs := lbc.cacheSyncs
 
@@ -679,9 +755,26 @@ func (lbc *LoadBalancerController) Run() {
 	}
 
 	lbc.preSyncSecrets()
+	lbc.preSyncHostOwnershipRules()
 
 	nl.Debugf(lbc.Logger, "Starting the queue with %d initial elements", lbc.syncQueue.Len())
 
+	if lbc.isNginxPlus && lbc.areCustomResourcesEnabled {
+		go wait.Until(func() {
+			lbc.syncQueue.AddTask(task{Kind: circuitBreaker, Key: circuitBreakerTaskKey})
+		}, circuitBreakerInterval, lbc.ctx.Done())
+	}
+
+	if !lbc.isNginxPlus && lbc.areCustomResourcesEnabled && lbc.streamHealthProbesEnabled {
+		go wait.Until(func() {
+			lbc.syncQueue.AddTask(task{Kind: streamHealthProbe, Key: streamHealthProbeTaskKey})
+		}, streamHealthProbeInterval, lbc.ctx.Done())
+	}
+
+	if lbc.reloadCoalescingEnabled {
+		go wait.Until(lbc.syncReloadFlush, reloadFlushInterval, lbc.ctx.Done())
+	}
+
 	go lbc.syncQueue.Run(time.Second, lbc.ctx.Done())
 	<-lbc.ctx.Done()
 }
@@ -706,6 +799,10 @@ func (nsi *namespacedInformer) start() {
 		go nsi.confSharedInformerFactory.Start(nsi.stopCh)
 	}
 
+	if nsi.isGatewayAPIEnabled {
+		go nsi.gatewaySharedInformerFactory.Start(nsi.stopCh)
+	}
+
 	if nsi.appProtectEnabled || nsi.appProtectDosEnabled {
 		go nsi.dynInformerFactory.Start(nsi.stopCh)
 	}
@@ -873,11 +970,11 @@ func (lbc *LoadBalancerController) createExtendedResources(resources []Resource)
 				mergeableIng := lbc.createMergeableIngresses(impl)
 				result.MergeableIngresses = append(result.MergeableIngresses, mergeableIng)
 			} else {
-				ingEx := lbc.createIngressEx(impl.Ingress, impl.ValidHosts, nil)
+				ingEx := lbc.createRegularIngressEx(impl)
 				result.IngressExes = append(result.IngressExes, ingEx)
 			}
 		case *TransportServerConfiguration:
-			tsEx := lbc.createTransportServerEx(impl.TransportServer, impl.ListenerPort, impl.IPv4, impl.IPv6)
+			tsEx := lbc.createTransportServerEx(impl)
 			result.TransportServerExes = append(result.TransportServerExes, tsEx)
 		}
 	}
@@ -897,6 +994,7 @@ func (lbc *LoadBalancerController) updateAllConfigs() {
 	if lbc.configMap != nil {
 		cfgParams, isNGINXConfigValid = configs.ParseConfigMap(ctx, lbc.configMap, lbc.isNginxPlus, lbc.appProtectEnabled, lbc.appProtectDosEnabled, lbc.configuration.isTLSPassthroughEnabled, lbc.configuration.isDirectiveAutoadjustEnabled, lbc.recorder)
 	}
+	lbc.updateDefaultBackendConfig(cfgParams)
 	if lbc.mgmtConfigMap != nil && lbc.isNginxPlus {
 		mgmtCfgParams, mgmtConfigHasWarnings, mgmtErr = configs.ParseMGMTConfigMap(ctx, lbc.mgmtConfigMap, lbc.recorder)
 		if mgmtErr != nil {
@@ -1013,37 +1111,71 @@ func (lbc *LoadBalancerController) preSyncSecrets() {
 	}
 }
 
+// sync processes a task.
+// The tasks run one at a time under syncLock, as the changes of the Configuration must be applied in the order they
+// are computed. The Configurator generates the configuration of the resources of a task concurrently.
 func (lbc *LoadBalancerController) sync(task task) {
+	lbc.startBatchSync(task)
+	nl.Debugf(lbc.Logger, "Syncing %v", task.Key)
+
+	lbc.syncLock.Lock()
+	lbc.syncTask(task)
+	lbc.syncLock.Unlock()
+
+	lbc.finishSync()
+}
+
+// startBatchSync disables the reloads when there are more tasks in the queue, so that they are applied in a single reload.
+func (lbc *LoadBalancerController) startBatchSync(task task) {
+	lbc.batchLock.Lock()
+	defer lbc.batchLock.Unlock()
+
 	if lbc.isNginxReady && lbc.syncQueue.Len() > 1 && !lbc.batchSyncEnabled {
 		lbc.configurator.DisableReloads()
 		lbc.batchSyncEnabled = true
 
 		nl.Debugf(lbc.Logger, "Batch processing %v items", lbc.syncQueue.Len())
 	}
-	nl.Debugf(lbc.Logger, "Syncing %v", task.Key)
-	if lbc.spiffeCertFetcher != nil {
-		lbc.syncLock.Lock()
-		defer lbc.syncLock.Unlock()
-	}
-	if lbc.batchSyncEnabled && task.Kind != endpointslice {
+	if lbc.batchSyncEnabled && task.Kind != endpointslice && task.Kind != circuitBreaker && task.Kind != streamHealthProbe {
 		nl.Debug(lbc.Logger, "Task is not endpointslice - enabling batch reload")
 		lbc.enableBatchReload = true
 	}
+	if lbc.batchSyncEnabled && task.Kind == configMap {
+		lbc.updateAllConfigsOnBatch = true
+	}
+}
+
+// isBatchSyncEnabled returns true if the reloads are disabled until the tasks in the queue are processed.
+func (lbc *LoadBalancerController) isBatchSyncEnabled() bool {
+	lbc.batchLock.Lock()
+	defer lbc.batchLock.Unlock()
+
+	return lbc.batchSyncEnabled
+}
+
+// enableBatchReloadForEndpoints makes the batch sync reload NGINX for the endpoints that the resources reference.
+func (lbc *LoadBalancerController) enableBatchReloadForEndpoints(key string) {
+	lbc.batchLock.Lock()
+	defer lbc.batchLock.Unlock()
+
+	if lbc.batchSyncEnabled {
+		nl.Debugf(lbc.Logger, "Endpointslice %v is referenced - enabling batch reload", key)
+		lbc.enableBatchReload = true
+	}
+}
+
+func (lbc *LoadBalancerController) syncTask(task task) {
 	switch task.Kind {
 	case ingress:
 		lbc.syncIngress(task)
 		lbc.updateIngressMetrics()
 		lbc.updateTransportServerMetrics()
 	case configMap:
-		if lbc.batchSyncEnabled {
-			lbc.updateAllConfigsOnBatch = true
-		}
 		lbc.syncConfigMap(task)
 	case endpointslice:
 		resourcesFound := lbc.syncEndpointSlices(task)
-		if lbc.batchSyncEnabled && resourcesFound {
-			nl.Debugf(lbc.Logger, "Endpointslice %v is referenced - enabling batch reload", task.Key)
-			lbc.enableBatchReload = true
+		if resourcesFound {
+			lbc.enableBatchReloadForEndpoints(task.Key)
 		}
 	case secret:
 		lbc.syncSecret(task)
@@ -1081,6 +1213,14 @@ func (lbc *LoadBalancerController) sync(task task) {
 		lbc.syncDosProtectedResource(task)
 	case ingressLink:
 		lbc.syncIngressLink(task)
+	case circuitBreaker:
+		lbc.syncCircuitBreakers()
+	case streamHealthProbe:
+		lbc.syncStreamHealthProbes()
+	case gatewayAPI:
+		lbc.syncGatewayAPI()
+		lbc.updateVirtualServerMetrics()
+		lbc.updateTransportServerMetrics()
 	}
 
 	if lbc.isNginxPlus && lbc.isNginxReady {
@@ -1092,26 +1232,56 @@ func (lbc *LoadBalancerController) sync(task task) {
 		}
 	}
 
-	if !lbc.isNginxReady && lbc.syncQueue.Len() == 0 {
+}
+
+// finishSync applies the configuration once the queue is empty: at the start, NGINX becomes ready,
+// and a batch sync reloads NGINX once for all the tasks in the batch.
+func (lbc *LoadBalancerController) finishSync() {
+	if lbc.syncQueue.Len() != 0 {
+		return
+	}
+
+	lbc.batchLock.Lock()
+	pending := !lbc.isNginxReady || lbc.batchSyncEnabled
+	lbc.batchLock.Unlock()
+	if !pending {
+		return
+	}
+
+	lbc.syncLock.Lock()
+	defer lbc.syncLock.Unlock()
+
+	lbc.batchLock.Lock()
+	becomeReady := !lbc.isNginxReady && lbc.syncQueue.Len() == 0
+	completeBatch := lbc.batchSyncEnabled && lbc.syncQueue.Len() == 0
+	updateAllConfigs := lbc.updateAllConfigsOnBatch
+	enableBatchReload := lbc.enableBatchReload
+	lbc.batchLock.Unlock()
+
+	if becomeReady {
 		lbc.configurator.EnableReloads()
 		lbc.updateAllConfigs()
 
+		lbc.batchLock.Lock()
 		lbc.isNginxReady = true
+		lbc.batchLock.Unlock()
 		nl.Debug(lbc.Logger, "NGINX is ready")
 	}
 
-	if lbc.batchSyncEnabled && lbc.syncQueue.Len() == 0 {
-		lbc.batchSyncEnabled = false
+	if completeBatch {
 		lbc.configurator.EnableReloads()
-		if lbc.updateAllConfigsOnBatch {
+		if updateAllConfigs {
 			lbc.updateAllConfigs()
 		} else {
-			if err := lbc.configurator.ReloadForBatchUpdates(lbc.enableBatchReload); err != nil {
+			if err := lbc.configurator.ReloadForBatchUpdates(enableBatchReload); err != nil {
 				nl.Errorf(lbc.Logger, "error reloading for batch updates: %v", err)
 			}
 		}
 
+		lbc.batchLock.Lock()
+		lbc.batchSyncEnabled = false
 		lbc.enableBatchReload = false
+		lbc.batchLock.Unlock()
 		nl.Debug(lbc.Logger, "Batch sync completed - disabling batch reload")
 	}
 }
@@ -1234,12 +1404,84 @@ func (lbc *LoadBalancerController) syncVirtualServer(task task) {
 
 	lbc.processChanges(changes)
 	lbc.processProblems(problems)
+
+	// The problems of a standby VirtualServer are reported with the validation of its config.
+	// If its host problem hasn't changed, the config is validated again here, as the VirtualServer might have changed.
+	if vsExists && !hasProblemForVirtualServer(problems, key) {
+		lbc.updateStandbyVirtualServerStatus(key)
+	}
+}
+
+// hasProblemForVirtualServer checks if one of the problems is reported for the VirtualServer with the key.
+func hasProblemForVirtualServer(problems []ConfigurationProblem, key string) bool {
+	for _, p := range problems {
+		if vs, ok := p.Object.(*conf_v1.VirtualServer); ok && getResourceKey(&vs.ObjectMeta) == key {
+			return true
+		}
+	}
+	return false
+}
+
+// updateStandbyVirtualServerStatus updates the status of a standby VirtualServer that does not hold its host
+// with its host problem merged with the validation of its config.
+func (lbc *LoadBalancerController) updateStandbyVirtualServerStatus(key string) {
+	standby, exists := lbc.configuration.getStandbyVirtualServer(key)
+	if !exists {
+		return
+	}
+
+	p, exists := lbc.configuration.getHostProblem(standby.config.GetKeyWithKind())
+	if !exists {
+		return
+	}
+	p = lbc.validateStandbyVirtualServer(standby, p)
+
+	vs := standby.config.VirtualServer
+	if p.IsError {
+		lbc.recorder.Event(vs, api_v1.EventTypeWarning, p.Reason, p.Message)
+	}
+
+	if lbc.reportCustomResourceStatusEnabled() {
+		state := conf_v1.StateWarning
+		if p.IsError {
+			state = conf_v1.StateInvalid
+		}
+
+		err := lbc.statusUpdater.UpdateVirtualServerStatus(vs, state, p.Reason, p.Message)
+		if err != nil {
+			nl.Errorf(lbc.Logger, "Error when updating the status for VirtualServer %v/%v: %v", vs.Namespace, vs.Name, err)
+		}
+	}
+}
+
+// validateStandbyVirtualServer validates the config of a standby VirtualServer that does not hold its host,
+// so that a standby which cannot take over its host is reported before the takeover.
+// It returns the host problem of the VirtualServer with the result of the validation.
+func (lbc *LoadBalancerController) validateStandbyVirtualServer(standby standbyVirtualServer, p ConfigurationProblem) ConfigurationProblem {
+	vsEx := lbc.createVirtualServerEx(standby.config.VirtualServer, standby.config.VirtualServerRoutes, standby.config.VirtualServerRouteSelectors)
+
+	if _, err := lbc.configurator.ValidateVirtualServer(vsEx); err != nil {
+		p.IsError = true
+		p.Message = fmt.Sprintf("%s; standby configuration is invalid: %v", p.Message, err)
+	} else {
+		p.Message = fmt.Sprintf("%s; standby configuration is ready", p.Message)
+	}
+
+	return p
 }
 
 func (lbc *LoadBalancerController) processProblems(problems []ConfigurationProblem) {
 	nl.Debugf(lbc.Logger, "Processing %v problems", len(problems))
 
+	lbc.enqueueGatewayAPIStatusUpdate(nil, problems)
+
 	for _, p := range problems {
+		if vs, ok := p.Object.(*conf_v1.VirtualServer); ok {
+			if standby, exists := lbc.configuration.getStandbyVirtualServer(getResourceKey(&vs.ObjectMeta)); exists {
+				p = lbc.validateStandbyVirtualServer(standby, p)
+			}
+		}
+
 		eventType := api_v1.EventTypeWarning
 		lbc.recorder.Event(p.Object, eventType, p.Reason, p.Message)
 
@@ -1276,9 +1518,49 @@ func (lbc *LoadBalancerController) processProblems(problems []ConfigurationProbl
 	}
 }
 
+// getServerAliasesForVirtualServer returns the server aliases held by the VirtualServer.
+// The configuration is created after the cert-manager and ExternalDNS controllers, so it is looked up on each call.
+func (lbc *LoadBalancerController) getServerAliasesForVirtualServer(vs *conf_v1.VirtualServer) []string {
+	return lbc.configuration.GetServerAliasesForVirtualServer(vs)
+}
+
+// enqueueServerAliasesSync enqueues the VirtualServer in the cert-manager and ExternalDNS controllers,
+// so that its Certificates and DNSEndpoint are synced with the server aliases it holds.
+func (lbc *LoadBalancerController) enqueueServerAliasesSync(vs *conf_v1.VirtualServer) {
+	if len(vs.Spec.ServerAliases) == 0 {
+		return
+	}
+	if lbc.certManagerController != nil {
+		lbc.certManagerController.EnqueueVirtualServer(vs.Namespace, vs.Name)
+	}
+	if lbc.externalDNSController != nil {
+		lbc.externalDNSController.EnqueueVirtualServer(vs.Namespace, vs.Name)
+	}
+}
+
 func (lbc *LoadBalancerController) processChanges(changes []ResourceChange) {
 	nl.Debugf(lbc.Logger, "Processing %v changes", len(changes))
 
+	lbc.enqueueGatewayAPIStatusUpdate(changes, nil)
+
+	// When a resource takes over the hosts of another resource, the config of the previous holder is deleted
+	// and the config of the new holder is added. Both are applied in a single reload, so that the hosts are always served.
+	// The batch lock is held until the takeover is applied, so that a batch sync doesn't start in between.
+	if lbc.isNginxReady && isHostTakeover(changes) {
+		lbc.batchLock.Lock()
+		defer lbc.batchLock.Unlock()
+
+		if !lbc.batchSyncEnabled {
+			lbc.configurator.DisableReloads()
+			defer func() {
+				lbc.configurator.EnableReloads()
+				if err := lbc.configurator.ReloadForBatchUpdates(true); err != nil {
+					nl.Errorf(lbc.Logger, "error reloading for host takeover: %v", err)
+				}
+			}()
+		}
+	}
+
 	for _, c := range changes {
 		if c.Op == AddOrUpdate {
 			switch impl := c.Resource.(type) {
@@ -1286,25 +1568,29 @@ func (lbc *LoadBalancerController) processChanges(changes []ResourceChange) {
 				vsEx := lbc.createVirtualServerEx(impl.VirtualServer, impl.VirtualServerRoutes, impl.VirtualServerRouteSelectors)
 
 				warnings, addOrUpdateErr := lbc.configurator.AddOrUpdateVirtualServer(vsEx)
+				lbc.addPendingReloadResourcesIfDeferred([]Resource{impl}, addOrUpdateErr)
 				lbc.updateVirtualServerStatusAndEvents(impl, warnings, addOrUpdateErr)
+				lbc.enqueueServerAliasesSync(impl.VirtualServer)
 			case *IngressConfiguration:
 				if impl.IsMaster {
 					mergeableIng := lbc.createMergeableIngresses(impl)
 
 					warnings, addOrUpdateErr := lbc.configurator.AddOrUpdateMergeableIngress(mergeableIng)
+					lbc.addPendingReloadResourcesIfDeferred([]Resource{impl}, addOrUpdateErr)
 					ingForEvent := mergeIngressPolicyWarnings(impl, mergeableIng.Master, mergeableIng.Minions)
 					lbc.updateMergeableIngressStatusAndEvents(ingForEvent, warnings, addOrUpdateErr)
 				} else {
-					// for regular Ingress, validMinionPaths is nil
-					ingEx := lbc.createIngressEx(impl.Ingress, impl.ValidHosts, nil)
+					ingEx := lbc.createRegularIngressEx(impl)
 
 					warnings, addOrUpdateErr := lbc.configurator.AddOrUpdateIngress(ingEx)
+					lbc.addPendingReloadResourcesIfDeferred([]Resource{impl}, addOrUpdateErr)
 					ingForEvent := mergeIngressPolicyWarnings(impl, ingEx, nil)
 					lbc.updateRegularIngressStatusAndEvents(ingForEvent, warnings, addOrUpdateErr)
 				}
 			case *TransportServerConfiguration:
-				tsEx := lbc.createTransportServerEx(impl.TransportServer, impl.ListenerPort, impl.IPv4, impl.IPv6)
+				tsEx := lbc.createTransportServerEx(impl)
 				warnings, addOrUpdateErr := lbc.configurator.AddOrUpdateTransportServer(tsEx)
+				lbc.addPendingReloadResourcesIfDeferred([]Resource{impl}, addOrUpdateErr)
 				lbc.updateTransportServerStatusAndEvents(impl, warnings, addOrUpdateErr)
 			}
 		} else if c.Op == Delete {
@@ -1328,6 +1614,7 @@ func (lbc *LoadBalancerController) processChanges(changes []ResourceChange) {
 
 				if vsExists {
 					lbc.UpdateVirtualServerStatusAndEventsOnDelete(impl, c.Error, deleteErr)
+					lbc.enqueueServerAliasesSync(impl.VirtualServer)
 				}
 			case *IngressConfiguration:
 				key := getResourceKey(&impl.Ingress.ObjectMeta)
@@ -1376,6 +1663,20 @@ func (lbc *LoadBalancerController) processChanges(changes []ResourceChange) {
 	}
 }
 
+// isHostTakeover checks if the changes both delete and add or update resources.
+func isHostTakeover(changes []ResourceChange) bool {
+	var hasDelete, hasAddOrUpdate bool
+	for _, c := range changes {
+		switch c.Op {
+		case Delete:
+			hasDelete = true
+		case AddOrUpdate:
+			hasAddOrUpdate = true
+		}
+	}
+	return hasDelete && hasAddOrUpdate
+}
+
 // UpdateVirtualServerStatusAndEventsOnDelete updates the virtual server status and events
 func (lbc *LoadBalancerController) UpdateVirtualServerStatusAndEventsOnDelete(vsConfig *VirtualServerConfiguration, changeError string, deleteErr error) {
 	eventType := api_v1.EventTypeWarning
@@ -1453,6 +1754,8 @@ func (lbc *LoadBalancerController) UpdateIngressStatusAndEventsOnDelete(ingConfi
 }
 
 func (lbc *LoadBalancerController) updateResourcesStatusAndEvents(resources []Resource, warnings configs.Warnings, operationErr error) {
+	lbc.addPendingReloadResourcesIfDeferred(resources, operationErr)
+
 	for _, r := range resources {
 		switch impl := r.(type) {
 		case *VirtualServerConfiguration:
@@ -1580,7 +1883,43 @@ func (lbc *LoadBalancerController) updateRegularIngressStatusAndEvents(ingConfig
 	msg := fmt.Sprintf("Configuration for %v was added or updated %s", getResourceKey(&ingConfig.Ingress.ObjectMeta), eventWarningMessage)
 	lbc.recorder.Eventf(ingConfig.Ingress, eventType, eventTitle, msg)
 
+	for _, canary := range ingConfig.Canaries {
+		canaryEventType := api_v1.EventTypeNormal
+		canaryEventTitle := nl.EventReasonAddedOrUpdated
+		canaryEventWarningMessage := ""
+
+		if messages, ok := warnings[canary]; ok {
+			canaryEventType = api_v1.EventTypeWarning
+			canaryEventTitle = nl.EventReasonAddedOrUpdatedWithWarning
+			canaryEventWarningMessage = fmt.Sprintf("with warning(s): %v", formatWarningMessages(messages))
+		}
+
+		if operationErr != nil {
+			canaryEventType = api_v1.EventTypeWarning
+			canaryEventTitle = nl.EventReasonAddedOrUpdatedWithError
+			canaryEventWarningMessage = fmt.Sprintf("%s; but was not applied: %v", canaryEventWarningMessage, operationErr)
+		}
+
+		canaryMsg := fmt.Sprintf("Configuration for %v was added or updated as a canary of %v %s", getResourceKey(&canary.ObjectMeta),
+			getResourceKey(&ingConfig.Ingress.ObjectMeta), canaryEventWarningMessage)
+		lbc.recorder.Eventf(canary, canaryEventType, canaryEventTitle, canaryMsg)
+	}
+
 	if lbc.reportStatusEnabled() {
+		if len(ingConfig.Canaries) > 0 {
+			ings := []networking.Ingress{*ingConfig.Ingress}
+
+			for _, canary := range ingConfig.Canaries {
+				ings = append(ings, *canary)
+			}
+
+			err := lbc.statusUpdater.BulkUpdateIngressStatus(ings)
+			if err != nil {
+				nl.Errorf(lbc.Logger, "error updating ing status: %v", err)
+			}
+			return
+		}
+
 		err := lbc.statusUpdater.UpdateIngressStatus(*ingConfig.Ingress)
 		if err != nil {
 			nl.Errorf(lbc.Logger, "error updating ingress status: %v", err)
@@ -2256,6 +2595,17 @@ func (lbc *LoadBalancerController) createMergeableIngresses(ingConfig *IngressCo
 	}
 }
 
+func (lbc *LoadBalancerController) createRegularIngressEx(ingConfig *IngressConfiguration) *configs.IngressEx {
+	// for regular Ingress, validMinionPaths is nil
+	ingEx := lbc.createIngressEx(ingConfig.Ingress, ingConfig.ValidHosts, nil)
+
+	for _, canary := range ingConfig.Canaries {
+		ingEx.Canaries = append(ingEx.Canaries, lbc.createIngressEx(canary, ingConfig.ValidHosts, nil))
+	}
+
+	return ingEx
+}
+
 func (lbc *LoadBalancerController) createIngressEx(ing *networking.Ingress, validHosts map[string]bool, validMinionPaths map[string]bool) *configs.IngressEx {
 	var endps []string
 	ingEx := &configs.IngressEx{
@@ -2507,6 +2857,18 @@ func (lbc *LoadBalancerController) createVirtualServerEx(virtualServer *conf_v1.
 		virtualServerEx.HTTPIPv6 = vsc.HTTPIPv6
 		virtualServerEx.HTTPSIPv4 = vsc.HTTPSIPv4
 		virtualServerEx.HTTPSIPv6 = vsc.HTTPSIPv6
+		virtualServerEx.HTTPListener = vsc.HTTPListener
+		virtualServerEx.HTTPSListener = vsc.HTTPSListener
+		virtualServerEx.ServerAliases = vsc.ServerAliases
+	}
+
+	if l := virtualServerEx.HTTPSListener; l != nil && l.TLS != nil && l.TLS.Secret != "" {
+		scrtRef := lbc.secretStore.GetSecret(l.TLS.Secret)
+		if scrtRef.Error != nil {
+			nl.Warnf(lbc.Logger, "Error trying to get the secret %v of listener %v for VirtualServer %v: %v", l.TLS.Secret, l.Name, virtualServer.Name, scrtRef.Error)
+		}
+
+		virtualServerEx.SecretRefs[l.TLS.Secret] = scrtRef
 	}
 
 	if virtualServer.Spec.TLS != nil && virtualServer.Spec.TLS.Secret != "" {
@@ -2806,6 +3168,7 @@ func (lbc *LoadBalancerController) createVirtualServerEx(virtualServer *conf_v1.
 	virtualServerEx.ExternalNameSvcs = externalNameSvcs
 	virtualServerEx.Policies = createPolicyMap(policies)
 	virtualServerEx.PodsByIP = podsByIP
+	virtualServerEx.MaintenancePages = lbc.getMaintenancePages(virtualServer, virtualServerRoutes)
 
 	return &virtualServerEx
 }
@@ -2161,7 +2161,7 @@ func TestGetPoliciesGlobalWatch(t *testing.T) {
 
 	expectedPolicies := []*conf_v1.Policy{validPolicy}
 	expectedErrors := []error{
-		errors.New("policy default/invalid-policy is invalid: spec: Invalid value: \"\": must specify exactly one of: `accessControl`, `rateLimit`, `ingressMTLS`, `egressMTLS`, `basicAuth`, `apiKey`, `cache`, `cors`, `jwt`, `oidc`, `waf`"),
+		errors.New("policy default/invalid-policy is invalid: spec: Invalid value: \"\": must specify exactly one of: `accessControl`, `rateLimit`, `ingressMTLS`, `egressMTLS`, `basicAuth`, `apiKey`, `cache`, `cors`, `headers`, `jwt`, `oidc`, `waf`"),
 		errors.New("policy nginx-ingress/valid-policy doesn't exist"),
 		errors.New("failed to get policy nginx-ingress/some-policy: GetByKey error"),
 		errors.New("referenced policy default/valid-policy-ingress-class has incorrect ingress class: test-class (controller ingress class: )"),
@@ -2259,7 +2259,7 @@ func TestGetPoliciesNamespacedWatch(t *testing.T) {
 
 	expectedPolicies := []*conf_v1.Policy{validPolicy}
 	expectedErrors := []error{
-		errors.New("policy default/invalid-policy is invalid: spec: Invalid value: \"\": must specify exactly one of: `accessControl`, `rateLimit`, `ingressMTLS`, `egressMTLS`, `basicAuth`, `apiKey`, `cache`, `cors`, `jwt`, `oidc`, `waf`"),
+		errors.New("policy default/invalid-policy is invalid: spec: Invalid value: \"\": must specify exactly one of: `accessControl`, `rateLimit`, `ingressMTLS`, `egressMTLS`, `basicAuth`, `apiKey`, `cache`, `cors`, `headers`, `jwt`, `oidc`, `waf`"),
 		errors.New("failed to get namespace nginx-ingress"),
 		errors.New("referenced policy default/valid-policy-ingress-class has incorrect ingress class: test-class (controller ingress class: )"),
 	}
@@ -2364,6 +2364,85 @@ func TestCreateIngressEx_SetsWarningWhenReferencedPolicyMissing(t *testing.T) {
 	}
 }
 
+// blockingStore blocks the lookups until they are released, to hold a task in its sync.
+type blockingStore struct {
+	cache.Store
+	lookups chan string
+	release chan struct{}
+}
+
+func (s *blockingStore) GetByKey(key string) (interface{}, bool, error) {
+	s.lookups <- key
+	<-s.release
+	return s.Store.GetByKey(key)
+}
+
+func TestSyncProcessesTasksOneAtATime(t *testing.T) {
+	t.Parallel()
+
+	vsLister := &blockingStore{
+		Store:   cache.NewStore(cache.MetaNamespaceKeyFunc),
+		lookups: make(chan string, 16),
+		release: make(chan struct{}),
+	}
+
+	lbc := &LoadBalancerController{
+		Logger:           nl.LoggerFromContext(t.Context()),
+		configurator:     createTestPolicySyncConfigurator(t, nginx.NewFakeManager("/etc/nginx")),
+		configuration:    createTestConfiguration(),
+		metricsCollector: collectors.NewControllerFakeCollector(),
+		isNginxReady:     true,
+		namespacedInformers: map[string]*namespacedInformer{
+			"default": {virtualServerLister: vsLister},
+		},
+	}
+	lbc.syncQueue = newTaskQueue(lbc.Logger, lbc.sync, 2, nil)
+
+	first := task{Kind: virtualserver, Key: "default/cafe"}
+	second := first
+	for i := 0; lbc.syncQueue.shardFor(second) == lbc.syncQueue.shardFor(first); i++ {
+		second = task{Kind: virtualserver, Key: fmt.Sprintf("default/cafe-%d", i)}
+	}
+
+	stopCh := make(chan struct{})
+	go lbc.syncQueue.Run(time.Second, stopCh)
+	defer func() {
+		close(stopCh)
+		lbc.syncQueue.Shutdown()
+	}()
+
+	lbc.syncQueue.AddTask(first)
+	lbc.syncQueue.AddTask(second)
+
+	// The second task must not reach the lister until the first one is released.
+	var firstSynced string
+	select {
+	case firstSynced = <-vsLister.lookups:
+	case <-time.After(5 * time.Second):
+		close(vsLister.release)
+		t.Fatal("sync() didn't process the tasks")
+	}
+
+	select {
+	case key := <-vsLister.lookups:
+		close(vsLister.release)
+		t.Fatalf("sync() processed the tasks of %v and %v concurrently", firstSynced, key)
+	case <-time.After(100 * time.Millisecond):
+	}
+	close(vsLister.release)
+
+	for {
+		select {
+		case key := <-vsLister.lookups:
+			if key != firstSynced {
+				return
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("sync() didn't process the second task")
+		}
+	}
+}
+
 func TestSyncPolicy_UpdatesMergeableIngressesWhenPolicyChanges(t *testing.T) {
 	t.Parallel()
 
@@ -3890,3 +3969,71 @@ func TestIsPodMarkedForDeletion(t *testing.T) {
 		})
 	}
 }
+
+type invalidConfigManager struct {
+	*nginx.FakeManager
+}
+
+func (m *invalidConfigManager) ValidateConfig(_ string, _ []byte) error {
+	return errors.New("nginx config test failed")
+}
+
+func TestProcessProblemsReportsStandbyVirtualServerValidation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		manager       nginx.Manager
+		expectedEvent string
+	}{
+		{
+			name:          "valid standby config",
+			manager:       nginx.NewFakeManager("/etc/nginx"),
+			expectedEvent: "Warning Rejected Host is superseded by VirtualServer default/virtualserver; standby configuration is ready",
+		},
+		{
+			name:          "invalid standby config",
+			manager:       &invalidConfigManager{FakeManager: nginx.NewFakeManager("/etc/nginx")},
+			expectedEvent: "Warning Rejected Host is superseded by VirtualServer default/virtualserver; standby configuration is invalid: error validating VirtualServer config: vs_default_virtualserver-standby: nginx config test failed",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			configuration := createTestConfiguration()
+			recorder := record.NewFakeRecorder(10)
+			lbc := &LoadBalancerController{
+				Logger:                  nl.LoggerFromContext(t.Context()),
+				configuration:           configuration,
+				configurator:            createTestPolicySyncConfigurator(t, test.manager),
+				recorder:                recorder,
+				isLeaderElectionEnabled: true,
+			}
+
+			vs := createTestVirtualServer("virtualserver", "foo.example.com")
+			standbyVS := createTestVirtualServer("virtualserver-standby", "foo.example.com")
+			standbyVS.Annotations = map[string]string{
+				"nginx.org/host-standby": "true",
+			}
+
+			configuration.AddOrUpdateVirtualServer(vs)
+			_, problems := configuration.AddOrUpdateVirtualServer(standbyVS)
+
+			lbc.processProblems(problems)
+
+			select {
+			case event := <-recorder.Events:
+				if event != test.expectedEvent {
+					t.Errorf("processProblems() recorded %q, expected %q", event, test.expectedEvent)
+				}
+			default:
+				t.Fatal("processProblems() didn't record an event for the standby VirtualServer")
+			}
+			if len(recorder.Events) != 0 {
+				t.Errorf("processProblems() recorded %d more events for the standby VirtualServer", len(recorder.Events))
+			}
+		})
+	}
+}
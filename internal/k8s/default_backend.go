@@ -0,0 +1,210 @@
+package k8s
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/nginx/kubernetes-ingress/internal/configs"
+	nl "github.com/nginx/kubernetes-ingress/internal/logger"
+	api_v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+// defaultBackendReferences are the default backend Service and the default error pages ConfigMap referenced by the NGINX ConfigMap.
+// They are read by the informer handlers, so they are guarded by the lock.
+type defaultBackendReferences struct {
+	lock      sync.RWMutex
+	service   string
+	configMap string
+}
+
+func (r *defaultBackendReferences) set(service string, configMap string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.service = service
+	r.configMap = configMap
+}
+
+func (r *defaultBackendReferences) isService(key string) bool {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	return r.service != "" && r.service == key
+}
+
+func (r *defaultBackendReferences) isConfigMap(key string) bool {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	return r.configMap != "" && r.configMap == key
+}
+
+// createDefaultErrorPagesConfigMapHandlers builds the handler funcs for the default error pages ConfigMap. Other ConfigMaps are ignored.
+func createDefaultErrorPagesConfigMapHandlers(lbc *LoadBalancerController) cache.ResourceEventHandlerFuncs {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			configMap := obj.(*api_v1.ConfigMap)
+			lbc.requeueNginxConfigMapForDefaultErrorPages(configMap)
+		},
+		DeleteFunc: func(obj interface{}) {
+			configMap, isConfigMap := obj.(*api_v1.ConfigMap)
+			if !isConfigMap {
+				deletedState, ok := obj.(cache.DeletedFinalStateUnknown)
+				if !ok {
+					nl.Debugf(lbc.Logger, "Error received unexpected object: %v", obj)
+					return
+				}
+				configMap, ok = deletedState.Obj.(*api_v1.ConfigMap)
+				if !ok {
+					nl.Debugf(lbc.Logger, "Error DeletedFinalStateUnknown contained non-ConfigMap object: %v", deletedState.Obj)
+					return
+				}
+			}
+			lbc.requeueNginxConfigMapForDefaultErrorPages(configMap)
+		},
+		UpdateFunc: func(old, cur interface{}) {
+			curConfigMap := cur.(*api_v1.ConfigMap)
+			oldConfigMap := old.(*api_v1.ConfigMap)
+			if !reflect.DeepEqual(oldConfigMap.Data, curConfigMap.Data) {
+				lbc.requeueNginxConfigMapForDefaultErrorPages(curConfigMap)
+			}
+		},
+	}
+}
+
+// addDefaultErrorPagesConfigMapHandler adds the handler for the default error pages ConfigMap to the namespaced informer.
+// The informer of the ConfigMaps is shared with the maintenance ConfigMaps.
+func (nsi *namespacedInformer) addDefaultErrorPagesConfigMapHandler(handlers cache.ResourceEventHandlerFuncs) {
+	informer := nsi.sharedInformerFactory.Core().V1().ConfigMaps().Informer()
+	informer.AddEventHandler(handlers) //nolint:errcheck,gosec
+	nsi.configMapLister = informer.GetStore()
+
+	nsi.cacheSyncs = append(nsi.cacheSyncs, informer.HasSynced)
+}
+
+// requeueNginxConfigMapForDefaultErrorPages requeues the NGINX ConfigMap if the ConfigMap is its default error pages ConfigMap.
+func (lbc *LoadBalancerController) requeueNginxConfigMapForDefaultErrorPages(configMap *api_v1.ConfigMap) {
+	if lbc.defaultBackendRefs.isConfigMap(getResourceKey(&configMap.ObjectMeta)) {
+		nl.Debugf(lbc.Logger, "Default error pages ConfigMap %v changed, syncing the NGINX ConfigMap", configMap.Name)
+		lbc.enqueueNginxConfigMap()
+	}
+}
+
+// requeueNginxConfigMapForDefaultBackend requeues the NGINX ConfigMap if the Service is its default backend Service.
+func (lbc *LoadBalancerController) requeueNginxConfigMapForDefaultBackend(svc *api_v1.Service) {
+	if lbc.defaultBackendRefs.isService(getResourceKey(&svc.ObjectMeta)) {
+		nl.Debugf(lbc.Logger, "Default backend service %v changed, syncing the NGINX ConfigMap", svc.Name)
+		lbc.enqueueNginxConfigMap()
+	}
+}
+
+// enqueueNginxConfigMap enqueues the task that syncs the NGINX ConfigMap and regenerates all configs.
+func (lbc *LoadBalancerController) enqueueNginxConfigMap() {
+	if lbc.nginxConfigMapName != "" {
+		lbc.syncQueue.AddTask(task{Kind: configMap, Key: lbc.nginxConfigMapName})
+	}
+}
+
+// updateDefaultBackendConfig resolves the default backend service and reads the cluster-wide error pages
+// referenced by the NGINX ConfigMap. The Service and the ConfigMap are read from the listers of the watched namespaces,
+// and the NGINX ConfigMap is synced again when they change.
+// The default backend serves the requests for unknown hosts in the default server and the requests for unmatched paths
+// of the VirtualServers and Ingresses that don't have a route or a path for /.
+func (lbc *LoadBalancerController) updateDefaultBackendConfig(cfgParams *configs.ConfigParams) {
+	lbc.defaultBackendRefs.set(cfgParams.DefaultBackendService, cfgParams.DefaultErrorPagesConfigMap)
+
+	if cfgParams.DefaultBackendService != "" {
+		address, err := lbc.getDefaultBackendAddress(cfgParams.DefaultBackendService, cfgParams.DefaultBackendServicePort)
+		if err != nil {
+			nl.Warnf(lbc.Logger, "Error resolving the default backend service %v, using default-server-return: %v", cfgParams.DefaultBackendService, err)
+			lbc.recordDefaultBackendWarning(err)
+		} else {
+			cfgParams.DefaultBackendAddress = address
+		}
+	}
+
+	if cfgParams.DefaultErrorPagesConfigMap != "" {
+		pages, err := lbc.getDefaultErrorPages(cfgParams.DefaultErrorPagesConfigMap)
+		if err != nil {
+			nl.Warnf(lbc.Logger, "Error getting the default error pages ConfigMap %v: %v", cfgParams.DefaultErrorPagesConfigMap, err)
+			lbc.recordDefaultBackendWarning(err)
+		} else {
+			cfgParams.DefaultErrorPages = pages
+		}
+	}
+}
+
+// getDefaultBackendAddress returns the address of the default backend service in the format <cluster IP>:<port>.
+func (lbc *LoadBalancerController) getDefaultBackendAddress(service string, port int) (string, error) {
+	ns, name, err := ParseNamespaceName(service)
+	if err != nil {
+		return "", err
+	}
+
+	nsi := lbc.getNamespacedInformer(ns)
+	if nsi == nil {
+		return "", fmt.Errorf("service %v/%v: the namespace is not watched", ns, name)
+	}
+
+	obj, exists, err := nsi.svcLister.GetByKey(service)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		return "", fmt.Errorf("service %v/%v doesn't exist", ns, name)
+	}
+	svc := obj.(*api_v1.Service)
+
+	if svc.Spec.ClusterIP == "" || svc.Spec.ClusterIP == api_v1.ClusterIPNone {
+		return "", fmt.Errorf("service %v/%v doesn't have a cluster IP", ns, name)
+	}
+
+	for _, p := range svc.Spec.Ports {
+		if int(p.Port) == port {
+			return fmt.Sprintf("%v:%v", svc.Spec.ClusterIP, port), nil
+		}
+	}
+
+	return "", fmt.Errorf("service %v/%v doesn't have the port %v", ns, name, port)
+}
+
+// getDefaultErrorPages returns the pages of the default error pages ConfigMap keyed by status code.
+func (lbc *LoadBalancerController) getDefaultErrorPages(configMap string) (map[int]string, error) {
+	ns, name, err := ParseNamespaceName(configMap)
+	if err != nil {
+		return nil, err
+	}
+
+	nsi := lbc.getNamespacedInformer(ns)
+	if nsi == nil || nsi.configMapLister == nil {
+		return nil, fmt.Errorf("ConfigMap %v/%v: the namespace is not watched", ns, name)
+	}
+
+	obj, exists, err := nsi.configMapLister.GetByKey(configMap)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("ConfigMap %v/%v doesn't exist", ns, name)
+	}
+
+	return getDefaultErrorPagesFromConfigMap(obj.(*api_v1.ConfigMap)), nil
+}
+
+func getDefaultErrorPagesFromConfigMap(cm *api_v1.ConfigMap) map[int]string {
+	pages := make(map[int]string)
+	for _, code := range configs.DefaultErrorPageCodes {
+		if page, exists := cm.Data[configs.GetDefaultErrorPageKey(code)]; exists {
+			pages[code] = page
+		}
+	}
+	return pages
+}
+
+func (lbc *LoadBalancerController) recordDefaultBackendWarning(err error) {
+	if lbc.configMap != nil {
+		lbc.recorder.Eventf(lbc.configMap, api_v1.EventTypeWarning, nl.EventReasonInvalidValue, "Default backend: %v", err)
+	}
+}
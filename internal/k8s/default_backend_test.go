@@ -0,0 +1,201 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	nl "github.com/nginx/kubernetes-ingress/internal/logger"
+	v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestGetDefaultBackendAddress(t *testing.T) {
+	t.Parallel()
+	svcLister := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	for _, svc := range []*v1.Service{
+		{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "default-backend", Namespace: "default"},
+			Spec: v1.ServiceSpec{
+				ClusterIP: "10.0.0.10",
+				Ports:     []v1.ServicePort{{Port: 8080}},
+			},
+		},
+		{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "headless", Namespace: "default"},
+			Spec: v1.ServiceSpec{
+				ClusterIP: v1.ClusterIPNone,
+				Ports:     []v1.ServicePort{{Port: 8080}},
+			},
+		},
+	} {
+		if err := svcLister.Add(svc); err != nil {
+			t.Fatalf("Failed to add the service: %v", err)
+		}
+	}
+	lbc := LoadBalancerController{
+		namespacedInformers: map[string]*namespacedInformer{
+			"default": {svcLister: svcLister},
+		},
+	}
+
+	address, err := lbc.getDefaultBackendAddress("default/default-backend", 8080)
+	if err != nil {
+		t.Fatalf("getDefaultBackendAddress() returned unexpected error: %v", err)
+	}
+	if address != "10.0.0.10:8080" {
+		t.Errorf("getDefaultBackendAddress() returned %q, want %q", address, "10.0.0.10:8080")
+	}
+
+	tests := []struct {
+		service string
+		port    int
+		msg     string
+	}{
+		{
+			service: "default/default-backend",
+			port:    80,
+			msg:     "unknown port",
+		},
+		{
+			service: "default/headless",
+			port:    8080,
+			msg:     "headless service",
+		},
+		{
+			service: "default/missing",
+			port:    8080,
+			msg:     "missing service",
+		},
+		{
+			service: "other/default-backend",
+			port:    8080,
+			msg:     "unwatched namespace",
+		},
+	}
+
+	for _, test := range tests {
+		if _, err := lbc.getDefaultBackendAddress(test.service, test.port); err == nil {
+			t.Errorf("getDefaultBackendAddress() returned no error for the case of %s", test.msg)
+		}
+	}
+}
+
+func TestGetDefaultErrorPagesFromConfigMap(t *testing.T) {
+	t.Parallel()
+	cm := &v1.ConfigMap{
+		Data: map[string]string{
+			"404.html": "<html>Not Found</html>",
+			"504.html": "<html>Gateway Timeout</html>",
+			"500.html": "<html>Internal Server Error</html>",
+		},
+	}
+
+	expected := map[int]string{
+		404: "<html>Not Found</html>",
+		504: "<html>Gateway Timeout</html>",
+	}
+
+	pages := getDefaultErrorPagesFromConfigMap(cm)
+	if diff := cmp.Diff(expected, pages); diff != "" {
+		t.Errorf("getDefaultErrorPagesFromConfigMap() returned unexpected result (-want +got):\n%s", diff)
+	}
+}
+
+func TestGetDefaultErrorPages(t *testing.T) {
+	t.Parallel()
+	configMapLister := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	err := configMapLister.Add(&v1.ConfigMap{
+		ObjectMeta: meta_v1.ObjectMeta{Name: "error-pages", Namespace: "default"},
+		Data:       map[string]string{"404.html": "<html>Not Found</html>"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to add the ConfigMap: %v", err)
+	}
+	lbc := LoadBalancerController{
+		namespacedInformers: map[string]*namespacedInformer{
+			"default": {configMapLister: configMapLister},
+			"nocm":    {},
+		},
+	}
+
+	pages, err := lbc.getDefaultErrorPages("default/error-pages")
+	if err != nil {
+		t.Fatalf("getDefaultErrorPages() returned unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(map[int]string{404: "<html>Not Found</html>"}, pages); diff != "" {
+		t.Errorf("getDefaultErrorPages() returned unexpected result (-want +got):\n%s", diff)
+	}
+
+	for _, configMap := range []string{"default/missing", "other/error-pages", "nocm/error-pages"} {
+		if _, err := lbc.getDefaultErrorPages(configMap); err == nil {
+			t.Errorf("getDefaultErrorPages(%q) returned no error", configMap)
+		}
+	}
+}
+
+func TestRequeueNginxConfigMapForDefaultBackendReferences(t *testing.T) {
+	t.Parallel()
+	backend := &v1.Service{ObjectMeta: meta_v1.ObjectMeta{Name: "default-backend", Namespace: "default"}}
+	errorPages := &v1.ConfigMap{ObjectMeta: meta_v1.ObjectMeta{Name: "error-pages", Namespace: "default"}}
+
+	tests := []struct {
+		service   string
+		configMap string
+		svc       *v1.Service
+		cm        *v1.ConfigMap
+		expected  int
+		msg       string
+	}{
+		{
+			service:  "default/default-backend",
+			svc:      backend,
+			expected: 1,
+			msg:      "default backend service changed",
+		},
+		{
+			service:  "other/default-backend",
+			svc:      backend,
+			expected: 0,
+			msg:      "unreferenced service changed",
+		},
+		{
+			configMap: "default/error-pages",
+			cm:        errorPages,
+			expected:  1,
+			msg:       "default error pages ConfigMap changed",
+		},
+		{
+			configMap: "default/other",
+			cm:        errorPages,
+			expected:  0,
+			msg:       "unreferenced ConfigMap changed",
+		},
+		{
+			svc:      backend,
+			cm:       errorPages,
+			expected: 0,
+			msg:      "no references",
+		},
+	}
+
+	for _, test := range tests {
+		lbc := LoadBalancerController{
+			Logger:             nl.LoggerFromContext(t.Context()),
+			nginxConfigMapName: "nginx-ingress/nginx-config",
+		}
+		lbc.syncQueue = newTaskQueue(lbc.Logger, func(task) {}, 1, nil)
+		lbc.defaultBackendRefs.set(test.service, test.configMap)
+
+		if test.svc != nil {
+			lbc.requeueNginxConfigMapForDefaultBackend(test.svc)
+		}
+		if test.cm != nil {
+			lbc.requeueNginxConfigMapForDefaultErrorPages(test.cm)
+		}
+
+		if l := lbc.syncQueue.Len(); l != test.expected {
+			t.Errorf("sync queue has %d element(s) but expected %d for the case of %s", l, test.expected, test.msg)
+		}
+	}
+}
@@ -0,0 +1,403 @@
+package k8s
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"sort"
+
+	nl "github.com/nginx/kubernetes-ingress/internal/logger"
+	api_v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	gateway_v1 "sigs.k8s.io/gateway-api/apis/v1"
+	gateway_v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gateway_informers "sigs.k8s.io/gateway-api/pkg/client/informers/externalversions"
+)
+
+const (
+	gatewayAPITaskKey              = "gateway-api"
+	gatewayAPIV1alpha2GroupVersion = "gateway.networking.k8s.io/v1alpha2"
+)
+
+// createGatewayAPIHandlers creates the handlers for the Gateway API resources.
+// All the resources are translated together, so every event enqueues the same task.
+func createGatewayAPIHandlers(lbc *LoadBalancerController) cache.ResourceEventHandlerFuncs {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(_ interface{}) {
+			lbc.enqueueGatewayAPITask()
+		},
+		DeleteFunc: func(_ interface{}) {
+			lbc.enqueueGatewayAPITask()
+		},
+		UpdateFunc: func(old, cur interface{}) {
+			oldObj, oldErr := meta.Accessor(old)
+			curObj, curErr := meta.Accessor(cur)
+			if oldErr == nil && curErr == nil && oldObj.GetGeneration() == curObj.GetGeneration() {
+				// only the status or the metadata changed
+				return
+			}
+			nl.Debugf(lbc.Logger, "Gateway API resource %v changed, syncing", curObj.GetName())
+			lbc.enqueueGatewayAPITask()
+		},
+	}
+}
+
+// enqueueGatewayAPITask enqueues the task that translates the Gateway API resources.
+func (lbc *LoadBalancerController) enqueueGatewayAPITask() {
+	lbc.syncQueue.AddTask(task{Kind: gatewayAPI, Key: gatewayAPITaskKey})
+}
+
+// addGatewayClassHandler adds the handler for GatewayClasses to the controller
+func (lbc *LoadBalancerController) addGatewayClassHandler(handlers cache.ResourceEventHandlerFuncs) {
+	factory := gateway_informers.NewSharedInformerFactory(lbc.gatewayClient, lbc.resync)
+	informer := factory.Gateway().V1().GatewayClasses().Informer()
+	informer.AddEventHandler(handlers) //nolint:errcheck,gosec
+
+	lbc.gatewayClassInformer = informer
+	lbc.cacheSyncs = append(lbc.cacheSyncs, informer.HasSynced)
+}
+
+// discoverGatewayAPIV1alpha2Kinds returns the kinds of the v1alpha2 Gateway API group version served by the API server.
+// TLSRoutes and TCPRoutes are only installed with the experimental channel of the Gateway API.
+func (lbc *LoadBalancerController) discoverGatewayAPIV1alpha2Kinds() map[string]bool {
+	kinds := make(map[string]bool)
+
+	resources, err := lbc.client.Discovery().ServerResourcesForGroupVersion(gatewayAPIV1alpha2GroupVersion)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			nl.Warnf(lbc.Logger, "Error discovering the resources of %s, TLSRoutes and TCPRoutes are ignored: %v", gatewayAPIV1alpha2GroupVersion, err)
+		}
+		return kinds
+	}
+
+	for _, r := range resources.APIResources {
+		kinds[r.Kind] = true
+	}
+
+	return kinds
+}
+
+// addGatewayAPIHandlers adds the handlers for Gateways, HTTPRoutes, TLSRoutes and TCPRoutes to the namespaced informer.
+// TLSRoutes and TCPRoutes are only watched if their kinds are in the served v1alpha2 kinds, otherwise their listers are left nil.
+func (nsi *namespacedInformer) addGatewayAPIHandlers(handlers cache.ResourceEventHandlerFuncs, v1alpha2Kinds map[string]bool) {
+	informers := []cache.SharedIndexInformer{
+		nsi.gatewaySharedInformerFactory.Gateway().V1().Gateways().Informer(),
+		nsi.gatewaySharedInformerFactory.Gateway().V1().HTTPRoutes().Informer(),
+	}
+	nsi.gatewayLister = informers[0].GetStore()
+	nsi.httpRouteLister = informers[1].GetStore()
+
+	if v1alpha2Kinds[tlsRouteKind] {
+		informer := nsi.gatewaySharedInformerFactory.Gateway().V1alpha2().TLSRoutes().Informer()
+		informers = append(informers, informer)
+		nsi.tlsRouteLister = informer.GetStore()
+	}
+	if v1alpha2Kinds[tcpRouteKind] {
+		informer := nsi.gatewaySharedInformerFactory.Gateway().V1alpha2().TCPRoutes().Informer()
+		informers = append(informers, informer)
+		nsi.tcpRouteLister = informer.GetStore()
+	}
+
+	for _, informer := range informers {
+		informer.AddEventHandler(handlers) //nolint:errcheck,gosec
+		nsi.cacheSyncs = append(nsi.cacheSyncs, informer.HasSynced)
+	}
+}
+
+// syncGatewayAPI translates the Gateway API resources into VirtualServers and TransportServers and updates their statuses.
+func (lbc *LoadBalancerController) syncGatewayAPI() {
+	nl.Debugf(lbc.Logger, "Syncing Gateway API resources")
+
+	resources := lbc.getGatewayAPIResources()
+
+	changes, problems := lbc.configuration.SetGatewayAPIResources(lbc.gatewayAPITranslator, resources)
+
+	lbc.processChanges(changes)
+	lbc.processProblems(problems)
+
+	if lbc.reportCustomResourceStatusEnabled() {
+		lbc.updateGatewayAPIStatuses(resources)
+	}
+}
+
+// getGatewayAPIResources returns the GatewayClasses with the controller name of the Ingress Controller, their Gateways and all the routes.
+func (lbc *LoadBalancerController) getGatewayAPIResources() gatewayAPIResources {
+	var resources gatewayAPIResources
+
+	classes := make(map[string]bool)
+	for _, obj := range lbc.gatewayClassInformer.GetStore().List() {
+		gc := obj.(*gateway_v1.GatewayClass)
+		if gc.Spec.ControllerName == lbc.gatewayAPITranslator.controllerName {
+			resources.GatewayClasses = append(resources.GatewayClasses, gc)
+			classes[gc.Name] = true
+		}
+	}
+
+	for _, nsi := range lbc.namespacedInformers {
+		if !nsi.isGatewayAPIEnabled {
+			continue
+		}
+
+		for _, obj := range nsi.gatewayLister.List() {
+			gw := obj.(*gateway_v1.Gateway)
+			if classes[string(gw.Spec.GatewayClassName)] {
+				resources.Gateways = append(resources.Gateways, gw)
+			}
+		}
+		for _, obj := range nsi.httpRouteLister.List() {
+			resources.HTTPRoutes = append(resources.HTTPRoutes, obj.(*gateway_v1.HTTPRoute))
+		}
+		if nsi.tlsRouteLister != nil {
+			for _, obj := range nsi.tlsRouteLister.List() {
+				resources.TLSRoutes = append(resources.TLSRoutes, obj.(*gateway_v1alpha2.TLSRoute))
+			}
+		}
+		if nsi.tcpRouteLister != nil {
+			for _, obj := range nsi.tcpRouteLister.List() {
+				resources.TCPRoutes = append(resources.TCPRoutes, obj.(*gateway_v1alpha2.TCPRoute))
+			}
+		}
+	}
+
+	sort.Slice(resources.GatewayClasses, func(i, j int) bool { return resources.GatewayClasses[i].Name < resources.GatewayClasses[j].Name })
+	sort.Slice(resources.Gateways, func(i, j int) bool {
+		return getResourceKey(&resources.Gateways[i].ObjectMeta) < getResourceKey(&resources.Gateways[j].ObjectMeta)
+	})
+	sort.Slice(resources.HTTPRoutes, func(i, j int) bool {
+		return getResourceKey(&resources.HTTPRoutes[i].ObjectMeta) < getResourceKey(&resources.HTTPRoutes[j].ObjectMeta)
+	})
+	sort.Slice(resources.TLSRoutes, func(i, j int) bool {
+		return getResourceKey(&resources.TLSRoutes[i].ObjectMeta) < getResourceKey(&resources.TLSRoutes[j].ObjectMeta)
+	})
+	sort.Slice(resources.TCPRoutes, func(i, j int) bool {
+		return getResourceKey(&resources.TCPRoutes[i].ObjectMeta) < getResourceKey(&resources.TCPRoutes[j].ObjectMeta)
+	})
+
+	return resources
+}
+
+// enqueueGatewayAPIStatusUpdate enqueues the Gateway API task if the changes or the problems involve resources translated from routes,
+// so that the statuses of the routes reflect the conflicts with other resources.
+func (lbc *LoadBalancerController) enqueueGatewayAPIStatusUpdate(changes []ResourceChange, problems []ConfigurationProblem) {
+	if lbc.gatewayAPITranslator == nil {
+		return
+	}
+
+	for _, c := range changes {
+		if _, ok := gatewayAPIRouteKey(c.Resource.GetObjectMeta()); ok {
+			lbc.enqueueGatewayAPITask()
+			return
+		}
+	}
+
+	for _, p := range problems {
+		if obj, err := meta.Accessor(p.Object); err == nil && isGatewayAPIObject(obj) {
+			lbc.enqueueGatewayAPITask()
+			return
+		}
+	}
+}
+
+// isGatewayAPIObject checks if the object was translated from a Gateway API route.
+func isGatewayAPIObject(obj meta_v1.Object) bool {
+	return slices.ContainsFunc(obj.GetOwnerReferences(), func(ref meta_v1.OwnerReference) bool {
+		return isGatewayAPIGroupVersion(ref.APIVersion)
+	})
+}
+
+// updateGatewayAPIStatuses updates the statuses of the GatewayClasses, the Gateways and the routes.
+func (lbc *LoadBalancerController) updateGatewayAPIStatuses(resources gatewayAPIResources) {
+	gatewayStatuses, routeParentStatuses := lbc.configuration.GetGatewayAPIStatuses()
+	client := lbc.gatewayClient
+	controllerName := lbc.gatewayAPITranslator.controllerName
+
+	for _, gc := range resources.GatewayClasses {
+		gcCopy := gc.DeepCopy()
+		gcCopy.Status.Conditions = mergeGatewayAPIConditions(gc.Status.Conditions, []meta_v1.Condition{
+			newGatewayAPICondition(gc.Generation, string(gateway_v1.GatewayClassConditionStatusAccepted), true,
+				string(gateway_v1.GatewayClassReasonAccepted), "GatewayClass is accepted"),
+		})
+		if reflect.DeepEqual(gc.Status, gcCopy.Status) {
+			continue
+		}
+
+		_, err := client.GatewayV1().GatewayClasses().UpdateStatus(context.TODO(), gcCopy, meta_v1.UpdateOptions{})
+		if err != nil {
+			nl.Errorf(lbc.Logger, "Error when updating the status for GatewayClass %v: %v", gc.Name, err)
+		}
+	}
+
+	addresses := lbc.statusUpdater.gatewayAddresses()
+	for _, gw := range resources.Gateways {
+		status := gatewayStatuses[getResourceKey(&gw.ObjectMeta)]
+		status.Addresses = addresses
+		status.Conditions = mergeGatewayAPIConditions(gw.Status.Conditions, status.Conditions)
+		for i := range status.Listeners {
+			var existing []meta_v1.Condition
+			for _, l := range gw.Status.Listeners {
+				if l.Name == status.Listeners[i].Name {
+					existing = l.Conditions
+				}
+			}
+			status.Listeners[i].Conditions = mergeGatewayAPIConditions(existing, status.Listeners[i].Conditions)
+		}
+		if reflect.DeepEqual(gw.Status, status) {
+			continue
+		}
+
+		gwCopy := gw.DeepCopy()
+		gwCopy.Status = status
+		_, err := client.GatewayV1().Gateways(gw.Namespace).UpdateStatus(context.TODO(), gwCopy, meta_v1.UpdateOptions{})
+		if err != nil {
+			nl.Errorf(lbc.Logger, "Error when updating the status for Gateway %v/%v: %v", gw.Namespace, gw.Name, err)
+		}
+	}
+
+	for _, route := range resources.HTTPRoutes {
+		parents := mergeRouteParentStatuses(route.Status.Parents, routeParentStatuses[getResourceKeyWithKind(httpRouteKind, &route.ObjectMeta)], controllerName)
+		if reflect.DeepEqual(route.Status.Parents, parents) {
+			continue
+		}
+
+		routeCopy := route.DeepCopy()
+		routeCopy.Status.Parents = parents
+		_, err := client.GatewayV1().HTTPRoutes(route.Namespace).UpdateStatus(context.TODO(), routeCopy, meta_v1.UpdateOptions{})
+		if err != nil {
+			nl.Errorf(lbc.Logger, "Error when updating the status for HTTPRoute %v/%v: %v", route.Namespace, route.Name, err)
+		}
+	}
+
+	for _, route := range resources.TLSRoutes {
+		parents := mergeRouteParentStatuses(route.Status.Parents, routeParentStatuses[getResourceKeyWithKind(tlsRouteKind, &route.ObjectMeta)], controllerName)
+		if reflect.DeepEqual(route.Status.Parents, parents) {
+			continue
+		}
+
+		routeCopy := route.DeepCopy()
+		routeCopy.Status.Parents = parents
+		_, err := client.GatewayV1alpha2().TLSRoutes(route.Namespace).UpdateStatus(context.TODO(), routeCopy, meta_v1.UpdateOptions{})
+		if err != nil {
+			nl.Errorf(lbc.Logger, "Error when updating the status for TLSRoute %v/%v: %v", route.Namespace, route.Name, err)
+		}
+	}
+
+	for _, route := range resources.TCPRoutes {
+		parents := mergeRouteParentStatuses(route.Status.Parents, routeParentStatuses[getResourceKeyWithKind(tcpRouteKind, &route.ObjectMeta)], controllerName)
+		if reflect.DeepEqual(route.Status.Parents, parents) {
+			continue
+		}
+
+		routeCopy := route.DeepCopy()
+		routeCopy.Status.Parents = parents
+		_, err := client.GatewayV1alpha2().TCPRoutes(route.Namespace).UpdateStatus(context.TODO(), routeCopy, meta_v1.UpdateOptions{})
+		if err != nil {
+			nl.Errorf(lbc.Logger, "Error when updating the status for TCPRoute %v/%v: %v", route.Namespace, route.Name, err)
+		}
+	}
+}
+
+// mergeGatewayAPIConditions returns the desired conditions with the transition times of the existing conditions whose status didn't change.
+func mergeGatewayAPIConditions(existing []meta_v1.Condition, desired []meta_v1.Condition) []meta_v1.Condition {
+	now := meta_v1.Now()
+	conditions := make([]meta_v1.Condition, 0, len(desired))
+
+	for _, cond := range desired {
+		cond.LastTransitionTime = now
+		if e := meta.FindStatusCondition(existing, cond.Type); e != nil && e.Status == cond.Status {
+			cond.LastTransitionTime = e.LastTransitionTime
+		}
+		conditions = append(conditions, cond)
+	}
+
+	return conditions
+}
+
+// mergeRouteParentStatuses replaces the statuses of the parents handled by the controller with the desired statuses,
+// keeping the statuses set by other controllers.
+func mergeRouteParentStatuses(existing []gateway_v1.RouteParentStatus, desired []gateway_v1.RouteParentStatus,
+	controllerName gateway_v1.GatewayController,
+) []gateway_v1.RouteParentStatus {
+	var parents []gateway_v1.RouteParentStatus
+
+	for _, p := range existing {
+		if p.ControllerName != controllerName {
+			parents = append(parents, p)
+		}
+	}
+
+	for _, p := range desired {
+		var conditions []meta_v1.Condition
+		for _, e := range existing {
+			if e.ControllerName == controllerName && reflect.DeepEqual(e.ParentRef, p.ParentRef) {
+				conditions = e.Conditions
+			}
+		}
+		p.Conditions = mergeGatewayAPIConditions(conditions, p.Conditions)
+		parents = append(parents, p)
+	}
+
+	return parents
+}
+
+// gatewayAddresses returns the addresses of the Ingress Controller for the status of the Gateways.
+func (su *statusUpdater) gatewayAddresses() []gateway_v1.GatewayStatusAddress {
+	var addresses []gateway_v1.GatewayStatusAddress
+
+	for _, lb := range su.status {
+		if lb.IP != "" {
+			addressType := gateway_v1.IPAddressType
+			addresses = append(addresses, gateway_v1.GatewayStatusAddress{Type: &addressType, Value: lb.IP})
+		}
+		if lb.Hostname != "" {
+			addressType := gateway_v1.HostnameAddressType
+			addresses = append(addresses, gateway_v1.GatewayStatusAddress{Type: &addressType, Value: lb.Hostname})
+		}
+	}
+
+	return addresses
+}
+
+// gatewayAPIEventRecorder records the events of the VirtualServers and the TransportServers translated from Gateway API routes
+// for the routes, because the translated resources don't exist in the cluster.
+type gatewayAPIEventRecorder struct {
+	record.EventRecorder
+}
+
+func (r gatewayAPIEventRecorder) Event(object runtime.Object, eventtype, reason, message string) {
+	r.EventRecorder.Event(gatewayAPIEventObject(object), eventtype, reason, message)
+}
+
+func (r gatewayAPIEventRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.EventRecorder.Eventf(gatewayAPIEventObject(object), eventtype, reason, messageFmt, args...)
+}
+
+func (r gatewayAPIEventRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.EventRecorder.AnnotatedEventf(gatewayAPIEventObject(object), annotations, eventtype, reason, messageFmt, args...)
+}
+
+// gatewayAPIEventObject returns the reference to the route the object was translated from, or the object itself.
+func gatewayAPIEventObject(object runtime.Object) runtime.Object {
+	obj, err := meta.Accessor(object)
+	if err != nil {
+		return object
+	}
+
+	for _, ref := range obj.GetOwnerReferences() {
+		if isGatewayAPIGroupVersion(ref.APIVersion) {
+			return &api_v1.ObjectReference{
+				APIVersion: ref.APIVersion,
+				Kind:       ref.Kind,
+				Namespace:  obj.GetNamespace(),
+				Name:       ref.Name,
+				UID:        ref.UID,
+			}
+		}
+	}
+
+	return object
+}
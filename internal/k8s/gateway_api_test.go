@@ -0,0 +1,74 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	nl "github.com/nginx/kubernetes-ingress/internal/logger"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	gateway_fake "sigs.k8s.io/gateway-api/pkg/client/clientset/versioned/fake"
+	gateway_informers "sigs.k8s.io/gateway-api/pkg/client/informers/externalversions"
+)
+
+func TestDiscoverGatewayAPIV1alpha2Kinds(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		resources []*meta_v1.APIResourceList
+		expected  map[string]bool
+	}{
+		{
+			name:     "standard channel",
+			expected: map[string]bool{},
+		},
+		{
+			name: "experimental channel",
+			resources: []*meta_v1.APIResourceList{
+				{
+					GroupVersion: gatewayAPIV1alpha2GroupVersion,
+					APIResources: []meta_v1.APIResource{
+						{Name: "tlsroutes", Kind: tlsRouteKind},
+						{Name: "tcproutes", Kind: tcpRouteKind},
+					},
+				},
+			},
+			expected: map[string]bool{tlsRouteKind: true, tcpRouteKind: true},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := fake.NewClientset()
+			client.Resources = test.resources
+			lbc := &LoadBalancerController{
+				Logger: nl.LoggerFromContext(t.Context()),
+				client: client,
+			}
+
+			if diff := cmp.Diff(test.expected, lbc.discoverGatewayAPIV1alpha2Kinds()); diff != "" {
+				t.Errorf("discoverGatewayAPIV1alpha2Kinds() returned unexpected result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAddGatewayAPIHandlersSkipsUnservedKinds(t *testing.T) {
+	t.Parallel()
+
+	nsi := &namespacedInformer{
+		gatewaySharedInformerFactory: gateway_informers.NewSharedInformerFactory(gateway_fake.NewClientset(), 0),
+	}
+	nsi.addGatewayAPIHandlers(cache.ResourceEventHandlerFuncs{}, map[string]bool{})
+
+	if nsi.tlsRouteLister != nil || nsi.tcpRouteLister != nil {
+		t.Error("addGatewayAPIHandlers() created the listers of the kinds that are not served")
+	}
+	if len(nsi.cacheSyncs) != 2 {
+		t.Errorf("addGatewayAPIHandlers() added %d cache syncs, expected 2 for Gateways and HTTPRoutes", len(nsi.cacheSyncs))
+	}
+}
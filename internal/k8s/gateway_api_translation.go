@@ -0,0 +1,1253 @@
+package k8s
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"maps"
+	"slices"
+	"strings"
+
+	conf_v1 "github.com/nginx/kubernetes-ingress/pkg/apis/configuration/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gateway_v1 "sigs.k8s.io/gateway-api/apis/v1"
+	gateway_v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+const (
+	gatewayKind   = "Gateway"
+	httpRouteKind = "HTTPRoute"
+	tlsRouteKind  = "TLSRoute"
+	tcpRouteKind  = "TCPRoute"
+	serviceKind   = "Service"
+
+	defaultGatewayHTTPPort  = 80
+	defaultGatewayHTTPSPort = 443
+
+	// routeReasonHostnameConflict is the reason of the Accepted condition of the routes whose hostname is taken by another resource.
+	routeReasonHostnameConflict gateway_v1.RouteConditionReason = "HostnameConflict"
+	// listenerReasonUnsupportedValue is the reason of the Accepted condition of the listeners with unsupported fields.
+	listenerReasonUnsupportedValue gateway_v1.ListenerConditionReason = "UnsupportedValue"
+)
+
+// gatewayAPIResources holds the Gateway API resources watched by the Ingress Controller.
+// GatewayClasses and Gateways only include the resources handled by the Ingress Controller, while routes include all the routes.
+type gatewayAPIResources struct {
+	GatewayClasses []*gateway_v1.GatewayClass
+	Gateways       []*gateway_v1.Gateway
+	HTTPRoutes     []*gateway_v1.HTTPRoute
+	TLSRoutes      []*gateway_v1alpha2.TLSRoute
+	TCPRoutes      []*gateway_v1alpha2.TCPRoute
+}
+
+// gatewayAPITranslation is the result of the translation of the Gateway API resources.
+type gatewayAPITranslation struct {
+	// virtualServers holds the VirtualServers translated from HTTPRoutes by their key.
+	virtualServers map[string]*conf_v1.VirtualServer
+	// transportServers holds the TransportServers translated from TLSRoutes and TCPRoutes by their key.
+	transportServers map[string]*conf_v1.TransportServer
+	// httpsListeners holds the HTTPS listeners with the TLS Secret of the Gateway listener by the key of the VirtualServers.
+	httpsListeners map[string]*conf_v1.Listener
+	// gatewayStatuses holds the statuses of the Gateways by their key.
+	gatewayStatuses map[string]gateway_v1.GatewayStatus
+	// routeParentStatuses holds the statuses of the parents handled by the Ingress Controller by the key with the kind of the routes.
+	routeParentStatuses map[string][]gateway_v1.RouteParentStatus
+}
+
+// applyHTTPSListener sets the TLS Secret of the Gateway listener the VirtualServer is bound to.
+func (t *gatewayAPITranslation) applyHTTPSListener(vsc *VirtualServerConfiguration) {
+	if t == nil {
+		return
+	}
+
+	l, exists := t.httpsListeners[getResourceKey(&vsc.VirtualServer.ObjectMeta)]
+	if !exists {
+		return
+	}
+
+	if vsc.HTTPSListener == nil {
+		vsc.HTTPSListener = l.DeepCopy()
+		return
+	}
+
+	listener := vsc.HTTPSListener.DeepCopy()
+	if listener.TLS == nil {
+		listener.TLS = &conf_v1.ListenerTLS{}
+	}
+	listener.TLS.Secret = l.TLS.Secret
+	vsc.HTTPSListener = listener
+}
+
+// rejectRoute sets the Accepted condition of the accepted parents of the route the resource was translated from to False.
+func (t *gatewayAPITranslation) rejectRoute(objectMeta *metav1.ObjectMeta, reason gateway_v1.RouteConditionReason, message string) {
+	key, ok := gatewayAPIRouteKey(objectMeta)
+	if !ok {
+		return
+	}
+
+	rejectRouteParents(t.routeParentStatuses[key], reason, message)
+}
+
+// rejectRouteParents sets the Accepted condition of the accepted parents to False.
+func rejectRouteParents(parents []gateway_v1.RouteParentStatus, reason gateway_v1.RouteConditionReason, message string) {
+	for i := range parents {
+		cond := meta.FindStatusCondition(parents[i].Conditions, string(gateway_v1.RouteConditionAccepted))
+		if cond != nil && cond.Status == metav1.ConditionTrue {
+			cond.Status = metav1.ConditionFalse
+			cond.Reason = string(reason)
+			cond.Message = message
+		}
+	}
+}
+
+// gatewayAPIRouteKey returns the key with the kind of the route the resource was translated from.
+func gatewayAPIRouteKey(objectMeta *metav1.ObjectMeta) (string, bool) {
+	for _, ref := range objectMeta.OwnerReferences {
+		if isGatewayAPIGroupVersion(ref.APIVersion) {
+			return fmt.Sprintf("%s/%s/%s", ref.Kind, objectMeta.Namespace, ref.Name), true
+		}
+	}
+
+	return "", false
+}
+
+// isGatewayAPIGroupVersion checks if the API version belongs to the Gateway API group.
+func isGatewayAPIGroupVersion(apiVersion string) bool {
+	return strings.HasPrefix(apiVersion, gateway_v1.GroupName+"/")
+}
+
+// gatewayListener is a listener of a Gateway handled by the Ingress Controller.
+type gatewayListener struct {
+	gateway *gateway_v1.Gateway
+	spec    gateway_v1.Listener
+	// nginxListener is the name of the GlobalConfiguration listener the listener is bound to.
+	// It is empty for the default HTTP and HTTPS listeners.
+	nginxListener string
+	// tlsSecret is the namespace-qualified name of the TLS Secret of an HTTPS listener.
+	tlsSecret      string
+	routeKinds     []gateway_v1.RouteGroupKind
+	attachedRoutes int32
+	accepted       metav1.Condition
+	resolvedRefs   metav1.Condition
+}
+
+func (l *gatewayListener) isValid() bool {
+	return l.accepted.Status == metav1.ConditionTrue && l.resolvedRefs.Status == metav1.ConditionTrue
+}
+
+// allowsRoute checks if a route of the kind from the namespace can attach to the listener.
+func (l *gatewayListener) allowsRoute(kind string, namespace string) bool {
+	if !slices.ContainsFunc(l.routeKinds, func(k gateway_v1.RouteGroupKind) bool { return string(k.Kind) == kind }) {
+		return false
+	}
+
+	if l.spec.AllowedRoutes == nil || l.spec.AllowedRoutes.Namespaces == nil || l.spec.AllowedRoutes.Namespaces.From == nil {
+		return namespace == l.gateway.Namespace
+	}
+
+	switch *l.spec.AllowedRoutes.Namespaces.From {
+	case gateway_v1.NamespacesFromAll:
+		return true
+	case gateway_v1.NamespacesFromSame:
+		return namespace == l.gateway.Namespace
+	}
+
+	return false
+}
+
+// routeAttachment is a listener a route is attached to along with the hostnames of the route on the listener.
+type routeAttachment struct {
+	listener  *gatewayListener
+	hostnames []string
+	// parent is the index of the parent status of the route.
+	parent int
+}
+
+// gatewayAPITranslator translates the Gateway API resources into VirtualServers and TransportServers.
+type gatewayAPITranslator struct {
+	controllerName     gateway_v1.GatewayController
+	tlsPassthroughPort int
+
+	listeners               map[string]conf_v1.Listener
+	isTLSPassthroughEnabled bool
+	gatewayListeners        map[string][]*gatewayListener
+	translation             *gatewayAPITranslation
+}
+
+// newGatewayAPITranslator creates a translator for the Gateway API resources of the GatewayClasses with the controller name.
+func newGatewayAPITranslator(controllerName string, tlsPassthroughPort int) *gatewayAPITranslator {
+	return &gatewayAPITranslator{
+		controllerName:     gateway_v1.GatewayController(controllerName),
+		tlsPassthroughPort: tlsPassthroughPort,
+	}
+}
+
+// translate translates the Gateway API resources using the listeners of the GlobalConfiguration.
+func (t *gatewayAPITranslator) translate(resources gatewayAPIResources, listeners map[string]conf_v1.Listener, isTLSPassthroughEnabled bool) *gatewayAPITranslation {
+	t.listeners = listeners
+	t.isTLSPassthroughEnabled = isTLSPassthroughEnabled
+	t.gatewayListeners = make(map[string][]*gatewayListener)
+	t.translation = &gatewayAPITranslation{
+		virtualServers:      make(map[string]*conf_v1.VirtualServer),
+		transportServers:    make(map[string]*conf_v1.TransportServer),
+		httpsListeners:      make(map[string]*conf_v1.Listener),
+		gatewayStatuses:     make(map[string]gateway_v1.GatewayStatus),
+		routeParentStatuses: make(map[string][]gateway_v1.RouteParentStatus),
+	}
+
+	for _, gw := range resources.Gateways {
+		t.gatewayListeners[getResourceKey(&gw.ObjectMeta)] = t.buildGatewayListeners(gw)
+	}
+
+	for _, route := range resources.HTTPRoutes {
+		t.translateHTTPRoute(route)
+	}
+	for _, route := range resources.TLSRoutes {
+		t.translateTLSRoute(route)
+	}
+	for _, route := range resources.TCPRoutes {
+		t.translateTCPRoute(route)
+	}
+
+	for _, gw := range resources.Gateways {
+		key := getResourceKey(&gw.ObjectMeta)
+		t.translation.gatewayStatuses[key] = buildGatewayStatus(gw, t.gatewayListeners[key])
+	}
+
+	return t.translation
+}
+
+// buildGatewayListeners binds the listeners of the Gateway to the listeners of NGINX.
+// HTTP and HTTPS listeners on the ports 80 and 443 are bound to the default listeners, while the listeners on other ports
+// need a GlobalConfiguration listener with the same port and protocol. TLS listeners are bound to the TLS Passthrough listener.
+func (t *gatewayAPITranslator) buildGatewayListeners(gw *gateway_v1.Gateway) []*gatewayListener {
+	listeners := make([]*gatewayListener, 0, len(gw.Spec.Listeners))
+
+	for _, spec := range gw.Spec.Listeners {
+		l := &gatewayListener{
+			gateway:      gw,
+			spec:         spec,
+			accepted:     newGatewayAPICondition(gw.Generation, string(gateway_v1.ListenerConditionAccepted), true, string(gateway_v1.ListenerReasonAccepted), "Listener is accepted"),
+			resolvedRefs: newGatewayAPICondition(gw.Generation, string(gateway_v1.ListenerConditionResolvedRefs), true, string(gateway_v1.ListenerReasonResolvedRefs), "References are resolved"),
+		}
+		listeners = append(listeners, l)
+
+		var routeKind string
+		switch spec.Protocol {
+		case gateway_v1.HTTPProtocolType:
+			routeKind = httpRouteKind
+			if spec.Port != defaultGatewayHTTPPort {
+				l.nginxListener = t.findNGINXListener(conf_v1.HTTPProtocol, false, int(spec.Port), gw.Namespace)
+			}
+		case gateway_v1.HTTPSProtocolType:
+			routeKind = httpRouteKind
+			if spec.Port != defaultGatewayHTTPSPort {
+				l.nginxListener = t.findNGINXListener(conf_v1.HTTPProtocol, true, int(spec.Port), gw.Namespace)
+			}
+			l.tlsSecret = t.getListenerTLSSecret(l)
+		case gateway_v1.TLSProtocolType:
+			routeKind = tlsRouteKind
+			if t.isTLSPassthroughEnabled && int(spec.Port) == t.tlsPassthroughPort {
+				l.nginxListener = conf_v1.TLSPassthroughListenerName
+			}
+			if spec.TLS != nil && spec.TLS.Mode != nil && *spec.TLS.Mode != gateway_v1.TLSModePassthrough {
+				l.accepted = newGatewayAPICondition(gw.Generation, string(gateway_v1.ListenerConditionAccepted), false, string(listenerReasonUnsupportedValue),
+					"Only the Passthrough TLS mode is supported")
+			}
+		case gateway_v1.TCPProtocolType:
+			routeKind = tcpRouteKind
+			l.nginxListener = t.findNGINXListener("TCP", false, int(spec.Port), gw.Namespace)
+		default:
+			l.accepted = newGatewayAPICondition(gw.Generation, string(gateway_v1.ListenerConditionAccepted), false, string(gateway_v1.ListenerReasonUnsupportedProtocol),
+				fmt.Sprintf("Protocol %s is not supported", spec.Protocol))
+			continue
+		}
+
+		isDefaultPort := (spec.Protocol == gateway_v1.HTTPProtocolType && spec.Port == defaultGatewayHTTPPort) ||
+			(spec.Protocol == gateway_v1.HTTPSProtocolType && spec.Port == defaultGatewayHTTPSPort)
+		if !isDefaultPort && l.nginxListener == "" && l.accepted.Status == metav1.ConditionTrue {
+			l.accepted = newGatewayAPICondition(gw.Generation, string(gateway_v1.ListenerConditionAccepted), false, string(gateway_v1.ListenerReasonPortUnavailable),
+				fmt.Sprintf("No listener of NGINX with the protocol %s and the port %d is available", spec.Protocol, spec.Port))
+		}
+
+		if spec.AllowedRoutes != nil && spec.AllowedRoutes.Namespaces != nil && spec.AllowedRoutes.Namespaces.From != nil &&
+			*spec.AllowedRoutes.Namespaces.From == gateway_v1.NamespacesFromSelector {
+			l.accepted = newGatewayAPICondition(gw.Generation, string(gateway_v1.ListenerConditionAccepted), false, string(listenerReasonUnsupportedValue),
+				"Namespace selectors of allowed routes are not supported")
+		}
+
+		t.buildListenerRouteKinds(l, routeKind)
+	}
+
+	return listeners
+}
+
+// findNGINXListener returns the name of the GlobalConfiguration listener with the protocol and the port that allows the namespace.
+func (t *gatewayAPITranslator) findNGINXListener(protocol string, isSSL bool, port int, namespace string) string {
+	for _, name := range slices.Sorted(maps.Keys(t.listeners)) {
+		l := t.listeners[name]
+		if l.Protocol == protocol && l.Ssl == isSSL && l.Port == port && isListenerAllowedForNamespace(l, namespace) {
+			return name
+		}
+	}
+
+	return ""
+}
+
+// getListenerTLSSecret returns the namespace-qualified name of the TLS Secret of an HTTPS listener.
+// Only a single Secret from the namespace of the Gateway is supported.
+func (t *gatewayAPITranslator) getListenerTLSSecret(l *gatewayListener) string {
+	gen := l.gateway.Generation
+	tls := l.spec.TLS
+
+	if tls == nil || len(tls.CertificateRefs) == 0 {
+		l.resolvedRefs = newGatewayAPICondition(gen, string(gateway_v1.ListenerConditionResolvedRefs), false, string(gateway_v1.ListenerReasonInvalidCertificateRef),
+			"HTTPS listeners require a certificate reference")
+		return ""
+	}
+
+	if tls.Mode != nil && *tls.Mode != gateway_v1.TLSModeTerminate {
+		l.accepted = newGatewayAPICondition(gen, string(gateway_v1.ListenerConditionAccepted), false, string(listenerReasonUnsupportedValue),
+			"Only the Terminate TLS mode is supported for HTTPS listeners")
+		return ""
+	}
+
+	ref := tls.CertificateRefs[0]
+	if (ref.Group != nil && *ref.Group != "") || (ref.Kind != nil && *ref.Kind != "Secret") {
+		l.resolvedRefs = newGatewayAPICondition(gen, string(gateway_v1.ListenerConditionResolvedRefs), false, string(gateway_v1.ListenerReasonInvalidCertificateRef),
+			"Only Secrets are supported as certificate references")
+		return ""
+	}
+
+	if ref.Namespace != nil && string(*ref.Namespace) != l.gateway.Namespace {
+		l.resolvedRefs = newGatewayAPICondition(gen, string(gateway_v1.ListenerConditionResolvedRefs), false, string(gateway_v1.ListenerReasonRefNotPermitted),
+			"Certificate references to other namespaces are not permitted")
+		return ""
+	}
+
+	return fmt.Sprintf("%s/%s", l.gateway.Namespace, ref.Name)
+}
+
+// buildListenerRouteKinds sets the kinds of the routes that can attach to the listener.
+func (t *gatewayAPITranslator) buildListenerRouteKinds(l *gatewayListener, supportedKind string) {
+	group := gateway_v1.Group(gateway_v1.GroupName)
+	supported := gateway_v1.RouteGroupKind{Group: &group, Kind: gateway_v1.Kind(supportedKind)}
+
+	if l.spec.AllowedRoutes == nil || len(l.spec.AllowedRoutes.Kinds) == 0 {
+		l.routeKinds = []gateway_v1.RouteGroupKind{supported}
+		return
+	}
+
+	for _, k := range l.spec.AllowedRoutes.Kinds {
+		if (k.Group == nil || *k.Group == group) && string(k.Kind) == supportedKind {
+			l.routeKinds = []gateway_v1.RouteGroupKind{supported}
+			continue
+		}
+
+		l.resolvedRefs = newGatewayAPICondition(l.gateway.Generation, string(gateway_v1.ListenerConditionResolvedRefs), false, string(gateway_v1.ListenerReasonInvalidRouteKinds),
+			fmt.Sprintf("Route kind %s is not supported by the listener", k.Kind))
+	}
+}
+
+// buildGatewayStatus builds the status of the Gateway from the status of its listeners.
+func buildGatewayStatus(gw *gateway_v1.Gateway, listeners []*gatewayListener) gateway_v1.GatewayStatus {
+	status := gateway_v1.GatewayStatus{}
+	valid := 0
+
+	for _, l := range listeners {
+		programmed := newGatewayAPICondition(gw.Generation, string(gateway_v1.ListenerConditionProgrammed), true, string(gateway_v1.ListenerReasonProgrammed), "Listener is programmed")
+		if l.isValid() {
+			valid++
+		} else {
+			programmed = newGatewayAPICondition(gw.Generation, string(gateway_v1.ListenerConditionProgrammed), false, string(gateway_v1.ListenerReasonInvalid), "Listener is invalid")
+		}
+
+		status.Listeners = append(status.Listeners, gateway_v1.ListenerStatus{
+			Name:           l.spec.Name,
+			SupportedKinds: l.routeKinds,
+			AttachedRoutes: l.attachedRoutes,
+			Conditions:     []metav1.Condition{l.accepted, l.resolvedRefs, programmed},
+		})
+	}
+
+	accepted := newGatewayAPICondition(gw.Generation, string(gateway_v1.GatewayConditionAccepted), true, string(gateway_v1.GatewayReasonAccepted), "Gateway is accepted")
+	if valid < len(listeners) {
+		accepted = newGatewayAPICondition(gw.Generation, string(gateway_v1.GatewayConditionAccepted), true, string(gateway_v1.GatewayReasonListenersNotValid),
+			"Some listeners of the Gateway are invalid")
+	}
+
+	programmed := newGatewayAPICondition(gw.Generation, string(gateway_v1.GatewayConditionProgrammed), true, string(gateway_v1.GatewayReasonProgrammed), "Gateway is programmed")
+	if valid == 0 {
+		programmed = newGatewayAPICondition(gw.Generation, string(gateway_v1.GatewayConditionProgrammed), false, string(gateway_v1.GatewayReasonInvalid),
+			"Gateway has no valid listeners")
+	}
+
+	status.Conditions = []metav1.Condition{accepted, programmed}
+
+	return status
+}
+
+// attachRoute attaches the route to the listeners of the Gateways handled by the Ingress Controller.
+// It returns the attachments and the statuses of the parent references to those Gateways.
+// If matchHostnames is false, the hostnames of the route and the listeners are ignored.
+func (t *gatewayAPITranslator) attachRoute(kind string, objectMeta *metav1.ObjectMeta, spec gateway_v1.CommonRouteSpec, hostnames []gateway_v1.Hostname,
+	matchHostnames bool,
+) ([]routeAttachment, []gateway_v1.RouteParentStatus) {
+	var attachments []routeAttachment
+	var parents []gateway_v1.RouteParentStatus
+
+	for _, ref := range spec.ParentRefs {
+		if (ref.Group != nil && *ref.Group != gateway_v1.GroupName) || (ref.Kind != nil && *ref.Kind != gatewayKind) {
+			continue
+		}
+
+		namespace := objectMeta.Namespace
+		if ref.Namespace != nil {
+			namespace = string(*ref.Namespace)
+		}
+
+		listeners, exists := t.gatewayListeners[fmt.Sprintf("%s/%s", namespace, ref.Name)]
+		if !exists {
+			continue
+		}
+
+		parent := gateway_v1.RouteParentStatus{
+			ParentRef:      ref,
+			ControllerName: t.controllerName,
+		}
+
+		matching, allowed, hostnameMatching := 0, 0, 0
+		for _, l := range listeners {
+			if (ref.SectionName != nil && *ref.SectionName != l.spec.Name) || (ref.Port != nil && *ref.Port != l.spec.Port) {
+				continue
+			}
+			matching++
+
+			if !l.isValid() || !l.allowsRoute(kind, objectMeta.Namespace) {
+				continue
+			}
+			allowed++
+
+			hosts := []string{""}
+			if matchHostnames {
+				hosts = intersectHostnames(l.spec.Hostname, hostnames)
+				if len(hosts) == 0 {
+					continue
+				}
+			}
+			hostnameMatching++
+
+			attachments = append(attachments, routeAttachment{listener: l, hostnames: hosts, parent: len(parents)})
+		}
+
+		var accepted metav1.Condition
+		switch {
+		case matching == 0:
+			accepted = newGatewayAPICondition(objectMeta.Generation, string(gateway_v1.RouteConditionAccepted), false, string(gateway_v1.RouteReasonNoMatchingParent),
+				"No listener of the Gateway matches the parent reference")
+		case allowed == 0:
+			accepted = newGatewayAPICondition(objectMeta.Generation, string(gateway_v1.RouteConditionAccepted), false, string(gateway_v1.RouteReasonNotAllowedByListeners),
+				"The route is not allowed by the listeners of the Gateway")
+		case hostnameMatching == 0:
+			accepted = newGatewayAPICondition(objectMeta.Generation, string(gateway_v1.RouteConditionAccepted), false, string(gateway_v1.RouteReasonNoMatchingListenerHostname),
+				"No hostname of the route matches the listeners of the Gateway")
+		default:
+			accepted = newGatewayAPICondition(objectMeta.Generation, string(gateway_v1.RouteConditionAccepted), true, string(gateway_v1.RouteReasonAccepted), "Route is accepted")
+		}
+		parent.Conditions = []metav1.Condition{accepted}
+
+		parents = append(parents, parent)
+	}
+
+	return attachments, parents
+}
+
+// finishRoute sets the ResolvedRefs condition of the parents of the route, rejects the parents whose attachments were dropped
+// and counts the route in the listeners it is attached to.
+func (t *gatewayAPITranslator) finishRoute(key string, parents []gateway_v1.RouteParentStatus, attachments []routeAttachment, resolvedRefs metav1.Condition,
+	rejectReason gateway_v1.RouteConditionReason, rejectMessage string,
+) {
+	if rejectMessage != "" {
+		rejectRouteParents(parents, rejectReason, rejectMessage)
+	}
+
+	var counted []*gatewayListener
+	for i := range parents {
+		parents[i].Conditions = append(parents[i].Conditions, resolvedRefs)
+
+		if !meta.IsStatusConditionTrue(parents[i].Conditions, string(gateway_v1.RouteConditionAccepted)) {
+			continue
+		}
+
+		attached := false
+		for _, a := range attachments {
+			if a.parent == i {
+				attached = true
+				if !slices.Contains(counted, a.listener) {
+					counted = append(counted, a.listener)
+					a.listener.attachedRoutes++
+				}
+			}
+		}
+		if !attached {
+			rejectRouteParents(parents[i:i+1], gateway_v1.RouteReasonUnsupportedValue,
+				"The route can't be attached to listeners of NGINX with different ports or TLS Secrets")
+		}
+	}
+
+	t.translation.routeParentStatuses[key] = parents
+}
+
+// bindVirtualServerListeners selects the HTTP and HTTPS listeners for the VirtualServer.
+// A VirtualServer supports a single HTTP and a single HTTPS listener with either the default or custom ports, so the attachments
+// to other listeners are dropped.
+func bindVirtualServerListeners(attachments []routeAttachment) (*gatewayListener, *gatewayListener, []routeAttachment) {
+	var http, https *gatewayListener
+	var bound []routeAttachment
+
+	for _, a := range attachments {
+		slot, other := &http, https
+		if a.listener.spec.Protocol == gateway_v1.HTTPSProtocolType {
+			slot, other = &https, http
+		}
+
+		if *slot != nil {
+			if (*slot).nginxListener != a.listener.nginxListener || (*slot).tlsSecret != a.listener.tlsSecret {
+				continue
+			}
+		} else {
+			if other != nil && (other.nginxListener == "") != (a.listener.nginxListener == "") {
+				continue
+			}
+			*slot = a.listener
+		}
+
+		bound = append(bound, a)
+	}
+
+	return http, https, bound
+}
+
+// translateHTTPRoute translates the HTTPRoute into a VirtualServer.
+func (t *gatewayAPITranslator) translateHTTPRoute(route *gateway_v1.HTTPRoute) {
+	key := getResourceKeyWithKind(httpRouteKind, &route.ObjectMeta)
+
+	attachments, parents := t.attachRoute(httpRouteKind, &route.ObjectMeta, route.Spec.CommonRouteSpec, route.Spec.Hostnames, true)
+	if len(parents) == 0 {
+		return
+	}
+
+	http, https, attachments := bindVirtualServerListeners(attachments)
+
+	upstreams, routes, resolvedRefs, err := buildVirtualServerRoutesForHTTPRoute(route)
+	if err != nil {
+		t.finishRoute(key, parents, attachments, resolvedRefs, gateway_v1.RouteReasonUnsupportedValue, err.Error())
+		return
+	}
+
+	hosts := collectAttachmentHostnames(attachments)
+	if slices.Contains(hosts, "") {
+		t.finishRoute(key, parents, attachments, resolvedRefs, gateway_v1.RouteReasonUnsupportedValue,
+			"The route or the listeners of the Gateway must specify a hostname")
+		return
+	}
+
+	t.finishRoute(key, parents, attachments, resolvedRefs, "", "")
+	if len(hosts) == 0 {
+		return
+	}
+
+	vs := &conf_v1.VirtualServer{
+		ObjectMeta: newTranslatedObjectMeta(gateway_v1.GroupVersion.String(), httpRouteKind, &route.ObjectMeta, "httproute_"+route.Name),
+		Spec: conf_v1.VirtualServerSpec{
+			Host:          hosts[0],
+			ServerAliases: hosts[1:],
+			Upstreams:     upstreams,
+			Routes:        routes,
+		},
+	}
+
+	if (http != nil && http.nginxListener != "") || (https != nil && https.nginxListener != "") {
+		vs.Spec.Listener = &conf_v1.VirtualServerListener{}
+		if http != nil {
+			vs.Spec.Listener.HTTP = http.nginxListener
+		}
+		if https != nil {
+			vs.Spec.Listener.HTTPS = https.nginxListener
+		}
+	}
+
+	var httpsListener *conf_v1.Listener
+	vsKey := getResourceKey(&vs.ObjectMeta)
+	if https != nil {
+		httpsListener = &conf_v1.Listener{
+			Name:     string(https.spec.Name),
+			Port:     int(https.spec.Port),
+			Protocol: conf_v1.HTTPProtocol,
+			Ssl:      true,
+			TLS:      &conf_v1.ListenerTLS{Secret: https.tlsSecret},
+		}
+		t.translation.httpsListeners[vsKey] = httpsListener
+	}
+
+	vs.Generation = translatedGeneration(vs.Spec, httpsListener)
+	t.translation.virtualServers[vsKey] = vs
+}
+
+// collectAttachmentHostnames returns the distinct hostnames of the attachments in their order.
+func collectAttachmentHostnames(attachments []routeAttachment) []string {
+	var hosts []string
+	for _, a := range attachments {
+		for _, h := range a.hostnames {
+			if !slices.Contains(hosts, h) {
+				hosts = append(hosts, h)
+			}
+		}
+	}
+
+	return hosts
+}
+
+// httpRouteMatch is a match of an HTTPRoute rule translated into the path and the conditions of a VirtualServer route.
+type httpRouteMatch struct {
+	path       string
+	conditions []conf_v1.Condition
+	action     *conf_v1.Action
+	splits     []conf_v1.Split
+	// specificity orders the matches with the same path: a match with a method wins over a match with more headers,
+	// which wins over a match with more query parameters.
+	specificity [3]int
+}
+
+// buildVirtualServerRoutesForHTTPRoute builds the upstreams and the routes of the VirtualServer from the rules of the HTTPRoute.
+// The rules are grouped by path, and the matches with headers, query parameters or methods become the matches of the routes.
+// It returns the ResolvedRefs condition of the route and an error if the route uses unsupported features.
+func buildVirtualServerRoutesForHTTPRoute(route *gateway_v1.HTTPRoute) ([]conf_v1.Upstream, []conf_v1.Route, metav1.Condition, error) {
+	var upstreams []conf_v1.Upstream
+	var matches []httpRouteMatch
+	resolvedRefs := newGatewayAPICondition(route.Generation, string(gateway_v1.RouteConditionResolvedRefs), true, string(gateway_v1.RouteReasonResolvedRefs), "References are resolved")
+
+	for i, rule := range route.Spec.Rules {
+		action, splits, refs, ruleUpstreams, err := buildHTTPRouteRuleAction(route, i, rule)
+		if err != nil {
+			return nil, nil, resolvedRefs, err
+		}
+		if refs != nil && resolvedRefs.Status == metav1.ConditionTrue {
+			resolvedRefs = *refs
+		}
+		upstreams = append(upstreams, ruleUpstreams...)
+
+		ruleMatches := rule.Matches
+		if len(ruleMatches) == 0 {
+			ruleMatches = []gateway_v1.HTTPRouteMatch{{}}
+		}
+
+		for _, m := range ruleMatches {
+			match, err := translateHTTPRouteMatch(m, rule.Filters)
+			if err != nil {
+				return nil, nil, resolvedRefs, err
+			}
+			match.action = action
+			match.splits = splits
+			matches = append(matches, match)
+		}
+	}
+
+	var routes []conf_v1.Route
+	paths := make(map[string]int)
+	for _, m := range matches {
+		i, exists := paths[m.path]
+		if !exists {
+			i = len(routes)
+			paths[m.path] = i
+			routes = append(routes, conf_v1.Route{Path: m.path})
+		}
+		r := &routes[i]
+
+		if len(m.conditions) == 0 {
+			if r.Action == nil && r.Splits == nil {
+				r.Action, r.Splits = m.action, m.splits
+			}
+			continue
+		}
+
+		r.Matches = append(r.Matches, conf_v1.Match{Conditions: m.conditions, Action: m.action, Splits: m.splits})
+	}
+
+	for i := range routes {
+		r := &routes[i]
+		slices.SortStableFunc(r.Matches, func(a, b conf_v1.Match) int {
+			return cmp.Compare(conditionsSpecificity(b.Conditions), conditionsSpecificity(a.Conditions))
+		})
+
+		if r.Action == nil && r.Splits == nil {
+			r.Action = &conf_v1.Action{Return: &conf_v1.ActionReturn{Code: 404, Body: "Not Found"}}
+		}
+	}
+
+	return upstreams, routes, resolvedRefs, nil
+}
+
+// conditionsSpecificity returns the precedence of the conditions of an HTTPRoute match among the matches with the same path.
+func conditionsSpecificity(conditions []conf_v1.Condition) int {
+	method, headers, args := 0, 0, 0
+	for _, c := range conditions {
+		switch {
+		case c.Variable != "":
+			method = 1
+		case c.Header != "":
+			headers++
+		case c.Argument != "":
+			args++
+		}
+	}
+
+	return method<<16 + headers<<8 + args
+}
+
+// translateHTTPRouteMatch translates the match into the path and the conditions of a VirtualServer route.
+func translateHTTPRouteMatch(m gateway_v1.HTTPRouteMatch, filters []gateway_v1.HTTPRouteFilter) (httpRouteMatch, error) {
+	var match httpRouteMatch
+
+	pathType, value := gateway_v1.PathMatchPathPrefix, "/"
+	if m.Path != nil {
+		if m.Path.Type != nil {
+			pathType = *m.Path.Type
+		}
+		if m.Path.Value != nil {
+			value = *m.Path.Value
+		}
+	}
+
+	switch pathType {
+	case gateway_v1.PathMatchPathPrefix:
+		match.path = value
+	case gateway_v1.PathMatchExact:
+		match.path = "=" + value
+	case gateway_v1.PathMatchRegularExpression:
+		match.path = "~" + value
+	default:
+		return match, fmt.Errorf("path match type %s is not supported", pathType)
+	}
+
+	for _, f := range filters {
+		if f.Type != gateway_v1.HTTPRouteFilterURLRewrite || f.URLRewrite == nil || f.URLRewrite.Path == nil {
+			continue
+		}
+		switch f.URLRewrite.Path.Type {
+		case gateway_v1.PrefixMatchHTTPPathModifier:
+			if pathType != gateway_v1.PathMatchPathPrefix {
+				return match, fmt.Errorf("path rewrites of the prefix match are only supported for prefix path matches")
+			}
+		case gateway_v1.FullPathHTTPPathModifier:
+			if pathType != gateway_v1.PathMatchExact {
+				return match, fmt.Errorf("path rewrites of the full path are only supported for exact path matches")
+			}
+		}
+	}
+
+	for _, h := range m.Headers {
+		condition := conf_v1.Condition{Header: string(h.Name), Value: h.Value}
+		if h.Type != nil {
+			switch *h.Type {
+			case gateway_v1.HeaderMatchExact:
+			case gateway_v1.HeaderMatchRegularExpression:
+				condition.Operator = "regex"
+			default:
+				return match, fmt.Errorf("header match type %s is not supported", *h.Type)
+			}
+		}
+		match.conditions = append(match.conditions, condition)
+	}
+
+	for _, q := range m.QueryParams {
+		condition := conf_v1.Condition{Argument: string(q.Name), Value: q.Value}
+		if q.Type != nil {
+			switch *q.Type {
+			case gateway_v1.QueryParamMatchExact:
+			case gateway_v1.QueryParamMatchRegularExpression:
+				condition.Operator = "regex"
+			default:
+				return match, fmt.Errorf("query parameter match type %s is not supported", *q.Type)
+			}
+		}
+		match.conditions = append(match.conditions, condition)
+	}
+
+	if m.Method != nil {
+		match.conditions = append(match.conditions, conf_v1.Condition{Method: string(*m.Method)})
+	}
+
+	return match, nil
+}
+
+// buildHTTPRouteRuleAction builds the action or the splits of the rule of the HTTPRoute along with the upstreams of its backends.
+// It returns a ResolvedRefs condition if some backend references are invalid.
+func buildHTTPRouteRuleAction(route *gateway_v1.HTTPRoute, ruleIndex int, rule gateway_v1.HTTPRouteRule,
+) (*conf_v1.Action, []conf_v1.Split, *metav1.Condition, []conf_v1.Upstream, error) {
+	var redirect *gateway_v1.HTTPRequestRedirectFilter
+	var rewrite *gateway_v1.HTTPURLRewriteFilter
+	var requestHeaders, responseHeaders *gateway_v1.HTTPHeaderFilter
+
+	for _, f := range rule.Filters {
+		switch {
+		case f.Type == gateway_v1.HTTPRouteFilterRequestRedirect && f.RequestRedirect != nil:
+			redirect = f.RequestRedirect
+		case f.Type == gateway_v1.HTTPRouteFilterURLRewrite && f.URLRewrite != nil:
+			rewrite = f.URLRewrite
+		case f.Type == gateway_v1.HTTPRouteFilterRequestHeaderModifier && f.RequestHeaderModifier != nil:
+			requestHeaders = f.RequestHeaderModifier
+		case f.Type == gateway_v1.HTTPRouteFilterResponseHeaderModifier && f.ResponseHeaderModifier != nil:
+			responseHeaders = f.ResponseHeaderModifier
+		default:
+			return nil, nil, nil, nil, fmt.Errorf("filter %s is not supported", f.Type)
+		}
+	}
+
+	if redirect != nil {
+		action, err := buildHTTPRouteRedirectAction(redirect)
+		return action, nil, nil, nil, err
+	}
+
+	proxy, err := buildHTTPRouteProxy(rewrite, requestHeaders, responseHeaders)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	var upstreams []conf_v1.Upstream
+	var weights []int32
+	var refs *metav1.Condition
+
+	for j, backend := range rule.BackendRefs {
+		if len(backend.Filters) > 0 {
+			return nil, nil, nil, nil, fmt.Errorf("filters of backends are not supported")
+		}
+
+		upstream, cond := buildGatewayAPIUpstream(route.Generation, route.Namespace, backend.BackendRef)
+		if cond != nil {
+			if refs == nil {
+				refs = cond
+			}
+			continue
+		}
+
+		weight := int32(1)
+		if backend.Weight != nil {
+			weight = *backend.Weight
+		}
+		if weight == 0 {
+			continue
+		}
+
+		upstreams = append(upstreams, conf_v1.Upstream{
+			Name:    fmt.Sprintf("rule%d-backend%d", ruleIndex, j),
+			Service: upstream.Service,
+			Port:    uint16(upstream.Port),
+		})
+		weights = append(weights, weight)
+	}
+
+	newAction := func(upstream string) *conf_v1.Action {
+		if proxy == nil {
+			return &conf_v1.Action{Pass: upstream}
+		}
+		p := proxy.DeepCopy()
+		p.Upstream = upstream
+		return &conf_v1.Action{Proxy: p}
+	}
+
+	switch len(upstreams) {
+	case 0:
+		return &conf_v1.Action{Return: &conf_v1.ActionReturn{Code: 500, Body: "Internal Server Error"}}, nil, refs, nil, nil
+	case 1:
+		return newAction(upstreams[0].Name), nil, refs, upstreams, nil
+	}
+
+	var splits []conf_v1.Split
+	for i, w := range splitWeights(weights) {
+		splits = append(splits, conf_v1.Split{Weight: w, Action: newAction(upstreams[i].Name)})
+	}
+
+	return nil, splits, refs, upstreams, nil
+}
+
+// buildGatewayAPIUpstream translates the backend reference into a TransportServer upstream without a name.
+// Only Services from the namespace of the route are supported, so it returns a ResolvedRefs condition for other references.
+func buildGatewayAPIUpstream(generation int64, namespace string, ref gateway_v1.BackendRef) (conf_v1.TransportServerUpstream, *metav1.Condition) {
+	if (ref.Group != nil && *ref.Group != "") || (ref.Kind != nil && *ref.Kind != serviceKind) {
+		cond := newGatewayAPICondition(generation, string(gateway_v1.RouteConditionResolvedRefs), false, string(gateway_v1.RouteReasonInvalidKind),
+			fmt.Sprintf("Backend %s is not a Service", ref.Name))
+		return conf_v1.TransportServerUpstream{}, &cond
+	}
+
+	if ref.Namespace != nil && string(*ref.Namespace) != namespace {
+		cond := newGatewayAPICondition(generation, string(gateway_v1.RouteConditionResolvedRefs), false, string(gateway_v1.RouteReasonRefNotPermitted),
+			fmt.Sprintf("Backend %s/%s is not in the namespace of the route", *ref.Namespace, ref.Name))
+		return conf_v1.TransportServerUpstream{}, &cond
+	}
+
+	if ref.Port == nil {
+		cond := newGatewayAPICondition(generation, string(gateway_v1.RouteConditionResolvedRefs), false, string(gateway_v1.RouteReasonUnsupportedValue),
+			fmt.Sprintf("Backend %s must specify a port", ref.Name))
+		return conf_v1.TransportServerUpstream{}, &cond
+	}
+
+	return conf_v1.TransportServerUpstream{Service: string(ref.Name), Port: int(*ref.Port)}, nil
+}
+
+// buildHTTPRouteRedirectAction translates the redirect filter into a redirect action.
+func buildHTTPRouteRedirectAction(redirect *gateway_v1.HTTPRequestRedirectFilter) (*conf_v1.Action, error) {
+	scheme := "${scheme}"
+	if redirect.Scheme != nil {
+		scheme = *redirect.Scheme
+	}
+
+	host := "${host}"
+	if redirect.Hostname != nil {
+		host = string(*redirect.Hostname)
+	}
+	if redirect.Port != nil {
+		host = fmt.Sprintf("%s:%d", host, *redirect.Port)
+	}
+
+	path := "${request_uri}"
+	if redirect.Path != nil {
+		if redirect.Path.Type != gateway_v1.FullPathHTTPPathModifier || redirect.Path.ReplaceFullPath == nil {
+			return nil, fmt.Errorf("only full path replacements are supported for redirects")
+		}
+		path = *redirect.Path.ReplaceFullPath
+	}
+
+	code := 302
+	if redirect.StatusCode != nil {
+		code = *redirect.StatusCode
+	}
+
+	return &conf_v1.Action{Redirect: &conf_v1.ActionRedirect{URL: fmt.Sprintf("%s://%s%s", scheme, host, path), Code: code}}, nil
+}
+
+// buildHTTPRouteProxy translates the rewrite and header modifier filters into a proxy action without an upstream.
+// It returns nil if the rule has no such filters.
+func buildHTTPRouteProxy(rewrite *gateway_v1.HTTPURLRewriteFilter, requestHeaders *gateway_v1.HTTPHeaderFilter, responseHeaders *gateway_v1.HTTPHeaderFilter,
+) (*conf_v1.ActionProxy, error) {
+	if rewrite == nil && requestHeaders == nil && responseHeaders == nil {
+		return nil, nil
+	}
+
+	proxy := &conf_v1.ActionProxy{}
+
+	if rewrite != nil && rewrite.Path != nil {
+		switch {
+		case rewrite.Path.Type == gateway_v1.FullPathHTTPPathModifier && rewrite.Path.ReplaceFullPath != nil:
+			proxy.RewritePath = *rewrite.Path.ReplaceFullPath
+		case rewrite.Path.Type == gateway_v1.PrefixMatchHTTPPathModifier && rewrite.Path.ReplacePrefixMatch != nil:
+			proxy.RewritePath = *rewrite.Path.ReplacePrefixMatch
+		}
+	}
+
+	var set []conf_v1.Header
+	if rewrite != nil && rewrite.Hostname != nil {
+		set = append(set, conf_v1.Header{Name: "Host", Value: string(*rewrite.Hostname)})
+	}
+
+	if requestHeaders != nil {
+		if len(requestHeaders.Add) > 0 {
+			return nil, fmt.Errorf("adding request headers is not supported")
+		}
+		for _, h := range requestHeaders.Set {
+			set = append(set, conf_v1.Header{Name: string(h.Name), Value: h.Value})
+		}
+		for _, name := range requestHeaders.Remove {
+			set = append(set, conf_v1.Header{Name: name})
+		}
+	}
+
+	if len(set) > 0 {
+		proxy.RequestHeaders = &conf_v1.ProxyRequestHeaders{Set: set}
+	}
+
+	if responseHeaders != nil {
+		proxy.ResponseHeaders = &conf_v1.ProxyResponseHeaders{Hide: responseHeaders.Remove}
+		for _, h := range responseHeaders.Set {
+			proxy.ResponseHeaders.Hide = append(proxy.ResponseHeaders.Hide, string(h.Name))
+			proxy.ResponseHeaders.Add = append(proxy.ResponseHeaders.Add, conf_v1.AddHeader{Header: conf_v1.Header{Name: string(h.Name), Value: h.Value}, Always: true})
+		}
+		for _, h := range responseHeaders.Add {
+			proxy.ResponseHeaders.Add = append(proxy.ResponseHeaders.Add, conf_v1.AddHeader{Header: conf_v1.Header{Name: string(h.Name), Value: h.Value}, Always: true})
+		}
+	}
+
+	return proxy, nil
+}
+
+// splitWeights converts the weights of the backends into percentages that add up to 100.
+// The percentages left after rounding down go to the backends with the largest remainders.
+func splitWeights(weights []int32) []int {
+	var total int64
+	for _, w := range weights {
+		total += int64(w)
+	}
+
+	percentages := make([]int, len(weights))
+	remainders := make([]int64, len(weights))
+	left := 100
+	for i, w := range weights {
+		percentages[i] = int(int64(w) * 100 / total)
+		remainders[i] = int64(w) * 100 % total
+		left -= percentages[i]
+	}
+
+	order := make([]int, len(weights))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int { return cmp.Compare(remainders[b], remainders[a]) })
+
+	for i := 0; i < left; i++ {
+		percentages[order[i%len(order)]]++
+	}
+
+	return percentages
+}
+
+// translateTLSRoute translates the TLSRoute into TransportServers for the TLS Passthrough listener, one per hostname.
+func (t *gatewayAPITranslator) translateTLSRoute(route *gateway_v1alpha2.TLSRoute) {
+	key := getResourceKeyWithKind(tlsRouteKind, &route.ObjectMeta)
+
+	attachments, parents := t.attachRoute(tlsRouteKind, &route.ObjectMeta, route.Spec.CommonRouteSpec, route.Spec.Hostnames, true)
+	if len(parents) == 0 {
+		return
+	}
+
+	var backendRefs [][]gateway_v1.BackendRef
+	for _, rule := range route.Spec.Rules {
+		backendRefs = append(backendRefs, rule.BackendRefs)
+	}
+
+	upstreams, action, resolvedRefs, err := buildTransportServerAction(route.Generation, route.Namespace, backendRefs)
+	if err != nil {
+		t.finishRoute(key, parents, attachments, resolvedRefs, gateway_v1.RouteReasonUnsupportedValue, err.Error())
+		return
+	}
+
+	hosts := collectAttachmentHostnames(attachments)
+	if slices.Contains(hosts, "") {
+		t.finishRoute(key, parents, attachments, resolvedRefs, gateway_v1.RouteReasonUnsupportedValue,
+			"The route or the listeners of the Gateway must specify a hostname")
+		return
+	}
+
+	t.finishRoute(key, parents, attachments, resolvedRefs, "", "")
+	if action == nil {
+		return
+	}
+
+	for i, host := range hosts {
+		name := "tlsroute_" + route.Name
+		if i > 0 {
+			name = fmt.Sprintf("%s_%d", name, i)
+		}
+
+		ts := &conf_v1.TransportServer{
+			ObjectMeta: newTranslatedObjectMeta(gateway_v1alpha2.GroupVersion.String(), tlsRouteKind, &route.ObjectMeta, name),
+			Spec: conf_v1.TransportServerSpec{
+				Listener: conf_v1.TransportServerListener{
+					Name:     conf_v1.TLSPassthroughListenerName,
+					Protocol: conf_v1.TLSPassthroughListenerProtocol,
+				},
+				Host:      host,
+				Upstreams: upstreams,
+				Action:    action,
+			},
+		}
+		ts.Generation = translatedGeneration(ts.Spec, nil)
+		t.translation.transportServers[getResourceKey(&ts.ObjectMeta)] = ts
+	}
+}
+
+// translateTCPRoute translates the TCPRoute into a TransportServer for the GlobalConfiguration listener of the Gateway listener.
+func (t *gatewayAPITranslator) translateTCPRoute(route *gateway_v1alpha2.TCPRoute) {
+	key := getResourceKeyWithKind(tcpRouteKind, &route.ObjectMeta)
+
+	attachments, parents := t.attachRoute(tcpRouteKind, &route.ObjectMeta, route.Spec.CommonRouteSpec, nil, false)
+	if len(parents) == 0 {
+		return
+	}
+
+	var bound []routeAttachment
+	for _, a := range attachments {
+		if len(bound) == 0 || bound[0].listener.nginxListener == a.listener.nginxListener {
+			bound = append(bound, a)
+		}
+	}
+
+	var backendRefs [][]gateway_v1.BackendRef
+	for _, rule := range route.Spec.Rules {
+		backendRefs = append(backendRefs, rule.BackendRefs)
+	}
+
+	upstreams, action, resolvedRefs, err := buildTransportServerAction(route.Generation, route.Namespace, backendRefs)
+	if err != nil {
+		t.finishRoute(key, parents, bound, resolvedRefs, gateway_v1.RouteReasonUnsupportedValue, err.Error())
+		return
+	}
+
+	t.finishRoute(key, parents, bound, resolvedRefs, "", "")
+	if action == nil || len(bound) == 0 {
+		return
+	}
+
+	ts := &conf_v1.TransportServer{
+		ObjectMeta: newTranslatedObjectMeta(gateway_v1alpha2.GroupVersion.String(), tcpRouteKind, &route.ObjectMeta, "tcproute_"+route.Name),
+		Spec: conf_v1.TransportServerSpec{
+			Listener: conf_v1.TransportServerListener{
+				Name:     bound[0].listener.nginxListener,
+				Protocol: "TCP",
+			},
+			Upstreams: upstreams,
+			Action:    action,
+		},
+	}
+	ts.Generation = translatedGeneration(ts.Spec, nil)
+	t.translation.transportServers[getResourceKey(&ts.ObjectMeta)] = ts
+}
+
+// buildTransportServerAction builds the upstreams and the action of a TransportServer from the backends of the rules of a TLSRoute
+// or a TCPRoute. Only a single rule is supported. The action is nil if the rule has no valid backends.
+func buildTransportServerAction(generation int64, namespace string, ruleBackendRefs [][]gateway_v1.BackendRef,
+) ([]conf_v1.TransportServerUpstream, *conf_v1.TransportServerAction, metav1.Condition, error) {
+	resolvedRefs := newGatewayAPICondition(generation, string(gateway_v1.RouteConditionResolvedRefs), true, string(gateway_v1.RouteReasonResolvedRefs), "References are resolved")
+
+	if len(ruleBackendRefs) != 1 {
+		return nil, nil, resolvedRefs, fmt.Errorf("exactly one rule is supported")
+	}
+
+	var upstreams []conf_v1.TransportServerUpstream
+	var weights []int32
+	for j, backend := range ruleBackendRefs[0] {
+		upstream, cond := buildGatewayAPIUpstream(generation, namespace, backend)
+		if cond != nil {
+			if resolvedRefs.Status == metav1.ConditionTrue {
+				resolvedRefs = *cond
+			}
+			continue
+		}
+
+		weight := int32(1)
+		if backend.Weight != nil {
+			weight = *backend.Weight
+		}
+		if weight == 0 {
+			continue
+		}
+
+		upstream.Name = fmt.Sprintf("backend%d", j)
+		upstreams = append(upstreams, upstream)
+		weights = append(weights, weight)
+	}
+
+	switch len(upstreams) {
+	case 0:
+		return nil, nil, resolvedRefs, nil
+	case 1:
+		return upstreams, &conf_v1.TransportServerAction{Pass: upstreams[0].Name}, resolvedRefs, nil
+	}
+
+	action := &conf_v1.TransportServerAction{}
+	for i, w := range splitWeights(weights) {
+		action.Splits = append(action.Splits, conf_v1.TransportServerSplit{Weight: w, Pass: upstreams[i].Name})
+	}
+
+	return upstreams, action, resolvedRefs, nil
+}
+
+// intersectHostnames returns the hostnames of the route that match the hostname of the listener.
+// If the route has no hostnames, it gets the hostname of the listener. A wildcard hostname of either side matches the hostnames
+// with any labels in place of the wildcard, and the more specific hostname of the two is returned.
+func intersectHostnames(listenerHostname *gateway_v1.Hostname, routeHostnames []gateway_v1.Hostname) []string {
+	listener := ""
+	if listenerHostname != nil {
+		listener = string(*listenerHostname)
+	}
+
+	if len(routeHostnames) == 0 {
+		return []string{listener}
+	}
+
+	var hosts []string
+	for _, h := range routeHostnames {
+		route := string(h)
+		var host string
+		switch {
+		case listener == "" || listener == route:
+			host = route
+		case strings.HasPrefix(listener, "*.") && strings.HasSuffix(route, listener[1:]):
+			host = route
+		case strings.HasPrefix(route, "*.") && strings.HasSuffix(listener, route[1:]):
+			host = listener
+		default:
+			continue
+		}
+
+		if !slices.Contains(hosts, host) {
+			hosts = append(hosts, host)
+		}
+	}
+
+	return hosts
+}
+
+// newTranslatedObjectMeta creates the metadata of a resource translated from a route.
+// The resource is owned by the route and has its creation timestamp, so that conflicts with other resources are resolved like
+// for the route itself. The names contain an underscore, so they never collide with the names of real resources.
+func newTranslatedObjectMeta(apiVersion string, kind string, route *metav1.ObjectMeta, name string) metav1.ObjectMeta {
+	isController := true
+
+	return metav1.ObjectMeta{
+		Namespace:         route.Namespace,
+		Name:              name,
+		CreationTimestamp: route.CreationTimestamp,
+		OwnerReferences: []metav1.OwnerReference{
+			{
+				APIVersion: apiVersion,
+				Kind:       kind,
+				Name:       route.Name,
+				UID:        route.UID,
+				Controller: &isController,
+			},
+		},
+	}
+}
+
+// translatedGeneration returns the generation of a translated resource, which is a hash of its spec.
+// Translated resources change when the routes, the Gateways or the GlobalConfiguration change, so the generation of the route
+// can't be used to detect the changes.
+func translatedGeneration(spec interface{}, listener *conf_v1.Listener) int64 {
+	h := fnv.New64a()
+	for _, v := range []interface{}{spec, listener} {
+		b, err := json.Marshal(v)
+		if err == nil {
+			_, _ = h.Write(b)
+		}
+	}
+
+	return int64(h.Sum64() >> 1)
+}
+
+// newGatewayAPICondition creates a condition of a Gateway API resource.
+func newGatewayAPICondition(generation int64, conditionType string, status bool, reason string, message string) metav1.Condition {
+	cond := metav1.Condition{
+		Type:               conditionType,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: generation,
+		Reason:             reason,
+		Message:            message,
+	}
+	if status {
+		cond.Status = metav1.ConditionTrue
+	}
+
+	return cond
+}
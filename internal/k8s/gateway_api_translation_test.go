@@ -0,0 +1,348 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	conf_v1 "github.com/nginx/kubernetes-ingress/pkg/apis/configuration/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gateway_v1 "sigs.k8s.io/gateway-api/apis/v1"
+	gateway_v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+func createTestGateway(listeners ...gateway_v1.Listener) *gateway_v1.Gateway {
+	return &gateway_v1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "gateway",
+		},
+		Spec: gateway_v1.GatewaySpec{
+			GatewayClassName: "nginx",
+			Listeners:        listeners,
+		},
+	}
+}
+
+func createTestHTTPRoute(namespace string, name string, hostnames ...gateway_v1.Hostname) *gateway_v1.HTTPRoute {
+	gatewayNamespace := gateway_v1.Namespace("default")
+
+	return &gateway_v1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         namespace,
+			Name:              name,
+			CreationTimestamp: metav1.Now(),
+		},
+		Spec: gateway_v1.HTTPRouteSpec{
+			CommonRouteSpec: gateway_v1.CommonRouteSpec{
+				ParentRefs: []gateway_v1.ParentReference{{Namespace: &gatewayNamespace, Name: "gateway"}},
+			},
+			Hostnames: hostnames,
+		},
+	}
+}
+
+func createTestBackendRef(name string, port int32, weight int32) gateway_v1.BackendRef {
+	p := gateway_v1.PortNumber(port)
+
+	return gateway_v1.BackendRef{
+		BackendObjectReference: gateway_v1.BackendObjectReference{Name: gateway_v1.ObjectName(name), Port: &p},
+		Weight:                 &weight,
+	}
+}
+
+func getAcceptedCondition(parents []gateway_v1.RouteParentStatus) *metav1.Condition {
+	if len(parents) != 1 {
+		return nil
+	}
+
+	return meta.FindStatusCondition(parents[0].Conditions, string(gateway_v1.RouteConditionAccepted))
+}
+
+func TestTranslateHTTPRoute(t *testing.T) {
+	t.Parallel()
+	hostname := gateway_v1.Hostname("*.example.com")
+	gw := createTestGateway(gateway_v1.Listener{Name: "http", Hostname: &hostname, Port: 80, Protocol: gateway_v1.HTTPProtocolType})
+
+	exact := gateway_v1.PathMatchExact
+	coffee := "/coffee"
+	route := createTestHTTPRoute("default", "cafe", "cafe.example.com", "tea.example.com", "cafe.example.org")
+	route.Spec.Rules = []gateway_v1.HTTPRouteRule{
+		{
+			BackendRefs: []gateway_v1.HTTPBackendRef{
+				{BackendRef: createTestBackendRef("tea-v1", 80, 1)},
+				{BackendRef: createTestBackendRef("tea-v2", 80, 2)},
+			},
+		},
+		{
+			Matches: []gateway_v1.HTTPRouteMatch{
+				{
+					Path:    &gateway_v1.HTTPPathMatch{Type: &exact, Value: &coffee},
+					Headers: []gateway_v1.HTTPHeaderMatch{{Name: "x-version", Value: "v2"}},
+				},
+			},
+			BackendRefs: []gateway_v1.HTTPBackendRef{{BackendRef: createTestBackendRef("coffee", 8080, 1)}},
+		},
+	}
+
+	translation := newGatewayAPITranslator("nginx.org/ingress-controller", 443).translate(gatewayAPIResources{
+		Gateways:   []*gateway_v1.Gateway{gw},
+		HTTPRoutes: []*gateway_v1.HTTPRoute{route},
+	}, nil, false)
+
+	expectedSpec := conf_v1.VirtualServerSpec{
+		Host:          "cafe.example.com",
+		ServerAliases: []string{"tea.example.com"},
+		Upstreams: []conf_v1.Upstream{
+			{Name: "rule0-backend0", Service: "tea-v1", Port: 80},
+			{Name: "rule0-backend1", Service: "tea-v2", Port: 80},
+			{Name: "rule1-backend0", Service: "coffee", Port: 8080},
+		},
+		Routes: []conf_v1.Route{
+			{
+				Path: "/",
+				Splits: []conf_v1.Split{
+					{Weight: 33, Action: &conf_v1.Action{Pass: "rule0-backend0"}},
+					{Weight: 67, Action: &conf_v1.Action{Pass: "rule0-backend1"}},
+				},
+			},
+			{
+				Path: "=/coffee",
+				Matches: []conf_v1.Match{
+					{
+						Conditions: []conf_v1.Condition{{Header: "x-version", Value: "v2"}},
+						Action:     &conf_v1.Action{Pass: "rule1-backend0"},
+					},
+				},
+				Action: &conf_v1.Action{Return: &conf_v1.ActionReturn{Code: 404, Body: "Not Found"}},
+			},
+		},
+	}
+
+	vs, exists := translation.virtualServers["default/httproute_cafe"]
+	if !exists {
+		t.Fatalf("translate() didn't return the VirtualServer for the HTTPRoute")
+	}
+	if diff := cmp.Diff(expectedSpec, vs.Spec); diff != "" {
+		t.Errorf("translate() returned unexpected VirtualServer spec (-want +got):\n%s", diff)
+	}
+
+	if err := createTestConfiguration().virtualServerValidator.ValidateVirtualServer(vs); err != nil {
+		t.Errorf("translate() returned an invalid VirtualServer: %v", err)
+	}
+
+	cond := getAcceptedCondition(translation.routeParentStatuses["HTTPRoute/default/cafe"])
+	if cond == nil || cond.Status != metav1.ConditionTrue {
+		t.Errorf("translate() returned the Accepted condition %v for the HTTPRoute but expected True", cond)
+	}
+
+	gwStatus := translation.gatewayStatuses["default/gateway"]
+	if len(gwStatus.Listeners) != 1 || gwStatus.Listeners[0].AttachedRoutes != 1 {
+		t.Errorf("translate() returned the listener statuses %v but expected a listener with 1 attached route", gwStatus.Listeners)
+	}
+}
+
+func TestTranslateHTTPRouteWithHTTPSListener(t *testing.T) {
+	t.Parallel()
+	gw := createTestGateway(gateway_v1.Listener{
+		Name:     "https",
+		Port:     8443,
+		Protocol: gateway_v1.HTTPSProtocolType,
+		TLS: &gateway_v1.ListenerTLSConfig{
+			CertificateRefs: []gateway_v1.SecretObjectReference{{Name: "cafe-secret"}},
+		},
+	})
+	route := createTestHTTPRoute("default", "cafe", "cafe.example.com")
+	route.Spec.Rules = []gateway_v1.HTTPRouteRule{{BackendRefs: []gateway_v1.HTTPBackendRef{{BackendRef: createTestBackendRef("tea", 80, 1)}}}}
+
+	listeners := map[string]conf_v1.Listener{
+		"https-8443": {Name: "https-8443", Port: 8443, Protocol: conf_v1.HTTPProtocol, Ssl: true},
+	}
+
+	translation := newGatewayAPITranslator("nginx.org/ingress-controller", 443).translate(gatewayAPIResources{
+		Gateways:   []*gateway_v1.Gateway{gw},
+		HTTPRoutes: []*gateway_v1.HTTPRoute{route},
+	}, listeners, false)
+
+	vs, exists := translation.virtualServers["default/httproute_cafe"]
+	if !exists {
+		t.Fatalf("translate() didn't return the VirtualServer for the HTTPRoute")
+	}
+
+	expectedListener := &conf_v1.VirtualServerListener{HTTPS: "https-8443"}
+	if diff := cmp.Diff(expectedListener, vs.Spec.Listener); diff != "" {
+		t.Errorf("translate() returned unexpected VirtualServer listener (-want +got):\n%s", diff)
+	}
+
+	l := translation.httpsListeners["default/httproute_cafe"]
+	if l == nil || l.TLS == nil || l.TLS.Secret != "default/cafe-secret" {
+		t.Errorf("translate() returned the HTTPS listener %v but expected the TLS Secret default/cafe-secret", l)
+	}
+}
+
+func TestTranslateHTTPRouteFails(t *testing.T) {
+	t.Parallel()
+	hostname := gateway_v1.Hostname("cafe.example.com")
+	gw := createTestGateway(
+		gateway_v1.Listener{Name: "http", Hostname: &hostname, Port: 80, Protocol: gateway_v1.HTTPProtocolType},
+		gateway_v1.Listener{Name: "http-8080", Port: 8080, Protocol: gateway_v1.HTTPProtocolType},
+	)
+
+	otherNamespace := createTestHTTPRoute("tea", "other-namespace", "cafe.example.com")
+
+	otherHostname := createTestHTTPRoute("default", "other-hostname", "tea.example.com")
+	http := gateway_v1.SectionName("http")
+	otherHostname.Spec.ParentRefs[0].SectionName = &http
+
+	unavailablePort := createTestHTTPRoute("default", "unavailable-port", "cafe.example.com")
+	http8080 := gateway_v1.SectionName("http-8080")
+	unavailablePort.Spec.ParentRefs[0].SectionName = &http8080
+
+	unsupportedFilter := createTestHTTPRoute("default", "unsupported-filter", "cafe.example.com")
+	unsupportedFilter.Spec.Rules = []gateway_v1.HTTPRouteRule{
+		{Filters: []gateway_v1.HTTPRouteFilter{{Type: gateway_v1.HTTPRouteFilterRequestMirror}}},
+	}
+
+	translation := newGatewayAPITranslator("nginx.org/ingress-controller", 443).translate(gatewayAPIResources{
+		Gateways:   []*gateway_v1.Gateway{gw},
+		HTTPRoutes: []*gateway_v1.HTTPRoute{otherNamespace, otherHostname, unavailablePort, unsupportedFilter},
+	}, nil, false)
+
+	tests := []struct {
+		key    string
+		reason gateway_v1.RouteConditionReason
+	}{
+		{key: "HTTPRoute/tea/other-namespace", reason: gateway_v1.RouteReasonNotAllowedByListeners},
+		{key: "HTTPRoute/default/other-hostname", reason: gateway_v1.RouteReasonNoMatchingListenerHostname},
+		{key: "HTTPRoute/default/unavailable-port", reason: gateway_v1.RouteReasonNotAllowedByListeners},
+		{key: "HTTPRoute/default/unsupported-filter", reason: gateway_v1.RouteReasonUnsupportedValue},
+	}
+
+	for _, test := range tests {
+		cond := getAcceptedCondition(translation.routeParentStatuses[test.key])
+		if cond == nil || cond.Status != metav1.ConditionFalse || cond.Reason != string(test.reason) {
+			t.Errorf("translate() returned the Accepted condition %v for %s but expected False with the reason %s", cond, test.key, test.reason)
+		}
+	}
+
+	if len(translation.virtualServers) != 0 {
+		t.Errorf("translate() returned %d VirtualServers but expected none", len(translation.virtualServers))
+	}
+
+	listenerStatuses := translation.gatewayStatuses["default/gateway"].Listeners
+	if len(listenerStatuses) != 2 || meta.IsStatusConditionTrue(listenerStatuses[1].Conditions, string(gateway_v1.ListenerConditionAccepted)) {
+		t.Errorf("translate() returned the listener statuses %v but expected the listener http-8080 not to be accepted", listenerStatuses)
+	}
+}
+
+func TestTranslateHTTPRouteMatch(t *testing.T) {
+	t.Parallel()
+	headerRegex := gateway_v1.HeaderMatchRegularExpression
+	queryRegex := gateway_v1.QueryParamMatchRegularExpression
+	method := gateway_v1.HTTPMethodPost
+
+	m := gateway_v1.HTTPRouteMatch{
+		Headers:     []gateway_v1.HTTPHeaderMatch{{Type: &headerRegex, Name: "x-version", Value: "^v[23]$"}},
+		QueryParams: []gateway_v1.HTTPQueryParamMatch{{Type: &queryRegex, Name: "user", Value: "^admin"}},
+		Method:      &method,
+	}
+
+	expected := []conf_v1.Condition{
+		{Header: "x-version", Value: "^v[23]$", Operator: "regex"},
+		{Argument: "user", Value: "^admin", Operator: "regex"},
+		{Method: "POST"},
+	}
+
+	match, err := translateHTTPRouteMatch(m, nil)
+	if err != nil {
+		t.Fatalf("translateHTTPRouteMatch() returned unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(expected, match.conditions); diff != "" {
+		t.Errorf("translateHTTPRouteMatch() returned unexpected conditions (-want +got):\n%s", diff)
+	}
+}
+
+func TestTranslateTCPRoute(t *testing.T) {
+	t.Parallel()
+	gw := createTestGateway(gateway_v1.Listener{Name: "tcp", Port: 5353, Protocol: gateway_v1.TCPProtocolType})
+	route := &gateway_v1alpha2.TCPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "dns"},
+		Spec: gateway_v1alpha2.TCPRouteSpec{
+			CommonRouteSpec: gateway_v1.CommonRouteSpec{ParentRefs: []gateway_v1.ParentReference{{Name: "gateway"}}},
+			Rules: []gateway_v1alpha2.TCPRouteRule{
+				{BackendRefs: []gateway_v1.BackendRef{createTestBackendRef("dns", 53, 1)}},
+			},
+		},
+	}
+	listeners := map[string]conf_v1.Listener{
+		"dns-tcp": {Name: "dns-tcp", Port: 5353, Protocol: "TCP"},
+	}
+
+	translation := newGatewayAPITranslator("nginx.org/ingress-controller", 443).translate(gatewayAPIResources{
+		Gateways:  []*gateway_v1.Gateway{gw},
+		TCPRoutes: []*gateway_v1alpha2.TCPRoute{route},
+	}, listeners, false)
+
+	expectedSpec := conf_v1.TransportServerSpec{
+		Listener:  conf_v1.TransportServerListener{Name: "dns-tcp", Protocol: "TCP"},
+		Upstreams: []conf_v1.TransportServerUpstream{{Name: "backend0", Service: "dns", Port: 53}},
+		Action:    &conf_v1.TransportServerAction{Pass: "backend0"},
+	}
+
+	ts, exists := translation.transportServers["default/tcproute_dns"]
+	if !exists {
+		t.Fatalf("translate() didn't return the TransportServer for the TCPRoute")
+	}
+	if diff := cmp.Diff(expectedSpec, ts.Spec, cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("translate() returned unexpected TransportServer spec (-want +got):\n%s", diff)
+	}
+
+	if key, ok := gatewayAPIRouteKey(&ts.ObjectMeta); !ok || key != "TCPRoute/default/dns" {
+		t.Errorf("gatewayAPIRouteKey() returned %q, %v but expected TCPRoute/default/dns", key, ok)
+	}
+}
+
+func TestSplitWeights(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		weights  []int32
+		expected []int
+	}{
+		{weights: []int32{1, 1}, expected: []int{50, 50}},
+		{weights: []int32{1, 2}, expected: []int{33, 67}},
+		{weights: []int32{1, 1, 1}, expected: []int{34, 33, 33}},
+		{weights: []int32{90, 10}, expected: []int{90, 10}},
+	}
+
+	for _, test := range tests {
+		if diff := cmp.Diff(test.expected, splitWeights(test.weights)); diff != "" {
+			t.Errorf("splitWeights(%v) returned unexpected result (-want +got):\n%s", test.weights, diff)
+		}
+	}
+}
+
+func TestIntersectHostnames(t *testing.T) {
+	t.Parallel()
+	wildcard := gateway_v1.Hostname("*.example.com")
+	cafe := gateway_v1.Hostname("cafe.example.com")
+
+	tests := []struct {
+		listener *gateway_v1.Hostname
+		route    []gateway_v1.Hostname
+		expected []string
+	}{
+		{listener: nil, route: []gateway_v1.Hostname{"cafe.example.com"}, expected: []string{"cafe.example.com"}},
+		{listener: &cafe, route: nil, expected: []string{"cafe.example.com"}},
+		{listener: nil, route: nil, expected: []string{""}},
+		{listener: &wildcard, route: []gateway_v1.Hostname{"cafe.example.com", "cafe.example.org"}, expected: []string{"cafe.example.com"}},
+		{listener: &cafe, route: []gateway_v1.Hostname{"*.example.com"}, expected: []string{"cafe.example.com"}},
+		{listener: &cafe, route: []gateway_v1.Hostname{"tea.example.com"}, expected: nil},
+	}
+
+	for _, test := range tests {
+		if diff := cmp.Diff(test.expected, intersectHostnames(test.listener, test.route)); diff != "" {
+			t.Errorf("intersectHostnames(%v, %v) returned unexpected result (-want +got):\n%s", test.listener, test.route, diff)
+		}
+	}
+}
@@ -108,6 +108,11 @@ func (lbc *LoadBalancerController) syncGlobalConfiguration(task task) {
 	}
 
 	lbc.processProblems(problems)
+
+	if lbc.gatewayAPITranslator != nil {
+		// the listeners of the Gateways are bound to the listeners of the GlobalConfiguration
+		lbc.enqueueGatewayAPITask()
+	}
 }
 
 // processChangesFromGlobalConfiguration processes changes that come from updates to the GlobalConfiguration resource.
@@ -135,7 +140,7 @@ func (lbc *LoadBalancerController) processChangesFromGlobalConfiguration(changes
 			}
 		case *TransportServerConfiguration:
 			if c.Op == AddOrUpdate {
-				tsEx := lbc.createTransportServerEx(impl.TransportServer, impl.ListenerPort, impl.IPv4, impl.IPv6)
+				tsEx := lbc.createTransportServerEx(impl)
 
 				updatedTSExes = append(updatedTSExes, tsEx)
 				updatedResources = append(updatedResources, impl)
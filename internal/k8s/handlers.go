@@ -131,6 +131,18 @@ func createVirtualServerHandlers(lbc *LoadBalancerController) cache.ResourceEven
 			curVs := cur.(*conf_v1.VirtualServer)
 			oldVs := old.(*conf_v1.VirtualServer)
 
+			if hasHostAnnotationChanges(&oldVs.ObjectMeta, &curVs.ObjectMeta) {
+				nl.Debugf(lbc.Logger, "VirtualServer %v host priority changed, syncing", curVs.Name)
+				lbc.AddSyncQueue(curVs)
+				return
+			}
+
+			if lbc.isNginxPlus && isVirtualServerMaintenanceToggle(oldVs, curVs) {
+				nl.Debugf(lbc.Logger, "VirtualServer %v maintenance mode changed, updating without reload", curVs.Name)
+				lbc.processVSMaintenanceChanges(oldVs, curVs)
+				return
+			}
+
 			if lbc.weightChangesDynamicReload {
 				var curVsCopy, oldVsCopy conf_v1.VirtualServer
 				err := copier.CopyWithOption(&curVsCopy, curVs, copier.Option{DeepCopy: true})
@@ -191,6 +203,12 @@ func createVirtualServerRouteHandlers(lbc *LoadBalancerController) cache.Resourc
 			curVsr := cur.(*conf_v1.VirtualServerRoute)
 			oldVsr := old.(*conf_v1.VirtualServerRoute)
 
+			if lbc.isNginxPlus && isVirtualServerRouteMaintenanceToggle(oldVsr, curVsr) {
+				nl.Debugf(lbc.Logger, "VirtualServerRoute %v maintenance mode changed, updating without reload", curVsr.Name)
+				lbc.processVSRMaintenanceChanges(oldVsr, curVsr)
+				return
+			}
+
 			if lbc.weightChangesDynamicReload {
 				var curVsrCopy, oldVsrCopy conf_v1.VirtualServerRoute
 				err := copier.CopyWithOption(&curVsrCopy, curVsr, copier.Option{DeepCopy: true})
@@ -0,0 +1,136 @@
+package k8s
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	nl "github.com/nginx/kubernetes-ingress/internal/logger"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/tools/cache"
+)
+
+// hostOwnershipRules maps host patterns to the namespaces allowed to claim the matching hosts.
+// A pattern is either a host or a wildcard host, like *.example.com, that matches the hosts with a single label in place of the wildcard.
+// The hosts that don't match any pattern can be claimed from any namespace.
+type hostOwnershipRules map[string][]string
+
+// parseHostOwnershipConfigMap parses the host ownership rules from the ConfigMap.
+// Every key of the ConfigMap is a namespace and its value is a comma-separated list of host patterns the namespace is allowed to claim.
+func parseHostOwnershipConfigMap(cm *v1.ConfigMap) (hostOwnershipRules, []string) {
+	rules := make(hostOwnershipRules)
+	var warnings []string
+
+	for _, namespace := range slices.Sorted(maps.Keys(cm.Data)) {
+		if errs := validation.IsDNS1123Label(namespace); len(errs) > 0 {
+			warnings = append(warnings, fmt.Sprintf("key %s is not a valid namespace: %s", namespace, strings.Join(errs, ", ")))
+			continue
+		}
+
+		for _, pattern := range strings.Split(cm.Data[namespace], ",") {
+			pattern = strings.TrimSpace(pattern)
+			if pattern == "" {
+				continue
+			}
+
+			var errs []string
+			if strings.HasPrefix(pattern, "*") {
+				errs = validation.IsWildcardDNS1123Subdomain(pattern)
+			} else {
+				errs = validation.IsDNS1123Subdomain(pattern)
+			}
+			if len(errs) > 0 {
+				warnings = append(warnings, fmt.Sprintf("host pattern %s of namespace %s is invalid: %s", pattern, namespace, strings.Join(errs, ", ")))
+				continue
+			}
+
+			if !slices.Contains(rules[pattern], namespace) {
+				rules[pattern] = append(rules[pattern], namespace)
+			}
+		}
+	}
+
+	return rules, warnings
+}
+
+// isHostAllowedForNamespace checks if resources from the namespace are allowed to claim the host.
+// The rules of the host take precedence over the rules of the wildcard pattern that matches the host.
+func (r hostOwnershipRules) isHostAllowedForNamespace(host string, namespace string) bool {
+	namespaces, exists := r[host]
+	if !exists {
+		namespaces, exists = r[wildcardHostPattern(host)]
+	}
+
+	return !exists || slices.Contains(namespaces, namespace)
+}
+
+// wildcardHostPattern returns the wildcard pattern that matches the host, like *.example.com for cafe.example.com.
+func wildcardHostPattern(host string) string {
+	i := strings.Index(host, ".")
+	if i < 0 {
+		return ""
+	}
+
+	return "*" + host[i:]
+}
+
+// addHostOwnershipConfigMapHandler adds the handler for the host ownership ConfigMap to the controller
+func (lbc *LoadBalancerController) addHostOwnershipConfigMapHandler(handlers cache.ResourceEventHandlerFuncs, namespace string) {
+	options := lbc.getConfigMapHandlerOptions(handlers, namespace)
+
+	lbc.hostOwnershipLister.Store, lbc.hostOwnershipController = cache.NewInformerWithOptions(options)
+	lbc.cacheSyncs = append(lbc.cacheSyncs, lbc.hostOwnershipController.HasSynced)
+}
+
+// preSyncHostOwnershipRules sets the host ownership rules before the queue is started.
+// Otherwise, the resources synced before the host ownership ConfigMap would claim their hosts regardless of the rules.
+// The warnings of the ConfigMap are reported when its task is synced.
+func (lbc *LoadBalancerController) preSyncHostOwnershipRules() {
+	if !lbc.watchHostOwnership {
+		return
+	}
+
+	obj, cmExists, err := lbc.hostOwnershipLister.GetByKey(lbc.hostOwnershipConfigMapName)
+	if err != nil {
+		nl.Errorf(lbc.Logger, "Error getting the host ownership ConfigMap %v: %v", lbc.hostOwnershipConfigMapName, err)
+		return
+	}
+	if !cmExists {
+		return
+	}
+
+	rules, _ := parseHostOwnershipConfigMap(obj.(*v1.ConfigMap))
+	nl.Debugf(lbc.Logger, "PreSync %d host ownership rules", len(rules))
+	lbc.configuration.SetHostOwnershipRules(rules)
+}
+
+// syncHostOwnershipConfigMap updates the host ownership rules from the ConfigMap.
+// If the ConfigMap doesn't exist, any namespace can claim any host.
+func (lbc *LoadBalancerController) syncHostOwnershipConfigMap(task task) {
+	obj, cmExists, err := lbc.hostOwnershipLister.GetByKey(task.Key)
+	if err != nil {
+		lbc.syncQueue.Requeue(task, err)
+		return
+	}
+
+	var rules hostOwnershipRules
+	if cmExists {
+		cm := obj.(*v1.ConfigMap)
+
+		var warnings []string
+		rules, warnings = parseHostOwnershipConfigMap(cm)
+		if len(warnings) > 0 {
+			lbc.recorder.Eventf(cm, v1.EventTypeWarning, nl.EventReasonUpdatedWithError,
+				"Host ownership ConfigMap %s updated with errors. Ignoring invalid values: %s", task.Key, strings.Join(warnings, "; "))
+		} else {
+			lbc.recorder.Eventf(cm, v1.EventTypeNormal, nl.EventReasonUpdated, "Host ownership ConfigMap %s updated without error", task.Key)
+		}
+	}
+
+	changes, problems := lbc.configuration.SetHostOwnershipRules(rules)
+
+	lbc.processChanges(changes)
+	lbc.processProblems(problems)
+}
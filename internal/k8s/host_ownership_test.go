@@ -0,0 +1,103 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	nl "github.com/nginx/kubernetes-ingress/internal/logger"
+	v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestParseHostOwnershipConfigMap(t *testing.T) {
+	t.Parallel()
+	cm := &v1.ConfigMap{
+		Data: map[string]string{
+			"cafe":    "cafe.example.com, *.cafe.example.com",
+			"tea":     "tea.example.com,cafe.example.com",
+			"Invalid": "invalid.example.com",
+			"coffee":  "coffee.example.com,coffee_example",
+		},
+	}
+
+	expectedRules := hostOwnershipRules{
+		"cafe.example.com":   {"cafe", "tea"},
+		"*.cafe.example.com": {"cafe"},
+		"tea.example.com":    {"tea"},
+		"coffee.example.com": {"coffee"},
+	}
+
+	rules, warnings := parseHostOwnershipConfigMap(cm)
+	if diff := cmp.Diff(expectedRules, rules); diff != "" {
+		t.Errorf("parseHostOwnershipConfigMap() returned unexpected rules (-want +got):\n%s", diff)
+	}
+	if len(warnings) != 2 {
+		t.Errorf("parseHostOwnershipConfigMap() returned %v but expected 2 warnings", warnings)
+	}
+}
+
+func TestIsHostAllowedForNamespace(t *testing.T) {
+	t.Parallel()
+	rules := hostOwnershipRules{
+		"*.example.com":   {"team-a"},
+		"api.example.com": {"team-b"},
+	}
+
+	tests := []struct {
+		host      string
+		namespace string
+		expected  bool
+	}{
+		{host: "cafe.example.com", namespace: "team-a", expected: true},
+		{host: "cafe.example.com", namespace: "team-b", expected: false},
+		{host: "api.example.com", namespace: "team-b", expected: true},
+		{host: "api.example.com", namespace: "team-a", expected: false},
+		{host: "*.example.com", namespace: "team-a", expected: true},
+		{host: "tea.cafe.example.com", namespace: "team-b", expected: true},
+		{host: "example.org", namespace: "team-b", expected: true},
+		{host: "", namespace: "team-b", expected: true},
+	}
+
+	for _, test := range tests {
+		if result := rules.isHostAllowedForNamespace(test.host, test.namespace); result != test.expected {
+			t.Errorf("isHostAllowedForNamespace(%q, %q) returned %v but expected %v", test.host, test.namespace, result, test.expected)
+		}
+	}
+
+	var noRules hostOwnershipRules
+	if !noRules.isHostAllowedForNamespace("cafe.example.com", "default") {
+		t.Errorf("isHostAllowedForNamespace() returned false for nil rules")
+	}
+}
+
+func TestPreSyncHostOwnershipRules(t *testing.T) {
+	t.Parallel()
+	lister := storeToConfigMapLister{Store: cache.NewStore(cache.MetaNamespaceKeyFunc)}
+	err := lister.Add(&v1.ConfigMap{
+		ObjectMeta: meta_v1.ObjectMeta{Name: "host-ownership", Namespace: "nginx-ingress"},
+		Data: map[string]string{
+			"cafe": "cafe.example.com",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lbc := LoadBalancerController{
+		Logger:                     nl.LoggerFromContext(t.Context()),
+		configuration:              createTestConfiguration(),
+		watchHostOwnership:         true,
+		hostOwnershipConfigMapName: "nginx-ingress/host-ownership",
+		hostOwnershipLister:        lister,
+	}
+
+	lbc.preSyncHostOwnershipRules()
+
+	expectedRules := hostOwnershipRules{
+		"cafe.example.com": {"cafe"},
+	}
+	if diff := cmp.Diff(expectedRules, lbc.configuration.hostOwnershipRules); diff != "" {
+		t.Errorf("preSyncHostOwnershipRules() set unexpected rules (-want +got):\n%s", diff)
+	}
+}
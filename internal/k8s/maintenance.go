@@ -0,0 +1,233 @@
+package k8s
+
+import (
+	"reflect"
+
+	"github.com/nginx/kubernetes-ingress/internal/configs"
+	nl "github.com/nginx/kubernetes-ingress/internal/logger"
+	conf_v1 "github.com/nginx/kubernetes-ingress/pkg/apis/configuration/v1"
+	api_v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+// createMaintenanceConfigMapHandlers builds the handler funcs for the ConfigMaps referenced by the maintenance mode
+// of VirtualServers and VirtualServerRoutes. Other ConfigMaps are ignored.
+func createMaintenanceConfigMapHandlers(lbc *LoadBalancerController) cache.ResourceEventHandlerFuncs {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			configMap := obj.(*api_v1.ConfigMap)
+			if lbc.isMaintenanceConfigMap(configMap) {
+				nl.Debugf(lbc.Logger, "Adding maintenance ConfigMap: %v", configMap.Name)
+				lbc.AddSyncQueue(configMap)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			configMap, isConfigMap := obj.(*api_v1.ConfigMap)
+			if !isConfigMap {
+				deletedState, ok := obj.(cache.DeletedFinalStateUnknown)
+				if !ok {
+					nl.Debugf(lbc.Logger, "Error received unexpected object: %v", obj)
+					return
+				}
+				configMap, ok = deletedState.Obj.(*api_v1.ConfigMap)
+				if !ok {
+					nl.Debugf(lbc.Logger, "Error DeletedFinalStateUnknown contained non-ConfigMap object: %v", deletedState.Obj)
+					return
+				}
+			}
+			if lbc.isMaintenanceConfigMap(configMap) {
+				nl.Debugf(lbc.Logger, "Removing maintenance ConfigMap: %v", configMap.Name)
+				lbc.AddSyncQueue(configMap)
+			}
+		},
+		UpdateFunc: func(old, cur interface{}) {
+			curConfigMap := cur.(*api_v1.ConfigMap)
+			oldConfigMap := old.(*api_v1.ConfigMap)
+			if !reflect.DeepEqual(oldConfigMap.Data, curConfigMap.Data) && lbc.isMaintenanceConfigMap(curConfigMap) {
+				nl.Debugf(lbc.Logger, "Maintenance ConfigMap %v changed, syncing", curConfigMap.Name)
+				lbc.AddSyncQueue(curConfigMap)
+			}
+		},
+	}
+}
+
+func (nsi *namespacedInformer) addMaintenanceConfigMapHandler(handlers cache.ResourceEventHandlerFuncs) {
+	informer := nsi.sharedInformerFactory.Core().V1().ConfigMaps().Informer()
+	informer.AddEventHandler(handlers) //nolint:errcheck,gosec
+	nsi.configMapLister = informer.GetStore()
+
+	nsi.cacheSyncs = append(nsi.cacheSyncs, informer.HasSynced)
+}
+
+// isMaintenanceConfigMap returns true if the ConfigMap is referenced by the maintenance mode of a VirtualServer or a VirtualServerRoute.
+func (lbc *LoadBalancerController) isMaintenanceConfigMap(configMap *api_v1.ConfigMap) bool {
+	return len(lbc.configuration.FindResourcesForMaintenanceConfigMap(configMap.Namespace, configMap.Name)) > 0
+}
+
+// syncMaintenanceConfigMap regenerates the VirtualServers that reference a maintenance ConfigMap, so that the changes of the page are applied.
+func (lbc *LoadBalancerController) syncMaintenanceConfigMap(task task) {
+	namespace, name, err := ParseNamespaceName(task.Key)
+	if err != nil {
+		nl.Warnf(lbc.Logger, "Error parsing the maintenance ConfigMap key %v: %v", task.Key, err)
+		return
+	}
+
+	resources := lbc.configuration.FindResourcesForMaintenanceConfigMap(namespace, name)
+	if len(resources) == 0 {
+		return
+	}
+
+	nl.Debugf(lbc.Logger, "Syncing maintenance ConfigMap %v", task.Key)
+
+	resourceExes := lbc.createExtendedResources(resources)
+	if len(resourceExes.VirtualServerExes) == 0 {
+		return
+	}
+
+	warnings, updateErr := lbc.configurator.AddOrUpdateVirtualServers(resourceExes.VirtualServerExes)
+	lbc.updateResourcesStatusAndEvents(resources, warnings, updateErr)
+}
+
+// getMaintenancePages returns the pages of the ConfigMaps referenced by the maintenance mode of a VirtualServer and its VirtualServerRoutes,
+// keyed by configs.GetMaintenancePageKey.
+func (lbc *LoadBalancerController) getMaintenancePages(virtualServer *conf_v1.VirtualServer, virtualServerRoutes []*conf_v1.VirtualServerRoute) map[string]string {
+	pages := make(map[string]string)
+
+	addPage := func(namespace string, maintenance *conf_v1.Maintenance) {
+		if maintenance == nil || maintenance.ConfigMap == nil {
+			return
+		}
+
+		pageKey := configs.GetMaintenancePageKey(namespace, maintenance.ConfigMap)
+		if _, exists := pages[pageKey]; exists {
+			return
+		}
+
+		nsi := lbc.getNamespacedInformer(namespace)
+		if nsi == nil || nsi.configMapLister == nil {
+			nl.Warnf(lbc.Logger, "Error getting the maintenance ConfigMap %v/%v: the namespace is not watched", namespace, maintenance.ConfigMap.Name)
+			return
+		}
+
+		obj, exists, err := nsi.configMapLister.GetByKey(namespace + "/" + maintenance.ConfigMap.Name)
+		if err != nil {
+			nl.Warnf(lbc.Logger, "Error getting the maintenance ConfigMap %v/%v: %v", namespace, maintenance.ConfigMap.Name, err)
+			return
+		}
+		if !exists {
+			nl.Warnf(lbc.Logger, "The maintenance ConfigMap %v/%v doesn't exist", namespace, maintenance.ConfigMap.Name)
+			return
+		}
+		cm := obj.(*api_v1.ConfigMap)
+
+		page, exists := cm.Data[configs.GetMaintenanceConfigMapKey(maintenance.ConfigMap)]
+		if !exists {
+			nl.Warnf(lbc.Logger, "The maintenance ConfigMap %v/%v doesn't have the key %v", namespace, maintenance.ConfigMap.Name, configs.GetMaintenanceConfigMapKey(maintenance.ConfigMap))
+			return
+		}
+		pages[pageKey] = page
+	}
+
+	addPage(virtualServer.Namespace, virtualServer.Spec.Maintenance)
+	for _, r := range virtualServer.Spec.Routes {
+		addPage(virtualServer.Namespace, r.Maintenance)
+	}
+	for _, vsr := range virtualServerRoutes {
+		for _, r := range vsr.Spec.Subroutes {
+			addPage(vsr.Namespace, r.Maintenance)
+		}
+	}
+
+	return pages
+}
+
+// isVirtualServerMaintenanceToggle returns true if the only change of the VirtualServer is enabling or disabling the maintenance mode.
+func isVirtualServerMaintenanceToggle(oldVs *conf_v1.VirtualServer, curVs *conf_v1.VirtualServer) bool {
+	if reflect.DeepEqual(oldVs.Spec, curVs.Spec) {
+		return false
+	}
+
+	oldSpec := oldVs.Spec.DeepCopy()
+	curSpec := curVs.Spec.DeepCopy()
+	for _, spec := range []*conf_v1.VirtualServerSpec{oldSpec, curSpec} {
+		disableMaintenance(spec.Maintenance)
+		for i := range spec.Routes {
+			disableMaintenance(spec.Routes[i].Maintenance)
+		}
+	}
+
+	return reflect.DeepEqual(oldSpec, curSpec)
+}
+
+// isVirtualServerRouteMaintenanceToggle returns true if the only change of the VirtualServerRoute is enabling or disabling the maintenance mode.
+func isVirtualServerRouteMaintenanceToggle(oldVsr *conf_v1.VirtualServerRoute, curVsr *conf_v1.VirtualServerRoute) bool {
+	if reflect.DeepEqual(oldVsr.Spec, curVsr.Spec) || !reflect.DeepEqual(oldVsr.Labels, curVsr.Labels) {
+		return false
+	}
+
+	oldSpec := oldVsr.Spec.DeepCopy()
+	curSpec := curVsr.Spec.DeepCopy()
+	for _, spec := range []*conf_v1.VirtualServerRouteSpec{oldSpec, curSpec} {
+		for i := range spec.Subroutes {
+			disableMaintenance(spec.Subroutes[i].Maintenance)
+		}
+	}
+
+	return reflect.DeepEqual(oldSpec, curSpec)
+}
+
+func disableMaintenance(maintenance *conf_v1.Maintenance) {
+	if maintenance != nil {
+		maintenance.Enable = false
+	}
+}
+
+// processVSMaintenanceChanges toggles the maintenance mode of a VirtualServer through the NGINX Plus API without reloading NGINX.
+func (lbc *LoadBalancerController) processVSMaintenanceChanges(vsOld *conf_v1.VirtualServer, vsNew *conf_v1.VirtualServer) {
+	if vsOld.Status.State == conf_v1.StateInvalid {
+		lbc.AddSyncQueue(vsNew)
+		return
+	}
+
+	if lbc.haltIfVSConfigInvalid(vsNew) {
+		return
+	}
+
+	key := getResourceKeyWithKind(virtualServerKind, &vsNew.ObjectMeta)
+	for _, r := range lbc.configuration.GetResourcesWithFilter(resourceFilter{VirtualServers: true}) {
+		vsc, ok := r.(*VirtualServerConfiguration)
+		if !ok || vsc.GetKeyWithKind() != key {
+			continue
+		}
+
+		vsEx := &configs.VirtualServerEx{
+			VirtualServer:               vsc.VirtualServer,
+			VirtualServerRoutes:         vsc.VirtualServerRoutes,
+			VirtualServerSelectorRoutes: vsc.VirtualServerRouteSelectors,
+		}
+		lbc.upsertMaintenanceKeyVals(vsEx)
+	}
+}
+
+// processVSRMaintenanceChanges toggles the maintenance mode of a VirtualServerRoute through the NGINX Plus API without reloading NGINX.
+func (lbc *LoadBalancerController) processVSRMaintenanceChanges(vsrOld *conf_v1.VirtualServerRoute, vsrNew *conf_v1.VirtualServerRoute) {
+	if vsrOld.Status.State == conf_v1.StateInvalid {
+		changes, problems := lbc.configuration.AddOrUpdateVirtualServerRoute(vsrNew)
+		lbc.processProblems(problems)
+		lbc.processChanges(changes)
+		return
+	}
+
+	halt, vsEx := lbc.haltIfVSRConfigInvalid(vsrNew)
+	if halt || vsEx == nil {
+		return
+	}
+
+	lbc.upsertMaintenanceKeyVals(vsEx)
+}
+
+func (lbc *LoadBalancerController) upsertMaintenanceKeyVals(vsEx *configs.VirtualServerEx) {
+	for _, update := range configs.GenerateMaintenanceKeyValUpdates(vsEx) {
+		lbc.configurator.UpsertSplitClientsKeyVal(update.Zone, update.Key, update.Value)
+	}
+}
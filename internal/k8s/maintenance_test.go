@@ -0,0 +1,67 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	nl "github.com/nginx/kubernetes-ingress/internal/logger"
+	conf_v1 "github.com/nginx/kubernetes-ingress/pkg/apis/configuration/v1"
+	api_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestGetMaintenancePages(t *testing.T) {
+	t.Parallel()
+	configMapLister := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	err := configMapLister.Add(&api_v1.ConfigMap{
+		ObjectMeta: meta_v1.ObjectMeta{Name: "maintenance-page", Namespace: "default"},
+		Data: map[string]string{
+			"index.html":  "<html>Maintenance</html>",
+			"custom.html": "<html>Custom</html>",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lbc := LoadBalancerController{
+		Logger: nl.LoggerFromContext(t.Context()),
+		namespacedInformers: map[string]*namespacedInformer{
+			"default": {configMapLister: configMapLister},
+		},
+	}
+
+	vs := &conf_v1.VirtualServer{
+		ObjectMeta: meta_v1.ObjectMeta{Name: "cafe", Namespace: "default"},
+		Spec: conf_v1.VirtualServerSpec{
+			Maintenance: &conf_v1.Maintenance{
+				ConfigMap: &conf_v1.MaintenanceConfigMap{Name: "maintenance-page"},
+			},
+			Routes: []conf_v1.Route{
+				{
+					Path: "/tea",
+					Maintenance: &conf_v1.Maintenance{
+						ConfigMap: &conf_v1.MaintenanceConfigMap{Name: "maintenance-page", Key: "custom.html"},
+					},
+				},
+				{
+					Path: "/coffee",
+					Maintenance: &conf_v1.Maintenance{
+						ConfigMap: &conf_v1.MaintenanceConfigMap{Name: "missing"},
+					},
+				},
+			},
+		},
+	}
+
+	expected := map[string]string{
+		"default/maintenance-page/index.html":  "<html>Maintenance</html>",
+		"default/maintenance-page/custom.html": "<html>Custom</html>",
+	}
+
+	pages := lbc.getMaintenancePages(vs, nil)
+	if diff := cmp.Diff(expected, pages); diff != "" {
+		t.Errorf("getMaintenancePages() returned unexpected result (-want +got):\n%s", diff)
+	}
+}
@@ -93,6 +93,9 @@ func (lbc *LoadBalancerController) syncNamespace(task task) {
 		if lbc.externalDNSController != nil {
 			lbc.externalDNSController.RemoveNamespacedInformer(key)
 		}
+		if lbc.gatewayAPITranslator != nil {
+			lbc.enqueueGatewayAPITask()
+		}
 	} else {
 		// check if informer group already exists
 		// if not create new namespaced informer group
@@ -1,6 +1,8 @@
 package policies
 
 import (
+	"maps"
+	"slices"
 	"strings"
 
 	conf_v1 "github.com/nginx/kubernetes-ingress/pkg/apis/configuration/v1"
@@ -29,12 +31,14 @@ func GetPolicyRefsFromAnnotation(value, namespace string) []conf_v1.PolicyRefere
 }
 
 // GetPolicyRefsFromPolicies parses the policies annotation and returns a slice of PolicyReference.
+// The references are sorted by the keys of the policies, so that the order is stable.
 func GetPolicyRefsFromPolicies(policies map[string]*conf_v1.Policy) []conf_v1.PolicyReference {
 	var policyRefs []conf_v1.PolicyReference
 	if len(policies) == 0 {
 		return policyRefs
 	}
-	for _, policy := range policies {
+	for _, key := range slices.Sorted(maps.Keys(policies)) {
+		policy := policies[key]
 		policyRef := conf_v1.PolicyReference{
 			Name:      policy.Name,
 			Namespace: policy.Namespace,
@@ -96,10 +96,16 @@ func (rc *secretReferenceChecker) IsReferencedByTransportServer(secretNamespace
 		return false
 	}
 
-	if ts.Spec.TLS != nil && ts.Spec.TLS.Secret == secretName {
+	if ts.Spec.TLS != nil && (ts.Spec.TLS.Secret == secretName || ts.Spec.TLS.ClientCertSecret == secretName) {
 		return true
 	}
 
+	for _, u := range ts.Spec.Upstreams {
+		if u.TLS != nil && (u.TLS.TLSSecret == secretName || u.TLS.TrustedCertSecret == secretName) {
+			return true
+		}
+	}
+
 	return false
 }
 
@@ -238,6 +244,62 @@ func (rc *policyReferenceChecker) IsReferencedByTransportServer(_ string, _ stri
 	return false
 }
 
+// maintenanceConfigMapReferenceChecker is a reference checker for the ConfigMaps with the pages of the maintenance mode.
+// Only VirtualServers and VirtualServerRoutes can reference those ConfigMaps.
+type maintenanceConfigMapReferenceChecker struct{}
+
+func newMaintenanceConfigMapReferenceChecker() *maintenanceConfigMapReferenceChecker {
+	return &maintenanceConfigMapReferenceChecker{}
+}
+
+func (rc *maintenanceConfigMapReferenceChecker) IsReferencedByIngress(_ string, _ string, _ *networking.Ingress) bool {
+	return false
+}
+
+func (rc *maintenanceConfigMapReferenceChecker) IsReferencedByMinion(_ string, _ string, _ *networking.Ingress) bool {
+	return false
+}
+
+func (rc *maintenanceConfigMapReferenceChecker) IsReferencedByVirtualServer(configMapNamespace string, configMapName string, vs *conf_v1.VirtualServer) bool {
+	if vs.Namespace != configMapNamespace {
+		return false
+	}
+
+	if isMaintenanceConfigMapReferenced(vs.Spec.Maintenance, configMapName) {
+		return true
+	}
+
+	for _, r := range vs.Spec.Routes {
+		if isMaintenanceConfigMapReferenced(r.Maintenance, configMapName) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (rc *maintenanceConfigMapReferenceChecker) IsReferencedByVirtualServerRoute(configMapNamespace string, configMapName string, vsr *conf_v1.VirtualServerRoute) bool {
+	if vsr.Namespace != configMapNamespace {
+		return false
+	}
+
+	for _, r := range vsr.Spec.Subroutes {
+		if isMaintenanceConfigMapReferenced(r.Maintenance, configMapName) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (rc *maintenanceConfigMapReferenceChecker) IsReferencedByTransportServer(_ string, _ string, _ *conf_v1.TransportServer) bool {
+	return false
+}
+
+func isMaintenanceConfigMapReferenced(maintenance *conf_v1.Maintenance, configMapName string) bool {
+	return maintenance != nil && maintenance.ConfigMap != nil && maintenance.ConfigMap.Name == configMapName
+}
+
 // appProtectResourceReferenceChecker is a reference checker for AppProtect related resources.
 // Only Regular/Master Ingress can reference those resources.
 type appProtectResourceReferenceChecker struct {
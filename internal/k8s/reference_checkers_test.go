@@ -352,6 +352,68 @@ func TestSecretIsReferencedByTransportServer(t *testing.T) {
 			expected:        false,
 			msg:             "tls secret is referenced but in another namespace",
 		},
+		{
+			ts: &conf_v1.TransportServer{
+				ObjectMeta: v1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: conf_v1.TransportServerSpec{
+					Upstreams: []conf_v1.TransportServerUpstream{
+						{
+							Name: "upstream1",
+							TLS: &conf_v1.TransportServerUpstreamTLS{
+								Enable:    true,
+								TLSSecret: "test-secret",
+							},
+						},
+					},
+				},
+			},
+			secretNamespace: "default",
+			secretName:      "test-secret",
+			expected:        true,
+			msg:             "upstream tls secret is referenced",
+		},
+		{
+			ts: &conf_v1.TransportServer{
+				ObjectMeta: v1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: conf_v1.TransportServerSpec{
+					TLS: &conf_v1.TransportServerTLS{
+						Secret:           "tls-secret",
+						ClientCertSecret: "test-secret",
+					},
+				},
+			},
+			secretNamespace: "default",
+			secretName:      "test-secret",
+			expected:        true,
+			msg:             "client cert secret is referenced",
+		},
+		{
+			ts: &conf_v1.TransportServer{
+				ObjectMeta: v1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: conf_v1.TransportServerSpec{
+					Upstreams: []conf_v1.TransportServerUpstream{
+						{
+							Name: "upstream1",
+							TLS: &conf_v1.TransportServerUpstreamTLS{
+								Enable:            true,
+								VerifyServer:      true,
+								TrustedCertSecret: "test-secret",
+							},
+						},
+					},
+				},
+			},
+			secretNamespace: "default",
+			secretName:      "test-secret",
+			expected:        true,
+			msg:             "upstream trusted cert secret is referenced",
+		},
 		{
 			ts: &conf_v1.TransportServer{
 				ObjectMeta: v1.ObjectMeta{
@@ -1422,6 +1484,131 @@ func TestPolicyIsReferencedByVirtualServerAndVirtualServerRoute(t *testing.T) {
 	}
 }
 
+func TestMaintenanceConfigMapIsReferencedByVirtualServerAndVirtualServerRoute(t *testing.T) {
+	t.Parallel()
+	maintenance := &conf_v1.Maintenance{
+		Enable: true,
+		ConfigMap: &conf_v1.MaintenanceConfigMap{
+			Name: "maintenance-page",
+		},
+	}
+	tests := []struct {
+		vs                 *conf_v1.VirtualServer
+		vsr                *conf_v1.VirtualServerRoute
+		configMapNamespace string
+		configMapName      string
+		expected           bool
+		msg                string
+	}{
+		{
+			vs: &conf_v1.VirtualServer{
+				ObjectMeta: v1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: conf_v1.VirtualServerSpec{
+					Maintenance: maintenance,
+				},
+			},
+			configMapNamespace: "default",
+			configMapName:      "maintenance-page",
+			expected:           true,
+			msg:                "configmap is referenced at the spec level",
+		},
+		{
+			vs: &conf_v1.VirtualServer{
+				ObjectMeta: v1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: conf_v1.VirtualServerSpec{
+					Maintenance: maintenance,
+				},
+			},
+			configMapNamespace: "some-namespace",
+			configMapName:      "maintenance-page",
+			expected:           false,
+			msg:                "wrong namespace for configmap at the spec level",
+		},
+		{
+			vs: &conf_v1.VirtualServer{
+				ObjectMeta: v1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: conf_v1.VirtualServerSpec{
+					Routes: []conf_v1.Route{
+						{
+							Maintenance: maintenance,
+						},
+					},
+				},
+			},
+			vsr: &conf_v1.VirtualServerRoute{
+				ObjectMeta: v1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: conf_v1.VirtualServerRouteSpec{
+					Subroutes: []conf_v1.Route{
+						{
+							Maintenance: maintenance,
+						},
+					},
+				},
+			},
+			configMapNamespace: "default",
+			configMapName:      "maintenance-page",
+			expected:           true,
+			msg:                "configmap is referenced in a route",
+		},
+		{
+			vs: &conf_v1.VirtualServer{
+				ObjectMeta: v1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: conf_v1.VirtualServerSpec{
+					Routes: []conf_v1.Route{
+						{
+							Maintenance: &conf_v1.Maintenance{Enable: true},
+						},
+					},
+				},
+			},
+			vsr: &conf_v1.VirtualServerRoute{
+				ObjectMeta: v1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: conf_v1.VirtualServerRouteSpec{
+					Subroutes: []conf_v1.Route{
+						{
+							Maintenance: maintenance,
+						},
+					},
+				},
+			},
+			configMapNamespace: "default",
+			configMapName:      "some-page",
+			expected:           false,
+			msg:                "wrong name for configmap in a route",
+		},
+	}
+
+	for _, test := range tests {
+		rc := newMaintenanceConfigMapReferenceChecker()
+
+		result := rc.IsReferencedByVirtualServer(test.configMapNamespace, test.configMapName, test.vs)
+		if result != test.expected {
+			t.Errorf("IsReferencedByVirtualServer() returned %v but expected %v for the case of %s", result, test.expected, test.msg)
+		}
+
+		if test.vsr == nil {
+			continue
+		}
+
+		result = rc.IsReferencedByVirtualServerRoute(test.configMapNamespace, test.configMapName, test.vsr)
+		if result != test.expected {
+			t.Errorf("IsReferencedByVirtualServerRoute() returned %v but expected %v for the case of %s", result, test.expected, test.msg)
+		}
+	}
+}
+
 func TestAppProtectResourceIsReferencedByIngresses(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
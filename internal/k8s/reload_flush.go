@@ -0,0 +1,64 @@
+package k8s
+
+import (
+	nl "github.com/nginx/kubernetes-ingress/internal/logger"
+)
+
+// syncReloadFlush reloads NGINX once for the configuration changes coalesced since the previous reload
+// when the debounce window and the minimum reload interval have passed.
+// The resources are reported as applied when their changes are deferred, so if the reload fails,
+// their status and events are updated with the error.
+func (lbc *LoadBalancerController) syncReloadFlush() {
+	if !lbc.configurator.IsReloadDue() {
+		return
+	}
+
+	// No task changes the configuration while the reload is flushed, so the pending resources are the ones in the reload.
+	lbc.syncLock.Lock()
+	defer lbc.syncLock.Unlock()
+
+	if !lbc.isNginxReady || lbc.isBatchSyncEnabled() {
+		return
+	}
+
+	resources := lbc.takePendingReloadResources()
+	if err := lbc.configurator.FlushReloads(); err != nil {
+		nl.Errorf(lbc.Logger, "Error reloading NGINX for the coalesced configuration changes: %v", err)
+		lbc.updateResourcesStatusAndEvents(resources, nil, err)
+	}
+}
+
+// addPendingReloadResourcesIfDeferred records the resources if their changes were applied without an error
+// and wait for a deferred reload.
+func (lbc *LoadBalancerController) addPendingReloadResourcesIfDeferred(resources []Resource, operationErr error) {
+	if operationErr == nil && lbc.reloadCoalescingEnabled && lbc.configurator.HasPendingReloads() {
+		lbc.addPendingReloadResources(resources)
+	}
+}
+
+// addPendingReloadResources records the resources whose changes wait for a deferred reload.
+func (lbc *LoadBalancerController) addPendingReloadResources(resources []Resource) {
+	lbc.pendingReloadLock.Lock()
+	defer lbc.pendingReloadLock.Unlock()
+
+	if lbc.pendingReloadResources == nil {
+		lbc.pendingReloadResources = make(map[string]Resource)
+	}
+	for _, r := range resources {
+		lbc.pendingReloadResources[r.GetKeyWithKind()] = r
+	}
+}
+
+// takePendingReloadResources returns the resources whose changes wait for a deferred reload and forgets them.
+func (lbc *LoadBalancerController) takePendingReloadResources() []Resource {
+	lbc.pendingReloadLock.Lock()
+	defer lbc.pendingReloadLock.Unlock()
+
+	resources := make([]Resource, 0, len(lbc.pendingReloadResources))
+	for _, r := range lbc.pendingReloadResources {
+		resources = append(resources, r)
+	}
+	lbc.pendingReloadResources = nil
+
+	return resources
+}
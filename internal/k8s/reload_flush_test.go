@@ -0,0 +1,147 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/nginx/kubernetes-ingress/internal/configs"
+	"github.com/nginx/kubernetes-ingress/internal/configs/version2"
+	nl "github.com/nginx/kubernetes-ingress/internal/logger"
+	"github.com/nginx/kubernetes-ingress/internal/metrics/collectors"
+	"github.com/nginx/kubernetes-ingress/internal/nginx"
+	conf_v1 "github.com/nginx/kubernetes-ingress/pkg/apis/configuration/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+)
+
+type failingReloadManager struct {
+	*nginx.FakeManager
+}
+
+func (m *failingReloadManager) Reload(_ bool) error {
+	return errors.New("reload failed")
+}
+
+func TestSyncReloadFlushReportsFailedReload(t *testing.T) {
+	t.Parallel()
+
+	configurator := configs.NewConfigurator(configs.ConfiguratorParams{
+		NginxManager:    &failingReloadManager{FakeManager: nginx.NewFakeManager("/etc/nginx")},
+		StaticCfgParams: &configs.StaticConfigParams{},
+		Config:          configs.NewDefaultConfigParams(context.Background(), false),
+		ReloadCoalescer: nginx.NewReloadCoalescer(0, 0, collectors.NewManagerFakeCollector()),
+	})
+	configurator.EnableReloads()
+
+	recorder := record.NewFakeRecorder(10)
+	lbc := &LoadBalancerController{
+		Logger:                  nl.LoggerFromContext(t.Context()),
+		configurator:            configurator,
+		recorder:                recorder,
+		isNginxReady:            true,
+		isLeaderElectionEnabled: true,
+		reloadCoalescingEnabled: true,
+	}
+
+	tsConfig := &TransportServerConfiguration{
+		TransportServer: &conf_v1.TransportServer{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "tcp-server", Namespace: "default"},
+		},
+	}
+
+	if err := configurator.Reload(nginx.ReloadForOtherUpdate); err != nil {
+		t.Fatalf("Reload() returned unexpected error for a deferred reload: %v", err)
+	}
+	lbc.updateResourcesStatusAndEvents([]Resource{tsConfig}, nil, nil)
+
+	if event := <-recorder.Events; !strings.HasPrefix(event, "Normal "+nl.EventReasonAddedOrUpdated) {
+		t.Errorf("updateResourcesStatusAndEvents() recorded %q for a deferred reload", event)
+	}
+
+	lbc.syncReloadFlush()
+
+	select {
+	case event := <-recorder.Events:
+		if !strings.HasPrefix(event, "Warning "+nl.EventReasonAddedOrUpdatedWithError) || !strings.Contains(event, "reload failed") {
+			t.Errorf("syncReloadFlush() recorded %q for a failed reload", event)
+		}
+	default:
+		t.Fatal("syncReloadFlush() didn't record an event for a failed reload")
+	}
+
+	if configurator.HasPendingReloads() {
+		t.Error("syncReloadFlush() didn't flush the pending reloads")
+	}
+	if len(lbc.takePendingReloadResources()) != 0 {
+		t.Error("syncReloadFlush() didn't forget the resources of the flushed reload")
+	}
+}
+
+func TestSyncReloadFlushReportsFailedReloadOfSingleChange(t *testing.T) {
+	t.Parallel()
+
+	templateExecutorV2, err := version2.NewTemplateExecutor(
+		filepath.Join("..", "configs", "version2", "nginx-plus.virtualserver.tmpl"),
+		filepath.Join("..", "configs", "version2", "nginx-plus.transportserver.tmpl"),
+		filepath.Join("..", "configs", "version2", "oidc.tmpl"),
+	)
+	if err != nil {
+		t.Fatalf("failed to create v2 template executor: %v", err)
+	}
+
+	configurator := configs.NewConfigurator(configs.ConfiguratorParams{
+		NginxManager:       &failingReloadManager{FakeManager: nginx.NewFakeManager("/etc/nginx")},
+		StaticCfgParams:    &configs.StaticConfigParams{},
+		Config:             configs.NewDefaultConfigParams(context.Background(), false),
+		TemplateExecutorV2: templateExecutorV2,
+		ReloadCoalescer:    nginx.NewReloadCoalescer(0, 0, collectors.NewManagerFakeCollector()),
+	})
+	configurator.EnableReloads()
+
+	recorder := record.NewFakeRecorder(10)
+	lbc := &LoadBalancerController{
+		Logger:                  nl.LoggerFromContext(t.Context()),
+		configurator:            configurator,
+		configuration:           createTestConfiguration(),
+		recorder:                recorder,
+		isNginxReady:            true,
+		isLeaderElectionEnabled: true,
+		reloadCoalescingEnabled: true,
+		namespacedInformers: map[string]*namespacedInformer{
+			"default": {svcLister: cache.NewStore(cache.MetaNamespaceKeyFunc)},
+		},
+	}
+
+	tsConfig := &TransportServerConfiguration{
+		TransportServer: &conf_v1.TransportServer{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "tcp-server", Namespace: "default"},
+			Spec: conf_v1.TransportServerSpec{
+				Listener:  conf_v1.TransportServerListener{Name: "tcp-listener", Protocol: "TCP"},
+				Upstreams: []conf_v1.TransportServerUpstream{{Name: "tcp-app", Service: "tcp-app-svc", Port: 5001}},
+				Action:    &conf_v1.TransportServerAction{Pass: "tcp-app"},
+			},
+		},
+		ListenerPort: 2020,
+	}
+
+	lbc.processChanges([]ResourceChange{{Op: AddOrUpdate, Resource: tsConfig}})
+
+	if event := <-recorder.Events; !strings.HasPrefix(event, "Normal "+nl.EventReasonAddedOrUpdated) {
+		t.Errorf("processChanges() recorded %q for a deferred reload", event)
+	}
+
+	lbc.syncReloadFlush()
+
+	select {
+	case event := <-recorder.Events:
+		if !strings.HasPrefix(event, "Warning "+nl.EventReasonAddedOrUpdatedWithError) || !strings.Contains(event, "reload failed") {
+			t.Errorf("syncReloadFlush() recorded %q for a failed reload", event)
+		}
+	default:
+		t.Fatal("syncReloadFlush() didn't record an event for the failed reload of a single change")
+	}
+}
@@ -31,6 +31,7 @@ func createServiceHandlers(lbc *LoadBalancerController) cache.ResourceEventHandl
 
 			nl.Infof(lbc.Logger, "Adding service: %v", svc.Name)
 			lbc.AddSyncQueue(svc)
+			lbc.requeueNginxConfigMapForDefaultBackend(svc)
 		},
 		DeleteFunc: func(obj interface{}) {
 			svc, isSvc := obj.(*v1.Service)
@@ -49,10 +50,12 @@ func createServiceHandlers(lbc *LoadBalancerController) cache.ResourceEventHandl
 
 			nl.Infof(lbc.Logger, "Removing service: %v", svc.Name)
 			lbc.AddSyncQueue(svc)
+			lbc.requeueNginxConfigMapForDefaultBackend(svc)
 		},
 		UpdateFunc: func(old, cur interface{}) {
 			if !reflect.DeepEqual(old, cur) {
 				curSvc := cur.(*v1.Service)
+				lbc.requeueNginxConfigMapForDefaultBackend(curSvc)
 				if lbc.IsExternalServiceForStatus(curSvc) {
 					lbc.AddSyncQueue(curSvc)
 					return
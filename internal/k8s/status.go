@@ -71,6 +71,10 @@ func (su *statusUpdater) UpdateExternalEndpointsForResource(r Resource) error {
 			ings = append(ings, *fm.Ingress)
 		}
 
+		for _, canary := range impl.Canaries {
+			ings = append(ings, *canary)
+		}
+
 		return su.BulkUpdateIngressStatus(ings)
 	case *VirtualServerConfiguration:
 		failed := false
@@ -507,6 +511,36 @@ func (su *statusUpdater) UpdateVirtualServerStatus(vs *conf_v1.VirtualServer, st
 	return err
 }
 
+// UpdateVirtualServerEjectedPeers updates the upstream servers ejected by the circuit breaker in the status of a VirtualServer.
+func (su *statusUpdater) UpdateVirtualServerEjectedPeers(vs *conf_v1.VirtualServer, ejectedPeers []conf_v1.EjectedPeer) error {
+	vsLatest, exists, err := su.getNamespacedInformer(vs.Namespace).virtualServerLister.Get(vs)
+	if err != nil {
+		nl.Infof(su.logger, "error getting VirtualServer from Store: %v", err)
+		return err
+	}
+	if !exists {
+		nl.Infof(su.logger, "VirtualServer doesn't exist in Store")
+		return nil
+	}
+
+	vsCopy := vsLatest.(*conf_v1.VirtualServer).DeepCopy()
+
+	if len(vsCopy.Status.EjectedPeers) == 0 && len(ejectedPeers) == 0 {
+		return nil
+	}
+	if reflect.DeepEqual(vsCopy.Status.EjectedPeers, ejectedPeers) {
+		return nil
+	}
+
+	vsCopy.Status.EjectedPeers = ejectedPeers
+
+	_, err = su.confClient.K8sV1().VirtualServers(vsCopy.Namespace).UpdateStatus(context.TODO(), vsCopy, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("error setting VirtualServer %v/%v status: %w", vsCopy.Namespace, vsCopy.Name, err)
+	}
+	return nil
+}
+
 func (su *statusUpdater) hasVsrStatusChanged(vsr *conf_v1.VirtualServerRoute, state string, reason string, message string, referencedByString string) bool {
 	if vsr.Status.State != state {
 		return true
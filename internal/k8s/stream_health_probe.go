@@ -0,0 +1,17 @@
+package k8s
+
+import (
+	nl "github.com/nginx/kubernetes-ingress/internal/logger"
+)
+
+// syncStreamHealthProbes probes the upstream servers of the TransportServers with health checks enabled
+// and marks the failing servers down in the NGINX configuration.
+func (lbc *LoadBalancerController) syncStreamHealthProbes() {
+	if !lbc.isNginxReady {
+		return
+	}
+
+	if err := lbc.configurator.UpdateStreamHealthProbes(lbc.ctx); err != nil {
+		nl.Errorf(lbc.Logger, "Error updating stream health probes: %v", err)
+	}
+}
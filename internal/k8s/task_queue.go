@@ -2,7 +2,9 @@ package k8s
 
 import (
 	"fmt"
+	"hash/fnv"
 	"log/slog"
+	"sync"
 	"time"
 
 	"github.com/nginx/kubernetes-ingress/pkg/apis/dos/v1beta1"
@@ -19,33 +21,76 @@ import (
 	"k8s.io/client-go/util/workqueue"
 )
 
-// taskQueue manages a work queue through an independent worker that
-// invokes the given sync function for every work item inserted.
+// taskQueue manages a sharded work queue through independent workers that
+// invoke the given sync function for every work item inserted.
+// The tasks of a resource are always added to the same shard, so they are processed in order.
 type taskQueue struct {
-	// queue is the work queue the worker polls
-	queue *workqueue.Type
+	// shards are the work queues the workers poll
+	shards []*taskQueueShard
 	// sync is called for each item in the queue
 	sync func(task)
-	// workerDone is closed when the worker exits
-	workerDone chan struct{}
+	// metricsProvider provides the workqueue metrics per kind of task. The metrics are not collected if it is nil
+	metricsProvider workqueue.MetricsProvider
+	// kindMetrics holds the workqueue metrics per kind of task
+	kindMetrics map[kind]*taskQueueMetrics
+	// queued holds the time when the tasks were added to the queue
+	queued map[task]time.Time
+	// mu protects kindMetrics and queued
+	mu sync.Mutex
 	// logger
 	logger *slog.Logger
 }
 
-// newTaskQueue creates a new task queue with the given sync function.
+// taskQueueShard is a work queue polled by a single worker
+type taskQueueShard struct {
+	// queue is the work queue the worker polls
+	queue *workqueue.Type
+	// workerDone is closed when the worker exits
+	workerDone chan struct{}
+}
+
+// taskQueueMetrics holds the workqueue metrics of a kind of task
+type taskQueueMetrics struct {
+	depth        workqueue.GaugeMetric
+	latency      workqueue.HistogramMetric
+	workDuration workqueue.HistogramMetric
+}
+
+// newTaskQueue creates a new task queue with the given sync function and the given number of workers.
 // The sync function is called for every element inserted into the queue.
-func newTaskQueue(logger *slog.Logger, syncFn func(task)) *taskQueue {
+func newTaskQueue(logger *slog.Logger, syncFn func(task), workers int, mp workqueue.MetricsProvider) *taskQueue {
+	if workers < 1 {
+		workers = 1
+	}
+
+	shards := make([]*taskQueueShard, workers)
+	for i := range shards {
+		name := "taskQueue"
+		if workers > 1 {
+			name = fmt.Sprintf("taskQueue-%d", i)
+		}
+		shards[i] = &taskQueueShard{
+			queue:      workqueue.NewNamed(name),
+			workerDone: make(chan struct{}),
+		}
+	}
+
 	return &taskQueue{
-		queue:      workqueue.NewNamed("taskQueue"),
-		sync:       syncFn,
-		workerDone: make(chan struct{}),
-		logger:     logger,
+		shards:          shards,
+		sync:            syncFn,
+		metricsProvider: mp,
+		kindMetrics:     make(map[kind]*taskQueueMetrics),
+		queued:          make(map[task]time.Time),
+		logger:          logger,
 	}
 }
 
-// Run begins running the worker for the given duration
+// Run begins running the workers for the given duration
 func (tq *taskQueue) Run(period time.Duration, stopCh <-chan struct{}) {
-	wait.Until(tq.worker, period, stopCh)
+	for _, shard := range tq.shards[1:] {
+		go wait.Until(func() { tq.worker(shard) }, period, stopCh)
+	}
+	wait.Until(func() { tq.worker(tq.shards[0]) }, period, stopCh)
 }
 
 // Enqueue enqueues ns/name of the given api object in the task queue.
@@ -63,19 +108,29 @@ func (tq *taskQueue) Enqueue(obj interface{}) {
 	}
 
 	nl.Debugf(tq.logger, "Adding an element with a key: %v", task.Key)
-	tq.queue.Add(task)
+	tq.add(task)
+}
+
+// AddTask adds a task that is not associated with an api object to the queue.
+func (tq *taskQueue) AddTask(t task) {
+	nl.Debugf(tq.logger, "Adding an element with a key: %v", t.Key)
+	tq.add(t)
 }
 
 // Requeue adds the task to the queue again and logs the given error
 func (tq *taskQueue) Requeue(task task, err error) {
 	nl.Errorf(tq.logger, "Requeuing %v, err %v", task.Key, err)
-	tq.queue.Add(task)
+	tq.add(task)
 }
 
 // Len returns the length of the queue
 func (tq *taskQueue) Len() int {
-	nl.Debugf(tq.logger, "The queue has %v element(s)", tq.queue.Len())
-	return tq.queue.Len()
+	length := 0
+	for _, shard := range tq.shards {
+		length += shard.queue.Len()
+	}
+	nl.Debugf(tq.logger, "The queue has %v element(s)", length)
+	return length
 }
 
 // RequeueAfter adds the task to the queue after the given duration
@@ -83,28 +138,92 @@ func (tq *taskQueue) RequeueAfter(t task, err error, after time.Duration) {
 	nl.Errorf(tq.logger, "Requeuing %v after %s, err %v", t.Key, after.String(), err)
 	go func(t task, after time.Duration) {
 		time.Sleep(after)
-		tq.queue.Add(t)
+		tq.add(t)
 	}(t, after)
 }
 
-// Worker processes work in the queue through sync.
-func (tq *taskQueue) worker() {
+// add adds the task to the shard of its resource.
+func (tq *taskQueue) add(t task) {
+	if tq.metricsProvider != nil {
+		tq.mu.Lock()
+		if _, exists := tq.queued[t]; !exists {
+			tq.queued[t] = time.Now()
+			tq.metricsFor(t.Kind).depth.Inc()
+		}
+		tq.mu.Unlock()
+	}
+
+	tq.shardFor(t).queue.Add(t)
+}
+
+// shardFor returns the shard of the resource of the task.
+func (tq *taskQueue) shardFor(t task) *taskQueueShard {
+	if len(tq.shards) == 1 {
+		return tq.shards[0]
+	}
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte{byte(t.Kind)})
+	_, _ = h.Write([]byte(t.Key))
+
+	return tq.shards[h.Sum32()%uint32(len(tq.shards))] // #nosec G115 -- the number of shards is small
+}
+
+// metricsFor returns the metrics of the kind of task. It must be called with mu locked.
+func (tq *taskQueue) metricsFor(k kind) *taskQueueMetrics {
+	m, exists := tq.kindMetrics[k]
+	if !exists {
+		name := "taskQueue_" + kindNames[k]
+		m = &taskQueueMetrics{
+			depth:        tq.metricsProvider.NewDepthMetric(name),
+			latency:      tq.metricsProvider.NewLatencyMetric(name),
+			workDuration: tq.metricsProvider.NewWorkDurationMetric(name),
+		}
+		tq.kindMetrics[k] = m
+	}
+	return m
+}
+
+// Worker processes work in the shard through sync.
+func (tq *taskQueue) worker(shard *taskQueueShard) {
 	for {
-		t, quit := tq.queue.Get()
+		item, quit := shard.queue.Get()
 		if quit {
-			close(tq.workerDone)
+			close(shard.workerDone)
 			return
 		}
-		nl.Debugf(tq.logger, "Syncing %v", t.(task).Key)
-		tq.sync(t.(task))
-		tq.queue.Done(t)
+		t := item.(task)
+
+		var m *taskQueueMetrics
+		if tq.metricsProvider != nil {
+			tq.mu.Lock()
+			m = tq.metricsFor(t.Kind)
+			if added, exists := tq.queued[t]; exists {
+				delete(tq.queued, t)
+				m.depth.Dec()
+				m.latency.Observe(time.Since(added).Seconds())
+			}
+			tq.mu.Unlock()
+		}
+
+		nl.Debugf(tq.logger, "Syncing %v", t.Key)
+		start := time.Now()
+		tq.sync(t)
+		if m != nil {
+			m.workDuration.Observe(time.Since(start).Seconds())
+		}
+		shard.queue.Done(item)
 	}
 }
 
-// Shutdown shuts down the work queue and waits for the worker to ACK
+// Shutdown shuts down the work queue and waits for the workers to ACK
 func (tq *taskQueue) Shutdown() {
-	tq.queue.ShutDown()
-	<-tq.workerDone
+	for _, shard := range tq.shards {
+		shard.queue.ShutDown()
+	}
+	for _, shard := range tq.shards {
+		<-shard.workerDone
+	}
 }
 
 // kind represents the kind of the Kubernetes resources of a task
@@ -130,8 +249,36 @@ const (
 	appProtectDosLogConf
 	appProtectDosProtectedResource
 	ingressLink
+	circuitBreaker
+	streamHealthProbe
+	gatewayAPI
 )
 
+// kindNames holds the names of the kinds of tasks used in the workqueue metrics
+var kindNames = map[kind]string{
+	ingress:                        "ingress",
+	endpointslice:                  "endpointslice",
+	configMap:                      "configmap",
+	secret:                         "secret",
+	service:                        "service",
+	namespace:                      "namespace",
+	virtualserver:                  "virtualserver",
+	virtualServerRoute:             "virtualserverroute",
+	globalConfiguration:            "globalconfiguration",
+	transportserver:                "transportserver",
+	policy:                         "policy",
+	appProtectPolicy:               "appprotectpolicy",
+	appProtectLogConf:              "appprotectlogconf",
+	appProtectUserSig:              "appprotectusersig",
+	appProtectDosPolicy:            "appprotectdospolicy",
+	appProtectDosLogConf:           "appprotectdoslogconf",
+	appProtectDosProtectedResource: "dosprotectedresource",
+	ingressLink:                    "ingresslink",
+	circuitBreaker:                 "circuitbreaker",
+	streamHealthProbe:              "streamhealthprobe",
+	gatewayAPI:                     "gatewayapi",
+}
+
 // task is an element of a taskQueue
 type task struct {
 	Kind kind
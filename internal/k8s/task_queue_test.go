@@ -0,0 +1,130 @@
+package k8s
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	nl "github.com/nginx/kubernetes-ingress/internal/logger"
+	"k8s.io/client-go/util/workqueue"
+)
+
+type fakeGaugeMetric struct {
+	mu    sync.Mutex
+	value int
+}
+
+func (m *fakeGaugeMetric) Inc() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.value++
+}
+
+func (m *fakeGaugeMetric) Dec() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.value--
+}
+
+type fakeHistogramMetric struct{}
+
+func (fakeHistogramMetric) Observe(float64) {}
+
+type fakeMetricsProvider struct {
+	workqueue.MetricsProvider
+	depths map[string]*fakeGaugeMetric
+}
+
+func (p *fakeMetricsProvider) NewDepthMetric(name string) workqueue.GaugeMetric {
+	p.depths[name] = &fakeGaugeMetric{}
+	return p.depths[name]
+}
+
+func (p *fakeMetricsProvider) NewLatencyMetric(string) workqueue.HistogramMetric {
+	return fakeHistogramMetric{}
+}
+
+func (p *fakeMetricsProvider) NewWorkDurationMetric(string) workqueue.HistogramMetric {
+	return fakeHistogramMetric{}
+}
+
+func TestTaskQueueShardsTasksByResource(t *testing.T) {
+	t.Parallel()
+	tq := newTaskQueue(nl.LoggerFromContext(t.Context()), func(task) {}, 4, nil)
+
+	for i := range 20 {
+		tsk := task{Kind: virtualserver, Key: fmt.Sprintf("default/cafe-%d", i)}
+		if tq.shardFor(tsk) != tq.shardFor(tsk) {
+			t.Errorf("shardFor() returned different shards for the task %v", tsk)
+		}
+	}
+
+	tq.AddTask(task{Kind: virtualserver, Key: "default/cafe"})
+	tq.AddTask(task{Kind: virtualserver, Key: "default/cafe"})
+	tq.AddTask(task{Kind: ingress, Key: "default/cafe"})
+
+	if tq.Len() != 2 {
+		t.Errorf("Len() returned %d but expected 2", tq.Len())
+	}
+}
+
+func TestTaskQueueDoesNotProcessTasksOfResourceConcurrently(t *testing.T) {
+	t.Parallel()
+	var mu sync.Mutex
+	inFlight := make(map[string]int)
+	processed := make(map[string]int)
+	concurrent := false
+	done := make(chan struct{})
+	keys := []string{"default/a", "default/b", "default/c", "default/d"}
+
+	var tq *taskQueue
+	syncFn := func(tsk task) {
+		mu.Lock()
+		inFlight[tsk.Key]++
+		concurrent = concurrent || inFlight[tsk.Key] > 1
+		mu.Unlock()
+
+		time.Sleep(time.Millisecond)
+
+		mu.Lock()
+		defer mu.Unlock()
+		inFlight[tsk.Key]--
+		processed[tsk.Key]++
+		if processed[tsk.Key] < 10 {
+			tq.AddTask(tsk)
+			return
+		}
+		for _, key := range keys {
+			if processed[key] < 10 {
+				return
+			}
+		}
+		close(done)
+	}
+
+	mp := &fakeMetricsProvider{depths: make(map[string]*fakeGaugeMetric)}
+	tq = newTaskQueue(nl.LoggerFromContext(t.Context()), syncFn, 3, mp)
+	for _, key := range keys {
+		tq.AddTask(task{Kind: secret, Key: key})
+	}
+
+	stopCh := make(chan struct{})
+	go tq.Run(time.Second, stopCh)
+
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("the tasks were not processed")
+	}
+	close(stopCh)
+	tq.Shutdown()
+
+	if concurrent {
+		t.Errorf("the tasks of a resource were processed concurrently")
+	}
+
+	if depth := mp.depths["taskQueue_secret"]; depth == nil || depth.value != 0 {
+		t.Errorf("the depth metric of the secret tasks is %v but expected 0", depth)
+	}
+}
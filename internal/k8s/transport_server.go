@@ -202,7 +202,8 @@ func (lbc *LoadBalancerController) updateTransportServersStatusFromEvents() erro
 	return nil
 }
 
-func (lbc *LoadBalancerController) createTransportServerEx(transportServer *conf_v1.TransportServer, listenerPort int, ipv4 string, ipv6 string) *configs.TransportServerEx {
+func (lbc *LoadBalancerController) createTransportServerEx(tsc *TransportServerConfiguration) *configs.TransportServerEx {
+	transportServer := tsc.TransportServer
 	endpoints := make(map[string][]string)
 	externalNameSvcs := make(map[string]bool)
 	podsByIP := make(map[string]string)
@@ -237,27 +238,53 @@ func (lbc *LoadBalancerController) createTransportServerEx(transportServer *conf
 
 	scrtRefs := make(map[string]*secrets.SecretReference)
 
-	if transportServer.Spec.TLS != nil && transportServer.Spec.TLS.Secret != "" {
-		scrtKey := transportServer.Namespace + "/" + transportServer.Spec.TLS.Secret
+	if transportServer.Spec.TLS != nil {
+		for _, name := range []string{transportServer.Spec.TLS.Secret, transportServer.Spec.TLS.ClientCertSecret} {
+			if name == "" {
+				continue
+			}
+			scrtKey := transportServer.Namespace + "/" + name
+
+			scrtRef := lbc.secretStore.GetSecret(scrtKey)
+			if scrtRef.Error != nil {
+				nl.Warnf(lbc.Logger, "Error trying to get the secret %v for TransportServer %v: %v", scrtKey, transportServer.Name, scrtRef.Error)
+			}
 
-		scrtRef := lbc.secretStore.GetSecret(scrtKey)
-		if scrtRef.Error != nil {
-			nl.Warnf(lbc.Logger, "Error trying to get the secret %v for TransportServer %v: %v", scrtKey, transportServer.Name, scrtRef.Error)
+			scrtRefs[scrtKey] = scrtRef
 		}
+	}
 
-		scrtRefs[scrtKey] = scrtRef
+	for _, u := range transportServer.Spec.Upstreams {
+		if u.TLS == nil || !u.TLS.Enable {
+			continue
+		}
+		for _, name := range []string{u.TLS.TLSSecret, u.TLS.TrustedCertSecret} {
+			if name == "" {
+				continue
+			}
+			scrtKey := transportServer.Namespace + "/" + name
+
+			scrtRef := lbc.secretStore.GetSecret(scrtKey)
+			if scrtRef.Error != nil {
+				nl.Warnf(lbc.Logger, "Error trying to get the secret %v for TransportServer %v: %v", scrtKey, transportServer.Name, scrtRef.Error)
+			}
+
+			scrtRefs[scrtKey] = scrtRef
+		}
 	}
 
 	return &configs.TransportServerEx{
-		ListenerPort:     listenerPort,
-		IPv4:             ipv4,
-		IPv6:             ipv6,
-		TransportServer:  transportServer,
-		Endpoints:        endpoints,
-		PodsByIP:         podsByIP,
-		ExternalNameSvcs: externalNameSvcs,
-		DisableIPV6:      disableIPV6,
-		SecretRefs:       scrtRefs,
+		ListenerPort:        tsc.ListenerPort,
+		IPv4:                tsc.IPv4,
+		IPv6:                tsc.IPv6,
+		AcceptProxyProtocol: tsc.AcceptProxyProtocol,
+		TrustedCIDRs:        tsc.TrustedCIDRs,
+		TransportServer:     transportServer,
+		Endpoints:           endpoints,
+		PodsByIP:            podsByIP,
+		ExternalNameSvcs:    externalNameSvcs,
+		DisableIPV6:         disableIPV6,
+		SecretRefs:          scrtRefs,
 	}
 }
 
@@ -22,6 +22,7 @@ import (
 	"reflect"
 	"strings"
 
+	"github.com/nginx/kubernetes-ingress/internal/configs"
 	discovery_v1 "k8s.io/api/discovery/v1"
 
 	v1 "k8s.io/api/core/v1"
@@ -138,6 +139,12 @@ func isMaster(ing *networking.Ingress) bool {
 	return ing.Annotations["nginx.org/mergeable-ingress-type"] == "master"
 }
 
+// isCanary determines if an ingress is a canary or not
+func isCanary(ing *networking.Ingress) bool {
+	canary, err := configs.ParseBool(ing.Annotations[configs.CanaryAnnotation])
+	return err == nil && canary
+}
+
 func isChallengeIngress(ing *networking.Ingress) bool {
 	return ing.Labels["acme.cert-manager.io/http01-solver"] == "true"
 }
@@ -149,6 +156,12 @@ func hasChanges(old *networking.Ingress, current *networking.Ingress) bool {
 	return !reflect.DeepEqual(old, current)
 }
 
+// hasHostAnnotationChanges determines if the host priority or standby annotations of a resource have changed
+func hasHostAnnotationChanges(old *meta_v1.ObjectMeta, current *meta_v1.ObjectMeta) bool {
+	return old.Annotations[hostPriorityAnnotation] != current.Annotations[hostPriorityAnnotation] ||
+		old.Annotations[hostStandbyAnnotation] != current.Annotations[hostStandbyAnnotation]
+}
+
 // ParseNamespaceName parses the string in the <namespace>/<name> format and returns the name and the namespace.
 // It returns an error in case the string does not follow the <namespace>/<name> format.
 func ParseNamespaceName(value string) (ns string, name string, err error) {
@@ -3,8 +3,10 @@ package k8s
 import (
 	"errors"
 	"fmt"
+	"maps"
 	"net/url"
 	"regexp"
+	"slices"
 	"sort"
 	"strings"
 
@@ -71,9 +73,17 @@ const (
 	stickyCookieServicesAnnotation        = configs.StickyCookieServicesAnnotation
 	stickyCookieServicesAnnotationPlus    = configs.StickyCookieServicesAnnotationPlus
 	pathRegexAnnotation                   = "nginx.org/path-regex"
+	pathRegexMapAnnotation                = configs.PathRegexMapAnnotation
 	useClusterIPAnnotation                = "nginx.org/use-cluster-ip"
 	httpRedirectCodeAnnotation            = "nginx.org/http-redirect-code"
 	appRootAnnotation                     = "nginx.org/app-root"
+	canaryAnnotation                      = configs.CanaryAnnotation
+	canaryWeightAnnotation                = configs.CanaryWeightAnnotation
+	canaryByHeaderAnnotation              = configs.CanaryByHeaderAnnotation
+	canaryByHeaderValueAnnotation         = configs.CanaryByHeaderValueAnnotation
+	canaryByCookieAnnotation              = configs.CanaryByCookieAnnotation
+	hostPriorityAnnotation                = "nginx.org/host-priority"
+	hostStandbyAnnotation                 = "nginx.org/host-standby"
 )
 
 const (
@@ -288,6 +298,14 @@ var (
 			validateRequiredAnnotation,
 			validateIntAnnotation,
 		},
+		hostPriorityAnnotation: {
+			validateRequiredAnnotation,
+			validateIntAnnotation,
+		},
+		hostStandbyAnnotation: {
+			validateRequiredAnnotation,
+			validateBoolAnnotation,
+		},
 		maxFailsAnnotation: {
 			validateRequiredAnnotation,
 			validateUint64Annotation,
@@ -372,6 +390,10 @@ var (
 		pathRegexAnnotation: {
 			validatePathRegex,
 		},
+		pathRegexMapAnnotation: {
+			validateRequiredAnnotation,
+			validatePathRegexMapAnnotation,
+		},
 		useClusterIPAnnotation: {
 			validateBoolAnnotation,
 		},
@@ -382,6 +404,31 @@ var (
 		appRootAnnotation: {
 			validateAppRootAnnotation,
 		},
+		canaryAnnotation: {
+			validateRequiredAnnotation,
+			validateBoolAnnotation,
+			validateCanaryAnnotation,
+		},
+		canaryWeightAnnotation: {
+			validateRelatedAnnotation(canaryAnnotation, validateIsTrue),
+			validateRequiredAnnotation,
+			validateCanaryWeightAnnotation,
+		},
+		canaryByHeaderAnnotation: {
+			validateRelatedAnnotation(canaryAnnotation, validateIsTrue),
+			validateRequiredAnnotation,
+			validateCanaryByHeaderAnnotation,
+		},
+		canaryByHeaderValueAnnotation: {
+			validateRelatedAnnotation(canaryByHeaderAnnotation, validateNoop),
+			validateRequiredAnnotation,
+			validateCanaryByHeaderValueAnnotation,
+		},
+		canaryByCookieAnnotation: {
+			validateRelatedAnnotation(canaryAnnotation, validateIsTrue),
+			validateRequiredAnnotation,
+			validateCanaryByCookieAnnotation,
+		},
 		configs.PoliciesAnnotation: {
 			validateRequiredAnnotation,
 			validateCommaSeparatedList,
@@ -441,6 +488,13 @@ func validatePathRegex(context *annotationValidationContext) field.ErrorList {
 	}
 }
 
+func validatePathRegexMapAnnotation(context *annotationValidationContext) field.ErrorList {
+	if _, err := configs.ParsePathRegexMap(context.value); err != nil {
+		return field.ErrorList{field.Invalid(context.fieldPath, context.value, err.Error())}
+	}
+	return nil
+}
+
 func validateHTTPRedirectCodeAnnotation(context *annotationValidationContext) field.ErrorList {
 	if _, err := configs.ParseHTTPRedirectCode(context.value); err != nil {
 		return field.ErrorList{field.Invalid(context.fieldPath, context.value, err.Error())}
@@ -495,6 +549,48 @@ func validateAppRootAnnotation(context *annotationValidationContext) field.Error
 	return allErrs
 }
 
+func validateCanaryAnnotation(context *annotationValidationContext) field.ErrorList {
+	if _, exists := context.annotations[mergeableIngressTypeAnnotation]; exists {
+		return field.ErrorList{field.Forbidden(context.fieldPath, fmt.Sprintf("a canary Ingress cannot be a mergeable Ingress: annotation %s must not be set", mergeableIngressTypeAnnotation))}
+	}
+	return nil
+}
+
+func validateCanaryWeightAnnotation(context *annotationValidationContext) field.ErrorList {
+	weight, err := configs.ParseInt(context.value)
+	if err != nil || weight < 0 || weight > 100 {
+		return field.ErrorList{field.Invalid(context.fieldPath, context.value, "must be an integer between 0 and 100")}
+	}
+	return nil
+}
+
+func validateCanaryByHeaderAnnotation(context *annotationValidationContext) field.ErrorList {
+	var allErrs field.ErrorList
+	for _, msg := range validation.IsHTTPHeaderName(context.value) {
+		allErrs = append(allErrs, field.Invalid(context.fieldPath, context.value, msg))
+	}
+	return allErrs
+}
+
+func validateCanaryByHeaderValueAnnotation(context *annotationValidationContext) field.ErrorList {
+	if strings.HasPrefix(context.value, "~") {
+		return field.ErrorList{field.Invalid(context.fieldPath, context.value, "must not start with '~'")}
+	}
+	if !validAnnotationValueRegex.MatchString(context.value) {
+		return field.ErrorList{field.Invalid(context.fieldPath, context.value, annotationValueFmtErrMsg)}
+	}
+	return nil
+}
+
+var canaryCookieNameRegexp = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
+func validateCanaryByCookieAnnotation(context *annotationValidationContext) field.ErrorList {
+	if !canaryCookieNameRegexp.MatchString(context.value) {
+		return field.ErrorList{field.Invalid(context.fieldPath, context.value, "must contain only letters, digits and '_'")}
+	}
+	return nil
+}
+
 func validateJWTLoginURLAnnotation(context *annotationValidationContext) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -668,6 +764,41 @@ func validateIngress(
 		allErrs = append(allErrs, validateChallengeIngress(&ing.Spec, field.NewPath("spec"))...)
 	}
 
+	allErrs = append(allErrs, validateIngressRegexPaths(ing, field.NewPath("annotations"))...)
+
+	return allErrs
+}
+
+// validateIngressRegexPaths validates that the paths of the path-regex-map annotation are paths of the Ingress
+// with the ImplementationSpecific pathType.
+// Regex paths shadowed by preceding ones are reported as warnings when the config of the Ingress is generated.
+func validateIngressRegexPaths(ing *networking.Ingress, annotationsPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	// invalid annotations are reported by validateIngressAnnotations
+	pathRegexes, _ := configs.ParsePathRegexMap(ing.Annotations[pathRegexMapAnnotation])
+
+	implementationSpecificPaths := sets.Set[string]{}
+
+	for _, r := range ing.Spec.Rules {
+		if r.HTTP == nil {
+			continue
+		}
+
+		for _, path := range r.HTTP.Paths {
+			if path.PathType != nil && *path.PathType == networking.PathTypeImplementationSpecific {
+				implementationSpecificPaths.Insert(path.Path)
+			}
+		}
+	}
+
+	for _, path := range slices.Sorted(maps.Keys(pathRegexes)) {
+		if !implementationSpecificPaths.Has(path) {
+			msg := fmt.Sprintf("path %s must be a path of the Ingress with the ImplementationSpecific pathType", path)
+			allErrs = append(allErrs, field.Invalid(annotationsPath.Child(pathRegexMapAnnotation), ing.Annotations[pathRegexMapAnnotation], msg))
+		}
+	}
+
 	return allErrs
 }
 
@@ -734,6 +865,25 @@ func validateIngressAnnotations(
 	return allErrs
 }
 
+// validateHostAnnotations validates the host priority and standby annotations of a VirtualServer or TransportServer.
+func validateHostAnnotations(annotations map[string]string) error {
+	allErrs := field.ErrorList{}
+
+	for _, name := range []string{hostPriorityAnnotation, hostStandbyAnnotation} {
+		if value, exists := annotations[name]; exists {
+			context := &annotationValidationContext{
+				annotations: annotations,
+				name:        name,
+				value:       value,
+				fieldPath:   field.NewPath("metadata", "annotations").Child(name),
+			}
+			allErrs = append(allErrs, validateIngressAnnotation(context)...)
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
+
 func validateIngressAnnotation(context *annotationValidationContext) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if validationFuncs, exists := annotationValidations[context.name]; exists {
@@ -2688,6 +2688,60 @@ func TestValidateNginxIngressAnnotations(t *testing.T) {
 			msg: "invalid nginx.org/keepalive annotation",
 		},
 
+		{
+			annotations: map[string]string{
+				"nginx.org/host-priority": "-10",
+			},
+			specServices:          map[string]bool{},
+			isPlus:                false,
+			appProtectEnabled:     false,
+			appProtectDosEnabled:  false,
+			internalRoutesEnabled: false,
+			expectedErrors:        nil,
+			msg:                   "valid nginx.org/host-priority annotation",
+		},
+		{
+			annotations: map[string]string{
+				"nginx.org/host-priority": "not_a_number",
+			},
+			specServices:          map[string]bool{},
+			isPlus:                false,
+			appProtectEnabled:     false,
+			appProtectDosEnabled:  false,
+			internalRoutesEnabled: false,
+			expectedErrors: []string{
+				`annotations.nginx.org/host-priority: Invalid value: "not_a_number": must be an integer`,
+			},
+			msg: "invalid nginx.org/host-priority annotation",
+		},
+
+		{
+			annotations: map[string]string{
+				"nginx.org/host-standby": "true",
+			},
+			specServices:          map[string]bool{},
+			isPlus:                false,
+			appProtectEnabled:     false,
+			appProtectDosEnabled:  false,
+			internalRoutesEnabled: false,
+			expectedErrors:        nil,
+			msg:                   "valid nginx.org/host-standby annotation",
+		},
+		{
+			annotations: map[string]string{
+				"nginx.org/host-standby": "not_a_boolean",
+			},
+			specServices:          map[string]bool{},
+			isPlus:                false,
+			appProtectEnabled:     false,
+			appProtectDosEnabled:  false,
+			internalRoutesEnabled: false,
+			expectedErrors: []string{
+				`annotations.nginx.org/host-standby: Invalid value: "not_a_boolean": must be a boolean`,
+			},
+			msg: "invalid nginx.org/host-standby annotation",
+		},
+
 		{
 			annotations: map[string]string{
 				"nginx.org/max-fails": "5",
@@ -4210,6 +4264,98 @@ func TestValidateNginxIngressAnnotations(t *testing.T) {
 			},
 			msg: "invalid app-root - contains whitespace",
 		},
+		{
+			annotations: map[string]string{
+				"nginx.org/canary":                 "true",
+				"nginx.org/canary-weight":          "20",
+				"nginx.org/canary-by-header":       "X-Canary",
+				"nginx.org/canary-by-header-value": "v2",
+				"nginx.org/canary-by-cookie":       "canary_user",
+			},
+			specServices:          map[string]bool{},
+			isPlus:                false,
+			appProtectEnabled:     false,
+			appProtectDosEnabled:  false,
+			internalRoutesEnabled: false,
+			expectedErrors:        nil,
+			msg:                   "valid canary annotations",
+		},
+		{
+			annotations: map[string]string{
+				"nginx.org/canary":                 "true",
+				"nginx.org/mergeable-ingress-type": "minion",
+			},
+			specServices:          map[string]bool{},
+			isPlus:                false,
+			appProtectEnabled:     false,
+			appProtectDosEnabled:  false,
+			internalRoutesEnabled: false,
+			expectedErrors: []string{
+				"annotations.nginx.org/canary: Forbidden: a canary Ingress cannot be a mergeable Ingress: annotation nginx.org/mergeable-ingress-type must not be set",
+			},
+			msg: "invalid nginx.org/canary annotation, mergeable Ingress",
+		},
+		{
+			annotations: map[string]string{
+				"nginx.org/canary-weight": "20",
+			},
+			specServices:          map[string]bool{},
+			isPlus:                false,
+			appProtectEnabled:     false,
+			appProtectDosEnabled:  false,
+			internalRoutesEnabled: false,
+			expectedErrors: []string{
+				"annotations.nginx.org/canary-weight: Forbidden: related annotation nginx.org/canary: must be set",
+			},
+			msg: "invalid nginx.org/canary-weight annotation, canary not set",
+		},
+		{
+			annotations: map[string]string{
+				"nginx.org/canary":        "true",
+				"nginx.org/canary-weight": "101",
+			},
+			specServices:          map[string]bool{},
+			isPlus:                false,
+			appProtectEnabled:     false,
+			appProtectDosEnabled:  false,
+			internalRoutesEnabled: false,
+			expectedErrors: []string{
+				`annotations.nginx.org/canary-weight: Invalid value: "101": must be an integer between 0 and 100`,
+			},
+			msg: "invalid nginx.org/canary-weight annotation, out of range",
+		},
+		{
+			annotations: map[string]string{
+				"nginx.org/canary":                 "true",
+				"nginx.org/canary-by-header":       "X Canary",
+				"nginx.org/canary-by-header-value": "~v2",
+			},
+			specServices:          map[string]bool{},
+			isPlus:                false,
+			appProtectEnabled:     false,
+			appProtectDosEnabled:  false,
+			internalRoutesEnabled: false,
+			expectedErrors: []string{
+				`annotations.nginx.org/canary-by-header: Invalid value: "X Canary": a valid HTTP header must consist of alphanumeric characters or '-' (e.g. 'X-Header-Name', regex used for validation is '[-A-Za-z0-9]+')`,
+				`annotations.nginx.org/canary-by-header-value: Invalid value: "~v2": must not start with '~'`,
+			},
+			msg: "invalid nginx.org/canary-by-header and nginx.org/canary-by-header-value annotations",
+		},
+		{
+			annotations: map[string]string{
+				"nginx.org/canary":           "true",
+				"nginx.org/canary-by-cookie": "canary-user",
+			},
+			specServices:          map[string]bool{},
+			isPlus:                false,
+			appProtectEnabled:     false,
+			appProtectDosEnabled:  false,
+			internalRoutesEnabled: false,
+			expectedErrors: []string{
+				`annotations.nginx.org/canary-by-cookie: Invalid value: "canary-user": must contain only letters, digits and '_'`,
+			},
+			msg: "invalid nginx.org/canary-by-cookie annotation",
+		},
 	}
 
 	for _, test := range tests {
@@ -4665,6 +4811,84 @@ func TestValidateMinionSpec(t *testing.T) {
 	}
 }
 
+func createTestRegexPathsIngress(annotations map[string]string, paths ...networking.HTTPIngressPath) *networking.Ingress {
+	return &networking.Ingress{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Annotations: annotations,
+		},
+		Spec: networking.IngressSpec{
+			Rules: []networking.IngressRule{
+				{
+					Host: "foo.example.com",
+					IngressRuleValue: networking.IngressRuleValue{
+						HTTP: &networking.HTTPIngressRuleValue{
+							Paths: paths,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestValidateIngressRegexPaths(t *testing.T) {
+	t.Parallel()
+
+	implementationSpecific := networking.PathTypeImplementationSpecific
+	prefix := networking.PathTypePrefix
+
+	tests := []struct {
+		ing            *networking.Ingress
+		expectedErrors []string
+		msg            string
+	}{
+		{
+			ing: createTestRegexPathsIngress(
+				map[string]string{
+					"nginx.org/path-regex-map": "path=/tea/(v[0-9]+)/(.*) regex=case_sensitive;path=/tea regex=case_sensitive",
+				},
+				networking.HTTPIngressPath{Path: "/tea/(v[0-9]+)/(.*)", PathType: &implementationSpecific},
+				networking.HTTPIngressPath{Path: "/tea", PathType: &implementationSpecific},
+				networking.HTTPIngressPath{Path: "/coffee", PathType: &prefix},
+			),
+			expectedErrors: nil,
+			msg:            "more specific regex path precedes less specific one",
+		},
+		{
+			ing: createTestRegexPathsIngress(
+				map[string]string{
+					"nginx.org/path-regex-map": "path=/tea regex=case_sensitive;path=/tea/(v[0-9]+)/(.*) regex=case_sensitive",
+				},
+				networking.HTTPIngressPath{Path: "/tea", PathType: &implementationSpecific},
+				networking.HTTPIngressPath{Path: "/tea/(v[0-9]+)/(.*)", PathType: &implementationSpecific},
+			),
+			expectedErrors: nil,
+			msg:            "shadowed regex path is not an error",
+		},
+		{
+			ing: createTestRegexPathsIngress(
+				map[string]string{
+					"nginx.org/path-regex-map": "path=/tea regex=case_sensitive;path=/coffee regex=exact",
+				},
+				networking.HTTPIngressPath{Path: "/tea", PathType: &prefix},
+			),
+			expectedErrors: []string{
+				`annotations.nginx.org/path-regex-map: Invalid value: "path=/tea regex=case_sensitive;path=/coffee regex=exact": path /coffee must be a path of the Ingress with the ImplementationSpecific pathType`,
+				`annotations.nginx.org/path-regex-map: Invalid value: "path=/tea regex=case_sensitive;path=/coffee regex=exact": path /tea must be a path of the Ingress with the ImplementationSpecific pathType`,
+			},
+			msg: "paths of the annotation are not ImplementationSpecific paths of the Ingress",
+		},
+	}
+
+	for _, test := range tests {
+		allErrs := validateIngressRegexPaths(test.ing, field.NewPath("annotations"))
+		assertion := assertErrors("validateIngressRegexPaths()", test.msg, allErrs, test.expectedErrors)
+		if assertion != "" {
+			t.Error(assertion)
+		}
+	}
+}
+
 func assertErrorTypes(msg string, allErrs field.ErrorList, expectedErrors []field.ErrorType) string {
 	returnedErrors := errorListToTypes(allErrs)
 	if !reflect.DeepEqual(returnedErrors, expectedErrors) {
@@ -13,6 +13,7 @@ const (
 	EventReasonLicenseExpiry             = "LicenseExpiry"             //nolint:revive
 	EventReasonNoIngressMasterFound      = "NoIngressMasterFound"      //nolint:revive
 	EventReasonNoVirtualServerFound      = "NoVirtualServerFound"      //nolint:revive
+	EventReasonNoPrimaryIngressFound     = "NoPrimaryIngressFound"     //nolint:revive
 	EventReasonRejected                  = "Rejected"                  //nolint:revive
 	EventReasonRejectedWithError         = "RejectedWithError"         //nolint:revive
 	EventReasonSecretDeleted             = "SecretDeleted"             //nolint:revive
@@ -11,6 +11,7 @@ type ManagerCollector interface {
 	IncNginxReloadCount(isEndPointUpdate bool)
 	IncNginxReloadErrors()
 	UpdateLastReloadTime(ms time.Duration)
+	ObserveCoalescedReload(changes int, delay time.Duration)
 	Register(registry *prometheus.Registry) error
 }
 
@@ -21,6 +22,8 @@ type LocalManagerMetricsCollector struct {
 	reloadsError     prometheus.Counter
 	lastReloadStatus prometheus.Gauge
 	lastReloadTime   prometheus.Gauge
+	coalescedChanges prometheus.Histogram
+	coalescingDelay  prometheus.Histogram
 }
 
 // NewLocalManagerMetricsCollector creates a new LocalManagerMetricsCollector
@@ -59,6 +62,24 @@ func NewLocalManagerMetricsCollector(constLabels map[string]string) *LocalManage
 				ConstLabels: constLabels,
 			},
 		),
+		coalescedChanges: prometheus.NewHistogram(
+			prometheus.HistogramOpts{
+				Name:        "nginx_reload_coalesced_changes",
+				Namespace:   metricsNamespace,
+				Help:        "Number of configuration changes coalesced into a single NGINX reload",
+				ConstLabels: constLabels,
+				Buckets:     []float64{1, 2, 5, 10, 20, 50, 100},
+			},
+		),
+		coalescingDelay: prometheus.NewHistogram(
+			prometheus.HistogramOpts{
+				Name:        "nginx_reload_coalescing_delay_seconds",
+				Namespace:   metricsNamespace,
+				Help:        "Delay in seconds added to NGINX reloads by coalescing the configuration changes",
+				ConstLabels: constLabels,
+				Buckets:     []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+			},
+		),
 	}
 	nc.reloadsTotal.WithLabelValues("other")
 	nc.reloadsTotal.WithLabelValues("endpoints")
@@ -97,12 +118,21 @@ func (nc *LocalManagerMetricsCollector) UpdateLastReloadTime(duration time.Durat
 	nc.lastReloadTime.Set(float64(duration / time.Millisecond))
 }
 
+// ObserveCoalescedReload records the number of configuration changes coalesced into an NGINX reload
+// and the delay added to the reload of the first change
+func (nc *LocalManagerMetricsCollector) ObserveCoalescedReload(changes int, delay time.Duration) {
+	nc.coalescedChanges.Observe(float64(changes))
+	nc.coalescingDelay.Observe(delay.Seconds())
+}
+
 // Describe implements prometheus.Collector interface Describe method
 func (nc *LocalManagerMetricsCollector) Describe(ch chan<- *prometheus.Desc) {
 	nc.reloadsTotal.Describe(ch)
 	nc.reloadsError.Describe(ch)
 	nc.lastReloadStatus.Describe(ch)
 	nc.lastReloadTime.Describe(ch)
+	nc.coalescedChanges.Describe(ch)
+	nc.coalescingDelay.Describe(ch)
 }
 
 // Collect implements the prometheus.Collector interface Collect method
@@ -111,6 +141,8 @@ func (nc *LocalManagerMetricsCollector) Collect(ch chan<- prometheus.Metric) {
 	nc.reloadsError.Collect(ch)
 	nc.lastReloadStatus.Collect(ch)
 	nc.lastReloadTime.Collect(ch)
+	nc.coalescedChanges.Collect(ch)
+	nc.coalescingDelay.Collect(ch)
 }
 
 // Register registers all the metrics of the collector
@@ -137,3 +169,6 @@ func (nc *ManagerFakeCollector) IncNginxReloadErrors() {}
 
 // UpdateLastReloadTime implements a fake UpdateLastReloadTime
 func (nc *ManagerFakeCollector) UpdateLastReloadTime(_ time.Duration) {}
+
+// ObserveCoalescedReload implements a fake ObserveCoalescedReload
+func (nc *ManagerFakeCollector) ObserveCoalescedReload(_ int, _ time.Duration) {}
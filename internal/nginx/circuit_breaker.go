@@ -0,0 +1,179 @@
+package nginx
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/nginx/nginx-plus-go-client/v3/client"
+)
+
+// CircuitBreakerConfig holds the parameters of the circuit breaker of an NGINX Plus upstream.
+type CircuitBreakerConfig struct {
+	Consecutive5xx     int
+	LatencyThreshold   time.Duration
+	EjectDuration      time.Duration
+	MaxEjectionPercent int
+}
+
+type peerState struct {
+	requests     uint64
+	failures     uint64
+	consecutive  uint64
+	ejectedUntil time.Time
+}
+
+// CircuitBreaker detects the failing servers of NGINX Plus upstreams based on the statistics of the NGINX Plus API
+// and keeps track of the servers that are ejected.
+type CircuitBreaker struct {
+	mu        sync.Mutex
+	upstreams map[string]map[string]*peerState
+}
+
+// NewCircuitBreaker creates a CircuitBreaker.
+func NewCircuitBreaker() *CircuitBreaker {
+	return &CircuitBreaker{
+		upstreams: make(map[string]map[string]*peerState),
+	}
+}
+
+// Evaluate updates the state of the servers of the upstreams with the given statistics and returns the ejected servers of each upstream.
+// The state of the upstreams that are not present in the configs is discarded.
+func (cb *CircuitBreaker) Evaluate(configs map[string]CircuitBreakerConfig, peers map[string][]client.Peer, now time.Time) map[string]map[string]bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	for upstream := range cb.upstreams {
+		if _, exists := configs[upstream]; !exists {
+			delete(cb.upstreams, upstream)
+		}
+	}
+
+	ejected := make(map[string]map[string]bool)
+	for upstream, cfg := range configs {
+		ejectedPeers := cb.evaluateUpstream(upstream, peers[upstream], cfg, now)
+		if len(ejectedPeers) > 0 {
+			ejected[upstream] = ejectedPeers
+		}
+	}
+
+	return ejected
+}
+
+func (cb *CircuitBreaker) evaluateUpstream(upstream string, peers []client.Peer, cfg CircuitBreakerConfig, now time.Time) map[string]bool {
+	previous := cb.upstreams[upstream]
+	states := make(map[string]*peerState)
+
+	var servers []client.Peer
+	for _, p := range peers {
+		if p.Backup {
+			continue
+		}
+		servers = append(servers, p)
+	}
+	sort.Slice(servers, func(i, j int) bool {
+		return servers[i].Server < servers[j].Server
+	})
+
+	var outliers []string
+	for _, p := range servers {
+		state, exists := previous[p.Server]
+		if !exists {
+			state = &peerState{
+				requests: p.Requests,
+				failures: peerFailures(p),
+			}
+		}
+		states[p.Server] = state
+
+		requests := p.Requests - state.requests
+		failures := peerFailures(p) - state.failures
+		if p.Requests < state.requests || peerFailures(p) < state.failures {
+			// the counters were reset by a reload of NGINX
+			requests, failures = p.Requests, peerFailures(p)
+		}
+		state.requests = p.Requests
+		state.failures = peerFailures(p)
+
+		if !state.ejectedUntil.IsZero() {
+			if now.Before(state.ejectedUntil) {
+				continue
+			}
+			state.ejectedUntil = time.Time{}
+			state.consecutive = 0
+		}
+
+		if requests == 0 {
+			continue
+		}
+
+		failures = min(failures, requests)
+		if failures == requests {
+			state.consecutive += failures
+		} else {
+			state.consecutive = 0
+		}
+
+		isSlow := cfg.LatencyThreshold > 0 && time.Duration(p.ResponseTime)*time.Millisecond > cfg.LatencyThreshold
+		if state.consecutive >= uint64(cfg.Consecutive5xx) || isSlow {
+			outliers = append(outliers, p.Server)
+		}
+	}
+
+	ejected := make(map[string]bool)
+	for server, state := range states {
+		if !state.ejectedUntil.IsZero() {
+			ejected[server] = true
+		}
+	}
+
+	// The last healthy server of the upstream is never ejected, so that the upstream keeps serving requests.
+	healthy := make(map[string]bool)
+	for _, p := range servers {
+		if !ejected[p.Server] && p.State == "up" {
+			healthy[p.Server] = true
+		}
+	}
+
+	maxEjected := len(servers) * cfg.MaxEjectionPercent / 100
+	if cfg.MaxEjectionPercent > 0 {
+		maxEjected = max(1, maxEjected)
+	}
+	for _, server := range outliers {
+		if len(ejected) >= maxEjected {
+			break
+		}
+		if healthy[server] && len(healthy) == 1 {
+			continue
+		}
+		states[server].ejectedUntil = now.Add(cfg.EjectDuration)
+		states[server].consecutive = 0
+		ejected[server] = true
+		delete(healthy, server)
+	}
+
+	cb.upstreams[upstream] = states
+
+	return ejected
+}
+
+// Ejected returns the servers of the upstream that are ejected at the given time.
+func (cb *CircuitBreaker) Ejected(upstream string, now time.Time) map[string]bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	ejected := make(map[string]bool)
+	for server, state := range cb.upstreams[upstream] {
+		if now.Before(state.ejectedUntil) {
+			ejected[server] = true
+		}
+	}
+
+	return ejected
+}
+
+// peerFailures returns the number of the failed requests of the server.
+// NGINX counts the errors, the timeouts and the responses that match the proxy_next_upstream conditions as fails.
+func peerFailures(p client.Peer) uint64 {
+	return max(p.Fails, p.Responses.Responses5xx)
+}
@@ -0,0 +1,167 @@
+package nginx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/nginx/nginx-plus-go-client/v3/client"
+)
+
+func peer(server string, requests uint64, fails uint64, responseTime uint64) client.Peer {
+	return client.Peer{
+		Server:       server,
+		State:        "up",
+		Requests:     requests,
+		Fails:        fails,
+		ResponseTime: responseTime,
+	}
+}
+
+func TestCircuitBreakerEjectsConsecutiveFailures(t *testing.T) {
+	t.Parallel()
+	cb := NewCircuitBreaker()
+	now := time.Now()
+	configs := map[string]CircuitBreakerConfig{
+		"vs_default_cafe_tea": {Consecutive5xx: 3, EjectDuration: 30 * time.Second, MaxEjectionPercent: 50},
+	}
+
+	cb.Evaluate(configs, map[string][]client.Peer{
+		"vs_default_cafe_tea": {peer("10.0.0.1:80", 10, 0, 5), peer("10.0.0.2:80", 10, 0, 5)},
+	}, now)
+
+	ejected := cb.Evaluate(configs, map[string][]client.Peer{
+		"vs_default_cafe_tea": {peer("10.0.0.1:80", 12, 2, 5), peer("10.0.0.2:80", 15, 2, 5)},
+	}, now.Add(5*time.Second))
+	if len(ejected) != 0 {
+		t.Errorf("Evaluate() returned %v but expected no ejected servers", ejected)
+	}
+
+	ejected = cb.Evaluate(configs, map[string][]client.Peer{
+		"vs_default_cafe_tea": {peer("10.0.0.1:80", 13, 3, 5), peer("10.0.0.2:80", 20, 2, 5)},
+	}, now.Add(10*time.Second))
+	expected := map[string]map[string]bool{
+		"vs_default_cafe_tea": {"10.0.0.1:80": true},
+	}
+	if !cmp.Equal(expected, ejected) {
+		t.Errorf("Evaluate() mismatch (-want +got):\n%s", cmp.Diff(expected, ejected))
+	}
+
+	if !cmp.Equal(expected["vs_default_cafe_tea"], cb.Ejected("vs_default_cafe_tea", now.Add(20*time.Second))) {
+		t.Errorf("Ejected() didn't return the ejected server before the eject duration expired")
+	}
+
+	ejected = cb.Evaluate(configs, map[string][]client.Peer{
+		"vs_default_cafe_tea": {peer("10.0.0.1:80", 13, 3, 5), peer("10.0.0.2:80", 25, 2, 5)},
+	}, now.Add(45*time.Second))
+	if len(ejected) != 0 {
+		t.Errorf("Evaluate() returned %v but expected the server to be restored after the eject duration", ejected)
+	}
+}
+
+func TestCircuitBreakerEjectsSlowServers(t *testing.T) {
+	t.Parallel()
+	cb := NewCircuitBreaker()
+	now := time.Now()
+	configs := map[string]CircuitBreakerConfig{
+		"vs_default_cafe_tea": {Consecutive5xx: 5, LatencyThreshold: time.Second, EjectDuration: 30 * time.Second, MaxEjectionPercent: 10},
+	}
+
+	cb.Evaluate(configs, map[string][]client.Peer{
+		"vs_default_cafe_tea": {peer("10.0.0.1:80", 10, 0, 1500), peer("10.0.0.2:80", 10, 0, 1500)},
+	}, now)
+
+	ejected := cb.Evaluate(configs, map[string][]client.Peer{
+		"vs_default_cafe_tea": {peer("10.0.0.1:80", 20, 0, 1500), peer("10.0.0.2:80", 20, 0, 1500)},
+	}, now.Add(5*time.Second))
+	expected := map[string]map[string]bool{
+		"vs_default_cafe_tea": {"10.0.0.1:80": true},
+	}
+	if !cmp.Equal(expected, ejected) {
+		t.Errorf("Evaluate() mismatch (-want +got):\n%s", cmp.Diff(expected, ejected))
+	}
+}
+
+func TestCircuitBreakerDiscardsRemovedUpstreams(t *testing.T) {
+	t.Parallel()
+	cb := NewCircuitBreaker()
+	now := time.Now()
+	configs := map[string]CircuitBreakerConfig{
+		"vs_default_cafe_tea": {Consecutive5xx: 1, EjectDuration: 30 * time.Second, MaxEjectionPercent: 50},
+	}
+	peers := map[string][]client.Peer{
+		"vs_default_cafe_tea": {peer("10.0.0.1:80", 0, 0, 0), peer("10.0.0.2:80", 0, 0, 0)},
+	}
+
+	cb.Evaluate(configs, peers, now)
+	peers["vs_default_cafe_tea"] = []client.Peer{peer("10.0.0.1:80", 1, 1, 0), peer("10.0.0.2:80", 1, 0, 0)}
+	cb.Evaluate(configs, peers, now.Add(time.Second))
+	if len(cb.Ejected("vs_default_cafe_tea", now.Add(time.Second))) != 1 {
+		t.Fatalf("Evaluate() didn't eject the failing server")
+	}
+
+	cb.Evaluate(map[string]CircuitBreakerConfig{}, peers, now.Add(2*time.Second))
+	if ejected := cb.Ejected("vs_default_cafe_tea", now.Add(2*time.Second)); len(ejected) != 0 {
+		t.Errorf("Ejected() returned %v but expected no servers for a removed upstream", ejected)
+	}
+}
+
+func TestCircuitBreakerLimitsEjections(t *testing.T) {
+	t.Parallel()
+	unhealthy := peer("10.0.0.3:80", 0, 0, 0)
+	unhealthy.State = "unhealthy"
+	failingUnhealthy := peer("10.0.0.3:80", 1, 1, 0)
+	failingUnhealthy.State = "unhealthy"
+
+	tests := []struct {
+		maxEjectionPercent int
+		before             []client.Peer
+		after              []client.Peer
+		expected           map[string]bool
+		msg                string
+	}{
+		{
+			maxEjectionPercent: 100,
+			before:             []client.Peer{peer("10.0.0.1:80", 0, 0, 0)},
+			after:              []client.Peer{peer("10.0.0.1:80", 1, 1, 0)},
+			expected:           map[string]bool{},
+			msg:                "the only server",
+		},
+		{
+			maxEjectionPercent: 100,
+			before:             []client.Peer{peer("10.0.0.1:80", 0, 0, 0), peer("10.0.0.2:80", 0, 0, 0)},
+			after:              []client.Peer{peer("10.0.0.1:80", 1, 1, 0), peer("10.0.0.2:80", 1, 1, 0)},
+			expected:           map[string]bool{"10.0.0.1:80": true},
+			msg:                "all servers failing",
+		},
+		{
+			maxEjectionPercent: 100,
+			before:             []client.Peer{peer("10.0.0.1:80", 0, 0, 0), unhealthy},
+			after:              []client.Peer{peer("10.0.0.1:80", 1, 1, 0), failingUnhealthy},
+			expected:           map[string]bool{"10.0.0.3:80": true},
+			msg:                "the last healthy server",
+		},
+		{
+			maxEjectionPercent: 0,
+			before:             []client.Peer{peer("10.0.0.1:80", 0, 0, 0), peer("10.0.0.2:80", 0, 0, 0)},
+			after:              []client.Peer{peer("10.0.0.1:80", 1, 1, 0), peer("10.0.0.2:80", 1, 0, 0)},
+			expected:           map[string]bool{},
+			msg:                "zero max ejection percent",
+		},
+	}
+
+	for _, test := range tests {
+		cb := NewCircuitBreaker()
+		now := time.Now()
+		configs := map[string]CircuitBreakerConfig{
+			"vs_default_cafe_tea": {Consecutive5xx: 1, EjectDuration: 30 * time.Second, MaxEjectionPercent: test.maxEjectionPercent},
+		}
+
+		cb.Evaluate(configs, map[string][]client.Peer{"vs_default_cafe_tea": test.before}, now)
+		ejected := cb.Evaluate(configs, map[string][]client.Peer{"vs_default_cafe_tea": test.after}, now.Add(time.Second))
+		if diff := cmp.Diff(test.expected, ejected["vs_default_cafe_tea"], cmpopts.EquateEmpty()); diff != "" {
+			t.Errorf("Evaluate() mismatch for the case of %s (-want +got):\n%s", test.msg, diff)
+		}
+	}
+}
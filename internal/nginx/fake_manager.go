@@ -134,6 +134,13 @@ func (fm *FakeManager) Reload(_ bool) error {
 	return nil
 }
 
+// ValidateConfig provides a fake implementation of ValidateConfig.
+func (fm *FakeManager) ValidateConfig(name string, content []byte) error {
+	nl.Debugf(fm.logger, "Validating config %v", name)
+	nl.Debug(fm.logger, string(content))
+	return nil
+}
+
 // Quit provides a fake implementation of Quit.
 func (fm *FakeManager) Quit() {
 	nl.Debug(fm.logger, "Quitting nginx")
@@ -160,6 +167,12 @@ func (fm *FakeManager) UpdateStreamServersInPlus(upstream string, servers []stri
 	return nil
 }
 
+// GetUpstreamPeersInPlus provides a fake implementation of GetUpstreamPeersInPlus.
+func (fm *FakeManager) GetUpstreamPeersInPlus() (map[string][]client.Peer, error) {
+	nl.Debugf(fm.logger, "Getting upstream peers")
+	return map[string][]client.Peer{}, nil
+}
+
 // AppProtectPluginStart is a fake implementation AppProtectPluginStart
 func (fm *FakeManager) AppProtectPluginStart(_ chan error, _ string) {
 	nl.Debugf(fm.logger, "Starting FakeAppProtectPlugin")
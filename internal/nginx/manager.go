@@ -34,6 +34,8 @@ const (
 	JWKSecretFileMode = 0o644
 	// HtpasswdSecretFileMode defines the default filemode for HTTP basic auth user files.
 	HtpasswdSecretFileMode = 0o644
+	// MaintenancePageFileMode defines the default filemode for the pages of the maintenance mode.
+	MaintenancePageFileMode = 0o644
 
 	configFileMode       = 0o644
 	nginxBinaryPath      = "/usr/sbin/nginx"
@@ -66,6 +68,8 @@ type ServerConfig struct {
 	MaxConns    int
 	FailTimeout string
 	SlowStart   string
+	// Ejected holds the servers that are marked as down because they were ejected by the circuit breaker.
+	Ejected map[string]bool
 }
 
 // The Manager interface updates NGINX configuration, starts, reloads and quits NGINX,
@@ -89,11 +93,13 @@ type Manager interface {
 	Start(done chan error)
 	Version() Version
 	Reload(isEndpointsUpdate bool) error
+	ValidateConfig(name string, content []byte) error
 	Quit()
 	UpdateConfigVersionFile()
 	SetPlusClients(plusClient *client.NginxClient, plusConfigVersionCheckClient *http.Client)
 	UpdateServersInPlus(upstream string, servers []string, config ServerConfig) error
 	UpdateStreamServersInPlus(upstream string, servers []string) error
+	GetUpstreamPeersInPlus() (map[string][]client.Peer, error)
 	AppProtectPluginStart(appDone chan error, logLevel string)
 	AppProtectPluginQuit()
 	AppProtectDosAgentStart(apdaDone chan error, debug bool, maxDaemon int, maxWorkers int, memory int)
@@ -377,6 +383,74 @@ func (lm *LocalManager) Reload(isEndpointsUpdate bool) error {
 	return nil
 }
 
+// ValidateConfig tests the configuration file with `nginx -t` without applying it.
+// The file is tested together with the main config and the other files in the conf.d folder,
+// taking the place of the file with the same name.
+func (lm *LocalManager) ValidateConfig(name string, content []byte) error {
+	dir, err := os.MkdirTemp(path.Dir(lm.mainConfFilename), "validate-")
+	if err != nil {
+		return fmt.Errorf("failed to create a folder for validating config %v: %w", name, err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			nl.Warnf(lm.logger, "Failed to delete the folder %v: %v", dir, err)
+		}
+	}()
+
+	mainConfFilename, err := createValidationConfig(lm.mainConfFilename, lm.confdPath, dir, name, content)
+	if err != nil {
+		return fmt.Errorf("failed to create config for validating %v: %w", name, err)
+	}
+
+	binaryFilename := getBinaryFileName(lm.debug)
+	if err := shellOut(lm.logger, fmt.Sprintf("%v -t -q -c %v", binaryFilename, mainConfFilename)); err != nil {
+		return fmt.Errorf("nginx config test failed: %w", err)
+	}
+
+	return nil
+}
+
+// createValidationConfig creates in the dir a copy of the main config that includes the files of the dir
+// instead of the conf.d folder. The files of the conf.d folder are linked in the dir, except the file with the name,
+// which is created with the content. It returns the filename of the main config copy.
+func createValidationConfig(mainConfFilename string, confdPath string, dir string, name string, content []byte) (string, error) {
+	mainConf, err := os.ReadFile(mainConfFilename)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %v: %w", mainConfFilename, err)
+	}
+
+	confdInclude := fmt.Sprintf("include %v/*.conf;", confdPath)
+	if !strings.Contains(string(mainConf), confdInclude) {
+		return "", fmt.Errorf("%v doesn't include the configs of %v", mainConfFilename, confdPath)
+	}
+	mainConf = []byte(strings.Replace(string(mainConf), confdInclude, fmt.Sprintf("include %v/*.conf;", dir), 1))
+
+	entries, err := os.ReadDir(confdPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %v: %w", confdPath, err)
+	}
+	for _, entry := range entries {
+		if entry.Name() == name+".conf" {
+			continue
+		}
+		if err := os.Symlink(path.Join(confdPath, entry.Name()), path.Join(dir, entry.Name())); err != nil {
+			return "", fmt.Errorf("failed to link %v: %w", entry.Name(), err)
+		}
+	}
+
+	if err := createFileAndWrite(path.Join(dir, name+".conf"), content); err != nil {
+		return "", err
+	}
+
+	// the main config copy doesn't match the include pattern, so that it doesn't include itself
+	validationMainConfFilename := path.Join(dir, "nginx.conf.main")
+	if err := createFileAndWrite(validationMainConfFilename, mainConf); err != nil {
+		return "", err
+	}
+
+	return validationMainConfFilename, nil
+}
+
 // Quit shutdowns NGINX gracefully.
 func (lm *LocalManager) Quit() {
 	nl.Debugf(lm.logger, "Quitting nginx")
@@ -438,13 +512,18 @@ func (lm *LocalManager) UpdateServersInPlus(upstream string, servers []string, c
 
 	var upsServers []client.UpstreamServer
 	for _, s := range servers {
-		upsServers = append(upsServers, client.UpstreamServer{
+		upsServer := client.UpstreamServer{
 			Server:      s,
 			MaxFails:    &config.MaxFails,
 			MaxConns:    &config.MaxConns,
 			FailTimeout: config.FailTimeout,
 			SlowStart:   config.SlowStart,
-		})
+		}
+		if config.Ejected[s] {
+			down := true
+			upsServer.Down = &down
+		}
+		upsServers = append(upsServers, upsServer)
 	}
 
 	added, removed, updated, err := lm.plusClient.UpdateHTTPServers(context.Background(), upstream, upsServers)
@@ -513,6 +592,21 @@ func (lm *LocalManager) UpdateStreamServersInPlus(upstream string, servers []str
 	return nil
 }
 
+// GetUpstreamPeersInPlus returns the statistics of the servers of the NGINX Plus HTTP upstreams.
+func (lm *LocalManager) GetUpstreamPeersInPlus() (map[string][]client.Peer, error) {
+	upstreams, err := lm.plusClient.GetUpstreams(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("error getting upstreams: %w", err)
+	}
+
+	peers := make(map[string][]client.Peer, len(*upstreams))
+	for name, upstream := range *upstreams {
+		peers[name] = upstream.Peers
+	}
+
+	return peers, nil
+}
+
 // verifyConfigVersion is used to check if the worker process that the API client is connected
 // to is using the latest version of nginx config. This way we avoid making changes on
 // a worker processes that is being shut down.
@@ -763,7 +857,7 @@ func (lm *LocalManager) DeleteKeyValStateFiles(virtualServerName string) {
 		nl.Warnf(lm.logger, "Failed to read the state files directory %s: %v", lm.stateFilesPath, err)
 	}
 	for _, file := range files {
-		if strings.HasPrefix(file.Name(), virtualServerName+"_keyval_zone_split_clients") {
+		if strings.HasPrefix(file.Name(), virtualServerName+"_keyval_zone_split_clients") || strings.HasPrefix(file.Name(), virtualServerName+"_keyval_zone_maintenance") {
 			if err := os.Remove(path.Join(lm.stateFilesPath, file.Name())); err != nil {
 				nl.Warnf(lm.logger, "Failed to delete the state file %s: %v", file.Name(), err)
 			}
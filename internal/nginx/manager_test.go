@@ -1,6 +1,9 @@
 package nginx
 
 import (
+	"fmt"
+	"os"
+	"path"
 	"testing"
 
 	"github.com/nginx/nginx-plus-go-client/v3/client"
@@ -221,3 +224,74 @@ REDHAT_SUPPORT_PRODUCT_VERSION="9.7"
 		})
 	}
 }
+
+func TestCreateValidationConfig(t *testing.T) {
+	t.Parallel()
+
+	confPath := t.TempDir()
+	confdPath := path.Join(confPath, "conf.d")
+	if err := os.Mkdir(confdPath, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	mainConfFilename := path.Join(confPath, "nginx.conf")
+	mainConf := fmt.Sprintf("http {\n    include %v/*.conf;\n}\n", confdPath)
+	files := map[string]string{
+		mainConfFilename:                          mainConf,
+		path.Join(confdPath, "default.conf"):      "server {}",
+		path.Join(confdPath, "vs_cafe_cafe.conf"): "server { listen 80; }",
+	}
+	for filename, content := range files {
+		if err := os.WriteFile(filename, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	dir := path.Join(confPath, "validate")
+	if err := os.Mkdir(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	validationMainConfFilename, err := createValidationConfig(mainConfFilename, confdPath, dir, "vs_cafe_cafe", []byte("server { listen 8080; }"))
+	if err != nil {
+		t.Fatalf("createValidationConfig() returned unexpected error: %v", err)
+	}
+
+	expectedFiles := map[string]string{
+		validationMainConfFilename:          fmt.Sprintf("http {\n    include %v/*.conf;\n}\n", dir),
+		path.Join(dir, "default.conf"):      "server {}",
+		path.Join(dir, "vs_cafe_cafe.conf"): "server { listen 8080; }",
+	}
+	for filename, expected := range expectedFiles {
+		content, err := os.ReadFile(filename)
+		if err != nil {
+			t.Fatalf("createValidationConfig() didn't create %v: %v", filename, err)
+		}
+		if string(content) != expected {
+			t.Errorf("createValidationConfig() created %v with %q, expected %q", filename, content, expected)
+		}
+	}
+
+	content, err := os.ReadFile(path.Join(confdPath, "vs_cafe_cafe.conf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "server { listen 80; }" {
+		t.Errorf("createValidationConfig() changed the applied config to %q", content)
+	}
+}
+
+func TestCreateValidationConfigFailsWithoutConfdInclude(t *testing.T) {
+	t.Parallel()
+
+	confPath := t.TempDir()
+	mainConfFilename := path.Join(confPath, "nginx.conf")
+	if err := os.WriteFile(mainConfFilename, []byte("http {}\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := createValidationConfig(mainConfFilename, path.Join(confPath, "conf.d"), t.TempDir(), "vs_cafe_cafe", nil)
+	if err == nil {
+		t.Error("createValidationConfig() returned no error for a main config without the conf.d include")
+	}
+}
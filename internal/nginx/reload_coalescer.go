@@ -0,0 +1,86 @@
+package nginx
+
+import (
+	"sync"
+	"time"
+
+	"github.com/nginx/kubernetes-ingress/internal/metrics/collectors"
+)
+
+// ReloadCoalescer coalesces the NGINX reloads requested by configuration changes into a single reload.
+// The pending reloads are flushed once the debounce window since the first pending request has passed
+// and the minimum interval since the previous reload has passed.
+type ReloadCoalescer struct {
+	mu                sync.Mutex
+	window            time.Duration
+	minInterval       time.Duration
+	pending           int
+	isEndpointsUpdate bool
+	firstRequest      time.Time
+	lastReload        time.Time
+	metricsCollector  collectors.ManagerCollector
+}
+
+// NewReloadCoalescer creates a ReloadCoalescer.
+func NewReloadCoalescer(window time.Duration, minInterval time.Duration, mc collectors.ManagerCollector) *ReloadCoalescer {
+	return &ReloadCoalescer{
+		window:           window,
+		minInterval:      minInterval,
+		metricsCollector: mc,
+	}
+}
+
+// Request records a reload requested at the given time.
+// The coalesced reload is an endpoints update only if all the coalesced requests are endpoints updates.
+func (rc *ReloadCoalescer) Request(isEndpointsUpdate bool, now time.Time) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	if rc.pending == 0 {
+		rc.firstRequest = now
+		rc.isEndpointsUpdate = isEndpointsUpdate
+	} else {
+		rc.isEndpointsUpdate = rc.isEndpointsUpdate && isEndpointsUpdate
+	}
+	rc.pending++
+}
+
+// Flush returns true if the pending reloads are due at the given time, along with whether the coalesced reload
+// is an endpoints update. The caller must reload NGINX if the reloads are due.
+func (rc *ReloadCoalescer) Flush(now time.Time) (isEndpointsUpdate bool, due bool) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	if !rc.due(now) {
+		return false, false
+	}
+
+	rc.metricsCollector.ObserveCoalescedReload(rc.pending, now.Sub(rc.firstRequest))
+
+	isEndpointsUpdate = rc.isEndpointsUpdate
+	rc.pending = 0
+	rc.isEndpointsUpdate = false
+	rc.lastReload = now
+
+	return isEndpointsUpdate, true
+}
+
+// Pending returns true if reloads were requested since the previous flush.
+func (rc *ReloadCoalescer) Pending() bool {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	return rc.pending > 0
+}
+
+// Due returns true if the pending reloads are due at the given time.
+func (rc *ReloadCoalescer) Due(now time.Time) bool {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	return rc.due(now)
+}
+
+func (rc *ReloadCoalescer) due(now time.Time) bool {
+	return rc.pending > 0 && !now.Before(rc.firstRequest.Add(rc.window)) && !now.Before(rc.lastReload.Add(rc.minInterval))
+}
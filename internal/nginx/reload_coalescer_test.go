@@ -0,0 +1,73 @@
+package nginx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nginx/kubernetes-ingress/internal/metrics/collectors"
+)
+
+func TestReloadCoalescerFlushesAfterWindow(t *testing.T) {
+	t.Parallel()
+	rc := NewReloadCoalescer(2*time.Second, 0, collectors.NewManagerFakeCollector())
+	now := time.Now()
+
+	if _, due := rc.Flush(now); due {
+		t.Errorf("Flush() returned due without pending reloads")
+	}
+
+	rc.Request(ReloadForEndpointsUpdate, now)
+	rc.Request(ReloadForOtherUpdate, now.Add(time.Second))
+
+	if !rc.Pending() {
+		t.Errorf("Pending() returned false after the reloads were requested")
+	}
+	if rc.Due(now.Add(time.Second)) {
+		t.Errorf("Due() returned true before the end of the window")
+	}
+	if _, due := rc.Flush(now.Add(time.Second)); due {
+		t.Errorf("Flush() returned due before the end of the window")
+	}
+	if !rc.Due(now.Add(2 * time.Second)) {
+		t.Errorf("Due() returned false at the end of the window")
+	}
+
+	isEndpointsUpdate, due := rc.Flush(now.Add(2 * time.Second))
+	if !due {
+		t.Fatalf("Flush() returned not due at the end of the window")
+	}
+	if isEndpointsUpdate {
+		t.Errorf("Flush() returned an endpoints update for coalesced reloads that include other updates")
+	}
+
+	if _, due := rc.Flush(now.Add(3 * time.Second)); due {
+		t.Errorf("Flush() returned due after the pending reloads were flushed")
+	}
+	if rc.Pending() {
+		t.Errorf("Pending() returned true after the pending reloads were flushed")
+	}
+}
+
+func TestReloadCoalescerKeepsMinInterval(t *testing.T) {
+	t.Parallel()
+	rc := NewReloadCoalescer(0, 5*time.Second, collectors.NewManagerFakeCollector())
+	now := time.Now()
+
+	rc.Request(ReloadForEndpointsUpdate, now)
+	isEndpointsUpdate, due := rc.Flush(now)
+	if !due {
+		t.Fatalf("Flush() returned not due for the first reload")
+	}
+	if !isEndpointsUpdate {
+		t.Errorf("Flush() returned not an endpoints update for a single endpoints update")
+	}
+
+	rc.Request(ReloadForEndpointsUpdate, now.Add(time.Second))
+	if _, due := rc.Flush(now.Add(time.Second)); due {
+		t.Errorf("Flush() returned due before the minimum interval since the previous reload")
+	}
+
+	if _, due := rc.Flush(now.Add(5 * time.Second)); !due {
+		t.Errorf("Flush() returned not due after the minimum interval since the previous reload")
+	}
+}
@@ -0,0 +1,237 @@
+package nginx
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const streamProbeBufferSize = 4096
+
+// StreamProbeConfig holds the parameters of the health check of the servers of a stream upstream.
+type StreamProbeConfig struct {
+	UDP      bool
+	Port     int
+	Interval time.Duration
+	Timeout  time.Duration
+	Fails    int
+	Passes   int
+	Send     string
+	Expect   string
+}
+
+type streamServerState struct {
+	down      bool
+	fails     int
+	passes    int
+	probing   bool
+	nextProbe time.Time
+}
+
+type streamProbeFunc func(ctx context.Context, address string, cfg StreamProbeConfig) error
+
+// StreamProber probes the servers of stream upstreams from the Ingress Controller and keeps track of the servers that are down.
+// It provides active health checks of stream upstreams for NGINX, which doesn't support them natively.
+type StreamProber struct {
+	mu        sync.Mutex
+	upstreams map[string]map[string]*streamServerState
+	changed   bool
+	probe     streamProbeFunc
+}
+
+// NewStreamProber creates a StreamProber.
+func NewStreamProber() *StreamProber {
+	return &StreamProber{
+		upstreams: make(map[string]map[string]*streamServerState),
+		probe:     probeStreamServer,
+	}
+}
+
+// Probe starts the probes of the servers of the upstreams that are due at the given time. The probes run in the background.
+// It returns true if a server went up or down since the previous call.
+// The state of the upstreams and the servers that are not present in the configs is discarded.
+func (sp *StreamProber) Probe(ctx context.Context, configs map[string]StreamProbeConfig, servers map[string][]string, now time.Time) bool {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+
+	for upstream := range sp.upstreams {
+		if _, exists := configs[upstream]; !exists {
+			delete(sp.upstreams, upstream)
+		}
+	}
+
+	for upstream, cfg := range configs {
+		previous := sp.upstreams[upstream]
+		states := make(map[string]*streamServerState)
+
+		for _, server := range servers[upstream] {
+			state, exists := previous[server]
+			if !exists {
+				state = &streamServerState{}
+			}
+			states[server] = state
+
+			if state.probing || now.Before(state.nextProbe) {
+				continue
+			}
+			state.probing = true
+			state.nextProbe = now.Add(cfg.Interval)
+
+			go func(upstream, server string, cfg StreamProbeConfig) {
+				err := sp.probe(ctx, server, cfg)
+				sp.record(upstream, server, cfg, err == nil)
+			}(upstream, server, cfg)
+		}
+
+		sp.upstreams[upstream] = states
+	}
+
+	changed := sp.changed
+	sp.changed = false
+
+	return changed
+}
+
+func (sp *StreamProber) record(upstream string, server string, cfg StreamProbeConfig, passed bool) {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+
+	state, exists := sp.upstreams[upstream][server]
+	if !exists {
+		return
+	}
+	state.probing = false
+
+	if passed {
+		state.fails = 0
+		state.passes++
+		if state.down && state.passes >= cfg.Passes {
+			state.down = false
+			sp.changed = true
+		}
+		return
+	}
+
+	state.passes = 0
+	state.fails++
+	if !state.down && state.fails >= cfg.Fails {
+		state.down = true
+		sp.changed = true
+	}
+}
+
+// Down returns the servers of the upstream that are down.
+func (sp *StreamProber) Down(upstream string) map[string]bool {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+
+	down := make(map[string]bool)
+	for server, state := range sp.upstreams[upstream] {
+		if state.down {
+			down[server] = true
+		}
+	}
+
+	return down
+}
+
+// probeStreamServer connects to the server, sends the configured data and checks the response.
+// A UDP server that doesn't respond is considered healthy unless a response is expected,
+// as only an ICMP error reports that the server is unreachable.
+func probeStreamServer(ctx context.Context, address string, cfg StreamProbeConfig) error {
+	network := "tcp"
+	if cfg.UDP {
+		network = "udp"
+	}
+
+	if cfg.Port > 0 {
+		host, _, err := net.SplitHostPort(address)
+		if err != nil {
+			return err
+		}
+		address = net.JoinHostPort(host, strconv.Itoa(cfg.Port))
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, cfg.Timeout)
+	defer cancel()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, network, address)
+	if err != nil {
+		return err
+	}
+	defer conn.Close() //nolint:errcheck
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return err
+		}
+	}
+
+	if cfg.Send != "" || cfg.UDP {
+		if _, err := conn.Write([]byte(decodeStreamProbeString(cfg.Send))); err != nil {
+			return err
+		}
+	}
+
+	if cfg.Expect == "" && !cfg.UDP {
+		return nil
+	}
+
+	buf := make([]byte, streamProbeBufferSize)
+	n, err := conn.Read(buf)
+	if err != nil {
+		var netErr net.Error
+		if cfg.Expect == "" && errors.As(err, &netErr) && netErr.Timeout() {
+			return nil
+		}
+		return err
+	}
+
+	return matchStreamProbeResponse(buf[:n], cfg.Expect)
+}
+
+// matchStreamProbeResponse matches the response against a literal string or a regular expression with the ~ or ~* modifier.
+func matchStreamProbeResponse(response []byte, expect string) error {
+	var matched bool
+
+	switch {
+	case expect == "":
+		return nil
+	case strings.HasPrefix(expect, "~*"):
+		re, err := regexp.Compile("(?i)" + strings.TrimPrefix(expect, "~*"))
+		if err != nil {
+			return err
+		}
+		matched = re.Match(response)
+	case strings.HasPrefix(expect, "~"):
+		re, err := regexp.Compile(strings.TrimPrefix(expect, "~"))
+		if err != nil {
+			return err
+		}
+		matched = re.Match(response)
+	default:
+		matched = bytes.Contains(response, []byte(decodeStreamProbeString(expect)))
+	}
+
+	if !matched {
+		return fmt.Errorf("response doesn't match %q", expect)
+	}
+	return nil
+}
+
+// decodeStreamProbeString decodes the escaped characters, such as the hex literals, of the send and expect strings.
+func decodeStreamProbeString(s string) string {
+	decoded, err := strconv.Unquote(`"` + s + `"`)
+	if err != nil {
+		return s
+	}
+	return decoded
+}
@@ -0,0 +1,173 @@
+package nginx
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// waitForStreamProbes waits until the probes started by the StreamProber complete.
+func waitForStreamProbes(t *testing.T, sp *StreamProber) {
+	t.Helper()
+	for range 100 {
+		probing := false
+		sp.mu.Lock()
+		for _, states := range sp.upstreams {
+			for _, state := range states {
+				probing = probing || state.probing
+			}
+		}
+		sp.mu.Unlock()
+		if !probing {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("the probes didn't complete")
+}
+
+func TestStreamProberMarksServersDownAndUp(t *testing.T) {
+	t.Parallel()
+	healthy := map[string]bool{"10.0.0.1:53": true, "10.0.0.2:53": false}
+
+	sp := NewStreamProber()
+	sp.probe = func(_ context.Context, address string, _ StreamProbeConfig) error {
+		if healthy[address] {
+			return nil
+		}
+		return errors.New("connection refused")
+	}
+
+	ctx := context.Background()
+	now := time.Now()
+	upstream := "ts_default_dns_dns-app"
+	configs := map[string]StreamProbeConfig{
+		upstream: {UDP: true, Interval: 5 * time.Second, Timeout: time.Second, Fails: 2, Passes: 1},
+	}
+	servers := map[string][]string{
+		upstream: {"10.0.0.1:53", "10.0.0.2:53"},
+	}
+
+	if sp.Probe(ctx, configs, servers, now) {
+		t.Errorf("Probe() returned true before any probe completed")
+	}
+	waitForStreamProbes(t, sp)
+
+	if sp.Probe(ctx, configs, servers, now.Add(time.Second)) {
+		t.Errorf("Probe() returned true after a single failed probe with fails set to 2")
+	}
+	waitForStreamProbes(t, sp)
+
+	if sp.Probe(ctx, configs, servers, now.Add(5*time.Second)) {
+		t.Errorf("Probe() returned true before the second probe completed")
+	}
+	waitForStreamProbes(t, sp)
+
+	if !sp.Probe(ctx, configs, servers, now.Add(6*time.Second)) {
+		t.Errorf("Probe() returned false after the server went down")
+	}
+	expected := map[string]bool{"10.0.0.2:53": true}
+	if diff := cmp.Diff(expected, sp.Down(upstream)); diff != "" {
+		t.Errorf("Down() mismatch (-want +got):\n%s", diff)
+	}
+	waitForStreamProbes(t, sp)
+
+	healthy["10.0.0.2:53"] = true
+	sp.Probe(ctx, configs, servers, now.Add(10*time.Second))
+	waitForStreamProbes(t, sp)
+
+	if !sp.Probe(ctx, configs, servers, now.Add(11*time.Second)) {
+		t.Errorf("Probe() returned false after the server went up")
+	}
+	if down := sp.Down(upstream); len(down) != 0 {
+		t.Errorf("Down() returned %v but expected no servers", down)
+	}
+}
+
+func TestStreamProberDiscardsRemovedServers(t *testing.T) {
+	t.Parallel()
+	sp := NewStreamProber()
+	sp.probe = func(_ context.Context, _ string, _ StreamProbeConfig) error {
+		return errors.New("connection refused")
+	}
+
+	ctx := context.Background()
+	now := time.Now()
+	upstream := "ts_default_dns_dns-app"
+	configs := map[string]StreamProbeConfig{
+		upstream: {Interval: 5 * time.Second, Timeout: time.Second, Fails: 1, Passes: 1},
+	}
+
+	sp.Probe(ctx, configs, map[string][]string{upstream: {"10.0.0.1:53"}}, now)
+	waitForStreamProbes(t, sp)
+
+	if down := sp.Down(upstream); len(down) != 1 {
+		t.Fatalf("Down() returned %v but expected one server", down)
+	}
+
+	sp.Probe(ctx, map[string]StreamProbeConfig{}, map[string][]string{}, now.Add(time.Second))
+	if down := sp.Down(upstream); len(down) != 0 {
+		t.Errorf("Down() returned %v but expected the state of the removed upstream to be discarded", down)
+	}
+}
+
+func TestProbeStreamServerTCP(t *testing.T) {
+	t.Parallel()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close() //nolint:errcheck
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			buf := make([]byte, 16)
+			if _, err := conn.Read(buf); err == nil {
+				_, _ = conn.Write([]byte("+PONG\r\n"))
+			}
+			_ = conn.Close()
+		}
+	}()
+
+	cfg := StreamProbeConfig{Timeout: time.Second, Send: `PING\r\n`, Expect: "~^\\+PONG"}
+	if err := probeStreamServer(context.Background(), ln.Addr().String(), cfg); err != nil {
+		t.Errorf("probeStreamServer() returned an unexpected error: %v", err)
+	}
+
+	cfg.Expect = "-ERR"
+	if err := probeStreamServer(context.Background(), ln.Addr().String(), cfg); err == nil {
+		t.Errorf("probeStreamServer() returned no error for a response that doesn't match")
+	}
+}
+
+func TestMatchStreamProbeResponse(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		response string
+		expect   string
+		matched  bool
+	}{
+		{response: "+PONG\r\n", expect: "", matched: true},
+		{response: "+PONG\r\n", expect: "PONG", matched: true},
+		{response: "+PONG\r\n", expect: `\x2bPONG`, matched: true},
+		{response: "+PONG\r\n", expect: "~^\\+PO", matched: true},
+		{response: "+PONG\r\n", expect: "~*pong", matched: true},
+		{response: "+PONG\r\n", expect: "~pong", matched: false},
+		{response: "-ERR\r\n", expect: "PONG", matched: false},
+	}
+
+	for _, test := range tests {
+		err := matchStreamProbeResponse([]byte(test.response), test.expect)
+		if (err == nil) != test.matched {
+			t.Errorf("matchStreamProbeResponse(%q, %q) returned %v", test.response, test.expect, err)
+		}
+	}
+}
@@ -47,6 +47,8 @@ type VirtualServerSpec struct {
 	IngressClass string `json:"ingressClassName"`
 	// The host (domain name) of the server. Must be a valid subdomain as defined in RFC 1123, such as my-app or hello.example.com. When using a wildcard domain like *.example.com the domain must be contained in double quotes. The host value needs to be unique among all Ingress and VirtualServer resources.
 	Host string `json:"host"`
+	// A list of additional hosts (domain names) of the server, such as www.example.com. The aliases share the routes, upstreams and policies of the host. Every alias needs to be unique among all Ingress and VirtualServer resources.
+	ServerAliases []string `json:"serverAliases"`
 	// Sets a custom HTTP and/or HTTPS listener. Valid fields are listener.http and listener.https. Each field must reference the name of a valid listener defined in a GlobalConfiguration resource
 	Listener *VirtualServerListener `json:"listener"`
 	// The TLS termination configuration.
@@ -69,6 +71,59 @@ type VirtualServerSpec struct {
 	ExternalDNS ExternalDNS `json:"externalDNS"`
 	// InternalRoute allows for the configuration of internal routing.
 	InternalRoute bool `json:"internalRoute"`
+	// The request ID configuration. Overrides the request-id ConfigMap keys.
+	RequestID *RequestID `json:"requestID"`
+	// The maintenance mode of the VirtualServer. When enabled, NGINX responds to all requests with the maintenance response.
+	Maintenance *Maintenance `json:"maintenance"`
+}
+
+// Maintenance defines the maintenance mode of a VirtualServer or a route.
+// In NGINX Plus, the maintenance mode is toggled through a keyval zone, so enabling or disabling it doesn't reload NGINX.
+type Maintenance struct {
+	// Enables the maintenance mode. The default is false.
+	Enable bool `json:"enable"`
+	// The status code of the maintenance response. The code must be in the range 400–599. The default is 503.
+	Code int `json:"code"`
+	// The MIME type of the maintenance response. The default is text/html.
+	Type string `json:"type"`
+	// The body of the maintenance response. The body can't be used along with the configMap field.
+	Body string `json:"body"`
+	// The ConfigMap that holds the HTML page of the maintenance response. The body can't be used along with the body field.
+	ConfigMap *MaintenanceConfigMap `json:"configMap"`
+	// The value of the Retry-After response header, either a number of seconds or an HTTP date.
+	RetryAfter string `json:"retryAfter"`
+	// The requests that bypass the maintenance mode and are processed as usual.
+	Bypass *MaintenanceBypass `json:"bypass"`
+}
+
+// MaintenanceConfigMap references a key of a ConfigMap in the namespace of the resource.
+type MaintenanceConfigMap struct {
+	// The name of the ConfigMap.
+	Name string `json:"name"`
+	// The key of the ConfigMap that holds the page. The default is index.html.
+	Key string `json:"key"`
+}
+
+// MaintenanceBypass defines the requests that bypass the maintenance mode.
+type MaintenanceBypass struct {
+	// A list of IPv4 or IPv6 addresses or CIDR ranges of the clients that bypass the maintenance mode.
+	Allow []string `json:"allow"`
+	// The name of a request header. The requests that include the header with the headerValue bypass the maintenance mode.
+	Header string `json:"header"`
+	// The value of the header. Required when the header field is set.
+	HeaderValue string `json:"headerValue"`
+}
+
+// RequestID defines how the ID of a request is obtained, propagated to the upstreams and returned to the clients.
+type RequestID struct {
+	// Enables the propagation of the request ID. The default is false.
+	Enable bool `json:"enable"`
+	// The name of the request header that carries the ID. Overrides the request-id-header ConfigMap key. The default is X-Request-ID.
+	Header string `json:"header"`
+	// Defines how the ID is obtained. With trust, the ID from the incoming request header is used and a new ID is generated only if the header is missing. With generate, a new ID is always generated. Overrides the request-id-mode ConfigMap key. The default is trust.
+	Mode string `json:"mode"`
+	// Enables adding the ID to the response headers. Overrides the request-id-echo ConfigMap key. The default is false.
+	Echo *bool `json:"echo"`
 }
 
 // VirtualServerListener references a custom http and/or https listener defined in GlobalConfiguration.
@@ -173,6 +228,10 @@ type Upstream struct {
 	Queue *UpstreamQueue `json:"queue"`
 	// The SessionCookie field configures session persistence which allows requests from the same client to be passed to the same upstream server. The information about the designated upstream server is passed in a session cookie generated by NGINX.
 	SessionCookie *SessionCookie `json:"sessionCookie"`
+	// The Affinity field configures session persistence based on consistent hashing of a request header, a cookie, a JWT claim or a route cookie generated by NGINX. Unlike sessionCookie, affinity is supported in both NGINX and NGINX Plus. Affinity cannot be used along with the lb-method or sessionCookie fields.
+	Affinity *UpstreamAffinity `json:"affinity"`
+	// The CircuitBreaker field configures the ejection of upstream servers that return consecutive errors or respond slowly.
+	CircuitBreaker *CircuitBreaker `json:"circuitBreaker"`
 	// Enables using the Cluster IP and port of the service instead of the default behavior of using the IP and port of the pods. When this field is enabled, the fields that configure NGINX behavior related to multiple upstream servers (like lb-method and next-upstream) will have no effect, as NGINX Ingress Controller will configure NGINX with only one upstream server that will match the service Cluster IP.
 	UseClusterIP bool `json:"use-cluster-ip"`
 	// Allows proxying requests with NTLM Authentication. In order for NTLM authentication to work, it is necessary to enable keepalive connections to upstream servers using the keepalive field. Note: this feature is supported only in NGINX Plus.
@@ -267,6 +326,55 @@ type SessionCookie struct {
 	SameSite string `json:"samesite"`
 }
 
+// UpstreamAffinity defines session affinity for an upstream using the hash load balancing method.
+// Exactly one of header, cookie, jwtClaim or routeCookie must be specified.
+type UpstreamAffinity struct {
+	// The name of a request header. Requests with the same header value are passed to the same upstream server.
+	Header string `json:"header"`
+	// The name of a cookie. Requests with the same cookie value are passed to the same upstream server.
+	Cookie string `json:"cookie"`
+	// The JWT claim. Requests with the same claim value are passed to the same upstream server. Nested claims should be separated by ".". Requires a JWT policy. Note: this feature is supported only in NGINX Plus.
+	JWTClaim string `json:"jwtClaim"`
+	// Configures a route cookie generated by NGINX for clients that do not present one yet. The value of the cookie is used as the hash key.
+	RouteCookie *AffinityRouteCookie `json:"routeCookie"`
+	// Enables the ketama consistent hashing method, which ensures that only a few keys will be remapped to different upstream servers when a server is added to or removed from the upstream. The default is true.
+	Consistent *bool `json:"consistent"`
+}
+
+// AffinityRouteCookie defines the parameters of a route cookie generated by NGINX for session affinity.
+type AffinityRouteCookie struct {
+	// The name of the cookie.
+	Name string `json:"name"`
+	// The path for which the cookie is set. The default is /.
+	Path string `json:"path"`
+	// The number of seconds until the cookie expires. By default, the cookie is a session cookie.
+	MaxAge *int `json:"maxAge"`
+	// The domain for which the cookie is set.
+	Domain string `json:"domain"`
+	// Adds the HttpOnly attribute to the cookie.
+	HTTPOnly bool `json:"httpOnly"`
+	// Adds the Secure attribute to the cookie.
+	Secure bool `json:"secure"`
+	// Adds the SameSite attribute to the cookie. The allowed values are: strict, lax, none
+	SameSite string `json:"samesite"`
+}
+
+// CircuitBreaker defines the parameters of the ejection of failing upstream servers.
+// In NGINX, the ejection is configured with the max_fails and fail_timeout parameters of the upstream servers, so the circuit breaker can't be used along with the max-fails and fail-timeout fields.
+// In NGINX Plus, NGINX Ingress Controller ejects the servers through the NGINX Plus API based on the upstream statistics.
+type CircuitBreaker struct {
+	// Enables the circuit breaker. The default is false.
+	Enable bool `json:"enable"`
+	// The number of consecutive errors, timeouts or 5xx responses after which a server is ejected. The default is 5. In NGINX, the errors are counted during the ejectDuration, and only the errors and the responses that match the next-upstream field are counted, so add http_500, http_502, http_503 or http_504 to the next-upstream field to count the 5xx responses.
+	Consecutive5xx *int `json:"consecutive5xx"`
+	// The average response time above which a server is ejected, for example, 2s. The read timeout of the upstream is not changed. Note: this field is supported only in NGINX Plus.
+	LatencyThreshold string `json:"latencyThreshold"`
+	// The time during which an ejected server does not receive requests. The default is 30s.
+	EjectDuration string `json:"ejectDuration"`
+	// The maximum percentage of the servers of the upstream that can be ejected at the same time. If the percentage is above 0, at least one server can be ejected. The last healthy server of the upstream is never ejected. The default is 10. Note: this field is supported only in NGINX Plus.
+	MaxEjectionPercent *int `json:"maxEjectionPercent"`
+}
+
 // Route defines a route.
 type Route struct {
 	// The path of the route. NGINX will match it against the URI of a request. Possible values are: a prefix ( / , /path ), an exact match ( =/exact/match ), a case insensitive regular expression ( ~*^/Bar.*\.jpg ) or a case sensitive regular expression ( ~^/foo.*\.jpg ). In the case of a prefix (must start with / ) or an exact match (must start with = ), the path must not include any whitespace characters, { , } or ;. In the case of the regex matches, all double quotes " must be escaped and the match can’t end in an unescaped backslash \. The path must be unique among the paths of all routes of the VirtualServer. Check the location directive for more information.
@@ -289,6 +397,8 @@ type Route struct {
 	LocationSnippets string `json:"location-snippets"`
 	// A reference to a DosProtectedResource, setting this enables DOS protection of the VirtualServer route.
 	Dos string `json:"dos"`
+	// The maintenance mode of the route. When the route references a VirtualServerRoute, the maintenance mode applies to the subroutes that don't define their own.
+	Maintenance *Maintenance `json:"maintenance"`
 }
 
 // Action defines an action.
@@ -333,6 +443,8 @@ type ActionProxy struct {
 	RequestHeaders *ProxyRequestHeaders `json:"requestHeaders"`
 	// The response headers modifications.
 	ResponseHeaders *ProxyResponseHeaders `json:"responseHeaders"`
+	// The response body modifications.
+	ResponseBody *ProxyResponseBody `json:"responseBody"`
 }
 
 // ProxyRequestHeaders defines the request headers manipulation in an ActionProxy.
@@ -343,6 +455,24 @@ type ProxyRequestHeaders struct {
 	Set []Header `json:"set"`
 }
 
+// ProxyResponseBody defines the response body manipulation in an ActionProxy.
+type ProxyResponseBody struct {
+	// The string replacements in the response body. The replacements are applied in the order of the list.
+	Rewrite []ResponseBodyRewrite `json:"rewrite"`
+	// The MIME types of the responses, in addition to text/html, in which the strings are replaced. The special value * matches any MIME type.
+	Types []string `json:"types"`
+	// Replaces only the first occurrence of each string. The default is false, which replaces all occurrences.
+	Once bool `json:"once"`
+}
+
+// ResponseBodyRewrite defines a string replacement in the response body.
+type ResponseBodyRewrite struct {
+	// The string to replace. The match is case-insensitive.
+	Match string `json:"match"`
+	// The replacement string. The replacement can contain NGINX variables.
+	Replacement string `json:"replacement"`
+}
+
 // ProxyResponseHeaders defines the response headers manipulation in an ActionProxy.
 type ProxyResponseHeaders struct {
 	// The headers that will not be passed* in the response to the client from a proxied upstream server.
@@ -380,8 +510,33 @@ type Condition struct {
 	Argument string `json:"argument"`
 	// The name of an NGINX variable. Must start with $.
 	Variable string `json:"variable"`
+	// The HTTP method of a request, for example, GET. Cannot be used together with the value and the operator.
+	Method string `json:"method"`
+	// The value to match the condition against.
+	Value string `json:"value"`
+	// The operator of the condition: equals, regex, present or absent. The default is equals.
+	// The regex operator matches the value as a regular expression. The present and absent operators check if the header, cookie, argument or variable is not empty or empty and do not use the value.
+	Operator string `json:"operator"`
+	// A list of alternative conditions. The condition is satisfied if at least one of the alternatives is satisfied. Cannot be used together with the other fields.
+	AnyOf []ConditionAlternative `json:"anyOf"`
+}
+
+// ConditionAlternative defines an alternative condition in the anyOf list of a Condition.
+type ConditionAlternative struct {
+	// The name of a header. Must consist of alphanumeric characters or -.
+	Header string `json:"header"`
+	// The name of a cookie. Must consist of alphanumeric characters or _.
+	Cookie string `json:"cookie"`
+	// The name of an argument. Must consist of alphanumeric characters or _.
+	Argument string `json:"argument"`
+	// The name of an NGINX variable. Must start with $.
+	Variable string `json:"variable"`
+	// The HTTP method of a request, for example, GET. Cannot be used together with the value and the operator.
+	Method string `json:"method"`
 	// The value to match the condition against.
 	Value string `json:"value"`
+	// The operator of the condition: equals, regex, present or absent. The default is equals.
+	Operator string `json:"operator"`
 }
 
 // Match defines a match.
@@ -462,6 +617,16 @@ type VirtualServerStatus struct {
 	Reason            string             `json:"reason"`
 	Message           string             `json:"message"`
 	ExternalEndpoints []ExternalEndpoint `json:"externalEndpoints,omitempty"`
+	// The upstream servers that are currently ejected by the circuit breaker.
+	EjectedPeers []EjectedPeer `json:"ejectedPeers,omitempty"`
+}
+
+// EjectedPeer defines an upstream server ejected by the circuit breaker.
+type EjectedPeer struct {
+	// The name of the upstream in the NGINX configuration.
+	Upstream string `json:"upstream"`
+	// The address of the upstream server.
+	Server string `json:"server"`
 }
 
 // ExternalEndpoint defines the IP/ Hostname and ports used to connect to this resource.
@@ -579,6 +744,32 @@ type Listener struct {
 	IPv6 string `json:"ipv6"`
 	// Whether the listener will be listening for SSL connections
 	Ssl bool `json:"ssl"`
+	// Enables accepting the PROXY protocol header, for example, from an external L4 load balancer. Supported only for the TCP protocol. The default is false.
+	AcceptProxyProtocol bool `json:"acceptProxyProtocol"`
+	// The IP addresses or CIDRs of the trusted sources of the PROXY protocol header. The client address from the header of a trusted source replaces the address of the connection. Requires acceptProxyProtocol.
+	TrustedCIDRs []string `json:"trustedCIDRs"`
+	// The TLS settings of an HTTP listener with ssl enabled. The settings apply to every VirtualServer that binds the listener.
+	TLS *ListenerTLS `json:"tls"`
+	// Enables or disables HTTP/2 for an HTTP listener with ssl enabled. The default is the value of the http2 ConfigMap key.
+	HTTP2 *bool `json:"http2"`
+	// The timeout for reading the header of a client request on an HTTP listener, for example, 10s. The default is 60s.
+	ClientHeaderTimeout string `json:"clientHeaderTimeout"`
+	// The timeout during which a keep-alive client connection stays open on an HTTP listener, for example, 30s. The default is the value of the keepalive-timeout ConfigMap key.
+	KeepaliveTimeout string `json:"keepaliveTimeout"`
+	// The namespaces of the VirtualServers and TransportServers that are allowed to bind the listener. By default, resources from all namespaces can bind the listener.
+	AllowedNamespaces []string `json:"allowedNamespaces"`
+}
+
+// ListenerTLS defines the TLS settings of an HTTP listener.
+type ListenerTLS struct {
+	// The name of a Secret with a TLS certificate and key in the namespace of the GlobalConfiguration. The certificate is used by the VirtualServers on the listener that don't specify a TLS Secret.
+	Secret string `json:"secret"`
+	// Rejects TLS handshakes for the VirtualServers on the listener that don't specify a TLS Secret. Cannot be used together with secret.
+	RejectHandshake bool `json:"rejectHandshake"`
+	// The enabled TLS protocols, for example, "TLSv1.2 TLSv1.3". The default is the NGINX default.
+	Protocols string `json:"protocols"`
+	// The enabled ciphers in the format understood by the OpenSSL library, for example, HIGH:!aNULL:!MD5. The default is the NGINX default.
+	Ciphers string `json:"ciphers"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -633,11 +824,33 @@ type TransportServerSpec struct {
 	SessionParameters *SessionParameters `json:"sessionParameters"`
 	// The action to perform for a request.
 	Action *TransportServerAction `json:"action"`
+	// The access log of the TransportServer. Overrides the stream-log-format ConfigMap key for the connections to the TransportServer.
+	AccessLog *TransportServerAccessLog `json:"accessLog"`
+}
+
+// TransportServerAccessLog defines the access log of a TransportServer.
+type TransportServerAccessLog struct {
+	// Enables the access log. If false, the connections to the TransportServer are not logged.
+	Enable bool `json:"enable"`
+	// The log format, for example, $remote_addr [$time_local] $protocol $status $session_time. The format can contain the variables of the NGINX stream modules, such as $remote_addr, $status, $bytes_sent or $upstream_addr. The default is the format set by the stream-log-format ConfigMap key.
+	Format string `json:"format"`
+	// The destination of the access log. Accepted values are /dev/stdout, /dev/stderr and a syslog server in the syslog:server=address[,parameter=value] format, for example, syslog:server=10.0.0.1:514,tag=tcp_app. The default is /dev/stdout.
+	Destination string `json:"destination"`
+	// Logs only the connections that end with one of the statuses, as reported by the $status variable. Accepted values are 200, 400, 403, 500, 502 and 503. By default, all connections are logged.
+	Statuses []int `json:"statuses"`
 }
 
 // TransportServerTLS defines TransportServerTLS configuration for a TransportServer.
 type TransportServerTLS struct {
 	Secret string `json:"secret"`
+	// The name of the Kubernetes secret that stores the CA certificate used to verify client certificates. It must be in the same namespace as the TransportServer resource. The secret must be of the type nginx.org/ca, and the certificate must be stored in the secret under the key ca.crt. The subject of a verified client certificate is available as the $ssl_client_s_dn variable, for example, in the stream-log-format ConfigMap key.
+	ClientCertSecret string `json:"clientCertSecret"`
+	// The file name of the Certificate Revocation List. NGINX Ingress Controller will look for this file in /etc/nginx/secrets
+	CrlFileName string `json:"crlFileName"`
+	// Verification for the client. Possible values are "on", "off", "optional", "optional_no_ca". The default is "on".
+	VerifyClient string `json:"verifyClient"`
+	// Sets the verification depth in the client certificates chain. The default is 1.
+	VerifyDepth *int `json:"verifyDepth"`
 }
 
 // TransportServerListener defines a listener for a TransportServer.
@@ -656,20 +869,50 @@ type TransportServerUpstream struct {
 	Service string `json:"service"`
 	// The port of the service. If the service doesn’t define that port, NGINX will assume the service has zero endpoints and close client connections/ignore datagrams. The port must fall into the range 1..65535.
 	Port int `json:"port"`
-	// Sets the number of unsuccessful attempts to communicate with the server that should happen in the duration set by the failTimeout parameter to consider the server unavailable. The default is 1.
+	// Sets the time during which the specified number of unsuccessful attempts to communicate with the server should happen to consider the server unavailable and the period of time the server will be considered unavailable. The default is 10s.
 	FailTimeout string `json:"failTimeout"`
-	// Sets the number of maximum connections to the proxied server. Default value is zero, meaning there is no limit. The default is 0.
+	// Sets the number of unsuccessful attempts to communicate with the server that should happen in the duration set by the failTimeout parameter to consider the server unavailable. The default is 1.
 	MaxFails *int `json:"maxFails"`
-	// Sets the time during which the specified number of unsuccessful attempts to communicate with the server should happen to consider the server unavailable and the period of time the server will be considered unavailable. The default is 10s.
+	// Sets the number of maximum connections to the proxied server. Default value is zero, meaning there is no limit. The default is 0.
 	MaxConns *int `json:"maxConns"`
-	// The health check configuration for the Upstream. Note: this feature is supported only in NGINX Plus.
+	// The health check configuration for the Upstream. Note: in NGINX, the health checks are performed by the Ingress Controller if the -enable-stream-health-probes command-line argument is set.
 	HealthCheck *TransportServerHealthCheck `json:"healthCheck"`
 	// The method used to load balance the upstream servers. By default, connections are distributed between the servers using a weighted round-robin balancing method.
 	LoadBalancingMethod string `json:"loadBalancingMethod"`
+	// Pins the connections and datagrams of a client to the same upstream server. Accepted values are None and ClientIP. ClientIP uses the consistent hash load balancing method on the client address and cannot be used together with loadBalancingMethod. The default is None.
+	SessionAffinity string `json:"sessionAffinity"`
 	// The name of the backup service of type ExternalName. This will be used when the primary servers are unavailable. Note: The parameter cannot be used along with the random, hash or ip_hash load balancing methods.
 	Backup string `json:"backup"`
 	// The port of the backup service. The backup port is required if the backup service name is provided. The port must fall into the range 1..65535.
 	BackupPort *uint16 `json:"backupPort"`
+	// The TLS configuration for connections to the upstream servers.
+	TLS *TransportServerUpstreamTLS `json:"tls"`
+	// Enables sending the PROXY protocol header with the client address to the upstream servers. The default is false.
+	ProxyProtocol bool `json:"proxyProtocol"`
+}
+
+// TransportServerUpstreamTLS defines the TLS configuration for connections to the upstream servers of a TransportServer.
+type TransportServerUpstreamTLS struct {
+	// Enables TLS for connections to the upstream servers. The default is false.
+	Enable bool `json:"enable"`
+	// The name of the Kubernetes secret that stores the client TLS certificate and key presented to the upstream servers. It must be in the same namespace as the TransportServer resource. The secret must be of the type kubernetes.io/tls.
+	TLSSecret string `json:"tlsSecret"`
+	// Enables verification of the upstream server certificate.
+	VerifyServer bool `json:"verifyServer"`
+	// Sets the verification depth in the upstream server certificates chain. The default is 1.
+	VerifyDepth *int `json:"verifyDepth"`
+	// Specifies the protocols for connections to the upstream servers, for example, TLSv1.2 TLSv1.3.
+	Protocols string `json:"protocols"`
+	// Enables reuse of SSL sessions to the upstream servers. The default is true.
+	SessionReuse *bool `json:"sessionReuse"`
+	// Specifies the enabled ciphers for connections to the upstream servers.
+	Ciphers string `json:"ciphers"`
+	// The name of the Kubernetes secret that stores the CA certificate used to verify the upstream server certificate. It must be in the same namespace as the TransportServer resource. The secret must be of the type nginx.org/ca.
+	TrustedCertSecret string `json:"trustedCertSecret"`
+	// Enables passing of the server name through Server Name Indication extension.
+	ServerName bool `json:"serverName"`
+	// Sets the server name used to verify the certificate of the upstream server and passed through Server Name Indication extension.
+	SSLName string `json:"sslName"`
 }
 
 // TransportServerHealthCheck defines the parameters for active Upstream HealthChecks.
@@ -726,6 +969,26 @@ type SessionParameters struct {
 type TransportServerAction struct {
 	// Passes connections/datagrams to an upstream. The upstream with that name must be defined in the resource.
 	Pass string `json:"pass"`
+	// Splits connections/datagrams between two or more upstreams according to their weights. Cannot be used together with pass.
+	Splits []TransportServerSplit `json:"splits"`
+	// Routes TLS connections to upstreams based on the server name from the TLS ClientHello without terminating TLS. Connections that don't match any server name are handled by pass or splits.
+	Matches []TransportServerActionMatch `json:"matches"`
+}
+
+// TransportServerSplit defines a weight for an upstream of a TransportServer.
+type TransportServerSplit struct {
+	// The weight of the upstream. Must fall into the range 0..100. The sum of the weights of all splits must be equal to 100.
+	Weight int `json:"weight"`
+	// The name of the upstream. The upstream with that name must be defined in the resource.
+	Pass string `json:"pass"`
+}
+
+// TransportServerActionMatch defines a server name based match for the action of a TransportServer.
+type TransportServerActionMatch struct {
+	// A list of server names to match against the server name from the TLS ClientHello. Wildcard names like *.example.com are supported.
+	ServerNames []string `json:"serverNames"`
+	// Passes the matched connections to an upstream. The upstream with that name must be defined in the resource.
+	Pass string `json:"pass"`
 }
 
 // TransportServerStatus defines the status for the TransportServer resource.
@@ -804,6 +1067,8 @@ type PolicySpec struct {
 	Cache *Cache `json:"cache"`
 	// The CORS policy configures Cross-Origin Resource Sharing headers
 	CORS *CORS `json:"cors"`
+	// The Headers policy modifies the request headers passed to the upstream servers and the response headers passed to the clients.
+	Headers *Headers `json:"headers"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -847,6 +1112,8 @@ type RateLimit struct {
 	RejectCode *int `json:"rejectCode"`
 	// Enables a constant rate-limit by dividing the configured rate by the number of nginx-ingress pods currently serving traffic. This adjustment ensures that the rate-limit remains consistent, even as the number of nginx-pods fluctuates due to autoscaling. This will not work properly if requests from a client are not evenly distributed across all ingress pods (Such as with sticky sessions, long lived TCP Connections with many requests, and so forth). In such cases using zone-sync instead would give better results. Enabling zone-sync will suppress this setting.
 	Scale bool `json:"scale"`
+	// Shares the rate limit among all the VirtualServers, VirtualServerRoutes and Ingresses that reference the policy, so that their requests are counted in a single zone named after the policy. By default, each resource that references the policy gets its own zone. Ingresses reference the policy with the nginx.org/policies annotation. Cannot be used together with condition.
+	Shared bool `json:"shared"`
 	// Add a condition to a rate-limit policy.
 	// +kubebuilder:validation:Optional
 	Condition *RateLimitCondition `json:"condition"`
@@ -1212,6 +1479,32 @@ type Cache struct {
 	Conditions *CacheConditions `json:"conditions,omitempty"`
 }
 
+// Headers defines a policy that modifies the request and response headers.
+// The policies referenced by a VirtualServer apply first, then the policies referenced by a route or a subroute, and then the requestHeaders and responseHeaders of the proxy action.
+// A later setting of a header with the same name takes precedence over an earlier one.
+type Headers struct {
+	// The headers of the requests passed to the upstream servers.
+	Request *HeadersRequest `json:"request"`
+	// The headers of the responses passed to the clients.
+	Response *HeadersResponse `json:"response"`
+}
+
+// HeadersRequest defines the request headers of a Headers policy.
+type HeadersRequest struct {
+	// Sets the headers of the requests passed to the upstream servers. An empty value removes the header.
+	Set []Header `json:"set"`
+}
+
+// HeadersResponse defines the response headers of a Headers policy.
+type HeadersResponse struct {
+	// The headers of the upstream responses that will not be passed to the clients, for example, Server.
+	Hide []string `json:"hide"`
+	// The headers hidden by NGINX by default that will be passed to the clients.
+	Pass []string `json:"pass"`
+	// Adds headers to the responses to the clients.
+	Add []AddHeader `json:"add"`
+}
+
 // CORS defines a Cross-Origin Resource Sharing policy for controlling cross-origin requests.
 // +kubebuilder:validation:XValidation:rule="!(self.allowOrigin.exists(origin, origin == '*') && has(self.allowCredentials) && self.allowCredentials == true)",message="cannot use wildcard '*' for allowOrigin when allowCredentials is true for security reasons"
 type CORS struct {
@@ -102,6 +102,11 @@ func (in *ActionProxy) DeepCopyInto(out *ActionProxy) {
 		*out = new(ProxyResponseHeaders)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ResponseBody != nil {
+		in, out := &in.ResponseBody, &out.ResponseBody
+		*out = new(ProxyResponseBody)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -169,6 +174,27 @@ func (in *AddHeader) DeepCopy() *AddHeader {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AffinityRouteCookie) DeepCopyInto(out *AffinityRouteCookie) {
+	*out = *in
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AffinityRouteCookie.
+func (in *AffinityRouteCookie) DeepCopy() *AffinityRouteCookie {
+	if in == nil {
+		return nil
+	}
+	out := new(AffinityRouteCookie)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BasicAuth) DeepCopyInto(out *BasicAuth) {
 	*out = *in
@@ -366,9 +392,40 @@ func (in *CertManager) DeepCopy() *CertManager {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CircuitBreaker) DeepCopyInto(out *CircuitBreaker) {
+	*out = *in
+	if in.Consecutive5xx != nil {
+		in, out := &in.Consecutive5xx, &out.Consecutive5xx
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxEjectionPercent != nil {
+		in, out := &in.MaxEjectionPercent, &out.MaxEjectionPercent
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CircuitBreaker.
+func (in *CircuitBreaker) DeepCopy() *CircuitBreaker {
+	if in == nil {
+		return nil
+	}
+	out := new(CircuitBreaker)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Condition) DeepCopyInto(out *Condition) {
 	*out = *in
+	if in.AnyOf != nil {
+		in, out := &in.AnyOf, &out.AnyOf
+		*out = make([]ConditionAlternative, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -382,6 +439,22 @@ func (in *Condition) DeepCopy() *Condition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConditionAlternative) DeepCopyInto(out *ConditionAlternative) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConditionAlternative.
+func (in *ConditionAlternative) DeepCopy() *ConditionAlternative {
+	if in == nil {
+		return nil
+	}
+	out := new(ConditionAlternative)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EgressMTLS) DeepCopyInto(out *EgressMTLS) {
 	*out = *in
@@ -408,6 +481,22 @@ func (in *EgressMTLS) DeepCopy() *EgressMTLS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EjectedPeer) DeepCopyInto(out *EjectedPeer) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EjectedPeer.
+func (in *EjectedPeer) DeepCopy() *EjectedPeer {
+	if in == nil {
+		return nil
+	}
+	out := new(EjectedPeer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ErrorPage) DeepCopyInto(out *ErrorPage) {
 	*out = *in
@@ -583,7 +672,9 @@ func (in *GlobalConfigurationSpec) DeepCopyInto(out *GlobalConfigurationSpec) {
 	if in.Listeners != nil {
 		in, out := &in.Listeners, &out.Listeners
 		*out = make([]Listener, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -614,6 +705,84 @@ func (in *Header) DeepCopy() *Header {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Headers) DeepCopyInto(out *Headers) {
+	*out = *in
+	if in.Request != nil {
+		in, out := &in.Request, &out.Request
+		*out = new(HeadersRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Response != nil {
+		in, out := &in.Response, &out.Response
+		*out = new(HeadersResponse)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Headers.
+func (in *Headers) DeepCopy() *Headers {
+	if in == nil {
+		return nil
+	}
+	out := new(Headers)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HeadersRequest) DeepCopyInto(out *HeadersRequest) {
+	*out = *in
+	if in.Set != nil {
+		in, out := &in.Set, &out.Set
+		*out = make([]Header, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeadersRequest.
+func (in *HeadersRequest) DeepCopy() *HeadersRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(HeadersRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HeadersResponse) DeepCopyInto(out *HeadersResponse) {
+	*out = *in
+	if in.Hide != nil {
+		in, out := &in.Hide, &out.Hide
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Pass != nil {
+		in, out := &in.Pass, &out.Pass
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Add != nil {
+		in, out := &in.Add, &out.Add
+		*out = make([]AddHeader, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeadersResponse.
+func (in *HeadersResponse) DeepCopy() *HeadersResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(HeadersResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HealthCheck) DeepCopyInto(out *HealthCheck) {
 	*out = *in
@@ -706,6 +875,26 @@ func (in *JWTCondition) DeepCopy() *JWTCondition {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Listener) DeepCopyInto(out *Listener) {
 	*out = *in
+	if in.TrustedCIDRs != nil {
+		in, out := &in.TrustedCIDRs, &out.TrustedCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(ListenerTLS)
+		**out = **in
+	}
+	if in.HTTP2 != nil {
+		in, out := &in.HTTP2, &out.HTTP2
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowedNamespaces != nil {
+		in, out := &in.AllowedNamespaces, &out.AllowedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -719,13 +908,94 @@ func (in *Listener) DeepCopy() *Listener {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerTLS) DeepCopyInto(out *ListenerTLS) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerTLS.
+func (in *ListenerTLS) DeepCopy() *ListenerTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Maintenance) DeepCopyInto(out *Maintenance) {
+	*out = *in
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(MaintenanceConfigMap)
+		**out = **in
+	}
+	if in.Bypass != nil {
+		in, out := &in.Bypass, &out.Bypass
+		*out = new(MaintenanceBypass)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Maintenance.
+func (in *Maintenance) DeepCopy() *Maintenance {
+	if in == nil {
+		return nil
+	}
+	out := new(Maintenance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceBypass) DeepCopyInto(out *MaintenanceBypass) {
+	*out = *in
+	if in.Allow != nil {
+		in, out := &in.Allow, &out.Allow
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceBypass.
+func (in *MaintenanceBypass) DeepCopy() *MaintenanceBypass {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceBypass)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceConfigMap) DeepCopyInto(out *MaintenanceConfigMap) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceConfigMap.
+func (in *MaintenanceConfigMap) DeepCopy() *MaintenanceConfigMap {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceConfigMap)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Match) DeepCopyInto(out *Match) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]Condition, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Action != nil {
 		in, out := &in.Action, &out.Action
@@ -918,6 +1188,11 @@ func (in *PolicySpec) DeepCopyInto(out *PolicySpec) {
 		*out = new(CORS)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = new(Headers)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1009,6 +1284,32 @@ func (in *ProxyRequestHeaders) DeepCopy() *ProxyRequestHeaders {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyResponseBody) DeepCopyInto(out *ProxyResponseBody) {
+	*out = *in
+	if in.Rewrite != nil {
+		in, out := &in.Rewrite, &out.Rewrite
+		*out = make([]ResponseBodyRewrite, len(*in))
+		copy(*out, *in)
+	}
+	if in.Types != nil {
+		in, out := &in.Types, &out.Types
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyResponseBody.
+func (in *ProxyResponseBody) DeepCopy() *ProxyResponseBody {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyResponseBody)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProxyResponseHeaders) DeepCopyInto(out *ProxyResponseHeaders) {
 	*out = *in
@@ -1121,6 +1422,43 @@ func (in *RateLimitCondition) DeepCopy() *RateLimitCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequestID) DeepCopyInto(out *RequestID) {
+	*out = *in
+	if in.Echo != nil {
+		in, out := &in.Echo, &out.Echo
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestID.
+func (in *RequestID) DeepCopy() *RequestID {
+	if in == nil {
+		return nil
+	}
+	out := new(RequestID)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResponseBodyRewrite) DeepCopyInto(out *ResponseBodyRewrite) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponseBodyRewrite.
+func (in *ResponseBodyRewrite) DeepCopy() *ResponseBodyRewrite {
+	if in == nil {
+		return nil
+	}
+	out := new(ResponseBodyRewrite)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Route) DeepCopyInto(out *Route) {
 	*out = *in
@@ -1160,6 +1498,11 @@ func (in *Route) DeepCopyInto(out *Route) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(Maintenance)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1343,9 +1686,42 @@ func (in *TransportServer) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransportServerAccessLog) DeepCopyInto(out *TransportServerAccessLog) {
+	*out = *in
+	if in.Statuses != nil {
+		in, out := &in.Statuses, &out.Statuses
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransportServerAccessLog.
+func (in *TransportServerAccessLog) DeepCopy() *TransportServerAccessLog {
+	if in == nil {
+		return nil
+	}
+	out := new(TransportServerAccessLog)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TransportServerAction) DeepCopyInto(out *TransportServerAction) {
 	*out = *in
+	if in.Splits != nil {
+		in, out := &in.Splits, &out.Splits
+		*out = make([]TransportServerSplit, len(*in))
+		copy(*out, *in)
+	}
+	if in.Matches != nil {
+		in, out := &in.Matches, &out.Matches
+		*out = make([]TransportServerActionMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1359,6 +1735,27 @@ func (in *TransportServerAction) DeepCopy() *TransportServerAction {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransportServerActionMatch) DeepCopyInto(out *TransportServerActionMatch) {
+	*out = *in
+	if in.ServerNames != nil {
+		in, out := &in.ServerNames, &out.ServerNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransportServerActionMatch.
+func (in *TransportServerActionMatch) DeepCopy() *TransportServerActionMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(TransportServerActionMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TransportServerHealthCheck) DeepCopyInto(out *TransportServerHealthCheck) {
 	*out = *in
@@ -1451,7 +1848,7 @@ func (in *TransportServerSpec) DeepCopyInto(out *TransportServerSpec) {
 	if in.TLS != nil {
 		in, out := &in.TLS, &out.TLS
 		*out = new(TransportServerTLS)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	out.Listener = in.Listener
 	if in.Upstreams != nil {
@@ -1474,7 +1871,12 @@ func (in *TransportServerSpec) DeepCopyInto(out *TransportServerSpec) {
 	if in.Action != nil {
 		in, out := &in.Action, &out.Action
 		*out = new(TransportServerAction)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AccessLog != nil {
+		in, out := &in.AccessLog, &out.AccessLog
+		*out = new(TransportServerAccessLog)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -1489,6 +1891,22 @@ func (in *TransportServerSpec) DeepCopy() *TransportServerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransportServerSplit) DeepCopyInto(out *TransportServerSplit) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransportServerSplit.
+func (in *TransportServerSplit) DeepCopy() *TransportServerSplit {
+	if in == nil {
+		return nil
+	}
+	out := new(TransportServerSplit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TransportServerStatus) DeepCopyInto(out *TransportServerStatus) {
 	*out = *in
@@ -1508,6 +1926,11 @@ func (in *TransportServerStatus) DeepCopy() *TransportServerStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TransportServerTLS) DeepCopyInto(out *TransportServerTLS) {
 	*out = *in
+	if in.VerifyDepth != nil {
+		in, out := &in.VerifyDepth, &out.VerifyDepth
+		*out = new(int)
+		**out = **in
+	}
 	return
 }
 
@@ -1544,6 +1967,11 @@ func (in *TransportServerUpstream) DeepCopyInto(out *TransportServerUpstream) {
 		*out = new(uint16)
 		**out = **in
 	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TransportServerUpstreamTLS)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1557,6 +1985,32 @@ func (in *TransportServerUpstream) DeepCopy() *TransportServerUpstream {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransportServerUpstreamTLS) DeepCopyInto(out *TransportServerUpstreamTLS) {
+	*out = *in
+	if in.VerifyDepth != nil {
+		in, out := &in.VerifyDepth, &out.VerifyDepth
+		*out = new(int)
+		**out = **in
+	}
+	if in.SessionReuse != nil {
+		in, out := &in.SessionReuse, &out.SessionReuse
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransportServerUpstreamTLS.
+func (in *TransportServerUpstreamTLS) DeepCopy() *TransportServerUpstreamTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(TransportServerUpstreamTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Upstream) DeepCopyInto(out *Upstream) {
 	*out = *in
@@ -1608,6 +2062,16 @@ func (in *Upstream) DeepCopyInto(out *Upstream) {
 		*out = new(SessionCookie)
 		**out = **in
 	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(UpstreamAffinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CircuitBreaker != nil {
+		in, out := &in.CircuitBreaker, &out.CircuitBreaker
+		*out = new(CircuitBreaker)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.BackupPort != nil {
 		in, out := &in.BackupPort, &out.BackupPort
 		*out = new(uint16)
@@ -1626,6 +2090,32 @@ func (in *Upstream) DeepCopy() *Upstream {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpstreamAffinity) DeepCopyInto(out *UpstreamAffinity) {
+	*out = *in
+	if in.RouteCookie != nil {
+		in, out := &in.RouteCookie, &out.RouteCookie
+		*out = new(AffinityRouteCookie)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Consistent != nil {
+		in, out := &in.Consistent, &out.Consistent
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpstreamAffinity.
+func (in *UpstreamAffinity) DeepCopy() *UpstreamAffinity {
+	if in == nil {
+		return nil
+	}
+	out := new(UpstreamAffinity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UpstreamBuffers) DeepCopyInto(out *UpstreamBuffers) {
 	*out = *in
@@ -1908,6 +2398,11 @@ func (in *VirtualServerRouteStatus) DeepCopy() *VirtualServerRouteStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualServerSpec) DeepCopyInto(out *VirtualServerSpec) {
 	*out = *in
+	if in.ServerAliases != nil {
+		in, out := &in.ServerAliases, &out.ServerAliases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Listener != nil {
 		in, out := &in.Listener, &out.Listener
 		*out = new(VirtualServerListener)
@@ -1938,6 +2433,16 @@ func (in *VirtualServerSpec) DeepCopyInto(out *VirtualServerSpec) {
 		}
 	}
 	in.ExternalDNS.DeepCopyInto(&out.ExternalDNS)
+	if in.RequestID != nil {
+		in, out := &in.RequestID, &out.RequestID
+		*out = new(RequestID)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(Maintenance)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1959,6 +2464,11 @@ func (in *VirtualServerStatus) DeepCopyInto(out *VirtualServerStatus) {
 		*out = make([]ExternalEndpoint, len(*in))
 		copy(*out, *in)
 	}
+	if in.EjectedPeers != nil {
+		in, out := &in.EjectedPeers, &out.EjectedPeers
+		*out = make([]EjectedPeer, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
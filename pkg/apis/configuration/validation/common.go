@@ -232,3 +232,34 @@ func isValidHeaderName(name string) bool {
 	validHeaderName := regexp.MustCompile(`^[a-zA-Z0-9\-_]+$`)
 	return validHeaderName.MatchString(name)
 }
+
+var sslProtocols = map[string]bool{
+	"SSLv2":   true,
+	"SSLv3":   true,
+	"TLSv1":   true,
+	"TLSv1.1": true,
+	"TLSv1.2": true,
+	"TLSv1.3": true,
+}
+
+// validateSSLProtocols validates a space-separated list of SSL/TLS protocols.
+func validateSSLProtocols(protocols string, fieldPath *field.Path) field.ErrorList {
+	for _, p := range strings.Fields(protocols) {
+		if !sslProtocols[p] {
+			msg := fmt.Sprintf("Accepted values: %s", mapToPrettyString(sslProtocols))
+			return field.ErrorList{field.Invalid(fieldPath, protocols, msg)}
+		}
+	}
+	return nil
+}
+
+var sslCiphersRegexp = regexp.MustCompile(`^[A-Za-z0-9!:+@_.-]+$`)
+
+// validateSSLCiphers validates a list of ciphers in the OpenSSL format.
+func validateSSLCiphers(ciphers string, fieldPath *field.Path) field.ErrorList {
+	if ciphers != "" && !sslCiphersRegexp.MatchString(ciphers) {
+		msg := "must be an OpenSSL cipher list, for example, HIGH:!aNULL:!MD5"
+		return field.ErrorList{field.Invalid(fieldPath, ciphers, msg)}
+	}
+	return nil
+}
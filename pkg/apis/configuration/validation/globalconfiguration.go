@@ -150,10 +150,93 @@ func (gcv *GlobalConfigurationValidator) validateListener(listener conf_v1.Liste
 	allErrs = append(allErrs, validateListenerProtocol(listener.Protocol, fieldPath.Child("protocol"))...)
 	allErrs = append(allErrs, validateListenerIPv4(listener.IPv4, fieldPath.Child("ipv4"))...)
 	allErrs = append(allErrs, validateListenerIPv6(listener.IPv6, fieldPath.Child("ipv6"))...)
+	allErrs = append(allErrs, validateListenerProxyProtocol(listener, fieldPath)...)
+	allErrs = append(allErrs, validateListenerTLS(listener, fieldPath)...)
+	allErrs = append(allErrs, validateListenerTimeouts(listener, fieldPath)...)
+	allErrs = append(allErrs, validateListenerAllowedNamespaces(listener.AllowedNamespaces, fieldPath.Child("allowedNamespaces"))...)
 
 	return allErrs
 }
 
+func validateListenerAllowedNamespaces(namespaces []string, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	seen := sets.New[string]()
+	for i, ns := range namespaces {
+		idxPath := fieldPath.Index(i)
+		for _, msg := range validation.IsDNS1123Label(ns) {
+			allErrs = append(allErrs, field.Invalid(idxPath, ns, msg))
+		}
+		if seen.Has(ns) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, ns))
+		}
+		seen.Insert(ns)
+	}
+
+	return allErrs
+}
+
+func validateListenerTLS(listener conf_v1.Listener, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	sslListener := listener.Protocol == "HTTP" && listener.Ssl
+	if listener.HTTP2 != nil && !sslListener {
+		allErrs = append(allErrs, field.Forbidden(fieldPath.Child("http2"), "can only be set for HTTP listeners with ssl enabled"))
+	}
+
+	tls := listener.TLS
+	if tls == nil {
+		return allErrs
+	}
+	if !sslListener {
+		return append(allErrs, field.Forbidden(fieldPath.Child("tls"), "can only be set for HTTP listeners with ssl enabled"))
+	}
+
+	tlsPath := fieldPath.Child("tls")
+	if tls.Secret != "" && tls.RejectHandshake {
+		allErrs = append(allErrs, field.Forbidden(tlsPath.Child("rejectHandshake"), "cannot be enabled together with secret"))
+	}
+	allErrs = append(allErrs, validateSecretName(tls.Secret, tlsPath.Child("secret"))...)
+	allErrs = append(allErrs, validateSSLProtocols(tls.Protocols, tlsPath.Child("protocols"))...)
+	return append(allErrs, validateSSLCiphers(tls.Ciphers, tlsPath.Child("ciphers"))...)
+}
+
+func validateListenerTimeouts(listener conf_v1.Listener, fieldPath *field.Path) field.ErrorList {
+	if listener.Protocol != "HTTP" {
+		allErrs := field.ErrorList{}
+		if listener.ClientHeaderTimeout != "" {
+			allErrs = append(allErrs, field.Forbidden(fieldPath.Child("clientHeaderTimeout"), "can only be set for HTTP listeners"))
+		}
+		if listener.KeepaliveTimeout != "" {
+			allErrs = append(allErrs, field.Forbidden(fieldPath.Child("keepaliveTimeout"), "can only be set for HTTP listeners"))
+		}
+		return allErrs
+	}
+
+	allErrs := validateTime(listener.ClientHeaderTimeout, fieldPath.Child("clientHeaderTimeout"))
+	return append(allErrs, validateTime(listener.KeepaliveTimeout, fieldPath.Child("keepaliveTimeout"))...)
+}
+
+func validateListenerProxyProtocol(listener conf_v1.Listener, fieldPath *field.Path) field.ErrorList {
+	if !listener.AcceptProxyProtocol {
+		if len(listener.TrustedCIDRs) > 0 {
+			return field.ErrorList{field.Forbidden(fieldPath.Child("trustedCIDRs"), "can only be set when acceptProxyProtocol is enabled")}
+		}
+		return nil
+	}
+
+	if listener.Protocol != "TCP" {
+		msg := "is supported only for the TCP protocol. For HTTP listeners, use the proxy-protocol ConfigMap key"
+		return field.ErrorList{field.Forbidden(fieldPath.Child("acceptProxyProtocol"), msg)}
+	}
+
+	allErrs := field.ErrorList{}
+	for i, cidr := range listener.TrustedCIDRs {
+		allErrs = append(allErrs, validateIPorCIDR(cidr, fieldPath.Child("trustedCIDRs").Index(i))...)
+	}
+	return allErrs
+}
+
 func validateGlobalConfigurationListenerName(name string, fieldPath *field.Path) field.ErrorList {
 	if name == conf_v1.TLSPassthroughListenerName {
 		return field.ErrorList{field.Forbidden(fieldPath, "is the name of a built-in listener")}
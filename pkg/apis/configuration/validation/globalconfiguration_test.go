@@ -423,6 +423,50 @@ func TestValidateListener(t *testing.T) {
 	}
 }
 
+func TestValidateListener_WithProxyProtocol(t *testing.T) {
+	t.Parallel()
+	listener := conf_v1.Listener{
+		Name:                "tcp-listener",
+		Port:                5432,
+		Protocol:            "TCP",
+		AcceptProxyProtocol: true,
+		TrustedCIDRs:        []string{"10.0.0.0/8", "192.168.1.10", "fd00::/8"},
+	}
+
+	gcv := createGlobalConfigurationValidator()
+
+	allErrs := gcv.validateListener(listener, field.NewPath("listener"))
+	if len(allErrs) > 0 {
+		t.Errorf("validateListener() returned errors %v for valid input", allErrs)
+	}
+}
+
+func TestValidateListener_WithTLSAndTimeouts(t *testing.T) {
+	t.Parallel()
+	listener := conf_v1.Listener{
+		Name:     "https-listener",
+		Port:     8443,
+		Protocol: "HTTP",
+		Ssl:      true,
+		TLS: &conf_v1.ListenerTLS{
+			Secret:    "default-cert",
+			Protocols: "TLSv1.2 TLSv1.3",
+			Ciphers:   "HIGH:!aNULL:!MD5",
+		},
+		HTTP2:               boolPtr(false),
+		ClientHeaderTimeout: "10s",
+		KeepaliveTimeout:    "30s",
+		AllowedNamespaces:   []string{"tenant-a", "tenant-b"},
+	}
+
+	gcv := createGlobalConfigurationValidator()
+
+	allErrs := gcv.validateListener(listener, field.NewPath("listener"))
+	if len(allErrs) > 0 {
+		t.Errorf("validateListener() returned errors %v for valid input", allErrs)
+	}
+}
+
 func TestValidateListenerFails(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -461,6 +505,137 @@ func TestValidateListenerFails(t *testing.T) {
 			},
 			msg: "name of a built-in listener",
 		},
+		{
+			Listener: conf_v1.Listener{
+				Name:                "http-listener",
+				Port:                8080,
+				Protocol:            "HTTP",
+				AcceptProxyProtocol: true,
+			},
+			msg: "proxy protocol on an HTTP listener",
+		},
+		{
+			Listener: conf_v1.Listener{
+				Name:                "udp-listener",
+				Port:                5353,
+				Protocol:            "UDP",
+				AcceptProxyProtocol: true,
+			},
+			msg: "proxy protocol on a UDP listener",
+		},
+		{
+			Listener: conf_v1.Listener{
+				Name:         "tcp-listener",
+				Port:         2201,
+				Protocol:     "TCP",
+				TrustedCIDRs: []string{"10.0.0.0/8"},
+			},
+			msg: "trusted CIDRs without proxy protocol",
+		},
+		{
+			Listener: conf_v1.Listener{
+				Name:                "tcp-listener",
+				Port:                2201,
+				Protocol:            "TCP",
+				AcceptProxyProtocol: true,
+				TrustedCIDRs:        []string{"10.0.0.0/33"},
+			},
+			msg: "invalid trusted CIDR",
+		},
+		{
+			Listener: conf_v1.Listener{
+				Name:     "http-listener",
+				Port:     8080,
+				Protocol: "HTTP",
+				TLS:      &conf_v1.ListenerTLS{Secret: "default-cert"},
+			},
+			msg: "tls on an HTTP listener without ssl",
+		},
+		{
+			Listener: conf_v1.Listener{
+				Name:     "http-listener",
+				Port:     8080,
+				Protocol: "HTTP",
+				HTTP2:    boolPtr(true),
+			},
+			msg: "http2 on an HTTP listener without ssl",
+		},
+		{
+			Listener: conf_v1.Listener{
+				Name:     "https-listener",
+				Port:     8443,
+				Protocol: "HTTP",
+				Ssl:      true,
+				TLS:      &conf_v1.ListenerTLS{Secret: "default-cert", RejectHandshake: true},
+			},
+			msg: "secret and rejectHandshake",
+		},
+		{
+			Listener: conf_v1.Listener{
+				Name:     "https-listener",
+				Port:     8443,
+				Protocol: "HTTP",
+				Ssl:      true,
+				TLS:      &conf_v1.ListenerTLS{Secret: "default/cert"},
+			},
+			msg: "invalid secret",
+		},
+		{
+			Listener: conf_v1.Listener{
+				Name:     "https-listener",
+				Port:     8443,
+				Protocol: "HTTP",
+				Ssl:      true,
+				TLS:      &conf_v1.ListenerTLS{Protocols: "TLSv1.2 TLSv1.4"},
+			},
+			msg: "invalid protocols",
+		},
+		{
+			Listener: conf_v1.Listener{
+				Name:     "https-listener",
+				Port:     8443,
+				Protocol: "HTTP",
+				Ssl:      true,
+				TLS:      &conf_v1.ListenerTLS{Ciphers: "HIGH; return 200"},
+			},
+			msg: "invalid ciphers",
+		},
+		{
+			Listener: conf_v1.Listener{
+				Name:                "http-listener",
+				Port:                8080,
+				Protocol:            "HTTP",
+				ClientHeaderTimeout: "10x",
+			},
+			msg: "invalid client header timeout",
+		},
+		{
+			Listener: conf_v1.Listener{
+				Name:             "tcp-listener",
+				Port:             2201,
+				Protocol:         "TCP",
+				KeepaliveTimeout: "30s",
+			},
+			msg: "keepalive timeout on a TCP listener",
+		},
+		{
+			Listener: conf_v1.Listener{
+				Name:              "tcp-listener",
+				Port:              2201,
+				Protocol:          "TCP",
+				AllowedNamespaces: []string{"Tenant_A"},
+			},
+			msg: "invalid allowed namespace",
+		},
+		{
+			Listener: conf_v1.Listener{
+				Name:              "tcp-listener",
+				Port:              2201,
+				Protocol:          "TCP",
+				AllowedNamespaces: []string{"tenant-a", "tenant-a"},
+			},
+			msg: "duplicate allowed namespace",
+		},
 	}
 
 	gcv := createGlobalConfigurationValidator()
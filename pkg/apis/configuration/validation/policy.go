@@ -103,8 +103,13 @@ func validatePolicySpec(spec *v1.PolicySpec, fieldPath *field.Path, isPlus, enab
 		fieldCount++
 	}
 
+	if spec.Headers != nil {
+		allErrs = append(allErrs, validateHeadersPolicy(spec.Headers, fieldPath.Child("headers"), isPlus)...)
+		fieldCount++
+	}
+
 	if fieldCount != 1 {
-		msg := "must specify exactly one of: `accessControl`, `rateLimit`, `ingressMTLS`, `egressMTLS`, `basicAuth`, `apiKey`, `cache`, `cors`"
+		msg := "must specify exactly one of: `accessControl`, `rateLimit`, `ingressMTLS`, `egressMTLS`, `basicAuth`, `apiKey`, `cache`, `cors`, `headers`"
 		if isPlus {
 			msg = fmt.Sprint(msg, ", `jwt`, `oidc`, `waf`")
 		}
@@ -176,6 +181,10 @@ func validateRateLimit(rateLimit *v1.RateLimit, fieldPath *field.Path, isPlus bo
 		allErrs = append(allErrs, field.Forbidden(fieldPath.Child("condition.jwt"), "is only supported in NGINX Plus"))
 	}
 
+	if rateLimit.Shared && rateLimit.Condition != nil {
+		allErrs = append(allErrs, field.Forbidden(fieldPath.Child("shared"), "cannot be used together with condition"))
+	}
+
 	return allErrs
 }
 
@@ -1150,6 +1159,105 @@ func validateCORSExposeHeaders(headers []string, fieldPath *field.Path) field.Er
 	return allErrs
 }
 
+func validateHeadersPolicy(headers *v1.Headers, fieldPath *field.Path, isPlus bool) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var set, hide, pass []string
+	var add []v1.AddHeader
+	if headers.Request != nil {
+		for _, h := range headers.Request.Set {
+			set = append(set, h.Name)
+		}
+	}
+	if headers.Response != nil {
+		hide, pass, add = headers.Response.Hide, headers.Response.Pass, headers.Response.Add
+	}
+
+	if len(set) == 0 && len(hide) == 0 && len(pass) == 0 && len(add) == 0 {
+		return append(allErrs, field.Required(fieldPath, "must specify at least one header in `request` or `response`"))
+	}
+
+	if headers.Request != nil {
+		setPath := fieldPath.Child("request").Child("set")
+		for i, h := range headers.Request.Set {
+			allErrs = append(allErrs, validateHeadersPolicyHeader(h, setPath.Index(i), isPlus)...)
+		}
+		allErrs = append(allErrs, validateDuplicateHeaderNames(set, setPath, "name")...)
+	}
+
+	if headers.Response == nil {
+		return allErrs
+	}
+
+	responsePath := fieldPath.Child("response")
+	for i, h := range hide {
+		for _, msg := range validation.IsHTTPHeaderName(h) {
+			allErrs = append(allErrs, field.Invalid(responsePath.Child("hide").Index(i), h, msg))
+		}
+	}
+	allErrs = append(allErrs, validateDuplicateHeaderNames(hide, responsePath.Child("hide"), "")...)
+
+	hidden := make(map[string]bool)
+	for _, h := range hide {
+		hidden[strings.ToLower(h)] = true
+	}
+	for i, h := range pass {
+		for _, msg := range validation.IsHTTPHeaderName(h) {
+			allErrs = append(allErrs, field.Invalid(responsePath.Child("pass").Index(i), h, msg))
+		}
+		if hidden[strings.ToLower(h)] {
+			allErrs = append(allErrs, field.Invalid(responsePath.Child("pass").Index(i), h, "cannot be both hidden and passed"))
+		}
+	}
+	allErrs = append(allErrs, validateDuplicateHeaderNames(pass, responsePath.Child("pass"), "")...)
+
+	var addNames []string
+	for i, h := range add {
+		allErrs = append(allErrs, validateHeadersPolicyHeader(h.Header, responsePath.Child("add").Index(i), isPlus)...)
+		addNames = append(addNames, h.Name)
+	}
+	allErrs = append(allErrs, validateDuplicateHeaderNames(addNames, responsePath.Child("add"), "name")...)
+
+	return allErrs
+}
+
+func validateHeadersPolicyHeader(h v1.Header, fieldPath *field.Path, isPlus bool) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if h.Name == "" {
+		allErrs = append(allErrs, field.Required(fieldPath.Child("name"), ""))
+	}
+
+	for _, msg := range validation.IsHTTPHeaderName(h.Name) {
+		allErrs = append(allErrs, field.Invalid(fieldPath.Child("name"), h.Name, msg))
+	}
+
+	allErrs = append(allErrs, validateEscapedStringWithVariables(h.Value, fieldPath.Child("value"),
+		actionProxyHeaderSpecialVariables, actionProxyHeaderVariables, isPlus)...)
+
+	return allErrs
+}
+
+// validateDuplicateHeaderNames validates that the header names are unique. The names are compared case-insensitively.
+func validateDuplicateHeaderNames(names []string, fieldPath *field.Path, child string) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	seen := make(map[string]bool)
+	for i, name := range names {
+		lower := strings.ToLower(name)
+		if seen[lower] {
+			idxPath := fieldPath.Index(i)
+			if child != "" {
+				idxPath = idxPath.Child(child)
+			}
+			allErrs = append(allErrs, field.Duplicate(idxPath, name))
+		}
+		seen[lower] = true
+	}
+
+	return allErrs
+}
+
 // validateHeaderName validates a header name for RFC compliance and security
 func validateHeaderName(header string, fieldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -841,6 +841,17 @@ func TestValidateRateLimit_PassesOnValidInput(t *testing.T) {
 			isPlus: true,
 			msg:    "ratelimit JWT Condition",
 		},
+		{
+			rateLimit: &v1.RateLimit{
+				Rate:     "10r/s",
+				Key:      "${binary_remote_addr}",
+				ZoneSize: "10M",
+				Scale:    true,
+				Shared:   true,
+			},
+			isPlus: false,
+			msg:    "shared ratelimit",
+		},
 	}
 
 	for _, test := range tests {
@@ -938,6 +949,19 @@ func TestValidateRateLimit_FailsOnInvalidInput(t *testing.T) {
 			isPlus: true,
 			msg:    "missing JWTCondition",
 		},
+		{
+			rateLimit: createInvalidRateLimit(func(r *v1.RateLimit) {
+				r.Shared = true
+				r.Condition = &v1.RateLimitCondition{
+					JWT: &v1.JWTCondition{
+						Claim: "sub",
+						Match: "Gold",
+					},
+				}
+			}),
+			isPlus: true,
+			msg:    "shared ratelimit with condition",
+		},
 	}
 
 	for _, test := range tests {
@@ -3332,6 +3356,104 @@ func TestValidateCORS(t *testing.T) {
 	}
 }
 
+func TestValidateHeadersPolicy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		headers   *v1.Headers
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			name: "Valid request and response headers",
+			headers: &v1.Headers{
+				Request: &v1.HeadersRequest{
+					Set: []v1.Header{{Name: "X-Forwarded-Prefix", Value: "/api"}, {Name: "X-Debug", Value: ""}},
+				},
+				Response: &v1.HeadersResponse{
+					Hide: []string{"Server", "X-Internal-Debug"},
+					Pass: []string{"X-Accel-Date"},
+					Add:  []v1.AddHeader{{Header: v1.Header{Name: "X-Frame-Options", Value: "DENY"}, Always: true}},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "Valid request header with variable",
+			headers: &v1.Headers{
+				Request: &v1.HeadersRequest{
+					Set: []v1.Header{{Name: "X-Client", Value: "${remote_addr}"}},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name:      "No headers",
+			headers:   &v1.Headers{Request: &v1.HeadersRequest{}},
+			expectErr: true,
+			errMsg:    "must specify at least one header",
+		},
+		{
+			name: "Invalid header name",
+			headers: &v1.Headers{
+				Request: &v1.HeadersRequest{
+					Set: []v1.Header{{Name: "X Debug", Value: "on"}},
+				},
+			},
+			expectErr: true,
+			errMsg:    "spec.headers.request.set[0].name",
+		},
+		{
+			name: "Invalid header value",
+			headers: &v1.Headers{
+				Response: &v1.HeadersResponse{
+					Add: []v1.AddHeader{{Header: v1.Header{Name: "X-Version", Value: `v1"`}}},
+				},
+			},
+			expectErr: true,
+			errMsg:    "spec.headers.response.add[0].value",
+		},
+		{
+			name: "Duplicate request headers",
+			headers: &v1.Headers{
+				Request: &v1.HeadersRequest{
+					Set: []v1.Header{{Name: "X-Version", Value: "v1"}, {Name: "x-version", Value: "v2"}},
+				},
+			},
+			expectErr: true,
+			errMsg:    "Duplicate value",
+		},
+		{
+			name: "Hidden and passed header",
+			headers: &v1.Headers{
+				Response: &v1.HeadersResponse{
+					Hide: []string{"Server"},
+					Pass: []string{"server"},
+				},
+			},
+			expectErr: true,
+			errMsg:    "cannot be both hidden and passed",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			errs := validateHeadersPolicy(test.headers, field.NewPath("spec").Child("headers"), false)
+
+			if test.expectErr {
+				if len(errs) == 0 {
+					t.Errorf("Expected error but got none")
+				} else if !strings.Contains(errs.ToAggregate().Error(), test.errMsg) {
+					t.Errorf("Expected error message containing '%s' not found in errors: %v", test.errMsg, errs)
+				}
+			} else if len(errs) > 0 {
+				t.Errorf("Expected no errors but got: %v", errs)
+			}
+		})
+	}
+}
+
 // TestCORSMDNCompliance tests that our CORS implementation follows MDN guidelines
 func TestCORSMDNCompliance(t *testing.T) {
 	t.Parallel()
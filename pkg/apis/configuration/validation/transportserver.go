@@ -3,6 +3,7 @@ package validation
 import (
 	"encoding/hex"
 	"fmt"
+	"net"
 	"regexp"
 	"strings"
 
@@ -45,14 +46,19 @@ func (tsv *TransportServerValidator) validateTransportServerSpec(spec *conf_v1.T
 
 	allErrs = append(allErrs, validateTransportServerUpstreamParameters(spec.UpstreamParameters, fieldPath.Child("upstreamParameters"), spec.Listener.Protocol)...)
 
+	allErrs = append(allErrs, validateUpstreamTLSForProtocol(spec.Upstreams, fieldPath.Child("upstreams"), spec.Listener.Protocol)...)
+
 	allErrs = append(allErrs, validateSessionParameters(spec.SessionParameters, fieldPath.Child("sessionParameters"))...)
 
 	if spec.Action == nil {
 		allErrs = append(allErrs, field.Required(fieldPath.Child("action"), "must specify action"))
 	} else {
 		allErrs = append(allErrs, validateTransportServerAction(spec.Action, fieldPath.Child("action"), upstreamNames)...)
+		allErrs = append(allErrs, validateTransportServerActionRouting(spec, fieldPath, isTLSPassthroughListener)...)
 	}
 
+	allErrs = append(allErrs, validateTransportServerAccessLog(spec.AccessLog, fieldPath.Child("accessLog"))...)
+
 	allErrs = append(allErrs, validateSnippets(spec.ServerSnippets, fieldPath.Child("serverSnippets"), tsv.snippetsEnabled)...)
 
 	allErrs = append(allErrs, validateSnippets(spec.StreamSnippets, fieldPath.Child("streamSnippets"), tsv.snippetsEnabled)...)
@@ -80,16 +86,43 @@ func validateTLS(
 		if tls == nil || tls.Secret == "" {
 			return field.ErrorList{field.Required(fieldPath, "must specify spec.tls.secret when host is specified, and the TransportServer is not using the TLS Passthrough listener")}
 		}
-		return validateSecretName(tls.Secret, fieldPath.Child("secret"))
+		allErrs := validateSecretName(tls.Secret, fieldPath.Child("secret"))
+		return append(allErrs, validateTLSClientVerification(tls, fieldPath)...)
 	}
 
 	if tls != nil && tls.Secret != "" {
-		return validateSecretName(tls.Secret, fieldPath.Child("secret"))
+		allErrs := validateSecretName(tls.Secret, fieldPath.Child("secret"))
+		return append(allErrs, validateTLSClientVerification(tls, fieldPath)...)
+	}
+
+	if tls != nil && tls.ClientCertSecret != "" {
+		return field.ErrorList{field.Required(fieldPath.Child("secret"), "must be set when clientCertSecret is set")}
 	}
 
 	return nil
 }
 
+var crlFileNameRegexp = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+
+func validateTLSClientVerification(tls *conf_v1.TransportServerTLS, fieldPath *field.Path) field.ErrorList {
+	if tls.ClientCertSecret == "" {
+		if tls.CrlFileName != "" || tls.VerifyClient != "" || tls.VerifyDepth != nil {
+			return field.ErrorList{field.Required(fieldPath.Child("clientCertSecret"), "must be set when client certificate verification is configured")}
+		}
+		return nil
+	}
+
+	allErrs := validateSecretName(tls.ClientCertSecret, fieldPath.Child("clientCertSecret"))
+	allErrs = append(allErrs, validateIngressMTLSVerifyClient(tls.VerifyClient, fieldPath.Child("verifyClient"))...)
+	if tls.VerifyDepth != nil {
+		allErrs = append(allErrs, validatePositiveIntOrZero(*tls.VerifyDepth, fieldPath.Child("verifyDepth"))...)
+	}
+	if tls.CrlFileName != "" && (!crlFileNameRegexp.MatchString(tls.CrlFileName) || tls.CrlFileName == "." || tls.CrlFileName == "..") {
+		allErrs = append(allErrs, field.Invalid(fieldPath.Child("crlFileName"), tls.CrlFileName, "must be a file name in /etc/nginx/secrets"))
+	}
+	return allErrs
+}
+
 func validateSnippets(serverSnippet string, fieldPath *field.Path, snippetsEnabled bool) field.ErrorList {
 	if !snippetsEnabled && serverSnippet != "" {
 		return field.ErrorList{field.Forbidden(fieldPath, "snippet specified but snippets feature is not enabled")}
@@ -180,7 +213,7 @@ func validateTransportServerUpstreams(upstreams []conf_v1.TransportServerUpstrea
 
 		allErrs = append(allErrs, validateServiceName(u.Service, idxPath.Child("service"))...)
 		allErrs = append(allErrs, validatePositiveIntOrZeroFromPointer(u.MaxFails, idxPath.Child("maxFails"))...)
-		allErrs = append(allErrs, validatePositiveIntOrZeroFromPointer(u.MaxFails, idxPath.Child("maxConns"))...)
+		allErrs = append(allErrs, validatePositiveIntOrZeroFromPointer(u.MaxConns, idxPath.Child("maxConns"))...)
 		allErrs = append(allErrs, validateTime(u.FailTimeout, idxPath.Child("failTimeout"))...)
 
 		for _, msg := range validation.IsValidPortNum(u.Port) {
@@ -189,12 +222,33 @@ func validateTransportServerUpstreams(upstreams []conf_v1.TransportServerUpstrea
 
 		allErrs = append(allErrs, validateTSUpstreamHealthChecks(u.HealthCheck, idxPath.Child("healthChecks"))...)
 		allErrs = append(allErrs, validateLoadBalancingMethod(u.LoadBalancingMethod, idxPath.Child("loadBalancingMethod"), isPlus)...)
+		allErrs = append(allErrs, validateSessionAffinity(u.SessionAffinity, u.LoadBalancingMethod, idxPath.Child("sessionAffinity"))...)
 		allErrs = append(allErrs, validateBackup(u.Backup, u.BackupPort, u.LoadBalancingMethod, idxPath)...)
+		allErrs = append(allErrs, validateTransportServerUpstreamTLS(u.TLS, idxPath.Child("tls"))...)
 	}
 
 	return allErrs, upstreamNames
 }
 
+var sessionAffinityValues = map[string]bool{
+	"None":     true,
+	"ClientIP": true,
+}
+
+func validateSessionAffinity(affinity string, method string, fieldPath *field.Path) field.ErrorList {
+	if affinity == "" {
+		return nil
+	}
+	if !sessionAffinityValues[affinity] {
+		msg := fmt.Sprintf("Accepted values: %s", mapToPrettyString(sessionAffinityValues))
+		return field.ErrorList{field.Invalid(fieldPath, affinity, msg)}
+	}
+	if affinity == "ClientIP" && method != "" {
+		return field.ErrorList{field.Forbidden(fieldPath, "cannot be ClientIP when loadBalancingMethod is set")}
+	}
+	return nil
+}
+
 func validateLoadBalancingMethod(method string, fieldPath *field.Path, isPlus bool) field.ErrorList {
 	if method == "" {
 		return nil
@@ -262,6 +316,42 @@ func validateHashLoadBalancingMethod(method string, fieldPath *field.Path, isPlu
 	return allErrs
 }
 
+func validateTransportServerUpstreamTLS(tls *conf_v1.TransportServerUpstreamTLS, fieldPath *field.Path) field.ErrorList {
+	if tls == nil {
+		return nil
+	}
+
+	allErrs := validateSecretName(tls.TLSSecret, fieldPath.Child("tlsSecret"))
+
+	if tls.VerifyServer && tls.TrustedCertSecret == "" {
+		return append(allErrs, field.Required(fieldPath.Child("trustedCertSecret"), "must be set when verifyServer is 'true'"))
+	}
+	allErrs = append(allErrs, validateSecretName(tls.TrustedCertSecret, fieldPath.Child("trustedCertSecret"))...)
+
+	if tls.VerifyDepth != nil {
+		allErrs = append(allErrs, validatePositiveIntOrZero(*tls.VerifyDepth, fieldPath.Child("verifyDepth"))...)
+	}
+
+	allErrs = append(allErrs, validateSSLProtocols(tls.Protocols, fieldPath.Child("protocols"))...)
+	allErrs = append(allErrs, validateSSLCiphers(tls.Ciphers, fieldPath.Child("ciphers"))...)
+
+	return append(allErrs, validateSSLName(tls.SSLName, fieldPath.Child("sslName"))...)
+}
+
+func validateUpstreamTLSForProtocol(upstreams []conf_v1.TransportServerUpstream, fieldPath *field.Path, protocol string) field.ErrorList {
+	if protocol != "UDP" {
+		return nil
+	}
+
+	allErrs := field.ErrorList{}
+	for i, u := range upstreams {
+		if u.TLS != nil && u.TLS.Enable {
+			allErrs = append(allErrs, field.Forbidden(fieldPath.Index(i).Child("tls"), "TLS to the upstream servers is not supported for the UDP protocol"))
+		}
+	}
+	return allErrs
+}
+
 func validateTSUpstreamHealthChecks(hc *conf_v1.TransportServerHealthCheck, fieldPath *field.Path) field.ErrorList {
 	if hc == nil {
 		return nil
@@ -376,6 +466,149 @@ func validateSessionParameters(sessionParameters *conf_v1.SessionParameters, fie
 	return validateTime(sessionParameters.Timeout, fieldPath.Child("timeout"))
 }
 
+var streamLogFormatVariables = map[string]bool{
+	"binary_remote_addr":         true,
+	"bytes_received":             true,
+	"bytes_sent":                 true,
+	"connection":                 true,
+	"hostname":                   true,
+	"msec":                       true,
+	"nginx_version":              true,
+	"pid":                        true,
+	"protocol":                   true,
+	"proxy_protocol_addr":        true,
+	"proxy_protocol_port":        true,
+	"proxy_protocol_server_addr": true,
+	"proxy_protocol_server_port": true,
+	"realip_remote_addr":         true,
+	"realip_remote_port":         true,
+	"remote_addr":                true,
+	"remote_port":                true,
+	"server_addr":                true,
+	"server_port":                true,
+	"session_time":               true,
+	"ssl_cipher":                 true,
+	"ssl_client_fingerprint":     true,
+	"ssl_client_i_dn":            true,
+	"ssl_client_s_dn":            true,
+	"ssl_client_serial":          true,
+	"ssl_client_verify":          true,
+	"ssl_preread_alpn_protocols": true,
+	"ssl_preread_protocol":       true,
+	"ssl_preread_server_name":    true,
+	"ssl_protocol":               true,
+	"ssl_server_name":            true,
+	"ssl_session_reused":         true,
+	"status":                     true,
+	"time_iso8601":               true,
+	"time_local":                 true,
+	"upstream_addr":              true,
+	"upstream_bytes_received":    true,
+	"upstream_bytes_sent":        true,
+	"upstream_connect_time":      true,
+	"upstream_first_byte_time":   true,
+	"upstream_session_time":      true,
+}
+
+var streamStatuses = map[int]bool{
+	200: true,
+	400: true,
+	403: true,
+	500: true,
+	502: true,
+	503: true,
+}
+
+var syslogParameters = map[string]bool{
+	"facility": true,
+	"severity": true,
+	"tag":      true,
+}
+
+var (
+	streamLogFormatVariableRegexp = regexp.MustCompile(`\$(?:\{([^}]*)\}|([a-zA-Z0-9_]*))`)
+	syslogParameterValueRegexp    = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
+)
+
+func validateTransportServerAccessLog(accessLog *conf_v1.TransportServerAccessLog, fieldPath *field.Path) field.ErrorList {
+	if accessLog == nil {
+		return nil
+	}
+
+	allErrs := validateStreamLogFormat(accessLog.Format, fieldPath.Child("format"))
+	allErrs = append(allErrs, validateStreamLogDestination(accessLog.Destination, fieldPath.Child("destination"))...)
+
+	statuses := sets.Set[int]{}
+	for i, status := range accessLog.Statuses {
+		idxPath := fieldPath.Child("statuses").Index(i)
+		if !streamStatuses[status] {
+			allErrs = append(allErrs, field.Invalid(idxPath, status, "must be one of 200, 400, 403, 500, 502 or 503"))
+		} else if statuses.Has(status) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, status))
+		}
+		statuses.Insert(status)
+	}
+
+	return allErrs
+}
+
+func validateStreamLogFormat(format string, fieldPath *field.Path) field.ErrorList {
+	if format == "" {
+		return nil
+	}
+
+	if err := ValidateEscapedString(format, `$remote_addr [$time_local] $status`, `$remote_addr \"$ssl_preread_server_name\"`); err != nil {
+		return field.ErrorList{field.Invalid(fieldPath, format, err.Error())}
+	}
+
+	allErrs := field.ErrorList{}
+	for _, match := range streamLogFormatVariableRegexp.FindAllStringSubmatch(format, -1) {
+		nVar := match[1] + match[2]
+		allErrs = append(allErrs, validateVariable(nVar, streamLogFormatVariables, fieldPath)...)
+	}
+
+	return allErrs
+}
+
+func validateStreamLogDestination(destination string, fieldPath *field.Path) field.ErrorList {
+	if destination == "" || destination == "/dev/stdout" || destination == "/dev/stderr" {
+		return nil
+	}
+
+	msg := "must be /dev/stdout, /dev/stderr or a syslog server in the syslog:server=address[,parameter=value] format"
+	if !strings.HasPrefix(destination, "syslog:server=") {
+		return field.ErrorList{field.Invalid(fieldPath, destination, msg)}
+	}
+
+	parts := strings.Split(strings.TrimPrefix(destination, "syslog:server="), ",")
+
+	host, port, err := net.SplitHostPort(parts[0])
+	if err != nil {
+		host = parts[0]
+	}
+	allErrs := field.ErrorList{}
+	if net.ParseIP(host) == nil {
+		for _, msg := range validation.IsDNS1123Subdomain(host) {
+			allErrs = append(allErrs, field.Invalid(fieldPath, destination, msg))
+		}
+	}
+	if port != "" {
+		allErrs = append(allErrs, validatePortNumber(port, fieldPath)...)
+	}
+
+	for _, param := range parts[1:] {
+		if param == "nohostname" {
+			continue
+		}
+		name, value, found := strings.Cut(param, "=")
+		if !found || !syslogParameters[name] || !syslogParameterValueRegexp.MatchString(value) {
+			allErrs = append(allErrs, field.Invalid(fieldPath, destination, fmt.Sprintf("invalid syslog parameter %q. Accepted parameters are facility, severity, tag and nohostname", param)))
+		}
+	}
+
+	return allErrs
+}
+
 func validateUDPUpstreamParameter(parameter *int, fieldPath *field.Path, protocol string) field.ErrorList {
 	if parameter != nil && protocol != "UDP" {
 		return field.ErrorList{field.Forbidden(fieldPath, "is not allowed for non-UDP TransportServers")}
@@ -384,8 +617,125 @@ func validateUDPUpstreamParameter(parameter *int, fieldPath *field.Path, protoco
 }
 
 func validateTransportServerAction(action *conf_v1.TransportServerAction, fieldPath *field.Path, upstreamNames sets.Set[string]) field.ErrorList {
-	if action.Pass == "" {
-		return field.ErrorList{field.Required(fieldPath, "must specify pass")}
+	var allErrs field.ErrorList
+
+	switch {
+	case action.Pass != "" && len(action.Splits) > 0:
+		return field.ErrorList{field.Forbidden(fieldPath.Child("splits"), "cannot be used together with pass")}
+	case action.Pass != "":
+		allErrs = validateReferencedUpstream(action.Pass, fieldPath.Child("pass"), upstreamNames)
+	case len(action.Splits) > 0:
+		allErrs = validateTransportServerSplits(action.Splits, fieldPath.Child("splits"), upstreamNames)
+	default:
+		return field.ErrorList{field.Required(fieldPath, "must specify pass or splits")}
+	}
+
+	return append(allErrs, validateTransportServerActionMatches(action.Matches, fieldPath.Child("matches"), upstreamNames)...)
+}
+
+func validateTransportServerSplits(splits []conf_v1.TransportServerSplit, fieldPath *field.Path, upstreamNames sets.Set[string]) field.ErrorList {
+	if len(splits) < 2 {
+		return field.ErrorList{field.Invalid(fieldPath, "", "must include at least 2 splits")}
+	}
+
+	allErrs := field.ErrorList{}
+	totalWeight := 0
+	for i, s := range splits {
+		idxPath := fieldPath.Index(i)
+
+		for _, msg := range validation.IsInRange(s.Weight, 0, 100) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("weight"), s.Weight, msg))
+		}
+
+		if s.Pass == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("pass"), ""))
+		} else {
+			allErrs = append(allErrs, validateReferencedUpstream(s.Pass, idxPath.Child("pass"), upstreamNames)...)
+		}
+
+		totalWeight += s.Weight
+	}
+
+	if totalWeight != 100 {
+		allErrs = append(allErrs, field.Invalid(fieldPath, "", "the sum of the weights of all splits must be equal to 100"))
 	}
-	return validateReferencedUpstream(action.Pass, fieldPath.Child("pass"), upstreamNames)
+
+	return allErrs
+}
+
+func validateTransportServerActionMatches(matches []conf_v1.TransportServerActionMatch, fieldPath *field.Path, upstreamNames sets.Set[string]) field.ErrorList {
+	allErrs := field.ErrorList{}
+	serverNames := sets.Set[string]{}
+
+	for i, m := range matches {
+		idxPath := fieldPath.Index(i)
+
+		if len(m.ServerNames) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("serverNames"), ""))
+		}
+		for j, name := range m.ServerNames {
+			namePath := idxPath.Child("serverNames").Index(j)
+			nameErrs := validateHost(name, namePath)
+			if len(nameErrs) > 0 {
+				allErrs = append(allErrs, nameErrs...)
+			} else if serverNames.Has(name) {
+				allErrs = append(allErrs, field.Duplicate(namePath, name))
+			} else {
+				serverNames.Insert(name)
+			}
+		}
+
+		if m.Pass == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("pass"), ""))
+		} else {
+			allErrs = append(allErrs, validateReferencedUpstream(m.Pass, idxPath.Child("pass"), upstreamNames)...)
+		}
+	}
+
+	return allErrs
+}
+
+// validateTransportServerActionRouting validates the features that can't be combined with splits and matches.
+func validateTransportServerActionRouting(spec *conf_v1.TransportServerSpec, fieldPath *field.Path, isTLSPassthroughListener bool) field.ErrorList {
+	if spec.Action == nil || (len(spec.Action.Splits) == 0 && len(spec.Action.Matches) == 0) {
+		return nil
+	}
+
+	allErrs := field.ErrorList{}
+
+	if len(spec.Action.Matches) > 0 {
+		matchesPath := fieldPath.Child("action").Child("matches")
+		switch {
+		case isTLSPassthroughListener:
+			allErrs = append(allErrs, field.Forbidden(matchesPath, "cannot be used with the TLS Passthrough listener"))
+		case spec.Listener.Protocol != "TCP":
+			allErrs = append(allErrs, field.Forbidden(matchesPath, "can only be used with the TCP protocol"))
+		case spec.TLS != nil && spec.TLS.Secret != "":
+			allErrs = append(allErrs, field.Forbidden(matchesPath, "cannot be used when TLS is terminated"))
+		}
+	}
+
+	referenced := sets.New(spec.Action.Pass)
+	for _, s := range spec.Action.Splits {
+		referenced.Insert(s.Pass)
+	}
+	for _, m := range spec.Action.Matches {
+		referenced.Insert(m.Pass)
+	}
+
+	msg := "is not supported for upstreams referenced by splits or matches"
+	for i, u := range spec.Upstreams {
+		if !referenced.Has(u.Name) {
+			continue
+		}
+		idxPath := fieldPath.Child("upstreams").Index(i)
+		if u.TLS != nil && u.TLS.Enable {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("tls"), msg))
+		}
+		if u.ProxyProtocol {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("proxyProtocol"), msg))
+		}
+	}
+
+	return allErrs
 }
@@ -189,6 +189,23 @@ func TestValidateTransportServerUpstreams(t *testing.T) {
 			},
 			msg: "2 valid upstreams",
 		},
+		{
+			upstreams: []conf_v1.TransportServerUpstream{
+				{
+					Name:            "upstream1",
+					Service:         "test-1",
+					Port:            53,
+					MaxFails:        createPointerFromInt(3),
+					MaxConns:        createPointerFromInt(100),
+					FailTimeout:     "30s",
+					SessionAffinity: "ClientIP",
+				},
+			},
+			expectedUpstreamNames: map[string]sets.Empty{
+				"upstream1": {},
+			},
+			msg: "upstream with passive health checks and session affinity",
+		},
 	}
 
 	for _, test := range tests {
@@ -246,6 +263,21 @@ func TestValidateTransportServerUpstreams_FailsOnInvalidInput(t *testing.T) {
 			},
 			msg: "invalid port",
 		},
+		{
+			upstreams: []conf_v1.TransportServerUpstream{
+				{
+					Name:     "upstream1",
+					Service:  "test-1",
+					Port:     80,
+					MaxFails: createPointerFromInt(1),
+					MaxConns: createPointerFromInt(-1),
+				},
+			},
+			expectedUpstreamNames: map[string]sets.Empty{
+				"upstream1": {},
+			},
+			msg: "invalid maxConns",
+		},
 		{
 			upstreams: []conf_v1.TransportServerUpstream{
 				{
@@ -264,6 +296,49 @@ func TestValidateTransportServerUpstreams_FailsOnInvalidInput(t *testing.T) {
 			},
 			msg: "duplicated upstreams",
 		},
+		{
+			upstreams: []conf_v1.TransportServerUpstream{
+				{
+					Name:     "upstream1",
+					Service:  "test-1",
+					Port:     80,
+					MaxConns: createPointerFromInt(-1),
+				},
+			},
+			expectedUpstreamNames: map[string]sets.Empty{
+				"upstream1": {},
+			},
+			msg: "invalid max conns",
+		},
+		{
+			upstreams: []conf_v1.TransportServerUpstream{
+				{
+					Name:            "upstream1",
+					Service:         "test-1",
+					Port:            80,
+					SessionAffinity: "Cookie",
+				},
+			},
+			expectedUpstreamNames: map[string]sets.Empty{
+				"upstream1": {},
+			},
+			msg: "invalid session affinity",
+		},
+		{
+			upstreams: []conf_v1.TransportServerUpstream{
+				{
+					Name:                "upstream1",
+					Service:             "test-1",
+					Port:                80,
+					SessionAffinity:     "ClientIP",
+					LoadBalancingMethod: "least_conn",
+				},
+			},
+			expectedUpstreamNames: map[string]sets.Empty{
+				"upstream1": {},
+			},
+			msg: "session affinity with load balancing method",
+		},
 	}
 
 	for _, test := range tests {
@@ -833,6 +908,102 @@ func TestValidateTSUpstreamHealthChecks_FailsOnInvalidInput(t *testing.T) {
 	}
 }
 
+func TestValidateTransportServerUpstreamTLS(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		tls *conf_v1.TransportServerUpstreamTLS
+		msg string
+	}{
+		{
+			tls: nil,
+			msg: "nil tls",
+		},
+		{
+			tls: &conf_v1.TransportServerUpstreamTLS{Enable: true},
+			msg: "tls enabled with defaults",
+		},
+		{
+			tls: &conf_v1.TransportServerUpstreamTLS{
+				Enable:            true,
+				TLSSecret:         "client-secret",
+				VerifyServer:      true,
+				VerifyDepth:       createPointerFromInt(2),
+				Protocols:         "TLSv1.2 TLSv1.3",
+				Ciphers:           "HIGH:!aNULL:!MD5",
+				TrustedCertSecret: "ca-secret",
+				ServerName:        true,
+				SSLName:           "db.example.com",
+			},
+			msg: "all fields set",
+		},
+	}
+	for _, test := range tests {
+		allErrs := validateTransportServerUpstreamTLS(test.tls, field.NewPath("tls"))
+		if len(allErrs) > 0 {
+			t.Errorf("validateTransportServerUpstreamTLS() returned errors %v for valid input for the case of %s", allErrs, test.msg)
+		}
+	}
+}
+
+func TestValidateTransportServerUpstreamTLS_FailsOnInvalidInput(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		tls *conf_v1.TransportServerUpstreamTLS
+		msg string
+	}{
+		{
+			tls: &conf_v1.TransportServerUpstreamTLS{Enable: true, TLSSecret: "-invalid"},
+			msg: "invalid tls secret name",
+		},
+		{
+			tls: &conf_v1.TransportServerUpstreamTLS{Enable: true, VerifyServer: true},
+			msg: "verify server without trusted cert secret",
+		},
+		{
+			tls: &conf_v1.TransportServerUpstreamTLS{Enable: true, VerifyDepth: createPointerFromInt(-1)},
+			msg: "negative verify depth",
+		},
+		{
+			tls: &conf_v1.TransportServerUpstreamTLS{Enable: true, Protocols: "TLSv1.2 TLSv2"},
+			msg: "invalid protocol",
+		},
+		{
+			tls: &conf_v1.TransportServerUpstreamTLS{Enable: true, Ciphers: "HIGH; return 200"},
+			msg: "invalid ciphers",
+		},
+		{
+			tls: &conf_v1.TransportServerUpstreamTLS{Enable: true, SSLName: "_invalid"},
+			msg: "invalid ssl name",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			allErrs := validateTransportServerUpstreamTLS(test.tls, field.NewPath("tls"))
+			if len(allErrs) == 0 {
+				t.Errorf("validateTransportServerUpstreamTLS() returned no errors for invalid input")
+			}
+		})
+	}
+}
+
+func TestValidateUpstreamTLSForProtocol(t *testing.T) {
+	t.Parallel()
+	upstreams := []conf_v1.TransportServerUpstream{
+		{
+			Name: "upstream1",
+			TLS:  &conf_v1.TransportServerUpstreamTLS{Enable: true},
+		},
+	}
+
+	if allErrs := validateUpstreamTLSForProtocol(upstreams, field.NewPath("upstreams"), "TCP"); len(allErrs) > 0 {
+		t.Errorf("validateUpstreamTLSForProtocol() returned errors %v for TCP", allErrs)
+	}
+	if allErrs := validateUpstreamTLSForProtocol(upstreams, field.NewPath("upstreams"), "UDP"); len(allErrs) == 0 {
+		t.Errorf("validateUpstreamTLSForProtocol() returned no errors for UDP")
+	}
+}
+
 func TestValidateUpstreamParameters(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -909,6 +1080,132 @@ func TestValidateSessionParameters_FailsOnInvalidInput(t *testing.T) {
 	}
 }
 
+func TestValidateTransportServerAccessLog(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		accessLog *conf_v1.TransportServerAccessLog
+		msg       string
+	}{
+		{
+			accessLog: nil,
+			msg:       "nil access log",
+		},
+		{
+			accessLog: &conf_v1.TransportServerAccessLog{},
+			msg:       "disabled access log",
+		},
+		{
+			accessLog: &conf_v1.TransportServerAccessLog{
+				Enable:      true,
+				Format:      `$remote_addr [$time_local] $protocol $status ${upstream_addr} \"$ssl_preread_server_name\"`,
+				Destination: "/dev/stderr",
+			},
+			msg: "custom format",
+		},
+		{
+			accessLog: &conf_v1.TransportServerAccessLog{
+				Enable:      true,
+				Destination: "syslog:server=10.0.0.1:514,facility=local7,tag=tcp_app,severity=info,nohostname",
+			},
+			msg: "syslog destination with an IP address",
+		},
+		{
+			accessLog: &conf_v1.TransportServerAccessLog{
+				Enable:      true,
+				Destination: "syslog:server=syslog.example.com",
+				Statuses:    []int{400, 502, 503},
+			},
+			msg: "syslog destination with a hostname and statuses",
+		},
+	}
+
+	for _, test := range tests {
+		allErrs := validateTransportServerAccessLog(test.accessLog, field.NewPath("accessLog"))
+		if len(allErrs) > 0 {
+			t.Errorf("validateTransportServerAccessLog() returned errors %v for valid input for the case of %s", allErrs, test.msg)
+		}
+	}
+}
+
+func TestValidateTransportServerAccessLog_FailsOnInvalidInput(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		accessLog *conf_v1.TransportServerAccessLog
+		msg       string
+	}{
+		{
+			accessLog: &conf_v1.TransportServerAccessLog{
+				Enable: true,
+				Format: "$remote_addr $request_uri",
+			},
+			msg: "HTTP variable in format",
+		},
+		{
+			accessLog: &conf_v1.TransportServerAccessLog{
+				Enable: true,
+				Format: "$remote_addr $",
+			},
+			msg: "format ends with $",
+		},
+		{
+			accessLog: &conf_v1.TransportServerAccessLog{
+				Enable: true,
+				Format: `$remote_addr "$status"`,
+			},
+			msg: "unescaped double quotes in format",
+		},
+		{
+			accessLog: &conf_v1.TransportServerAccessLog{
+				Enable:      true,
+				Destination: "/var/log/nginx/tcp.log",
+			},
+			msg: "file destination",
+		},
+		{
+			accessLog: &conf_v1.TransportServerAccessLog{
+				Enable:      true,
+				Destination: "syslog:server=10.0.0.1:99999",
+			},
+			msg: "invalid syslog port",
+		},
+		{
+			accessLog: &conf_v1.TransportServerAccessLog{
+				Enable:      true,
+				Destination: "syslog:server=10.0.0.1:514,buffer=32k",
+			},
+			msg: "unsupported syslog parameter",
+		},
+		{
+			accessLog: &conf_v1.TransportServerAccessLog{
+				Enable:      true,
+				Destination: "syslog:server=10.0.0.1:514;tag=a",
+			},
+			msg: "invalid syslog server",
+		},
+		{
+			accessLog: &conf_v1.TransportServerAccessLog{
+				Enable:   true,
+				Statuses: []int{404},
+			},
+			msg: "invalid status",
+		},
+		{
+			accessLog: &conf_v1.TransportServerAccessLog{
+				Enable:   true,
+				Statuses: []int{502, 502},
+			},
+			msg: "duplicated status",
+		},
+	}
+
+	for _, test := range tests {
+		allErrs := validateTransportServerAccessLog(test.accessLog, field.NewPath("accessLog"))
+		if len(allErrs) == 0 {
+			t.Errorf("validateTransportServerAccessLog() returned no errors for invalid input: %v", test.msg)
+		}
+	}
+}
+
 func TestValidateUDPUpstreamParameter(t *testing.T) {
 	t.Parallel()
 	validInput := []struct {
@@ -981,9 +1278,58 @@ func TestValidateTransportServerAction(t *testing.T) {
 	}
 }
 
+func TestValidateTransportServerAction_WithSplitsAndMatches(t *testing.T) {
+	t.Parallel()
+	upstreamNames := sets.New("stable", "canary", "db-a", "db-b")
+
+	tests := []struct {
+		action *conf_v1.TransportServerAction
+		msg    string
+	}{
+		{
+			action: &conf_v1.TransportServerAction{
+				Splits: []conf_v1.TransportServerSplit{
+					{Weight: 90, Pass: "stable"},
+					{Weight: 10, Pass: "canary"},
+				},
+			},
+			msg: "splits",
+		},
+		{
+			action: &conf_v1.TransportServerAction{
+				Pass: "stable",
+				Matches: []conf_v1.TransportServerActionMatch{
+					{ServerNames: []string{"a.example.com"}, Pass: "db-a"},
+					{ServerNames: []string{"b.example.com", "*.b.example.com"}, Pass: "db-b"},
+				},
+			},
+			msg: "matches with pass",
+		},
+		{
+			action: &conf_v1.TransportServerAction{
+				Splits: []conf_v1.TransportServerSplit{
+					{Weight: 50, Pass: "stable"},
+					{Weight: 50, Pass: "canary"},
+				},
+				Matches: []conf_v1.TransportServerActionMatch{
+					{ServerNames: []string{"a.example.com"}, Pass: "db-a"},
+				},
+			},
+			msg: "matches with splits",
+		},
+	}
+
+	for _, test := range tests {
+		allErrs := validateTransportServerAction(test.action, field.NewPath("action"), upstreamNames)
+		if len(allErrs) > 0 {
+			t.Errorf("validateTransportServerAction() returned errors %v for valid input for the case of %s", allErrs, test.msg)
+		}
+	}
+}
+
 func TestValidateTransportServerAction_FailsOnInvalidInput(t *testing.T) {
 	t.Parallel()
-	upstreamNames := map[string]sets.Empty{}
+	upstreamNames := sets.New("stable", "canary")
 
 	tests := []struct {
 		action *conf_v1.TransportServerAction
@@ -1001,6 +1347,79 @@ func TestValidateTransportServerAction_FailsOnInvalidInput(t *testing.T) {
 			},
 			msg: "pass references a non-existing upstream",
 		},
+		{
+			action: &conf_v1.TransportServerAction{
+				Pass: "stable",
+				Splits: []conf_v1.TransportServerSplit{
+					{Weight: 50, Pass: "stable"},
+					{Weight: 50, Pass: "canary"},
+				},
+			},
+			msg: "pass and splits",
+		},
+		{
+			action: &conf_v1.TransportServerAction{
+				Splits: []conf_v1.TransportServerSplit{
+					{Weight: 100, Pass: "stable"},
+				},
+			},
+			msg: "single split",
+		},
+		{
+			action: &conf_v1.TransportServerAction{
+				Splits: []conf_v1.TransportServerSplit{
+					{Weight: 50, Pass: "stable"},
+					{Weight: 40, Pass: "canary"},
+				},
+			},
+			msg: "weights don't sum to 100",
+		},
+		{
+			action: &conf_v1.TransportServerAction{
+				Splits: []conf_v1.TransportServerSplit{
+					{Weight: 50, Pass: "stable"},
+					{Weight: 50, Pass: "non-existing"},
+				},
+			},
+			msg: "split references a non-existing upstream",
+		},
+		{
+			action: &conf_v1.TransportServerAction{
+				Pass: "stable",
+				Matches: []conf_v1.TransportServerActionMatch{
+					{Pass: "canary"},
+				},
+			},
+			msg: "match without server names",
+		},
+		{
+			action: &conf_v1.TransportServerAction{
+				Pass: "stable",
+				Matches: []conf_v1.TransportServerActionMatch{
+					{ServerNames: []string{"a.example.com"}, Pass: "canary"},
+					{ServerNames: []string{"a.example.com"}, Pass: "stable"},
+				},
+			},
+			msg: "duplicate server names",
+		},
+		{
+			action: &conf_v1.TransportServerAction{
+				Pass: "stable",
+				Matches: []conf_v1.TransportServerActionMatch{
+					{ServerNames: []string{"a_example.com"}, Pass: "canary"},
+				},
+			},
+			msg: "invalid server name",
+		},
+		{
+			action: &conf_v1.TransportServerAction{
+				Pass: "stable",
+				Matches: []conf_v1.TransportServerActionMatch{
+					{ServerNames: []string{"a.example.com"}},
+				},
+			},
+			msg: "match without pass",
+		},
 	}
 
 	for _, test := range tests {
@@ -1011,6 +1430,69 @@ func TestValidateTransportServerAction_FailsOnInvalidInput(t *testing.T) {
 	}
 }
 
+func TestValidateTransportServerActionRouting_FailsOnInvalidInput(t *testing.T) {
+	t.Parallel()
+	newSpec := func() *conf_v1.TransportServerSpec {
+		return &conf_v1.TransportServerSpec{
+			Listener: conf_v1.TransportServerListener{
+				Name:     "tcp-listener",
+				Protocol: "TCP",
+			},
+			Upstreams: []conf_v1.TransportServerUpstream{
+				{Name: "stable"},
+				{Name: "canary"},
+			},
+			Action: &conf_v1.TransportServerAction{
+				Pass: "stable",
+				Matches: []conf_v1.TransportServerActionMatch{
+					{ServerNames: []string{"a.example.com"}, Pass: "canary"},
+				},
+			},
+		}
+	}
+
+	udpSpec := newSpec()
+	udpSpec.Listener.Protocol = "UDP"
+
+	tlsSpec := newSpec()
+	tlsSpec.TLS = &conf_v1.TransportServerTLS{Secret: "tls-secret"}
+
+	upstreamTLSSpec := newSpec()
+	upstreamTLSSpec.Upstreams[0].TLS = &conf_v1.TransportServerUpstreamTLS{Enable: true}
+
+	proxyProtocolSpec := newSpec()
+	proxyProtocolSpec.Upstreams[1].ProxyProtocol = true
+
+	tests := []struct {
+		spec             *conf_v1.TransportServerSpec
+		isTLSPassthrough bool
+		msg              string
+	}{
+		{spec: newSpec(), isTLSPassthrough: true, msg: "matches with the TLS Passthrough listener"},
+		{spec: udpSpec, msg: "matches with UDP"},
+		{spec: tlsSpec, msg: "matches with TLS termination"},
+		{spec: upstreamTLSSpec, msg: "upstream TLS on the default upstream"},
+		{spec: proxyProtocolSpec, msg: "proxy protocol on a matched upstream"},
+	}
+
+	for _, test := range tests {
+		allErrs := validateTransportServerActionRouting(test.spec, field.NewPath("spec"), test.isTLSPassthrough)
+		if len(allErrs) == 0 {
+			t.Errorf("validateTransportServerActionRouting() returned no errors for the case of %s", test.msg)
+		}
+	}
+
+	if allErrs := validateTransportServerActionRouting(newSpec(), field.NewPath("spec"), false); len(allErrs) > 0 {
+		t.Errorf("validateTransportServerActionRouting() returned errors %v for valid input", allErrs)
+	}
+
+	healthCheckSpec := newSpec()
+	healthCheckSpec.Upstreams[1].HealthCheck = &conf_v1.TransportServerHealthCheck{Enabled: true}
+	if allErrs := validateTransportServerActionRouting(healthCheckSpec, field.NewPath("spec"), false); len(allErrs) > 0 {
+		t.Errorf("validateTransportServerActionRouting() returned errors %v for a health check on a matched upstream", allErrs)
+	}
+}
+
 func TestValidateMatchSend(t *testing.T) {
 	t.Parallel()
 	validInput := []string{
@@ -1151,6 +1633,17 @@ func TestValidateTsTLS(t *testing.T) {
 			isTLSPassthrough: false,
 			hostSpecified:    false,
 		},
+		{
+			tls: &conf_v1.TransportServerTLS{
+				Secret:           "my-secret",
+				ClientCertSecret: "my-ca-secret",
+				CrlFileName:      "my-crl.crl",
+				VerifyClient:     "optional",
+				VerifyDepth:      createPointerFromInt(2),
+			},
+			isTLSPassthrough: false,
+			hostSpecified:    true,
+		},
 	}
 
 	for _, tc := range validTestCases {
@@ -1196,6 +1689,56 @@ func TestValidateTsTLS_FailsOnInvalidInput(t *testing.T) {
 			isTLSPassthrough: false,
 			hostSpecified:    true,
 		},
+		{
+			tls: &conf_v1.TransportServerTLS{
+				ClientCertSecret: "my-ca-secret",
+			},
+			isTLSPassthrough: false,
+			hostSpecified:    false,
+		},
+		{
+			tls: &conf_v1.TransportServerTLS{
+				Secret:           "my-secret",
+				ClientCertSecret: "-",
+			},
+			isTLSPassthrough: false,
+			hostSpecified:    true,
+		},
+		{
+			tls: &conf_v1.TransportServerTLS{
+				Secret:           "my-secret",
+				ClientCertSecret: "my-ca-secret",
+				VerifyClient:     "always",
+			},
+			isTLSPassthrough: false,
+			hostSpecified:    true,
+		},
+		{
+			tls: &conf_v1.TransportServerTLS{
+				Secret:           "my-secret",
+				ClientCertSecret: "my-ca-secret",
+				VerifyDepth:      createPointerFromInt(-1),
+			},
+			isTLSPassthrough: false,
+			hostSpecified:    true,
+		},
+		{
+			tls: &conf_v1.TransportServerTLS{
+				Secret:           "my-secret",
+				ClientCertSecret: "my-ca-secret",
+				CrlFileName:      "../my-crl.crl",
+			},
+			isTLSPassthrough: false,
+			hostSpecified:    true,
+		},
+		{
+			tls: &conf_v1.TransportServerTLS{
+				Secret:       "my-secret",
+				VerifyClient: "on",
+			},
+			isTLSPassthrough: false,
+			hostSpecified:    true,
+		},
 	}
 
 	for _, test := range invalidTLSes {
@@ -2,7 +2,9 @@ package validation
 
 import (
 	"fmt"
+	"net/http"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -112,6 +114,7 @@ func (vsv *VirtualServerValidator) validateVirtualServerSpec(spec *v1.VirtualSer
 	allErrs := field.ErrorList{}
 
 	allErrs = append(allErrs, validateHost(spec.Host, fieldPath.Child("host"))...)
+	allErrs = append(allErrs, validateServerAliases(spec.ServerAliases, spec.Host, fieldPath.Child("serverAliases"))...)
 	allErrs = append(allErrs, vsv.validateTLS(spec.TLS, fieldPath.Child("tls"))...)
 	allErrs = append(allErrs, validatePolicies(spec.Policies, fieldPath.Child("policies"), namespace)...)
 
@@ -124,6 +127,36 @@ func (vsv *VirtualServerValidator) validateVirtualServerSpec(spec *v1.VirtualSer
 
 	allErrs = append(allErrs, vsv.validateExternalDNS(&spec.ExternalDNS, fieldPath.Child("externalDNS"))...)
 
+	allErrs = append(allErrs, validateRequestID(spec.RequestID, fieldPath.Child("requestID"))...)
+
+	allErrs = append(allErrs, vsv.validateMaintenance(spec.Maintenance, fieldPath.Child("maintenance"))...)
+
+	return allErrs
+}
+
+var validRequestIDModes = map[string]bool{
+	"trust":    true,
+	"generate": true,
+}
+
+func validateRequestID(requestID *v1.RequestID, fieldPath *field.Path) field.ErrorList {
+	if requestID == nil {
+		return nil
+	}
+
+	allErrs := field.ErrorList{}
+
+	if requestID.Header != "" {
+		for _, msg := range validation.IsHTTPHeaderName(requestID.Header) {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("header"), requestID.Header, msg))
+		}
+	}
+
+	if requestID.Mode != "" && !validRequestIDModes[requestID.Mode] {
+		allErrs = append(allErrs, field.Invalid(fieldPath.Child("mode"), requestID.Mode, fmt.Sprintf("Accepted values: %s",
+			mapToPrettyString(validRequestIDModes))))
+	}
+
 	return allErrs
 }
 
@@ -148,6 +181,25 @@ func validateHost(host string, fieldPath *field.Path) field.ErrorList {
 	return allErrs
 }
 
+func validateServerAliases(aliases []string, host string, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	hosts := sets.New(host)
+
+	for i, alias := range aliases {
+		idxPath := fieldPath.Index(i)
+
+		if hosts.Has(alias) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, alias))
+			continue
+		}
+		hosts.Insert(alias)
+
+		allErrs = append(allErrs, validateHost(alias, idxPath)...)
+	}
+
+	return allErrs
+}
+
 func validatePolicies(policies []v1.PolicyReference, fieldPath *field.Path, namespace string) field.ErrorList {
 	allErrs := field.ErrorList{}
 	policyKeys := sets.Set[string]{}
@@ -466,6 +518,110 @@ func validateSessionCookie(sc *v1.SessionCookie, fieldPath *field.Path) field.Er
 	return allErrs
 }
 
+const (
+	jwtClaimFmt    = `[A-Za-z0-9_]+(\.[A-Za-z0-9_]+)*`
+	jwtClaimErrMsg = `a valid claim must consist of alphanumeric characters or '_', with nested claims separated by '.'`
+)
+
+var jwtClaimRegexp = regexp.MustCompile("^" + jwtClaimFmt + "$")
+
+// validateUpstreamAffinity implements validation rules for hash based session affinity.
+//
+// Affinity is rendered into the hash load balancing method, so it can't be combined with
+// the lb-method, sessionCookie and backup fields of the upstream.
+//
+// [Ref.]: https://nginx.org/en/docs/http/ngx_http_upstream_module.html#hash
+func validateUpstreamAffinity(u v1.Upstream, fieldPath *field.Path, isPlus bool) field.ErrorList {
+	affinity := u.Affinity
+	if affinity == nil {
+		return nil
+	}
+
+	allErrs := field.ErrorList{}
+	fieldCount := 0
+
+	if affinity.Header != "" {
+		for _, msg := range validation.IsHTTPHeaderName(affinity.Header) {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("header"), affinity.Header, msg))
+		}
+		fieldCount++
+	}
+
+	if affinity.Cookie != "" {
+		for _, msg := range isCookieName(affinity.Cookie) {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("cookie"), affinity.Cookie, msg))
+		}
+		fieldCount++
+	}
+
+	if affinity.JWTClaim != "" {
+		if !isPlus {
+			allErrs = append(allErrs, field.Forbidden(fieldPath.Child("jwtClaim"), "is only supported in NGINX Plus"))
+		} else if !jwtClaimRegexp.MatchString(affinity.JWTClaim) {
+			msg := validation.RegexError(jwtClaimErrMsg, jwtClaimFmt, "sub", "user.id")
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("jwtClaim"), affinity.JWTClaim, msg))
+		}
+		fieldCount++
+	}
+
+	if affinity.RouteCookie != nil {
+		allErrs = append(allErrs, validateAffinityRouteCookie(affinity.RouteCookie, fieldPath.Child("routeCookie"))...)
+		fieldCount++
+	}
+
+	if fieldCount != 1 {
+		allErrs = append(allErrs, field.Invalid(fieldPath, "", "must specify exactly one of: `header`, `cookie`, `jwtClaim` or `routeCookie`"))
+	}
+
+	if u.LBMethod != "" {
+		allErrs = append(allErrs, field.Forbidden(fieldPath, "affinity cannot be used along with `lb-method`"))
+	}
+
+	if u.SessionCookie != nil && u.SessionCookie.Enable {
+		allErrs = append(allErrs, field.Forbidden(fieldPath, "affinity cannot be used along with `sessionCookie`"))
+	}
+
+	if u.Backup != "" {
+		allErrs = append(allErrs, field.Forbidden(fieldPath, "affinity cannot be used along with `backup`"))
+	}
+
+	return allErrs
+}
+
+func validateAffinityRouteCookie(rc *v1.AffinityRouteCookie, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if rc.Name == "" {
+		allErrs = append(allErrs, field.Required(fieldPath.Child("name"), ""))
+	} else {
+		for _, msg := range isCookieName(rc.Name) {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("name"), rc.Name, msg))
+		}
+	}
+
+	if rc.Path != "" {
+		allErrs = append(allErrs, validatePath(rc.Path, fieldPath.Child("path"))...)
+	}
+
+	allErrs = append(allErrs, validatePositiveIntOrZeroFromPointer(rc.MaxAge, fieldPath.Child("maxAge"))...)
+
+	if rc.Domain != "" {
+		// A Domain prefix of "." is allowed.
+		domain := strings.TrimPrefix(rc.Domain, ".")
+		for _, msg := range validation.IsDNS1123Subdomain(domain) {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("domain"), rc.Domain, msg))
+		}
+	}
+
+	if rc.SameSite != "" {
+		switch strings.ToLower(rc.SameSite) {
+		case "strict", "lax", "none":
+		default:
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("samesite"), rc.SameSite, "must be one of: `strict`, `lax`, `none`"))
+		}
+	}
+	return allErrs
+}
+
 // validateUpstreamType validates that the protocol type of the upstream is of a supported protocol.
 // Current supported protocols are "http" and "grpc". If unset, it will default to "http".
 func validateUpstreamType(typeName string, fieldPath *field.Path) field.ErrorList {
@@ -643,6 +799,8 @@ func (vsv *VirtualServerValidator) validateUpstreams(upstreams []v1.Upstream, fi
 		allErrs = append(allErrs, validateSize(u.ProxyBusyBuffersSize, idxPath.Child("busy-buffers-size"))...)
 		allErrs = append(allErrs, validateQueue(u.Queue, idxPath.Child("queue"))...)
 		allErrs = append(allErrs, validateSessionCookie(u.SessionCookie, idxPath.Child("sessionCookie"))...)
+		allErrs = append(allErrs, validateUpstreamAffinity(u, idxPath.Child("affinity"), vsv.isPlus)...)
+		allErrs = append(allErrs, validateCircuitBreaker(u, idxPath.Child("circuitBreaker"), vsv.isPlus)...)
 		allErrs = append(allErrs, validateUpstreamType(u.Type, idxPath.Child("type"))...)
 
 		for _, msg := range validation.IsValidPortNum(int(u.Port)) {
@@ -657,6 +815,63 @@ func (vsv *VirtualServerValidator) validateUpstreams(upstreams []v1.Upstream, fi
 	return allErrs, upstreamNames
 }
 
+// validateCircuitBreaker implements validation rules for the circuit breaker of an upstream.
+//
+// In NGINX, the circuit breaker is rendered into the max_fails and fail_timeout parameters of the upstream servers,
+// so it can't be combined with the corresponding fields of the upstream. The latency threshold is checked against
+// the upstream statistics of the NGINX Plus API, so it is only supported in NGINX Plus.
+//
+// [Ref.]: https://nginx.org/en/docs/http/ngx_http_upstream_module.html#max_fails
+func validateCircuitBreaker(u v1.Upstream, fieldPath *field.Path, isPlus bool) field.ErrorList {
+	cb := u.CircuitBreaker
+	if cb == nil {
+		return nil
+	}
+
+	allErrs := field.ErrorList{}
+
+	if cb.Consecutive5xx != nil && *cb.Consecutive5xx < 1 {
+		allErrs = append(allErrs, field.Invalid(fieldPath.Child("consecutive5xx"), *cb.Consecutive5xx, "must be positive"))
+	}
+
+	if cb.LatencyThreshold != "" && !isPlus {
+		allErrs = append(allErrs, field.Forbidden(fieldPath.Child("latencyThreshold"), "latencyThreshold is only supported in NGINX Plus"))
+	}
+
+	allErrs = append(allErrs, validateTime(cb.LatencyThreshold, fieldPath.Child("latencyThreshold"))...)
+	allErrs = append(allErrs, validateTime(cb.EjectDuration, fieldPath.Child("ejectDuration"))...)
+
+	if cb.MaxEjectionPercent != nil {
+		if !isPlus {
+			allErrs = append(allErrs, field.Forbidden(fieldPath.Child("maxEjectionPercent"), "maxEjectionPercent is only supported in NGINX Plus"))
+		} else if *cb.MaxEjectionPercent < 0 || *cb.MaxEjectionPercent > 100 {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("maxEjectionPercent"), *cb.MaxEjectionPercent, "must be in the range 0..100"))
+		}
+	}
+
+	if !cb.Enable {
+		return allErrs
+	}
+
+	if u.ProxyNextUpstream == "off" {
+		allErrs = append(allErrs, field.Forbidden(fieldPath, "circuitBreaker can't be used with next-upstream off"))
+	}
+
+	if isPlus {
+		return allErrs
+	}
+
+	if u.MaxFails != nil {
+		allErrs = append(allErrs, field.Forbidden(fieldPath, "circuitBreaker can't be used with max-fails"))
+	}
+
+	if u.FailTimeout != "" {
+		allErrs = append(allErrs, field.Forbidden(fieldPath, "circuitBreaker can't be used with fail-timeout"))
+	}
+
+	return allErrs
+}
+
 // validateBackup validates backup service name and port semantics and business logic.
 //
 // Backup can't be used with load balancing methods: 'hash', 'hash_ip' and 'random'.
@@ -828,6 +1043,7 @@ func (vsv *VirtualServerValidator) validateRoute(route v1.Route, fieldPath *fiel
 		for i, m := range route.Matches {
 			allErrs = append(allErrs, vsv.validateMatch(m, fieldPath.Child("matches").Index(i), upstreamNames, route.Path)...)
 		}
+		allErrs = append(allErrs, validateDuplicateMatches(route.Matches, fieldPath.Child("matches"))...)
 	}
 
 	if route.RouteSelector != nil {
@@ -859,6 +1075,104 @@ func (vsv *VirtualServerValidator) validateRoute(route v1.Route, fieldPath *fiel
 
 	allErrs = append(allErrs, validateDos(vsv.isDosEnabled, route.Dos, fieldPath.Child("dos"))...)
 
+	allErrs = append(allErrs, vsv.validateMaintenance(route.Maintenance, fieldPath.Child("maintenance"))...)
+
+	return allErrs
+}
+
+const (
+	maintenanceBypassHeaderValueFmt    = `[A-Za-z0-9._~+/=-]+`
+	maintenanceBypassHeaderValueErrMsg = "must consist of alphanumeric characters or '.', '_', '~', '+', '/', '=' or '-'"
+)
+
+var maintenanceBypassHeaderValueRegexp = regexp.MustCompile("^" + maintenanceBypassHeaderValueFmt + "$")
+
+func (vsv *VirtualServerValidator) validateMaintenance(m *v1.Maintenance, fieldPath *field.Path) field.ErrorList {
+	if m == nil {
+		return nil
+	}
+
+	allErrs := field.ErrorList{}
+
+	if m.Code != 0 && (m.Code < 400 || m.Code > 599) {
+		allErrs = append(allErrs, field.Invalid(fieldPath.Child("code"), m.Code, "must be a valid status code either 4XX or 5XX, for example, 503"))
+	}
+
+	if m.Type != "" {
+		allErrs = append(allErrs, validateActionReturnType(m.Type, fieldPath.Child("type"))...)
+	}
+
+	if m.Body != "" {
+		allErrs = append(allErrs, validateEscapedStringWithVariables(m.Body, fieldPath.Child("body"), returnBodySpecialVariables, returnBodyVariables, vsv.isPlus)...)
+	}
+
+	if m.ConfigMap != nil {
+		if m.Body != "" {
+			allErrs = append(allErrs, field.Forbidden(fieldPath.Child("configMap"), "configMap can't be used along with body"))
+		}
+		allErrs = append(allErrs, validateMaintenanceConfigMap(m.ConfigMap, fieldPath.Child("configMap"))...)
+	}
+
+	if m.RetryAfter != "" {
+		if _, err := strconv.ParseUint(m.RetryAfter, 10, 32); err != nil {
+			if _, err := http.ParseTime(m.RetryAfter); err != nil {
+				allErrs = append(allErrs, field.Invalid(fieldPath.Child("retryAfter"), m.RetryAfter, "must be a number of seconds or an HTTP date, for example, 120 or Wed, 21 Oct 2015 07:28:00 GMT"))
+			}
+		}
+	}
+
+	if m.Bypass != nil {
+		allErrs = append(allErrs, validateMaintenanceBypass(m.Bypass, fieldPath.Child("bypass"))...)
+	}
+
+	return allErrs
+}
+
+func validateMaintenanceConfigMap(cm *v1.MaintenanceConfigMap, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cm.Name == "" {
+		allErrs = append(allErrs, field.Required(fieldPath.Child("name"), ""))
+	} else {
+		for _, msg := range validation.IsDNS1123Subdomain(cm.Name) {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("name"), cm.Name, msg))
+		}
+	}
+
+	if cm.Key != "" {
+		for _, msg := range validation.IsConfigMapKey(cm.Key) {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("key"), cm.Key, msg))
+		}
+	}
+
+	return allErrs
+}
+
+func validateMaintenanceBypass(bypass *v1.MaintenanceBypass, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, ipOrCIDR := range bypass.Allow {
+		allErrs = append(allErrs, validateIPorCIDR(ipOrCIDR, fieldPath.Child("allow").Index(i))...)
+	}
+
+	if bypass.Header == "" {
+		if bypass.HeaderValue != "" {
+			allErrs = append(allErrs, field.Required(fieldPath.Child("header"), "must be set along with headerValue"))
+		}
+		return allErrs
+	}
+
+	for _, msg := range validation.IsHTTPHeaderName(bypass.Header) {
+		allErrs = append(allErrs, field.Invalid(fieldPath.Child("header"), bypass.Header, msg))
+	}
+
+	if bypass.HeaderValue == "" {
+		allErrs = append(allErrs, field.Required(fieldPath.Child("headerValue"), "must be set along with header"))
+	} else if !maintenanceBypassHeaderValueRegexp.MatchString(bypass.HeaderValue) {
+		msg := validation.RegexError(maintenanceBypassHeaderValueErrMsg, maintenanceBypassHeaderValueFmt, "s3cr3t", "maintenance-token")
+		allErrs = append(allErrs, field.Invalid(fieldPath.Child("headerValue"), bypass.HeaderValue, msg))
+	}
+
 	return allErrs
 }
 
@@ -1136,6 +1450,7 @@ func (vsv *VirtualServerValidator) validateActionProxy(p *v1.ActionProxy, fieldP
 	allErrs := validateReferencedUpstream(p.Upstream, fieldPath.Child("upstream"), upstreamNames)
 	allErrs = append(allErrs, vsv.validateActionProxyRequestHeaders(p.RequestHeaders, fieldPath.Child("requestHeaders"))...)
 	allErrs = append(allErrs, vsv.validateActionProxyResponseHeaders(p.ResponseHeaders, fieldPath.Child("responseHeaders"))...)
+	allErrs = append(allErrs, vsv.validateActionProxyResponseBody(p.ResponseBody, fieldPath.Child("responseBody"))...)
 
 	if strings.HasPrefix(path, "~") || internal {
 		allErrs = append(allErrs, validateActionProxyRewritePathForRegexp(p.RewritePath, fieldPath.Child("rewritePath"))...)
@@ -1283,6 +1598,41 @@ func (vsv *VirtualServerValidator) validateActionProxyResponseHeaders(responseHe
 	return allErrs
 }
 
+const (
+	responseBodyTypeFmt    = `[A-Za-z0-9][A-Za-z0-9!#$&^_.+-]*/[A-Za-z0-9][A-Za-z0-9!#$&^_.+-]*`
+	responseBodyTypeErrMsg = "must be a MIME type or *"
+)
+
+var responseBodyTypeRegexp = regexp.MustCompile("^" + responseBodyTypeFmt + "$")
+
+func (vsv *VirtualServerValidator) validateActionProxyResponseBody(responseBody *v1.ProxyResponseBody, fieldPath *field.Path) field.ErrorList {
+	if responseBody == nil {
+		return nil
+	}
+
+	allErrs := field.ErrorList{}
+	for i, r := range responseBody.Rewrite {
+		idxPath := fieldPath.Child("rewrite").Index(i)
+		if r.Match == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("match"), ""))
+		} else {
+			allErrs = append(allErrs, validateEscapedStringWithVariables(r.Match, idxPath.Child("match"),
+				actionProxyHeaderSpecialVariables, actionProxyHeaderVariables, vsv.isPlus)...)
+		}
+		allErrs = append(allErrs, validateEscapedStringWithVariables(r.Replacement, idxPath.Child("replacement"),
+			actionProxyHeaderSpecialVariables, actionProxyHeaderVariables, vsv.isPlus)...)
+	}
+
+	for i, t := range responseBody.Types {
+		if t != "*" && !responseBodyTypeRegexp.MatchString(t) {
+			msg := validation.RegexError(responseBodyTypeErrMsg, responseBodyTypeFmt, "text/css", "application/json")
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("types").Index(i), t, msg))
+		}
+	}
+
+	return allErrs
+}
+
 var validIgnoreHeaders = map[string]bool{
 	"X-Accel-Redirect":   true,
 	"X-Accel-Expires":    true,
@@ -1418,6 +1768,7 @@ func (vsv *VirtualServerValidator) validateMatch(match v1.Match, fieldPath *fiel
 		for i, c := range match.Conditions {
 			allErrs = append(allErrs, validateCondition(c, fieldPath.Child("conditions").Index(i))...)
 		}
+		allErrs = append(allErrs, validateConflictingConditions(match.Conditions, fieldPath.Child("conditions"))...)
 	}
 
 	fieldCount := 0
@@ -1440,6 +1791,10 @@ func (vsv *VirtualServerValidator) validateMatch(match v1.Match, fieldPath *fiel
 }
 
 func validateCondition(condition v1.Condition, fieldPath *field.Path) field.ErrorList {
+	if len(condition.AnyOf) > 0 {
+		return validateAnyOfCondition(condition, fieldPath)
+	}
+
 	allErrs := field.ErrorList{}
 
 	fieldCount := 0
@@ -1470,12 +1825,202 @@ func validateCondition(condition v1.Condition, fieldPath *field.Path) field.Erro
 		fieldCount++
 	}
 
+	if condition.Method != "" {
+		if !validMatchMethods[condition.Method] {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("method"), condition.Method, "must be an HTTP method in upper case, for example, GET"))
+		}
+		if condition.Value != "" {
+			allErrs = append(allErrs, field.Forbidden(fieldPath.Child("value"), "cannot be used together with `method`"))
+		}
+		if condition.Operator != "" {
+			allErrs = append(allErrs, field.Forbidden(fieldPath.Child("operator"), "cannot be used together with `method`"))
+		}
+		fieldCount++
+	}
+
 	if fieldCount != 1 {
-		allErrs = append(allErrs, field.Invalid(fieldPath, "", "must specify exactly one of: `header`, `cookie`, `argument` or `variable`"))
+		allErrs = append(allErrs, field.Invalid(fieldPath, "", "must specify exactly one of: `header`, `cookie`, `argument`, `variable`, `method` or `anyOf`"))
 	}
 
-	for _, msg := range isValidMatchValue(condition.Value) {
-		allErrs = append(allErrs, field.Invalid(fieldPath.Child("value"), condition.Value, msg))
+	switch condition.Operator {
+	case "", conditionOperatorEquals:
+		for _, msg := range isValidMatchValue(condition.Value) {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("value"), condition.Value, msg))
+		}
+	case conditionOperatorRegex:
+		allErrs = append(allErrs, validateRegexMatchValue(condition.Value, fieldPath.Child("value"))...)
+	case conditionOperatorPresent, conditionOperatorAbsent:
+		if condition.Value != "" {
+			allErrs = append(allErrs, field.Forbidden(fieldPath.Child("value"), fmt.Sprintf("cannot be used with the operator `%s`", condition.Operator)))
+		}
+	default:
+		allErrs = append(allErrs, field.Invalid(fieldPath.Child("operator"), condition.Operator, "must be one of: `equals`, `regex`, `present` or `absent`"))
+	}
+
+	return allErrs
+}
+
+const (
+	conditionOperatorEquals  = "equals"
+	conditionOperatorRegex   = "regex"
+	conditionOperatorPresent = "present"
+	conditionOperatorAbsent  = "absent"
+)
+
+var validMatchMethods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodPost:    true,
+	http.MethodPut:     true,
+	http.MethodPatch:   true,
+	http.MethodDelete:  true,
+	http.MethodConnect: true,
+	http.MethodOptions: true,
+	http.MethodTrace:   true,
+}
+
+func validateAnyOfCondition(condition v1.Condition, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if condition.Header != "" || condition.Cookie != "" || condition.Argument != "" || condition.Variable != "" ||
+		condition.Method != "" || condition.Value != "" || condition.Operator != "" {
+		allErrs = append(allErrs, field.Invalid(fieldPath, "", "must not specify other fields together with `anyOf`"))
+	}
+
+	for i, alt := range condition.AnyOf {
+		allErrs = append(allErrs, validateCondition(conditionFromAlternative(alt), fieldPath.Child("anyOf").Index(i))...)
+	}
+
+	return allErrs
+}
+
+// conditionFromAlternative converts an alternative of an anyOf condition into a condition.
+func conditionFromAlternative(alt v1.ConditionAlternative) v1.Condition {
+	return v1.Condition{
+		Header:   alt.Header,
+		Cookie:   alt.Cookie,
+		Argument: alt.Argument,
+		Variable: alt.Variable,
+		Method:   alt.Method,
+		Value:    alt.Value,
+		Operator: alt.Operator,
+	}
+}
+
+func validateRegexMatchValue(value string, fieldPath *field.Path) field.ErrorList {
+	for _, msg := range isValidMatchValue(value) {
+		return field.ErrorList{field.Invalid(fieldPath, value, msg)}
+	}
+
+	regex := strings.TrimPrefix(value, "!")
+	if regex == "" {
+		return field.ErrorList{field.Required(fieldPath, "must specify a regular expression for the operator `regex`")}
+	}
+	if _, err := regexp2.Compile(regex, 0); err != nil {
+		return field.ErrorList{field.Invalid(fieldPath, value, fmt.Sprintf("must be a valid regular expression: %v", err))}
+	}
+
+	return nil
+}
+
+// getConditionSubject returns the part of the request that the condition checks, for example, "header:user-agent".
+func getConditionSubject(condition v1.Condition) string {
+	switch {
+	case condition.Header != "":
+		return "header:" + strings.ToLower(condition.Header)
+	case condition.Cookie != "":
+		return "cookie:" + condition.Cookie
+	case condition.Argument != "":
+		return "argument:" + condition.Argument
+	case condition.Variable != "":
+		return "variable:" + condition.Variable
+	case condition.Method != "":
+		return "variable:$request_method"
+	}
+	return ""
+}
+
+// getConditionExpectedValue returns the value that the condition requires, if the condition is satisfied by a single value only.
+// The empty value stands for the absent header, cookie, argument or variable.
+func getConditionExpectedValue(condition v1.Condition) (string, bool) {
+	switch {
+	case condition.Method != "":
+		return condition.Method, true
+	case condition.Operator == conditionOperatorAbsent:
+		return "", true
+	case condition.Operator == "" || condition.Operator == conditionOperatorEquals:
+		if !strings.HasPrefix(condition.Value, "!") {
+			return condition.Value, true
+		}
+	}
+	return "", false
+}
+
+// areConditionsConflicting checks if two conditions of the same subject can never be satisfied together.
+func areConditionsConflicting(c1 v1.Condition, c2 v1.Condition) bool {
+	value1, single1 := getConditionExpectedValue(c1)
+	value2, single2 := getConditionExpectedValue(c2)
+
+	if single1 && single2 {
+		return value1 != value2
+	}
+
+	// a present condition conflicts with the absent one and with the negated empty value
+	isEmptyExcluded := func(c v1.Condition) bool {
+		return c.Operator == conditionOperatorPresent || ((c.Operator == "" || c.Operator == conditionOperatorEquals) && c.Value == "!")
+	}
+	if (single1 && value1 == "" && isEmptyExcluded(c2)) || (single2 && value2 == "" && isEmptyExcluded(c1)) {
+		return true
+	}
+
+	// a negated value conflicts with the same value
+	if single1 && (c2.Operator == "" || c2.Operator == conditionOperatorEquals) && c2.Value == "!"+value1 {
+		return true
+	}
+	return single2 && (c1.Operator == "" || c1.Operator == conditionOperatorEquals) && c1.Value == "!"+value2
+}
+
+func validateConflictingConditions(conditions []v1.Condition, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for j, c := range conditions {
+		subject := getConditionSubject(c)
+		if subject == "" {
+			continue
+		}
+
+		for i := 0; i < j; i++ {
+			if getConditionSubject(conditions[i]) == subject && areConditionsConflicting(conditions[i], c) {
+				allErrs = append(allErrs, field.Invalid(fieldPath.Index(j), "", fmt.Sprintf("conflicts with condition %d, so the match can never be satisfied", i)))
+				break
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// getMatchConditionsKey returns a key of the conditions of a match, which does not depend on the order of the conditions.
+func getMatchConditionsKey(match v1.Match) string {
+	keys := make([]string, 0, len(match.Conditions))
+	for _, c := range match.Conditions {
+		keys = append(keys, fmt.Sprintf("%+v", c))
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ";")
+}
+
+func validateDuplicateMatches(matches []v1.Match, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	keys := make(map[string]int)
+	for i, m := range matches {
+		key := getMatchConditionsKey(m)
+		if prev, exists := keys[key]; exists {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Index(i).Child("conditions"), "", fmt.Sprintf("duplicates the conditions of match %d, so the match can never be used", prev)))
+			continue
+		}
+		keys[key] = i
 	}
 
 	return allErrs
@@ -237,6 +237,36 @@ func TestValidateHost(t *testing.T) {
 	}
 }
 
+func TestValidateServerAliases(t *testing.T) {
+	t.Parallel()
+	validAliases := [][]string{
+		nil,
+		{"www.example.com"},
+		{"www.example.com", "*.example.org"},
+	}
+
+	for _, aliases := range validAliases {
+		allErrs := validateServerAliases(aliases, "example.com", field.NewPath("serverAliases"))
+		if len(allErrs) > 0 {
+			t.Errorf("validateServerAliases(%v) returned errors %v for valid input", aliases, allErrs)
+		}
+	}
+
+	invalidAliases := [][]string{
+		{""},
+		{"example.com"},
+		{"www.example.com", "www.example.com"},
+		{"-www.example.com"},
+	}
+
+	for _, aliases := range invalidAliases {
+		allErrs := validateServerAliases(aliases, "example.com", field.NewPath("serverAliases"))
+		if len(allErrs) == 0 {
+			t.Errorf("validateServerAliases(%v) returned no errors for invalid input", aliases)
+		}
+	}
+}
+
 func TestValidateDos(t *testing.T) {
 	t.Parallel()
 	validDosResources := []string{
@@ -2006,6 +2036,48 @@ func TestValidateCondition(t *testing.T) {
 			},
 			msg: "valid variable",
 		},
+		{
+			condition: v1.Condition{
+				Method: "POST",
+			},
+			msg: "valid method",
+		},
+		{
+			condition: v1.Condition{
+				Header:   "x-version",
+				Operator: "present",
+			},
+			msg: "valid present operator",
+		},
+		{
+			condition: v1.Condition{
+				Argument: "debug",
+				Operator: "absent",
+			},
+			msg: "valid absent operator",
+		},
+		{
+			condition: v1.Condition{
+				Header:   "user-agent",
+				Value:    "!^curl/[0-9.]+$",
+				Operator: "regex",
+			},
+			msg: "valid regex operator",
+		},
+		{
+			condition: v1.Condition{
+				AnyOf: []v1.ConditionAlternative{
+					{
+						Method: "GET",
+					},
+					{
+						Cookie: "version",
+						Value:  "v2",
+					},
+				},
+			},
+			msg: "valid anyOf",
+		},
 	}
 
 	for _, test := range tests {
@@ -2060,6 +2132,74 @@ func TestValidateConditionFails(t *testing.T) {
 			},
 			msg: "invalid variable",
 		},
+		{
+			condition: v1.Condition{
+				Method: "get",
+			},
+			msg: "invalid method",
+		},
+		{
+			condition: v1.Condition{
+				Method: "GET",
+				Value:  "POST",
+			},
+			msg: "method with value",
+		},
+		{
+			condition: v1.Condition{
+				Header:   "x-version",
+				Operator: "contains",
+			},
+			msg: "invalid operator",
+		},
+		{
+			condition: v1.Condition{
+				Header:   "x-version",
+				Value:    "v1",
+				Operator: "present",
+			},
+			msg: "present operator with value",
+		},
+		{
+			condition: v1.Condition{
+				Header:   "x-version",
+				Value:    "v[1",
+				Operator: "regex",
+			},
+			msg: "invalid regex",
+		},
+		{
+			condition: v1.Condition{
+				Header:   "x-version",
+				Operator: "regex",
+			},
+			msg: "empty regex",
+		},
+		{
+			condition: v1.Condition{
+				Header: "x-version",
+				Value:  "v1",
+				AnyOf: []v1.ConditionAlternative{
+					{
+						Method: "GET",
+					},
+				},
+			},
+			msg: "anyOf with other fields",
+		},
+		{
+			condition: v1.Condition{
+				AnyOf: []v1.ConditionAlternative{
+					{
+						Method: "GET",
+					},
+					{
+						Cookie: "my-cookie",
+					},
+				},
+			},
+			msg: "invalid anyOf alternative",
+		},
 	}
 
 	for _, test := range tests {
@@ -2070,6 +2210,85 @@ func TestValidateConditionFails(t *testing.T) {
 	}
 }
 
+func TestValidateConflictingConditions(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		conditions []v1.Condition
+		expected   int
+		msg        string
+	}{
+		{
+			conditions: []v1.Condition{
+				{Header: "x-version", Operator: "present"},
+				{Header: "x-version", Value: "!v1"},
+				{Method: "GET"},
+				{Cookie: "user", Value: "john"},
+			},
+			expected: 0,
+			msg:      "no conflicts",
+		},
+		{
+			conditions: []v1.Condition{
+				{Header: "X-Version", Operator: "present"},
+				{Header: "x-version", Operator: "absent"},
+			},
+			expected: 1,
+			msg:      "present and absent",
+		},
+		{
+			conditions: []v1.Condition{
+				{Argument: "debug", Operator: "absent"},
+				{Argument: "debug", Value: "true"},
+			},
+			expected: 1,
+			msg:      "absent and value",
+		},
+		{
+			conditions: []v1.Condition{
+				{Method: "GET"},
+				{Variable: "$request_method", Value: "POST"},
+			},
+			expected: 1,
+			msg:      "different values",
+		},
+		{
+			conditions: []v1.Condition{
+				{Cookie: "user", Value: "john"},
+				{Cookie: "user", Value: "!john"},
+			},
+			expected: 1,
+			msg:      "value and negated value",
+		},
+	}
+
+	for _, test := range tests {
+		allErrs := validateConflictingConditions(test.conditions, field.NewPath("conditions"))
+		if len(allErrs) != test.expected {
+			t.Errorf("validateConflictingConditions() returned %d errors %v but expected %d for the case of %s", len(allErrs), allErrs, test.expected, test.msg)
+		}
+	}
+}
+
+func TestValidateDuplicateMatches(t *testing.T) {
+	t.Parallel()
+	matches := []v1.Match{
+		{
+			Conditions: []v1.Condition{{Header: "x-version", Value: "v1"}, {Method: "GET"}},
+		},
+		{
+			Conditions: []v1.Condition{{Header: "x-version", Value: "v2"}},
+		},
+		{
+			Conditions: []v1.Condition{{Method: "GET"}, {Header: "x-version", Value: "v1"}},
+		},
+	}
+
+	allErrs := validateDuplicateMatches(matches, field.NewPath("matches"))
+	if len(allErrs) != 1 || allErrs[0].Field != "matches[2].conditions" {
+		t.Errorf("validateDuplicateMatches() returned errors %v but expected one error for matches[2].conditions", allErrs)
+	}
+}
+
 func TestIsCookieName_ErrorsOnInvalidInput(t *testing.T) {
 	t.Parallel()
 
@@ -3470,6 +3689,389 @@ func TestValidateSessionCookie_FailsOnInvalidInput(t *testing.T) {
 	}
 }
 
+func TestValidateRequestID(t *testing.T) {
+	t.Parallel()
+	tests := []*v1.RequestID{
+		nil,
+		{
+			Enable: true,
+		},
+		{
+			Enable: true,
+			Header: "X-Correlation-ID",
+			Mode:   "generate",
+			Echo:   boolPtr(true),
+		},
+		{
+			Enable: false,
+			Mode:   "trust",
+		},
+	}
+	for _, test := range tests {
+		allErrs := validateRequestID(test, field.NewPath("requestID"))
+		if len(allErrs) != 0 {
+			t.Errorf("validateRequestID(%+v) returned errors %v for valid input", test, allErrs)
+		}
+	}
+}
+
+func TestValidateRequestID_FailsOnInvalidInput(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		requestID *v1.RequestID
+		msg       string
+	}{
+		{
+			requestID: &v1.RequestID{Enable: true, Header: "X Request ID"},
+			msg:       "invalid header",
+		},
+		{
+			requestID: &v1.RequestID{Enable: true, Mode: "random"},
+			msg:       "invalid mode",
+		},
+	}
+	for _, test := range tests {
+		allErrs := validateRequestID(test.requestID, field.NewPath("requestID"))
+		if len(allErrs) == 0 {
+			t.Errorf("validateRequestID() returned no errors for invalid input for the case of %s", test.msg)
+		}
+	}
+}
+
+func TestValidateUpstreamAffinity(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		upstream v1.Upstream
+		isPlus   bool
+		msg      string
+	}{
+		{
+			upstream: v1.Upstream{Affinity: &v1.UpstreamAffinity{Header: "X-User-ID"}},
+			msg:      "affinity by header",
+		},
+		{
+			upstream: v1.Upstream{Affinity: &v1.UpstreamAffinity{Cookie: "session_id"}},
+			msg:      "affinity by cookie",
+		},
+		{
+			upstream: v1.Upstream{Affinity: &v1.UpstreamAffinity{JWTClaim: "user.id"}},
+			isPlus:   true,
+			msg:      "affinity by nested jwt claim in NGINX Plus",
+		},
+		{
+			upstream: v1.Upstream{
+				Affinity: &v1.UpstreamAffinity{
+					RouteCookie: &v1.AffinityRouteCookie{
+						Name: "route", Path: "/tea", MaxAge: createPointerFromInt(3600), Domain: ".example.com", HTTPOnly: true, Secure: true, SameSite: "Lax",
+					},
+				},
+			},
+			msg: "affinity by route cookie",
+		},
+	}
+	for _, test := range tests {
+		allErrs := validateUpstreamAffinity(test.upstream, field.NewPath("affinity"), test.isPlus)
+		if len(allErrs) != 0 {
+			t.Errorf("validateUpstreamAffinity() returned errors %v for valid input for the case of: %s", allErrs, test.msg)
+		}
+	}
+}
+
+func TestValidateUpstreamAffinity_FailsOnInvalidInput(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		upstream v1.Upstream
+		isPlus   bool
+		msg      string
+	}{
+		{
+			upstream: v1.Upstream{Affinity: &v1.UpstreamAffinity{}},
+			msg:      "no hash key",
+		},
+		{
+			upstream: v1.Upstream{Affinity: &v1.UpstreamAffinity{Header: "X-User-ID", Cookie: "session_id"}},
+			msg:      "multiple hash keys",
+		},
+		{
+			upstream: v1.Upstream{Affinity: &v1.UpstreamAffinity{Header: "X User"}},
+			msg:      "invalid header name",
+		},
+		{
+			upstream: v1.Upstream{Affinity: &v1.UpstreamAffinity{Cookie: "$ession"}},
+			msg:      "invalid cookie name",
+		},
+		{
+			upstream: v1.Upstream{Affinity: &v1.UpstreamAffinity{JWTClaim: "sub"}},
+			msg:      "jwt claim in NGINX OSS",
+		},
+		{
+			upstream: v1.Upstream{Affinity: &v1.UpstreamAffinity{JWTClaim: "user..id"}},
+			isPlus:   true,
+			msg:      "invalid jwt claim",
+		},
+		{
+			upstream: v1.Upstream{Affinity: &v1.UpstreamAffinity{RouteCookie: &v1.AffinityRouteCookie{}}},
+			msg:      "route cookie without name",
+		},
+		{
+			upstream: v1.Upstream{Affinity: &v1.UpstreamAffinity{RouteCookie: &v1.AffinityRouteCookie{Name: "route", MaxAge: createPointerFromInt(-1)}}},
+			msg:      "route cookie with negative max age",
+		},
+		{
+			upstream: v1.Upstream{Affinity: &v1.UpstreamAffinity{RouteCookie: &v1.AffinityRouteCookie{Name: "route", SameSite: "bogus_value"}}},
+			msg:      "route cookie with invalid samesite value",
+		},
+		{
+			upstream: v1.Upstream{LBMethod: "least_conn", Affinity: &v1.UpstreamAffinity{Header: "X-User-ID"}},
+			msg:      "affinity with lb-method",
+		},
+		{
+			upstream: v1.Upstream{
+				SessionCookie: &v1.SessionCookie{Enable: true, Name: "srv_id"},
+				Affinity:      &v1.UpstreamAffinity{Header: "X-User-ID"},
+			},
+			isPlus: true,
+			msg:    "affinity with session cookie",
+		},
+		{
+			upstream: v1.Upstream{Backup: "backup-svc", Affinity: &v1.UpstreamAffinity{Header: "X-User-ID"}},
+			isPlus:   true,
+			msg:      "affinity with backup",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			allErrs := validateUpstreamAffinity(test.upstream, field.NewPath("affinity"), test.isPlus)
+			if len(allErrs) == 0 {
+				t.Errorf("validateUpstreamAffinity() did not return errors for invalid input for the case of: %s", test.msg)
+			}
+		})
+	}
+}
+
+func TestValidateCircuitBreaker(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		upstream v1.Upstream
+		isPlus   bool
+		msg      string
+	}{
+		{
+			upstream: v1.Upstream{CircuitBreaker: &v1.CircuitBreaker{Enable: true}},
+			msg:      "circuit breaker with defaults",
+		},
+		{
+			upstream: v1.Upstream{
+				ProxyNextUpstream: "error http_502",
+				CircuitBreaker: &v1.CircuitBreaker{
+					Enable: true, Consecutive5xx: createPointerFromInt(3), EjectDuration: "1m",
+				},
+			},
+			msg: "circuit breaker with all NGINX fields",
+		},
+		{
+			upstream: v1.Upstream{
+				ProxyReadTimeout: "10s",
+				CircuitBreaker:   &v1.CircuitBreaker{Enable: true, LatencyThreshold: "2s"},
+			},
+			isPlus: true,
+			msg:    "latency threshold with read-timeout in NGINX Plus",
+		},
+		{
+			upstream: v1.Upstream{
+				MaxFails:    createPointerFromInt(2),
+				FailTimeout: "10s",
+				CircuitBreaker: &v1.CircuitBreaker{
+					Enable: true, MaxEjectionPercent: createPointerFromInt(50),
+				},
+			},
+			isPlus: true,
+			msg:    "circuit breaker with max-fails, fail-timeout and maxEjectionPercent in NGINX Plus",
+		},
+		{
+			upstream: v1.Upstream{MaxFails: createPointerFromInt(2), CircuitBreaker: &v1.CircuitBreaker{Consecutive5xx: createPointerFromInt(3)}},
+			msg:      "disabled circuit breaker with max-fails",
+		},
+	}
+	for _, test := range tests {
+		allErrs := validateCircuitBreaker(test.upstream, field.NewPath("circuitBreaker"), test.isPlus)
+		if len(allErrs) != 0 {
+			t.Errorf("validateCircuitBreaker() returned errors %v for valid input for the case of: %s", allErrs, test.msg)
+		}
+	}
+}
+
+func TestValidateCircuitBreaker_FailsOnInvalidInput(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		upstream v1.Upstream
+		isPlus   bool
+		msg      string
+	}{
+		{
+			upstream: v1.Upstream{CircuitBreaker: &v1.CircuitBreaker{Enable: true, Consecutive5xx: createPointerFromInt(0)}},
+			msg:      "zero consecutive5xx",
+		},
+		{
+			upstream: v1.Upstream{CircuitBreaker: &v1.CircuitBreaker{Enable: true, LatencyThreshold: "fast"}},
+			isPlus:   true,
+			msg:      "invalid latency threshold",
+		},
+		{
+			upstream: v1.Upstream{CircuitBreaker: &v1.CircuitBreaker{Enable: true, EjectDuration: "-1s"}},
+			msg:      "invalid eject duration",
+		},
+		{
+			upstream: v1.Upstream{CircuitBreaker: &v1.CircuitBreaker{Enable: true, MaxEjectionPercent: createPointerFromInt(50)}},
+			msg:      "maxEjectionPercent in NGINX OSS",
+		},
+		{
+			upstream: v1.Upstream{CircuitBreaker: &v1.CircuitBreaker{Enable: true, MaxEjectionPercent: createPointerFromInt(101)}},
+			isPlus:   true,
+			msg:      "maxEjectionPercent out of range",
+		},
+		{
+			upstream: v1.Upstream{CircuitBreaker: &v1.CircuitBreaker{Enable: true, LatencyThreshold: "2s"}},
+			msg:      "latency threshold in NGINX OSS",
+		},
+		{
+			upstream: v1.Upstream{ProxyNextUpstream: "off", CircuitBreaker: &v1.CircuitBreaker{Enable: true}},
+			isPlus:   true,
+			msg:      "circuit breaker with next-upstream off",
+		},
+		{
+			upstream: v1.Upstream{MaxFails: createPointerFromInt(2), CircuitBreaker: &v1.CircuitBreaker{Enable: true}},
+			msg:      "circuit breaker with max-fails in NGINX OSS",
+		},
+		{
+			upstream: v1.Upstream{FailTimeout: "10s", CircuitBreaker: &v1.CircuitBreaker{Enable: true}},
+			msg:      "circuit breaker with fail-timeout in NGINX OSS",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			allErrs := validateCircuitBreaker(test.upstream, field.NewPath("circuitBreaker"), test.isPlus)
+			if len(allErrs) == 0 {
+				t.Errorf("validateCircuitBreaker() did not return errors for invalid input for the case of: %s", test.msg)
+			}
+		})
+	}
+}
+
+func TestValidateMaintenance(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		maintenance *v1.Maintenance
+		msg         string
+	}{
+		{
+			maintenance: nil,
+			msg:         "no maintenance",
+		},
+		{
+			maintenance: &v1.Maintenance{Enable: true},
+			msg:         "maintenance with defaults",
+		},
+		{
+			maintenance: &v1.Maintenance{
+				Enable:     true,
+				Code:       502,
+				Type:       "application/json",
+				Body:       `{\"status\": \"maintenance\"}`,
+				RetryAfter: "120",
+			},
+			msg: "maintenance with body",
+		},
+		{
+			maintenance: &v1.Maintenance{
+				ConfigMap:  &v1.MaintenanceConfigMap{Name: "maintenance-page", Key: "page.html"},
+				RetryAfter: "Wed, 21 Oct 2015 07:28:00 GMT",
+			},
+			msg: "disabled maintenance with ConfigMap",
+		},
+		{
+			maintenance: &v1.Maintenance{
+				Enable: true,
+				Bypass: &v1.MaintenanceBypass{
+					Allow:       []string{"10.0.0.0/8", "192.168.1.1"},
+					Header:      "X-Maintenance-Bypass",
+					HeaderValue: "s3cr3t",
+				},
+			},
+			msg: "maintenance with bypass",
+		},
+	}
+	vsv := &VirtualServerValidator{isPlus: false}
+	for _, test := range tests {
+		allErrs := vsv.validateMaintenance(test.maintenance, field.NewPath("maintenance"))
+		if len(allErrs) != 0 {
+			t.Errorf("validateMaintenance() returned errors %v for valid input for the case of: %s", allErrs, test.msg)
+		}
+	}
+}
+
+func TestValidateMaintenance_FailsOnInvalidInput(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		maintenance *v1.Maintenance
+		msg         string
+	}{
+		{
+			maintenance: &v1.Maintenance{Code: 200},
+			msg:         "invalid code",
+		},
+		{
+			maintenance: &v1.Maintenance{Type: "text\"html"},
+			msg:         "invalid type",
+		},
+		{
+			maintenance: &v1.Maintenance{Body: `unescaped "quote"`},
+			msg:         "invalid body",
+		},
+		{
+			maintenance: &v1.Maintenance{Body: "maintenance", ConfigMap: &v1.MaintenanceConfigMap{Name: "maintenance-page"}},
+			msg:         "body along with configMap",
+		},
+		{
+			maintenance: &v1.Maintenance{ConfigMap: &v1.MaintenanceConfigMap{}},
+			msg:         "configMap without name",
+		},
+		{
+			maintenance: &v1.Maintenance{ConfigMap: &v1.MaintenanceConfigMap{Name: "maintenance-page", Key: "page/html"}},
+			msg:         "invalid configMap key",
+		},
+		{
+			maintenance: &v1.Maintenance{RetryAfter: "2 minutes"},
+			msg:         "invalid retryAfter",
+		},
+		{
+			maintenance: &v1.Maintenance{Bypass: &v1.MaintenanceBypass{Allow: []string{"10.0.0.0/33"}}},
+			msg:         "invalid bypass allow",
+		},
+		{
+			maintenance: &v1.Maintenance{Bypass: &v1.MaintenanceBypass{Header: "X-Maintenance-Bypass"}},
+			msg:         "bypass header without value",
+		},
+		{
+			maintenance: &v1.Maintenance{Bypass: &v1.MaintenanceBypass{HeaderValue: "s3cr3t"}},
+			msg:         "bypass header value without header",
+		},
+		{
+			maintenance: &v1.Maintenance{Bypass: &v1.MaintenanceBypass{Header: "X-Maintenance-Bypass", HeaderValue: "s3cr3t;"}},
+			msg:         "invalid bypass header value",
+		},
+	}
+	vsv := &VirtualServerValidator{isPlus: false}
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			allErrs := vsv.validateMaintenance(test.maintenance, field.NewPath("maintenance"))
+			if len(allErrs) == 0 {
+				t.Errorf("validateMaintenance() did not return errors for invalid input for the case of: %s", test.msg)
+			}
+		})
+	}
+}
+
 func TestValidateRedirectStatusCode(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -4161,6 +4763,107 @@ func TestValidateActionProxyResponseHeadersFails(t *testing.T) {
 	}
 }
 
+func TestValidateActionProxyResponseBody(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		responseBody *v1.ProxyResponseBody
+	}{
+		{
+			responseBody: nil,
+		},
+		{
+			responseBody: &v1.ProxyResponseBody{
+				Rewrite: []v1.ResponseBodyRewrite{
+					{
+						Match:       "http://backend.internal",
+						Replacement: "https://${host}",
+					},
+					{
+						Match: `<script src=\"/debug.js\"></script>`,
+					},
+				},
+				Types: []string{"text/css", "application/javascript"},
+				Once:  true,
+			},
+		},
+		{
+			responseBody: &v1.ProxyResponseBody{
+				Rewrite: []v1.ResponseBodyRewrite{
+					{
+						Match:       "backend.internal",
+						Replacement: "cafe.example.com",
+					},
+				},
+				Types: []string{"*"},
+			},
+		},
+	}
+
+	vsv := &VirtualServerValidator{isPlus: false}
+
+	for _, test := range tests {
+		allErrs := vsv.validateActionProxyResponseBody(test.responseBody, field.NewPath("responseBody"))
+		if len(allErrs) != 0 {
+			t.Errorf("validateActionProxyResponseBody(%v) returned errors for valid input: %v", test.responseBody, allErrs)
+		}
+	}
+}
+
+func TestValidateActionProxyResponseBodyFails(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		responseBody *v1.ProxyResponseBody
+		msg          string
+	}{
+		{
+			responseBody: &v1.ProxyResponseBody{
+				Rewrite: []v1.ResponseBodyRewrite{
+					{
+						Replacement: "cafe.example.com",
+					},
+				},
+			},
+			msg: "missing match",
+		},
+		{
+			responseBody: &v1.ProxyResponseBody{
+				Rewrite: []v1.ResponseBodyRewrite{
+					{
+						Match:       "backend.internal",
+						Replacement: "${invalid}",
+					},
+				},
+			},
+			msg: "invalid variable in replacement",
+		},
+		{
+			responseBody: &v1.ProxyResponseBody{
+				Rewrite: []v1.ResponseBodyRewrite{
+					{
+						Match: `unescaped "quote"`,
+					},
+				},
+			},
+			msg: "unescaped quote in match",
+		},
+		{
+			responseBody: &v1.ProxyResponseBody{
+				Types: []string{"text/css;"},
+			},
+			msg: "invalid type",
+		},
+	}
+
+	vsv := &VirtualServerValidator{isPlus: false}
+
+	for _, test := range tests {
+		allErrs := vsv.validateActionProxyResponseBody(test.responseBody, field.NewPath("responseBody"))
+		if len(allErrs) == 0 {
+			t.Errorf("validateActionProxyResponseBody(%v) returned no errors for invalid input for the case of %v", test.responseBody, test.msg)
+		}
+	}
+}
+
 func TestValidateIgnoreHeaders(t *testing.T) {
 	t.Parallel()
 	var ignoreHeaders []string
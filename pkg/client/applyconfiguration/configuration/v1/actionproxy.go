@@ -15,6 +15,8 @@ type ActionProxyApplyConfiguration struct {
 	RequestHeaders *ProxyRequestHeadersApplyConfiguration `json:"requestHeaders,omitempty"`
 	// The response headers modifications.
 	ResponseHeaders *ProxyResponseHeadersApplyConfiguration `json:"responseHeaders,omitempty"`
+	// The response body modifications.
+	ResponseBody *ProxyResponseBodyApplyConfiguration `json:"responseBody,omitempty"`
 }
 
 // ActionProxyApplyConfiguration constructs a declarative configuration of the ActionProxy type for use with
@@ -54,3 +56,11 @@ func (b *ActionProxyApplyConfiguration) WithResponseHeaders(value *ProxyResponse
 	b.ResponseHeaders = value
 	return b
 }
+
+// WithResponseBody sets the ResponseBody field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResponseBody field is set to the value of the last call.
+func (b *ActionProxyApplyConfiguration) WithResponseBody(value *ProxyResponseBodyApplyConfiguration) *ActionProxyApplyConfiguration {
+	b.ResponseBody = value
+	return b
+}
@@ -0,0 +1,86 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// AffinityRouteCookieApplyConfiguration represents a declarative configuration of the AffinityRouteCookie type for use
+// with apply.
+//
+// AffinityRouteCookie defines the parameters of a route cookie generated by NGINX for session affinity.
+type AffinityRouteCookieApplyConfiguration struct {
+	// The name of the cookie.
+	Name *string `json:"name,omitempty"`
+	// The path for which the cookie is set. The default is /.
+	Path *string `json:"path,omitempty"`
+	// The number of seconds until the cookie expires. By default, the cookie is a session cookie.
+	MaxAge *int `json:"maxAge,omitempty"`
+	// The domain for which the cookie is set.
+	Domain *string `json:"domain,omitempty"`
+	// Adds the HttpOnly attribute to the cookie.
+	HTTPOnly *bool `json:"httpOnly,omitempty"`
+	// Adds the Secure attribute to the cookie.
+	Secure *bool `json:"secure,omitempty"`
+	// Adds the SameSite attribute to the cookie. The allowed values are: strict, lax, none
+	SameSite *string `json:"samesite,omitempty"`
+}
+
+// AffinityRouteCookieApplyConfiguration constructs a declarative configuration of the AffinityRouteCookie type for use with
+// apply.
+func AffinityRouteCookie() *AffinityRouteCookieApplyConfiguration {
+	return &AffinityRouteCookieApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *AffinityRouteCookieApplyConfiguration) WithName(value string) *AffinityRouteCookieApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithPath sets the Path field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Path field is set to the value of the last call.
+func (b *AffinityRouteCookieApplyConfiguration) WithPath(value string) *AffinityRouteCookieApplyConfiguration {
+	b.Path = &value
+	return b
+}
+
+// WithMaxAge sets the MaxAge field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxAge field is set to the value of the last call.
+func (b *AffinityRouteCookieApplyConfiguration) WithMaxAge(value int) *AffinityRouteCookieApplyConfiguration {
+	b.MaxAge = &value
+	return b
+}
+
+// WithDomain sets the Domain field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Domain field is set to the value of the last call.
+func (b *AffinityRouteCookieApplyConfiguration) WithDomain(value string) *AffinityRouteCookieApplyConfiguration {
+	b.Domain = &value
+	return b
+}
+
+// WithHTTPOnly sets the HTTPOnly field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HTTPOnly field is set to the value of the last call.
+func (b *AffinityRouteCookieApplyConfiguration) WithHTTPOnly(value bool) *AffinityRouteCookieApplyConfiguration {
+	b.HTTPOnly = &value
+	return b
+}
+
+// WithSecure sets the Secure field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Secure field is set to the value of the last call.
+func (b *AffinityRouteCookieApplyConfiguration) WithSecure(value bool) *AffinityRouteCookieApplyConfiguration {
+	b.Secure = &value
+	return b
+}
+
+// WithSameSite sets the SameSite field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SameSite field is set to the value of the last call.
+func (b *AffinityRouteCookieApplyConfiguration) WithSameSite(value string) *AffinityRouteCookieApplyConfiguration {
+	b.SameSite = &value
+	return b
+}
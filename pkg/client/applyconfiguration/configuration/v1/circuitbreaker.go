@@ -0,0 +1,68 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// CircuitBreakerApplyConfiguration represents a declarative configuration of the CircuitBreaker type for use
+// with apply.
+//
+// CircuitBreaker defines the parameters of the ejection of failing upstream servers.
+// In NGINX, the ejection is configured with the max_fails and fail_timeout parameters of the upstream servers, so the circuit breaker can't be used along with the max-fails and fail-timeout fields.
+// In NGINX Plus, NGINX Ingress Controller ejects the servers through the NGINX Plus API based on the upstream statistics.
+type CircuitBreakerApplyConfiguration struct {
+	// Enables the circuit breaker. The default is false.
+	Enable *bool `json:"enable,omitempty"`
+	// The number of consecutive errors, timeouts or 5xx responses after which a server is ejected. The default is 5. In NGINX, the errors are counted during the ejectDuration, and only the errors and the responses that match the next-upstream field are counted, so add http_500, http_502, http_503 or http_504 to the next-upstream field to count the 5xx responses.
+	Consecutive5xx *int `json:"consecutive5xx,omitempty"`
+	// The average response time above which a server is ejected, for example, 2s. The read timeout of the upstream is not changed. Note: this field is supported only in NGINX Plus.
+	LatencyThreshold *string `json:"latencyThreshold,omitempty"`
+	// The time during which an ejected server does not receive requests. The default is 30s.
+	EjectDuration *string `json:"ejectDuration,omitempty"`
+	// The maximum percentage of the servers of the upstream that can be ejected at the same time. If the percentage is above 0, at least one server can be ejected. The last healthy server of the upstream is never ejected. The default is 10. Note: this field is supported only in NGINX Plus.
+	MaxEjectionPercent *int `json:"maxEjectionPercent,omitempty"`
+}
+
+// CircuitBreakerApplyConfiguration constructs a declarative configuration of the CircuitBreaker type for use with
+// apply.
+func CircuitBreaker() *CircuitBreakerApplyConfiguration {
+	return &CircuitBreakerApplyConfiguration{}
+}
+
+// WithEnable sets the Enable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Enable field is set to the value of the last call.
+func (b *CircuitBreakerApplyConfiguration) WithEnable(value bool) *CircuitBreakerApplyConfiguration {
+	b.Enable = &value
+	return b
+}
+
+// WithConsecutive5xx sets the Consecutive5xx field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Consecutive5xx field is set to the value of the last call.
+func (b *CircuitBreakerApplyConfiguration) WithConsecutive5xx(value int) *CircuitBreakerApplyConfiguration {
+	b.Consecutive5xx = &value
+	return b
+}
+
+// WithLatencyThreshold sets the LatencyThreshold field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LatencyThreshold field is set to the value of the last call.
+func (b *CircuitBreakerApplyConfiguration) WithLatencyThreshold(value string) *CircuitBreakerApplyConfiguration {
+	b.LatencyThreshold = &value
+	return b
+}
+
+// WithEjectDuration sets the EjectDuration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EjectDuration field is set to the value of the last call.
+func (b *CircuitBreakerApplyConfiguration) WithEjectDuration(value string) *CircuitBreakerApplyConfiguration {
+	b.EjectDuration = &value
+	return b
+}
+
+// WithMaxEjectionPercent sets the MaxEjectionPercent field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxEjectionPercent field is set to the value of the last call.
+func (b *CircuitBreakerApplyConfiguration) WithMaxEjectionPercent(value int) *CircuitBreakerApplyConfiguration {
+	b.MaxEjectionPercent = &value
+	return b
+}
@@ -15,8 +15,15 @@ type ConditionApplyConfiguration struct {
 	Argument *string `json:"argument,omitempty"`
 	// The name of an NGINX variable. Must start with $.
 	Variable *string `json:"variable,omitempty"`
+	// The HTTP method of a request, for example, GET. Cannot be used together with the value and the operator.
+	Method *string `json:"method,omitempty"`
 	// The value to match the condition against.
 	Value *string `json:"value,omitempty"`
+	// The operator of the condition: equals, regex, present or absent. The default is equals.
+	// The regex operator matches the value as a regular expression. The present and absent operators check if the header, cookie, argument or variable is not empty or empty and do not use the value.
+	Operator *string `json:"operator,omitempty"`
+	// A list of alternative conditions. The condition is satisfied if at least one of the alternatives is satisfied. Cannot be used together with the other fields.
+	AnyOf []ConditionAlternativeApplyConfiguration `json:"anyOf,omitempty"`
 }
 
 // ConditionApplyConfiguration constructs a declarative configuration of the Condition type for use with
@@ -57,6 +64,14 @@ func (b *ConditionApplyConfiguration) WithVariable(value string) *ConditionApply
 	return b
 }
 
+// WithMethod sets the Method field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Method field is set to the value of the last call.
+func (b *ConditionApplyConfiguration) WithMethod(value string) *ConditionApplyConfiguration {
+	b.Method = &value
+	return b
+}
+
 // WithValue sets the Value field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Value field is set to the value of the last call.
@@ -64,3 +79,24 @@ func (b *ConditionApplyConfiguration) WithValue(value string) *ConditionApplyCon
 	b.Value = &value
 	return b
 }
+
+// WithOperator sets the Operator field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Operator field is set to the value of the last call.
+func (b *ConditionApplyConfiguration) WithOperator(value string) *ConditionApplyConfiguration {
+	b.Operator = &value
+	return b
+}
+
+// WithAnyOf adds the given value to the AnyOf field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AnyOf field.
+func (b *ConditionApplyConfiguration) WithAnyOf(values ...*ConditionAlternativeApplyConfiguration) *ConditionApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAnyOf")
+		}
+		b.AnyOf = append(b.AnyOf, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,86 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// ConditionAlternativeApplyConfiguration represents a declarative configuration of the ConditionAlternative type for use
+// with apply.
+//
+// ConditionAlternative defines an alternative condition in the anyOf list of a Condition.
+type ConditionAlternativeApplyConfiguration struct {
+	// The name of a header. Must consist of alphanumeric characters or -.
+	Header *string `json:"header,omitempty"`
+	// The name of a cookie. Must consist of alphanumeric characters or _.
+	Cookie *string `json:"cookie,omitempty"`
+	// The name of an argument. Must consist of alphanumeric characters or _.
+	Argument *string `json:"argument,omitempty"`
+	// The name of an NGINX variable. Must start with $.
+	Variable *string `json:"variable,omitempty"`
+	// The HTTP method of a request, for example, GET. Cannot be used together with the value and the operator.
+	Method *string `json:"method,omitempty"`
+	// The value to match the condition against.
+	Value *string `json:"value,omitempty"`
+	// The operator of the condition: equals, regex, present or absent. The default is equals.
+	Operator *string `json:"operator,omitempty"`
+}
+
+// ConditionAlternativeApplyConfiguration constructs a declarative configuration of the ConditionAlternative type for use with
+// apply.
+func ConditionAlternative() *ConditionAlternativeApplyConfiguration {
+	return &ConditionAlternativeApplyConfiguration{}
+}
+
+// WithHeader sets the Header field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Header field is set to the value of the last call.
+func (b *ConditionAlternativeApplyConfiguration) WithHeader(value string) *ConditionAlternativeApplyConfiguration {
+	b.Header = &value
+	return b
+}
+
+// WithCookie sets the Cookie field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Cookie field is set to the value of the last call.
+func (b *ConditionAlternativeApplyConfiguration) WithCookie(value string) *ConditionAlternativeApplyConfiguration {
+	b.Cookie = &value
+	return b
+}
+
+// WithArgument sets the Argument field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Argument field is set to the value of the last call.
+func (b *ConditionAlternativeApplyConfiguration) WithArgument(value string) *ConditionAlternativeApplyConfiguration {
+	b.Argument = &value
+	return b
+}
+
+// WithVariable sets the Variable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Variable field is set to the value of the last call.
+func (b *ConditionAlternativeApplyConfiguration) WithVariable(value string) *ConditionAlternativeApplyConfiguration {
+	b.Variable = &value
+	return b
+}
+
+// WithMethod sets the Method field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Method field is set to the value of the last call.
+func (b *ConditionAlternativeApplyConfiguration) WithMethod(value string) *ConditionAlternativeApplyConfiguration {
+	b.Method = &value
+	return b
+}
+
+// WithValue sets the Value field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Value field is set to the value of the last call.
+func (b *ConditionAlternativeApplyConfiguration) WithValue(value string) *ConditionAlternativeApplyConfiguration {
+	b.Value = &value
+	return b
+}
+
+// WithOperator sets the Operator field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Operator field is set to the value of the last call.
+func (b *ConditionAlternativeApplyConfiguration) WithOperator(value string) *ConditionAlternativeApplyConfiguration {
+	b.Operator = &value
+	return b
+}
@@ -0,0 +1,36 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// EjectedPeerApplyConfiguration represents a declarative configuration of the EjectedPeer type for use
+// with apply.
+//
+// EjectedPeer defines an upstream server ejected by the circuit breaker.
+type EjectedPeerApplyConfiguration struct {
+	// The name of the upstream in the NGINX configuration.
+	Upstream *string `json:"upstream,omitempty"`
+	// The address of the upstream server.
+	Server *string `json:"server,omitempty"`
+}
+
+// EjectedPeerApplyConfiguration constructs a declarative configuration of the EjectedPeer type for use with
+// apply.
+func EjectedPeer() *EjectedPeerApplyConfiguration {
+	return &EjectedPeerApplyConfiguration{}
+}
+
+// WithUpstream sets the Upstream field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Upstream field is set to the value of the last call.
+func (b *EjectedPeerApplyConfiguration) WithUpstream(value string) *EjectedPeerApplyConfiguration {
+	b.Upstream = &value
+	return b
+}
+
+// WithServer sets the Server field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Server field is set to the value of the last call.
+func (b *EjectedPeerApplyConfiguration) WithServer(value string) *EjectedPeerApplyConfiguration {
+	b.Server = &value
+	return b
+}
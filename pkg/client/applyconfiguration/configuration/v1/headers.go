@@ -0,0 +1,38 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// HeadersApplyConfiguration represents a declarative configuration of the Headers type for use
+// with apply.
+//
+// Headers defines a policy that modifies the request and response headers.
+// The policies referenced by a VirtualServer apply first, then the policies referenced by a route or a subroute, and then the requestHeaders and responseHeaders of the proxy action.
+// A later setting of a header with the same name takes precedence over an earlier one.
+type HeadersApplyConfiguration struct {
+	// The headers of the requests passed to the upstream servers.
+	Request *HeadersRequestApplyConfiguration `json:"request,omitempty"`
+	// The headers of the responses passed to the clients.
+	Response *HeadersResponseApplyConfiguration `json:"response,omitempty"`
+}
+
+// HeadersApplyConfiguration constructs a declarative configuration of the Headers type for use with
+// apply.
+func Headers() *HeadersApplyConfiguration {
+	return &HeadersApplyConfiguration{}
+}
+
+// WithRequest sets the Request field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Request field is set to the value of the last call.
+func (b *HeadersApplyConfiguration) WithRequest(value *HeadersRequestApplyConfiguration) *HeadersApplyConfiguration {
+	b.Request = value
+	return b
+}
+
+// WithResponse sets the Response field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Response field is set to the value of the last call.
+func (b *HeadersApplyConfiguration) WithResponse(value *HeadersResponseApplyConfiguration) *HeadersApplyConfiguration {
+	b.Response = value
+	return b
+}
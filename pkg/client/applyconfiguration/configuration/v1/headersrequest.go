@@ -0,0 +1,31 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// HeadersRequestApplyConfiguration represents a declarative configuration of the HeadersRequest type for use
+// with apply.
+//
+// HeadersRequest defines the request headers of a Headers policy.
+type HeadersRequestApplyConfiguration struct {
+	// Sets the headers of the requests passed to the upstream servers. An empty value removes the header.
+	Set []HeaderApplyConfiguration `json:"set,omitempty"`
+}
+
+// HeadersRequestApplyConfiguration constructs a declarative configuration of the HeadersRequest type for use with
+// apply.
+func HeadersRequest() *HeadersRequestApplyConfiguration {
+	return &HeadersRequestApplyConfiguration{}
+}
+
+// WithSet adds the given value to the Set field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Set field.
+func (b *HeadersRequestApplyConfiguration) WithSet(values ...*HeaderApplyConfiguration) *HeadersRequestApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSet")
+		}
+		b.Set = append(b.Set, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,55 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// HeadersResponseApplyConfiguration represents a declarative configuration of the HeadersResponse type for use
+// with apply.
+//
+// HeadersResponse defines the response headers of a Headers policy.
+type HeadersResponseApplyConfiguration struct {
+	// The headers of the upstream responses that will not be passed to the clients, for example, Server.
+	Hide []string `json:"hide,omitempty"`
+	// The headers hidden by NGINX by default that will be passed to the clients.
+	Pass []string `json:"pass,omitempty"`
+	// Adds headers to the responses to the clients.
+	Add []AddHeaderApplyConfiguration `json:"add,omitempty"`
+}
+
+// HeadersResponseApplyConfiguration constructs a declarative configuration of the HeadersResponse type for use with
+// apply.
+func HeadersResponse() *HeadersResponseApplyConfiguration {
+	return &HeadersResponseApplyConfiguration{}
+}
+
+// WithHide adds the given value to the Hide field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Hide field.
+func (b *HeadersResponseApplyConfiguration) WithHide(values ...string) *HeadersResponseApplyConfiguration {
+	for i := range values {
+		b.Hide = append(b.Hide, values[i])
+	}
+	return b
+}
+
+// WithPass adds the given value to the Pass field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Pass field.
+func (b *HeadersResponseApplyConfiguration) WithPass(values ...string) *HeadersResponseApplyConfiguration {
+	for i := range values {
+		b.Pass = append(b.Pass, values[i])
+	}
+	return b
+}
+
+// WithAdd adds the given value to the Add field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Add field.
+func (b *HeadersResponseApplyConfiguration) WithAdd(values ...*AddHeaderApplyConfiguration) *HeadersResponseApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAdd")
+		}
+		b.Add = append(b.Add, *values[i])
+	}
+	return b
+}
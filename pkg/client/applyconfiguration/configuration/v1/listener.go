@@ -19,6 +19,20 @@ type ListenerApplyConfiguration struct {
 	IPv6 *string `json:"ipv6,omitempty"`
 	// Whether the listener will be listening for SSL connections
 	Ssl *bool `json:"ssl,omitempty"`
+	// Enables accepting the PROXY protocol header, for example, from an external L4 load balancer. Supported only for the TCP protocol. The default is false.
+	AcceptProxyProtocol *bool `json:"acceptProxyProtocol,omitempty"`
+	// The IP addresses or CIDRs of the trusted sources of the PROXY protocol header. The client address from the header of a trusted source replaces the address of the connection. Requires acceptProxyProtocol.
+	TrustedCIDRs []string `json:"trustedCIDRs,omitempty"`
+	// The TLS settings of an HTTP listener with ssl enabled. The settings apply to every VirtualServer that binds the listener.
+	TLS *ListenerTLSApplyConfiguration `json:"tls,omitempty"`
+	// Enables or disables HTTP/2 for an HTTP listener with ssl enabled. The default is the value of the http2 ConfigMap key.
+	HTTP2 *bool `json:"http2,omitempty"`
+	// The timeout for reading the header of a client request on an HTTP listener, for example, 10s. The default is 60s.
+	ClientHeaderTimeout *string `json:"clientHeaderTimeout,omitempty"`
+	// The timeout during which a keep-alive client connection stays open on an HTTP listener, for example, 30s. The default is the value of the keepalive-timeout ConfigMap key.
+	KeepaliveTimeout *string `json:"keepaliveTimeout,omitempty"`
+	// The namespaces of the VirtualServers and TransportServers that are allowed to bind the listener. By default, resources from all namespaces can bind the listener.
+	AllowedNamespaces []string `json:"allowedNamespaces,omitempty"`
 }
 
 // ListenerApplyConfiguration constructs a declarative configuration of the Listener type for use with
@@ -74,3 +88,63 @@ func (b *ListenerApplyConfiguration) WithSsl(value bool) *ListenerApplyConfigura
 	b.Ssl = &value
 	return b
 }
+
+// WithAcceptProxyProtocol sets the AcceptProxyProtocol field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AcceptProxyProtocol field is set to the value of the last call.
+func (b *ListenerApplyConfiguration) WithAcceptProxyProtocol(value bool) *ListenerApplyConfiguration {
+	b.AcceptProxyProtocol = &value
+	return b
+}
+
+// WithTrustedCIDRs adds the given value to the TrustedCIDRs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the TrustedCIDRs field.
+func (b *ListenerApplyConfiguration) WithTrustedCIDRs(values ...string) *ListenerApplyConfiguration {
+	for i := range values {
+		b.TrustedCIDRs = append(b.TrustedCIDRs, values[i])
+	}
+	return b
+}
+
+// WithTLS sets the TLS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TLS field is set to the value of the last call.
+func (b *ListenerApplyConfiguration) WithTLS(value *ListenerTLSApplyConfiguration) *ListenerApplyConfiguration {
+	b.TLS = value
+	return b
+}
+
+// WithHTTP2 sets the HTTP2 field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HTTP2 field is set to the value of the last call.
+func (b *ListenerApplyConfiguration) WithHTTP2(value bool) *ListenerApplyConfiguration {
+	b.HTTP2 = &value
+	return b
+}
+
+// WithClientHeaderTimeout sets the ClientHeaderTimeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClientHeaderTimeout field is set to the value of the last call.
+func (b *ListenerApplyConfiguration) WithClientHeaderTimeout(value string) *ListenerApplyConfiguration {
+	b.ClientHeaderTimeout = &value
+	return b
+}
+
+// WithKeepaliveTimeout sets the KeepaliveTimeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the KeepaliveTimeout field is set to the value of the last call.
+func (b *ListenerApplyConfiguration) WithKeepaliveTimeout(value string) *ListenerApplyConfiguration {
+	b.KeepaliveTimeout = &value
+	return b
+}
+
+// WithAllowedNamespaces adds the given value to the AllowedNamespaces field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AllowedNamespaces field.
+func (b *ListenerApplyConfiguration) WithAllowedNamespaces(values ...string) *ListenerApplyConfiguration {
+	for i := range values {
+		b.AllowedNamespaces = append(b.AllowedNamespaces, values[i])
+	}
+	return b
+}
@@ -0,0 +1,56 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// ListenerTLSApplyConfiguration represents a declarative configuration of the ListenerTLS type for use
+// with apply.
+//
+// ListenerTLS defines the TLS settings of an HTTP listener.
+type ListenerTLSApplyConfiguration struct {
+	// The name of a Secret with a TLS certificate and key in the namespace of the GlobalConfiguration. The certificate is used by the VirtualServers on the listener that don't specify a TLS Secret.
+	Secret *string `json:"secret,omitempty"`
+	// Rejects TLS handshakes for the VirtualServers on the listener that don't specify a TLS Secret. Cannot be used together with secret.
+	RejectHandshake *bool `json:"rejectHandshake,omitempty"`
+	// The enabled TLS protocols, for example, "TLSv1.2 TLSv1.3". The default is the NGINX default.
+	Protocols *string `json:"protocols,omitempty"`
+	// The enabled ciphers in the format understood by the OpenSSL library, for example, HIGH:!aNULL:!MD5. The default is the NGINX default.
+	Ciphers *string `json:"ciphers,omitempty"`
+}
+
+// ListenerTLSApplyConfiguration constructs a declarative configuration of the ListenerTLS type for use with
+// apply.
+func ListenerTLS() *ListenerTLSApplyConfiguration {
+	return &ListenerTLSApplyConfiguration{}
+}
+
+// WithSecret sets the Secret field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Secret field is set to the value of the last call.
+func (b *ListenerTLSApplyConfiguration) WithSecret(value string) *ListenerTLSApplyConfiguration {
+	b.Secret = &value
+	return b
+}
+
+// WithRejectHandshake sets the RejectHandshake field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RejectHandshake field is set to the value of the last call.
+func (b *ListenerTLSApplyConfiguration) WithRejectHandshake(value bool) *ListenerTLSApplyConfiguration {
+	b.RejectHandshake = &value
+	return b
+}
+
+// WithProtocols sets the Protocols field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Protocols field is set to the value of the last call.
+func (b *ListenerTLSApplyConfiguration) WithProtocols(value string) *ListenerTLSApplyConfiguration {
+	b.Protocols = &value
+	return b
+}
+
+// WithCiphers sets the Ciphers field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Ciphers field is set to the value of the last call.
+func (b *ListenerTLSApplyConfiguration) WithCiphers(value string) *ListenerTLSApplyConfiguration {
+	b.Ciphers = &value
+	return b
+}
@@ -0,0 +1,87 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// MaintenanceApplyConfiguration represents a declarative configuration of the Maintenance type for use
+// with apply.
+//
+// Maintenance defines the maintenance mode of a VirtualServer or a route.
+// In NGINX Plus, the maintenance mode is toggled through a keyval zone, so enabling or disabling it doesn't reload NGINX.
+type MaintenanceApplyConfiguration struct {
+	// Enables the maintenance mode. The default is false.
+	Enable *bool `json:"enable,omitempty"`
+	// The status code of the maintenance response. The code must be in the range 400–599. The default is 503.
+	Code *int `json:"code,omitempty"`
+	// The MIME type of the maintenance response. The default is text/html.
+	Type *string `json:"type,omitempty"`
+	// The body of the maintenance response. The body can't be used along with the configMap field.
+	Body *string `json:"body,omitempty"`
+	// The ConfigMap that holds the HTML page of the maintenance response. The body can't be used along with the body field.
+	ConfigMap *MaintenanceConfigMapApplyConfiguration `json:"configMap,omitempty"`
+	// The value of the Retry-After response header, either a number of seconds or an HTTP date.
+	RetryAfter *string `json:"retryAfter,omitempty"`
+	// The requests that bypass the maintenance mode and are processed as usual.
+	Bypass *MaintenanceBypassApplyConfiguration `json:"bypass,omitempty"`
+}
+
+// MaintenanceApplyConfiguration constructs a declarative configuration of the Maintenance type for use with
+// apply.
+func Maintenance() *MaintenanceApplyConfiguration {
+	return &MaintenanceApplyConfiguration{}
+}
+
+// WithEnable sets the Enable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Enable field is set to the value of the last call.
+func (b *MaintenanceApplyConfiguration) WithEnable(value bool) *MaintenanceApplyConfiguration {
+	b.Enable = &value
+	return b
+}
+
+// WithCode sets the Code field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Code field is set to the value of the last call.
+func (b *MaintenanceApplyConfiguration) WithCode(value int) *MaintenanceApplyConfiguration {
+	b.Code = &value
+	return b
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *MaintenanceApplyConfiguration) WithType(value string) *MaintenanceApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithBody sets the Body field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Body field is set to the value of the last call.
+func (b *MaintenanceApplyConfiguration) WithBody(value string) *MaintenanceApplyConfiguration {
+	b.Body = &value
+	return b
+}
+
+// WithConfigMap sets the ConfigMap field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigMap field is set to the value of the last call.
+func (b *MaintenanceApplyConfiguration) WithConfigMap(value *MaintenanceConfigMapApplyConfiguration) *MaintenanceApplyConfiguration {
+	b.ConfigMap = value
+	return b
+}
+
+// WithRetryAfter sets the RetryAfter field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RetryAfter field is set to the value of the last call.
+func (b *MaintenanceApplyConfiguration) WithRetryAfter(value string) *MaintenanceApplyConfiguration {
+	b.RetryAfter = &value
+	return b
+}
+
+// WithBypass sets the Bypass field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Bypass field is set to the value of the last call.
+func (b *MaintenanceApplyConfiguration) WithBypass(value *MaintenanceBypassApplyConfiguration) *MaintenanceApplyConfiguration {
+	b.Bypass = value
+	return b
+}
@@ -0,0 +1,48 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// MaintenanceBypassApplyConfiguration represents a declarative configuration of the MaintenanceBypass type for use
+// with apply.
+//
+// MaintenanceBypass defines the requests that bypass the maintenance mode.
+type MaintenanceBypassApplyConfiguration struct {
+	// A list of IPv4 or IPv6 addresses or CIDR ranges of the clients that bypass the maintenance mode.
+	Allow []string `json:"allow,omitempty"`
+	// The name of a request header. The requests that include the header with the headerValue bypass the maintenance mode.
+	Header *string `json:"header,omitempty"`
+	// The value of the header. Required when the header field is set.
+	HeaderValue *string `json:"headerValue,omitempty"`
+}
+
+// MaintenanceBypassApplyConfiguration constructs a declarative configuration of the MaintenanceBypass type for use with
+// apply.
+func MaintenanceBypass() *MaintenanceBypassApplyConfiguration {
+	return &MaintenanceBypassApplyConfiguration{}
+}
+
+// WithAllow adds the given value to the Allow field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Allow field.
+func (b *MaintenanceBypassApplyConfiguration) WithAllow(values ...string) *MaintenanceBypassApplyConfiguration {
+	for i := range values {
+		b.Allow = append(b.Allow, values[i])
+	}
+	return b
+}
+
+// WithHeader sets the Header field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Header field is set to the value of the last call.
+func (b *MaintenanceBypassApplyConfiguration) WithHeader(value string) *MaintenanceBypassApplyConfiguration {
+	b.Header = &value
+	return b
+}
+
+// WithHeaderValue sets the HeaderValue field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HeaderValue field is set to the value of the last call.
+func (b *MaintenanceBypassApplyConfiguration) WithHeaderValue(value string) *MaintenanceBypassApplyConfiguration {
+	b.HeaderValue = &value
+	return b
+}
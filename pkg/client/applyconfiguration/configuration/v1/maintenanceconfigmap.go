@@ -0,0 +1,36 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// MaintenanceConfigMapApplyConfiguration represents a declarative configuration of the MaintenanceConfigMap type for use
+// with apply.
+//
+// MaintenanceConfigMap references a key of a ConfigMap in the namespace of the resource.
+type MaintenanceConfigMapApplyConfiguration struct {
+	// The name of the ConfigMap.
+	Name *string `json:"name,omitempty"`
+	// The key of the ConfigMap that holds the page. The default is index.html.
+	Key *string `json:"key,omitempty"`
+}
+
+// MaintenanceConfigMapApplyConfiguration constructs a declarative configuration of the MaintenanceConfigMap type for use with
+// apply.
+func MaintenanceConfigMap() *MaintenanceConfigMapApplyConfiguration {
+	return &MaintenanceConfigMapApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *MaintenanceConfigMapApplyConfiguration) WithName(value string) *MaintenanceConfigMapApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithKey sets the Key field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Key field is set to the value of the last call.
+func (b *MaintenanceConfigMapApplyConfiguration) WithKey(value string) *MaintenanceConfigMapApplyConfiguration {
+	b.Key = &value
+	return b
+}
@@ -33,6 +33,8 @@ type PolicySpecApplyConfiguration struct {
 	Cache *CacheApplyConfiguration `json:"cache,omitempty"`
 	// The CORS policy configures Cross-Origin Resource Sharing headers
 	CORS *CORSApplyConfiguration `json:"cors,omitempty"`
+	// The Headers policy modifies the request headers passed to the upstream servers and the response headers passed to the clients.
+	Headers *HeadersApplyConfiguration `json:"headers,omitempty"`
 }
 
 // PolicySpecApplyConfiguration constructs a declarative configuration of the PolicySpec type for use with
@@ -136,3 +138,11 @@ func (b *PolicySpecApplyConfiguration) WithCORS(value *CORSApplyConfiguration) *
 	b.CORS = value
 	return b
 }
+
+// WithHeaders sets the Headers field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Headers field is set to the value of the last call.
+func (b *PolicySpecApplyConfiguration) WithHeaders(value *HeadersApplyConfiguration) *PolicySpecApplyConfiguration {
+	b.Headers = value
+	return b
+}
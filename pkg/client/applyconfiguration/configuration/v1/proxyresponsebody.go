@@ -0,0 +1,53 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// ProxyResponseBodyApplyConfiguration represents a declarative configuration of the ProxyResponseBody type for use
+// with apply.
+//
+// ProxyResponseBody defines the response body manipulation in an ActionProxy.
+type ProxyResponseBodyApplyConfiguration struct {
+	// The string replacements in the response body. The replacements are applied in the order of the list.
+	Rewrite []ResponseBodyRewriteApplyConfiguration `json:"rewrite,omitempty"`
+	// The MIME types of the responses, in addition to text/html, in which the strings are replaced. The special value * matches any MIME type.
+	Types []string `json:"types,omitempty"`
+	// Replaces only the first occurrence of each string. The default is false, which replaces all occurrences.
+	Once *bool `json:"once,omitempty"`
+}
+
+// ProxyResponseBodyApplyConfiguration constructs a declarative configuration of the ProxyResponseBody type for use with
+// apply.
+func ProxyResponseBody() *ProxyResponseBodyApplyConfiguration {
+	return &ProxyResponseBodyApplyConfiguration{}
+}
+
+// WithRewrite adds the given value to the Rewrite field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Rewrite field.
+func (b *ProxyResponseBodyApplyConfiguration) WithRewrite(values ...*ResponseBodyRewriteApplyConfiguration) *ProxyResponseBodyApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithRewrite")
+		}
+		b.Rewrite = append(b.Rewrite, *values[i])
+	}
+	return b
+}
+
+// WithTypes adds the given value to the Types field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Types field.
+func (b *ProxyResponseBodyApplyConfiguration) WithTypes(values ...string) *ProxyResponseBodyApplyConfiguration {
+	for i := range values {
+		b.Types = append(b.Types, values[i])
+	}
+	return b
+}
+
+// WithOnce sets the Once field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Once field is set to the value of the last call.
+func (b *ProxyResponseBodyApplyConfiguration) WithOnce(value bool) *ProxyResponseBodyApplyConfiguration {
+	b.Once = &value
+	return b
+}
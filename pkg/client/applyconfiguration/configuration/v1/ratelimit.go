@@ -29,6 +29,8 @@ type RateLimitApplyConfiguration struct {
 	RejectCode *int `json:"rejectCode,omitempty"`
 	// Enables a constant rate-limit by dividing the configured rate by the number of nginx-ingress pods currently serving traffic. This adjustment ensures that the rate-limit remains consistent, even as the number of nginx-pods fluctuates due to autoscaling. This will not work properly if requests from a client are not evenly distributed across all ingress pods (Such as with sticky sessions, long lived TCP Connections with many requests, and so forth). In such cases using zone-sync instead would give better results. Enabling zone-sync will suppress this setting.
 	Scale *bool `json:"scale,omitempty"`
+	// Shares the rate limit among all the VirtualServers, VirtualServerRoutes and Ingresses that reference the policy, so that their requests are counted in a single zone named after the policy. By default, each resource that references the policy gets its own zone. Ingresses reference the policy with the nginx.org/policies annotation. Cannot be used together with condition.
+	Shared *bool `json:"shared,omitempty"`
 	// Add a condition to a rate-limit policy.
 	Condition *RateLimitConditionApplyConfiguration `json:"condition,omitempty"`
 }
@@ -119,6 +121,14 @@ func (b *RateLimitApplyConfiguration) WithScale(value bool) *RateLimitApplyConfi
 	return b
 }
 
+// WithShared sets the Shared field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Shared field is set to the value of the last call.
+func (b *RateLimitApplyConfiguration) WithShared(value bool) *RateLimitApplyConfiguration {
+	b.Shared = &value
+	return b
+}
+
 // WithCondition sets the Condition field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Condition field is set to the value of the last call.
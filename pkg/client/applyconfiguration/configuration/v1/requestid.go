@@ -0,0 +1,56 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// RequestIDApplyConfiguration represents a declarative configuration of the RequestID type for use
+// with apply.
+//
+// RequestID defines how the ID of a request is obtained, propagated to the upstreams and returned to the clients.
+type RequestIDApplyConfiguration struct {
+	// Enables the propagation of the request ID. The default is false.
+	Enable *bool `json:"enable,omitempty"`
+	// The name of the request header that carries the ID. Overrides the request-id-header ConfigMap key. The default is X-Request-ID.
+	Header *string `json:"header,omitempty"`
+	// Defines how the ID is obtained. With trust, the ID from the incoming request header is used and a new ID is generated only if the header is missing. With generate, a new ID is always generated. Overrides the request-id-mode ConfigMap key. The default is trust.
+	Mode *string `json:"mode,omitempty"`
+	// Enables adding the ID to the response headers. Overrides the request-id-echo ConfigMap key. The default is false.
+	Echo *bool `json:"echo,omitempty"`
+}
+
+// RequestIDApplyConfiguration constructs a declarative configuration of the RequestID type for use with
+// apply.
+func RequestID() *RequestIDApplyConfiguration {
+	return &RequestIDApplyConfiguration{}
+}
+
+// WithEnable sets the Enable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Enable field is set to the value of the last call.
+func (b *RequestIDApplyConfiguration) WithEnable(value bool) *RequestIDApplyConfiguration {
+	b.Enable = &value
+	return b
+}
+
+// WithHeader sets the Header field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Header field is set to the value of the last call.
+func (b *RequestIDApplyConfiguration) WithHeader(value string) *RequestIDApplyConfiguration {
+	b.Header = &value
+	return b
+}
+
+// WithMode sets the Mode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Mode field is set to the value of the last call.
+func (b *RequestIDApplyConfiguration) WithMode(value string) *RequestIDApplyConfiguration {
+	b.Mode = &value
+	return b
+}
+
+// WithEcho sets the Echo field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Echo field is set to the value of the last call.
+func (b *RequestIDApplyConfiguration) WithEcho(value bool) *RequestIDApplyConfiguration {
+	b.Echo = &value
+	return b
+}
@@ -0,0 +1,36 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// ResponseBodyRewriteApplyConfiguration represents a declarative configuration of the ResponseBodyRewrite type for use
+// with apply.
+//
+// ResponseBodyRewrite defines a string replacement in the response body.
+type ResponseBodyRewriteApplyConfiguration struct {
+	// The string to replace. The match is case-insensitive.
+	Match *string `json:"match,omitempty"`
+	// The replacement string. The replacement can contain NGINX variables.
+	Replacement *string `json:"replacement,omitempty"`
+}
+
+// ResponseBodyRewriteApplyConfiguration constructs a declarative configuration of the ResponseBodyRewrite type for use with
+// apply.
+func ResponseBodyRewrite() *ResponseBodyRewriteApplyConfiguration {
+	return &ResponseBodyRewriteApplyConfiguration{}
+}
+
+// WithMatch sets the Match field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Match field is set to the value of the last call.
+func (b *ResponseBodyRewriteApplyConfiguration) WithMatch(value string) *ResponseBodyRewriteApplyConfiguration {
+	b.Match = &value
+	return b
+}
+
+// WithReplacement sets the Replacement field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replacement field is set to the value of the last call.
+func (b *ResponseBodyRewriteApplyConfiguration) WithReplacement(value string) *ResponseBodyRewriteApplyConfiguration {
+	b.Replacement = &value
+	return b
+}
@@ -31,6 +31,8 @@ type RouteApplyConfiguration struct {
 	LocationSnippets *string `json:"location-snippets,omitempty"`
 	// A reference to a DosProtectedResource, setting this enables DOS protection of the VirtualServer route.
 	Dos *string `json:"dos,omitempty"`
+	// The maintenance mode of the route. When the route references a VirtualServerRoute, the maintenance mode applies to the subroutes that don't define their own.
+	Maintenance *MaintenanceApplyConfiguration `json:"maintenance,omitempty"`
 }
 
 // RouteApplyConfiguration constructs a declarative configuration of the Route type for use with
@@ -138,3 +140,11 @@ func (b *RouteApplyConfiguration) WithDos(value string) *RouteApplyConfiguration
 	b.Dos = &value
 	return b
 }
+
+// WithMaintenance sets the Maintenance field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Maintenance field is set to the value of the last call.
+func (b *RouteApplyConfiguration) WithMaintenance(value *MaintenanceApplyConfiguration) *RouteApplyConfiguration {
+	b.Maintenance = value
+	return b
+}
@@ -0,0 +1,58 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// TransportServerAccessLogApplyConfiguration represents a declarative configuration of the TransportServerAccessLog type for use
+// with apply.
+//
+// TransportServerAccessLog defines the access log of a TransportServer.
+type TransportServerAccessLogApplyConfiguration struct {
+	// Enables the access log. If false, the connections to the TransportServer are not logged.
+	Enable *bool `json:"enable,omitempty"`
+	// The log format, for example, $remote_addr [$time_local] $protocol $status $session_time. The format can contain the variables of the NGINX stream modules, such as $remote_addr, $status, $bytes_sent or $upstream_addr. The default is the format set by the stream-log-format ConfigMap key.
+	Format *string `json:"format,omitempty"`
+	// The destination of the access log. Accepted values are /dev/stdout, /dev/stderr and a syslog server in the syslog:server=address[,parameter=value] format, for example, syslog:server=10.0.0.1:514,tag=tcp_app. The default is /dev/stdout.
+	Destination *string `json:"destination,omitempty"`
+	// Logs only the connections that end with one of the statuses, as reported by the $status variable. Accepted values are 200, 400, 403, 500, 502 and 503. By default, all connections are logged.
+	Statuses []int `json:"statuses,omitempty"`
+}
+
+// TransportServerAccessLogApplyConfiguration constructs a declarative configuration of the TransportServerAccessLog type for use with
+// apply.
+func TransportServerAccessLog() *TransportServerAccessLogApplyConfiguration {
+	return &TransportServerAccessLogApplyConfiguration{}
+}
+
+// WithEnable sets the Enable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Enable field is set to the value of the last call.
+func (b *TransportServerAccessLogApplyConfiguration) WithEnable(value bool) *TransportServerAccessLogApplyConfiguration {
+	b.Enable = &value
+	return b
+}
+
+// WithFormat sets the Format field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Format field is set to the value of the last call.
+func (b *TransportServerAccessLogApplyConfiguration) WithFormat(value string) *TransportServerAccessLogApplyConfiguration {
+	b.Format = &value
+	return b
+}
+
+// WithDestination sets the Destination field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Destination field is set to the value of the last call.
+func (b *TransportServerAccessLogApplyConfiguration) WithDestination(value string) *TransportServerAccessLogApplyConfiguration {
+	b.Destination = &value
+	return b
+}
+
+// WithStatuses adds the given value to the Statuses field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Statuses field.
+func (b *TransportServerAccessLogApplyConfiguration) WithStatuses(values ...int) *TransportServerAccessLogApplyConfiguration {
+	for i := range values {
+		b.Statuses = append(b.Statuses, values[i])
+	}
+	return b
+}
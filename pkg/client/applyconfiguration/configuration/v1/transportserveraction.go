@@ -9,6 +9,10 @@ package v1
 type TransportServerActionApplyConfiguration struct {
 	// Passes connections/datagrams to an upstream. The upstream with that name must be defined in the resource.
 	Pass *string `json:"pass,omitempty"`
+	// Splits connections/datagrams between two or more upstreams according to their weights. Cannot be used together with pass.
+	Splits []TransportServerSplitApplyConfiguration `json:"splits,omitempty"`
+	// Routes TLS connections to upstreams based on the server name from the TLS ClientHello without terminating TLS. Connections that don't match any server name are handled by pass or splits.
+	Matches []TransportServerActionMatchApplyConfiguration `json:"matches,omitempty"`
 }
 
 // TransportServerActionApplyConfiguration constructs a declarative configuration of the TransportServerAction type for use with
@@ -24,3 +28,29 @@ func (b *TransportServerActionApplyConfiguration) WithPass(value string) *Transp
 	b.Pass = &value
 	return b
 }
+
+// WithSplits adds the given value to the Splits field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Splits field.
+func (b *TransportServerActionApplyConfiguration) WithSplits(values ...*TransportServerSplitApplyConfiguration) *TransportServerActionApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSplits")
+		}
+		b.Splits = append(b.Splits, *values[i])
+	}
+	return b
+}
+
+// WithMatches adds the given value to the Matches field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Matches field.
+func (b *TransportServerActionApplyConfiguration) WithMatches(values ...*TransportServerActionMatchApplyConfiguration) *TransportServerActionApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithMatches")
+		}
+		b.Matches = append(b.Matches, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,38 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// TransportServerActionMatchApplyConfiguration represents a declarative configuration of the TransportServerActionMatch type for use
+// with apply.
+//
+// TransportServerActionMatch defines a server name based match for the action of a TransportServer.
+type TransportServerActionMatchApplyConfiguration struct {
+	// A list of server names to match against the server name from the TLS ClientHello. Wildcard names like *.example.com are supported.
+	ServerNames []string `json:"serverNames,omitempty"`
+	// Passes the matched connections to an upstream. The upstream with that name must be defined in the resource.
+	Pass *string `json:"pass,omitempty"`
+}
+
+// TransportServerActionMatchApplyConfiguration constructs a declarative configuration of the TransportServerActionMatch type for use with
+// apply.
+func TransportServerActionMatch() *TransportServerActionMatchApplyConfiguration {
+	return &TransportServerActionMatchApplyConfiguration{}
+}
+
+// WithServerNames adds the given value to the ServerNames field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ServerNames field.
+func (b *TransportServerActionMatchApplyConfiguration) WithServerNames(values ...string) *TransportServerActionMatchApplyConfiguration {
+	for i := range values {
+		b.ServerNames = append(b.ServerNames, values[i])
+	}
+	return b
+}
+
+// WithPass sets the Pass field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Pass field is set to the value of the last call.
+func (b *TransportServerActionMatchApplyConfiguration) WithPass(value string) *TransportServerActionMatchApplyConfiguration {
+	b.Pass = &value
+	return b
+}
@@ -27,6 +27,8 @@ type TransportServerSpecApplyConfiguration struct {
 	SessionParameters *SessionParametersApplyConfiguration `json:"sessionParameters,omitempty"`
 	// The action to perform for a request.
 	Action *TransportServerActionApplyConfiguration `json:"action,omitempty"`
+	// The access log of the TransportServer. Overrides the stream-log-format ConfigMap key for the connections to the TransportServer.
+	AccessLog *TransportServerAccessLogApplyConfiguration `json:"accessLog,omitempty"`
 }
 
 // TransportServerSpecApplyConfiguration constructs a declarative configuration of the TransportServerSpec type for use with
@@ -119,3 +121,11 @@ func (b *TransportServerSpecApplyConfiguration) WithAction(value *TransportServe
 	b.Action = value
 	return b
 }
+
+// WithAccessLog sets the AccessLog field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AccessLog field is set to the value of the last call.
+func (b *TransportServerSpecApplyConfiguration) WithAccessLog(value *TransportServerAccessLogApplyConfiguration) *TransportServerSpecApplyConfiguration {
+	b.AccessLog = value
+	return b
+}
@@ -0,0 +1,36 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// TransportServerSplitApplyConfiguration represents a declarative configuration of the TransportServerSplit type for use
+// with apply.
+//
+// TransportServerSplit defines a weight for an upstream of a TransportServer.
+type TransportServerSplitApplyConfiguration struct {
+	// The weight of the upstream. Must fall into the range 0..100. The sum of the weights of all splits must be equal to 100.
+	Weight *int `json:"weight,omitempty"`
+	// The name of the upstream. The upstream with that name must be defined in the resource.
+	Pass *string `json:"pass,omitempty"`
+}
+
+// TransportServerSplitApplyConfiguration constructs a declarative configuration of the TransportServerSplit type for use with
+// apply.
+func TransportServerSplit() *TransportServerSplitApplyConfiguration {
+	return &TransportServerSplitApplyConfiguration{}
+}
+
+// WithWeight sets the Weight field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Weight field is set to the value of the last call.
+func (b *TransportServerSplitApplyConfiguration) WithWeight(value int) *TransportServerSplitApplyConfiguration {
+	b.Weight = &value
+	return b
+}
+
+// WithPass sets the Pass field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Pass field is set to the value of the last call.
+func (b *TransportServerSplitApplyConfiguration) WithPass(value string) *TransportServerSplitApplyConfiguration {
+	b.Pass = &value
+	return b
+}
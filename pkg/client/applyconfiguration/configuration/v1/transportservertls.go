@@ -8,6 +8,14 @@ package v1
 // TransportServerTLS defines TransportServerTLS configuration for a TransportServer.
 type TransportServerTLSApplyConfiguration struct {
 	Secret *string `json:"secret,omitempty"`
+	// The name of the Kubernetes secret that stores the CA certificate used to verify client certificates. It must be in the same namespace as the TransportServer resource. The secret must be of the type nginx.org/ca, and the certificate must be stored in the secret under the key ca.crt. The subject of a verified client certificate is available as the $ssl_client_s_dn variable, for example, in the stream-log-format ConfigMap key.
+	ClientCertSecret *string `json:"clientCertSecret,omitempty"`
+	// The file name of the Certificate Revocation List. NGINX Ingress Controller will look for this file in /etc/nginx/secrets
+	CrlFileName *string `json:"crlFileName,omitempty"`
+	// Verification for the client. Possible values are "on", "off", "optional", "optional_no_ca". The default is "on".
+	VerifyClient *string `json:"verifyClient,omitempty"`
+	// Sets the verification depth in the client certificates chain. The default is 1.
+	VerifyDepth *int `json:"verifyDepth,omitempty"`
 }
 
 // TransportServerTLSApplyConfiguration constructs a declarative configuration of the TransportServerTLS type for use with
@@ -23,3 +31,35 @@ func (b *TransportServerTLSApplyConfiguration) WithSecret(value string) *Transpo
 	b.Secret = &value
 	return b
 }
+
+// WithClientCertSecret sets the ClientCertSecret field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClientCertSecret field is set to the value of the last call.
+func (b *TransportServerTLSApplyConfiguration) WithClientCertSecret(value string) *TransportServerTLSApplyConfiguration {
+	b.ClientCertSecret = &value
+	return b
+}
+
+// WithCrlFileName sets the CrlFileName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CrlFileName field is set to the value of the last call.
+func (b *TransportServerTLSApplyConfiguration) WithCrlFileName(value string) *TransportServerTLSApplyConfiguration {
+	b.CrlFileName = &value
+	return b
+}
+
+// WithVerifyClient sets the VerifyClient field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VerifyClient field is set to the value of the last call.
+func (b *TransportServerTLSApplyConfiguration) WithVerifyClient(value string) *TransportServerTLSApplyConfiguration {
+	b.VerifyClient = &value
+	return b
+}
+
+// WithVerifyDepth sets the VerifyDepth field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VerifyDepth field is set to the value of the last call.
+func (b *TransportServerTLSApplyConfiguration) WithVerifyDepth(value int) *TransportServerTLSApplyConfiguration {
+	b.VerifyDepth = &value
+	return b
+}
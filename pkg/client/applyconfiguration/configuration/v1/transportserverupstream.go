@@ -13,20 +13,26 @@ type TransportServerUpstreamApplyConfiguration struct {
 	Service *string `json:"service,omitempty"`
 	// The port of the service. If the service doesn’t define that port, NGINX will assume the service has zero endpoints and close client connections/ignore datagrams. The port must fall into the range 1..65535.
 	Port *int `json:"port,omitempty"`
-	// Sets the number of unsuccessful attempts to communicate with the server that should happen in the duration set by the failTimeout parameter to consider the server unavailable. The default is 1.
+	// Sets the time during which the specified number of unsuccessful attempts to communicate with the server should happen to consider the server unavailable and the period of time the server will be considered unavailable. The default is 10s.
 	FailTimeout *string `json:"failTimeout,omitempty"`
-	// Sets the number of maximum connections to the proxied server. Default value is zero, meaning there is no limit. The default is 0.
+	// Sets the number of unsuccessful attempts to communicate with the server that should happen in the duration set by the failTimeout parameter to consider the server unavailable. The default is 1.
 	MaxFails *int `json:"maxFails,omitempty"`
-	// Sets the time during which the specified number of unsuccessful attempts to communicate with the server should happen to consider the server unavailable and the period of time the server will be considered unavailable. The default is 10s.
+	// Sets the number of maximum connections to the proxied server. Default value is zero, meaning there is no limit. The default is 0.
 	MaxConns *int `json:"maxConns,omitempty"`
-	// The health check configuration for the Upstream. Note: this feature is supported only in NGINX Plus.
+	// The health check configuration for the Upstream. Note: in NGINX, the health checks are performed by the Ingress Controller if the -enable-stream-health-probes command-line argument is set.
 	HealthCheck *TransportServerHealthCheckApplyConfiguration `json:"healthCheck,omitempty"`
 	// The method used to load balance the upstream servers. By default, connections are distributed between the servers using a weighted round-robin balancing method.
 	LoadBalancingMethod *string `json:"loadBalancingMethod,omitempty"`
+	// Pins the connections and datagrams of a client to the same upstream server. Accepted values are None and ClientIP. ClientIP uses the consistent hash load balancing method on the client address and cannot be used together with loadBalancingMethod. The default is None.
+	SessionAffinity *string `json:"sessionAffinity,omitempty"`
 	// The name of the backup service of type ExternalName. This will be used when the primary servers are unavailable. Note: The parameter cannot be used along with the random, hash or ip_hash load balancing methods.
 	Backup *string `json:"backup,omitempty"`
 	// The port of the backup service. The backup port is required if the backup service name is provided. The port must fall into the range 1..65535.
 	BackupPort *uint16 `json:"backupPort,omitempty"`
+	// The TLS configuration for connections to the upstream servers.
+	TLS *TransportServerUpstreamTLSApplyConfiguration `json:"tls,omitempty"`
+	// Enables sending the PROXY protocol header with the client address to the upstream servers. The default is false.
+	ProxyProtocol *bool `json:"proxyProtocol,omitempty"`
 }
 
 // TransportServerUpstreamApplyConfiguration constructs a declarative configuration of the TransportServerUpstream type for use with
@@ -99,6 +105,14 @@ func (b *TransportServerUpstreamApplyConfiguration) WithLoadBalancingMethod(valu
 	return b
 }
 
+// WithSessionAffinity sets the SessionAffinity field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SessionAffinity field is set to the value of the last call.
+func (b *TransportServerUpstreamApplyConfiguration) WithSessionAffinity(value string) *TransportServerUpstreamApplyConfiguration {
+	b.SessionAffinity = &value
+	return b
+}
+
 // WithBackup sets the Backup field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Backup field is set to the value of the last call.
@@ -114,3 +128,19 @@ func (b *TransportServerUpstreamApplyConfiguration) WithBackupPort(value uint16)
 	b.BackupPort = &value
 	return b
 }
+
+// WithTLS sets the TLS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TLS field is set to the value of the last call.
+func (b *TransportServerUpstreamApplyConfiguration) WithTLS(value *TransportServerUpstreamTLSApplyConfiguration) *TransportServerUpstreamApplyConfiguration {
+	b.TLS = value
+	return b
+}
+
+// WithProxyProtocol sets the ProxyProtocol field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ProxyProtocol field is set to the value of the last call.
+func (b *TransportServerUpstreamApplyConfiguration) WithProxyProtocol(value bool) *TransportServerUpstreamApplyConfiguration {
+	b.ProxyProtocol = &value
+	return b
+}
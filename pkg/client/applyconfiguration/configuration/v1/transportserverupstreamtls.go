@@ -0,0 +1,116 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// TransportServerUpstreamTLSApplyConfiguration represents a declarative configuration of the TransportServerUpstreamTLS type for use
+// with apply.
+//
+// TransportServerUpstreamTLS defines the TLS configuration for connections to the upstream servers of a TransportServer.
+type TransportServerUpstreamTLSApplyConfiguration struct {
+	// Enables TLS for connections to the upstream servers. The default is false.
+	Enable *bool `json:"enable,omitempty"`
+	// The name of the Kubernetes secret that stores the client TLS certificate and key presented to the upstream servers. It must be in the same namespace as the TransportServer resource. The secret must be of the type kubernetes.io/tls.
+	TLSSecret *string `json:"tlsSecret,omitempty"`
+	// Enables verification of the upstream server certificate.
+	VerifyServer *bool `json:"verifyServer,omitempty"`
+	// Sets the verification depth in the upstream server certificates chain. The default is 1.
+	VerifyDepth *int `json:"verifyDepth,omitempty"`
+	// Specifies the protocols for connections to the upstream servers, for example, TLSv1.2 TLSv1.3.
+	Protocols *string `json:"protocols,omitempty"`
+	// Enables reuse of SSL sessions to the upstream servers. The default is true.
+	SessionReuse *bool `json:"sessionReuse,omitempty"`
+	// Specifies the enabled ciphers for connections to the upstream servers.
+	Ciphers *string `json:"ciphers,omitempty"`
+	// The name of the Kubernetes secret that stores the CA certificate used to verify the upstream server certificate. It must be in the same namespace as the TransportServer resource. The secret must be of the type nginx.org/ca.
+	TrustedCertSecret *string `json:"trustedCertSecret,omitempty"`
+	// Enables passing of the server name through Server Name Indication extension.
+	ServerName *bool `json:"serverName,omitempty"`
+	// Sets the server name used to verify the certificate of the upstream server and passed through Server Name Indication extension.
+	SSLName *string `json:"sslName,omitempty"`
+}
+
+// TransportServerUpstreamTLSApplyConfiguration constructs a declarative configuration of the TransportServerUpstreamTLS type for use with
+// apply.
+func TransportServerUpstreamTLS() *TransportServerUpstreamTLSApplyConfiguration {
+	return &TransportServerUpstreamTLSApplyConfiguration{}
+}
+
+// WithEnable sets the Enable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Enable field is set to the value of the last call.
+func (b *TransportServerUpstreamTLSApplyConfiguration) WithEnable(value bool) *TransportServerUpstreamTLSApplyConfiguration {
+	b.Enable = &value
+	return b
+}
+
+// WithTLSSecret sets the TLSSecret field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TLSSecret field is set to the value of the last call.
+func (b *TransportServerUpstreamTLSApplyConfiguration) WithTLSSecret(value string) *TransportServerUpstreamTLSApplyConfiguration {
+	b.TLSSecret = &value
+	return b
+}
+
+// WithVerifyServer sets the VerifyServer field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VerifyServer field is set to the value of the last call.
+func (b *TransportServerUpstreamTLSApplyConfiguration) WithVerifyServer(value bool) *TransportServerUpstreamTLSApplyConfiguration {
+	b.VerifyServer = &value
+	return b
+}
+
+// WithVerifyDepth sets the VerifyDepth field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VerifyDepth field is set to the value of the last call.
+func (b *TransportServerUpstreamTLSApplyConfiguration) WithVerifyDepth(value int) *TransportServerUpstreamTLSApplyConfiguration {
+	b.VerifyDepth = &value
+	return b
+}
+
+// WithProtocols sets the Protocols field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Protocols field is set to the value of the last call.
+func (b *TransportServerUpstreamTLSApplyConfiguration) WithProtocols(value string) *TransportServerUpstreamTLSApplyConfiguration {
+	b.Protocols = &value
+	return b
+}
+
+// WithSessionReuse sets the SessionReuse field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SessionReuse field is set to the value of the last call.
+func (b *TransportServerUpstreamTLSApplyConfiguration) WithSessionReuse(value bool) *TransportServerUpstreamTLSApplyConfiguration {
+	b.SessionReuse = &value
+	return b
+}
+
+// WithCiphers sets the Ciphers field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Ciphers field is set to the value of the last call.
+func (b *TransportServerUpstreamTLSApplyConfiguration) WithCiphers(value string) *TransportServerUpstreamTLSApplyConfiguration {
+	b.Ciphers = &value
+	return b
+}
+
+// WithTrustedCertSecret sets the TrustedCertSecret field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TrustedCertSecret field is set to the value of the last call.
+func (b *TransportServerUpstreamTLSApplyConfiguration) WithTrustedCertSecret(value string) *TransportServerUpstreamTLSApplyConfiguration {
+	b.TrustedCertSecret = &value
+	return b
+}
+
+// WithServerName sets the ServerName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServerName field is set to the value of the last call.
+func (b *TransportServerUpstreamTLSApplyConfiguration) WithServerName(value bool) *TransportServerUpstreamTLSApplyConfiguration {
+	b.ServerName = &value
+	return b
+}
+
+// WithSSLName sets the SSLName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SSLName field is set to the value of the last call.
+func (b *TransportServerUpstreamTLSApplyConfiguration) WithSSLName(value string) *TransportServerUpstreamTLSApplyConfiguration {
+	b.SSLName = &value
+	return b
+}
@@ -61,6 +61,10 @@ type UpstreamApplyConfiguration struct {
 	Queue *UpstreamQueueApplyConfiguration `json:"queue,omitempty"`
 	// The SessionCookie field configures session persistence which allows requests from the same client to be passed to the same upstream server. The information about the designated upstream server is passed in a session cookie generated by NGINX.
 	SessionCookie *SessionCookieApplyConfiguration `json:"sessionCookie,omitempty"`
+	// The Affinity field configures session persistence based on consistent hashing of a request header, a cookie, a JWT claim or a route cookie generated by NGINX. Unlike sessionCookie, affinity is supported in both NGINX and NGINX Plus. Affinity cannot be used along with the lb-method or sessionCookie fields.
+	Affinity *UpstreamAffinityApplyConfiguration `json:"affinity,omitempty"`
+	// The CircuitBreaker field configures the ejection of upstream servers that return consecutive errors or respond slowly.
+	CircuitBreaker *CircuitBreakerApplyConfiguration `json:"circuitBreaker,omitempty"`
 	// Enables using the Cluster IP and port of the service instead of the default behavior of using the IP and port of the pods. When this field is enabled, the fields that configure NGINX behavior related to multiple upstream servers (like lb-method and next-upstream) will have no effect, as NGINX Ingress Controller will configure NGINX with only one upstream server that will match the service Cluster IP.
 	UseClusterIP *bool `json:"use-cluster-ip,omitempty"`
 	// Allows proxying requests with NTLM Authentication. In order for NTLM authentication to work, it is necessary to enable keepalive connections to upstream servers using the keepalive field. Note: this feature is supported only in NGINX Plus.
@@ -293,6 +297,22 @@ func (b *UpstreamApplyConfiguration) WithSessionCookie(value *SessionCookieApply
 	return b
 }
 
+// WithAffinity sets the Affinity field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Affinity field is set to the value of the last call.
+func (b *UpstreamApplyConfiguration) WithAffinity(value *UpstreamAffinityApplyConfiguration) *UpstreamApplyConfiguration {
+	b.Affinity = value
+	return b
+}
+
+// WithCircuitBreaker sets the CircuitBreaker field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CircuitBreaker field is set to the value of the last call.
+func (b *UpstreamApplyConfiguration) WithCircuitBreaker(value *CircuitBreakerApplyConfiguration) *UpstreamApplyConfiguration {
+	b.CircuitBreaker = value
+	return b
+}
+
 // WithUseClusterIP sets the UseClusterIP field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the UseClusterIP field is set to the value of the last call.
@@ -0,0 +1,67 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// UpstreamAffinityApplyConfiguration represents a declarative configuration of the UpstreamAffinity type for use
+// with apply.
+//
+// UpstreamAffinity defines session affinity for an upstream using the hash load balancing method.
+// Exactly one of header, cookie, jwtClaim or routeCookie must be specified.
+type UpstreamAffinityApplyConfiguration struct {
+	// The name of a request header. Requests with the same header value are passed to the same upstream server.
+	Header *string `json:"header,omitempty"`
+	// The name of a cookie. Requests with the same cookie value are passed to the same upstream server.
+	Cookie *string `json:"cookie,omitempty"`
+	// The JWT claim. Requests with the same claim value are passed to the same upstream server. Nested claims should be separated by ".". Requires a JWT policy. Note: this feature is supported only in NGINX Plus.
+	JWTClaim *string `json:"jwtClaim,omitempty"`
+	// Configures a route cookie generated by NGINX for clients that do not present one yet. The value of the cookie is used as the hash key.
+	RouteCookie *AffinityRouteCookieApplyConfiguration `json:"routeCookie,omitempty"`
+	// Enables the ketama consistent hashing method, which ensures that only a few keys will be remapped to different upstream servers when a server is added to or removed from the upstream. The default is true.
+	Consistent *bool `json:"consistent,omitempty"`
+}
+
+// UpstreamAffinityApplyConfiguration constructs a declarative configuration of the UpstreamAffinity type for use with
+// apply.
+func UpstreamAffinity() *UpstreamAffinityApplyConfiguration {
+	return &UpstreamAffinityApplyConfiguration{}
+}
+
+// WithHeader sets the Header field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Header field is set to the value of the last call.
+func (b *UpstreamAffinityApplyConfiguration) WithHeader(value string) *UpstreamAffinityApplyConfiguration {
+	b.Header = &value
+	return b
+}
+
+// WithCookie sets the Cookie field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Cookie field is set to the value of the last call.
+func (b *UpstreamAffinityApplyConfiguration) WithCookie(value string) *UpstreamAffinityApplyConfiguration {
+	b.Cookie = &value
+	return b
+}
+
+// WithJWTClaim sets the JWTClaim field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the JWTClaim field is set to the value of the last call.
+func (b *UpstreamAffinityApplyConfiguration) WithJWTClaim(value string) *UpstreamAffinityApplyConfiguration {
+	b.JWTClaim = &value
+	return b
+}
+
+// WithRouteCookie sets the RouteCookie field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RouteCookie field is set to the value of the last call.
+func (b *UpstreamAffinityApplyConfiguration) WithRouteCookie(value *AffinityRouteCookieApplyConfiguration) *UpstreamAffinityApplyConfiguration {
+	b.RouteCookie = value
+	return b
+}
+
+// WithConsistent sets the Consistent field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Consistent field is set to the value of the last call.
+func (b *UpstreamAffinityApplyConfiguration) WithConsistent(value bool) *UpstreamAffinityApplyConfiguration {
+	b.Consistent = &value
+	return b
+}
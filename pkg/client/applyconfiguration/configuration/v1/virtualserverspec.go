@@ -11,6 +11,8 @@ type VirtualServerSpecApplyConfiguration struct {
 	IngressClass *string `json:"ingressClassName,omitempty"`
 	// The host (domain name) of the server. Must be a valid subdomain as defined in RFC 1123, such as my-app or hello.example.com. When using a wildcard domain like *.example.com the domain must be contained in double quotes. The host value needs to be unique among all Ingress and VirtualServer resources.
 	Host *string `json:"host,omitempty"`
+	// A list of additional hosts (domain names) of the server, such as www.example.com. The aliases share the routes, upstreams and policies of the host. Every alias needs to be unique among all Ingress and VirtualServer resources.
+	ServerAliases []string `json:"serverAliases,omitempty"`
 	// Sets a custom HTTP and/or HTTPS listener. Valid fields are listener.http and listener.https. Each field must reference the name of a valid listener defined in a GlobalConfiguration resource
 	Listener *VirtualServerListenerApplyConfiguration `json:"listener,omitempty"`
 	// The TLS termination configuration.
@@ -33,6 +35,10 @@ type VirtualServerSpecApplyConfiguration struct {
 	ExternalDNS *ExternalDNSApplyConfiguration `json:"externalDNS,omitempty"`
 	// InternalRoute allows for the configuration of internal routing.
 	InternalRoute *bool `json:"internalRoute,omitempty"`
+	// The request ID configuration. Overrides the request-id ConfigMap keys.
+	RequestID *RequestIDApplyConfiguration `json:"requestID,omitempty"`
+	// The maintenance mode of the VirtualServer. When enabled, NGINX responds to all requests with the maintenance response.
+	Maintenance *MaintenanceApplyConfiguration `json:"maintenance,omitempty"`
 }
 
 // VirtualServerSpecApplyConfiguration constructs a declarative configuration of the VirtualServerSpec type for use with
@@ -57,6 +63,16 @@ func (b *VirtualServerSpecApplyConfiguration) WithHost(value string) *VirtualSer
 	return b
 }
 
+// WithServerAliases adds the given value to the ServerAliases field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ServerAliases field.
+func (b *VirtualServerSpecApplyConfiguration) WithServerAliases(values ...string) *VirtualServerSpecApplyConfiguration {
+	for i := range values {
+		b.ServerAliases = append(b.ServerAliases, values[i])
+	}
+	return b
+}
+
 // WithListener sets the Listener field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Listener field is set to the value of the last call.
@@ -159,3 +175,19 @@ func (b *VirtualServerSpecApplyConfiguration) WithInternalRoute(value bool) *Vir
 	b.InternalRoute = &value
 	return b
 }
+
+// WithRequestID sets the RequestID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RequestID field is set to the value of the last call.
+func (b *VirtualServerSpecApplyConfiguration) WithRequestID(value *RequestIDApplyConfiguration) *VirtualServerSpecApplyConfiguration {
+	b.RequestID = value
+	return b
+}
+
+// WithMaintenance sets the Maintenance field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Maintenance field is set to the value of the last call.
+func (b *VirtualServerSpecApplyConfiguration) WithMaintenance(value *MaintenanceApplyConfiguration) *VirtualServerSpecApplyConfiguration {
+	b.Maintenance = value
+	return b
+}
@@ -11,6 +11,8 @@ type VirtualServerStatusApplyConfiguration struct {
 	Reason            *string                              `json:"reason,omitempty"`
 	Message           *string                              `json:"message,omitempty"`
 	ExternalEndpoints []ExternalEndpointApplyConfiguration `json:"externalEndpoints,omitempty"`
+	// The upstream servers that are currently ejected by the circuit breaker.
+	EjectedPeers []EjectedPeerApplyConfiguration `json:"ejectedPeers,omitempty"`
 }
 
 // VirtualServerStatusApplyConfiguration constructs a declarative configuration of the VirtualServerStatus type for use with
@@ -55,3 +57,16 @@ func (b *VirtualServerStatusApplyConfiguration) WithExternalEndpoints(values ...
 	}
 	return b
 }
+
+// WithEjectedPeers adds the given value to the EjectedPeers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the EjectedPeers field.
+func (b *VirtualServerStatusApplyConfiguration) WithEjectedPeers(values ...*EjectedPeerApplyConfiguration) *VirtualServerStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithEjectedPeers")
+		}
+		b.EjectedPeers = append(b.EjectedPeers, *values[i])
+	}
+	return b
+}
@@ -56,6 +56,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &applyconfigurationconfigurationv1.ActionReturnApplyConfiguration{}
 	case configurationv1.SchemeGroupVersion.WithKind("AddHeader"):
 		return &applyconfigurationconfigurationv1.AddHeaderApplyConfiguration{}
+	case configurationv1.SchemeGroupVersion.WithKind("AffinityRouteCookie"):
+		return &applyconfigurationconfigurationv1.AffinityRouteCookieApplyConfiguration{}
 	case configurationv1.SchemeGroupVersion.WithKind("APIKey"):
 		return &applyconfigurationconfigurationv1.APIKeyApplyConfiguration{}
 	case configurationv1.SchemeGroupVersion.WithKind("BasicAuth"):
@@ -70,12 +72,18 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &applyconfigurationconfigurationv1.CacheManagerApplyConfiguration{}
 	case configurationv1.SchemeGroupVersion.WithKind("CertManager"):
 		return &applyconfigurationconfigurationv1.CertManagerApplyConfiguration{}
+	case configurationv1.SchemeGroupVersion.WithKind("CircuitBreaker"):
+		return &applyconfigurationconfigurationv1.CircuitBreakerApplyConfiguration{}
 	case configurationv1.SchemeGroupVersion.WithKind("Condition"):
 		return &applyconfigurationconfigurationv1.ConditionApplyConfiguration{}
+	case configurationv1.SchemeGroupVersion.WithKind("ConditionAlternative"):
+		return &applyconfigurationconfigurationv1.ConditionAlternativeApplyConfiguration{}
 	case configurationv1.SchemeGroupVersion.WithKind("CORS"):
 		return &applyconfigurationconfigurationv1.CORSApplyConfiguration{}
 	case configurationv1.SchemeGroupVersion.WithKind("EgressMTLS"):
 		return &applyconfigurationconfigurationv1.EgressMTLSApplyConfiguration{}
+	case configurationv1.SchemeGroupVersion.WithKind("EjectedPeer"):
+		return &applyconfigurationconfigurationv1.EjectedPeerApplyConfiguration{}
 	case configurationv1.SchemeGroupVersion.WithKind("ErrorPage"):
 		return &applyconfigurationconfigurationv1.ErrorPageApplyConfiguration{}
 	case configurationv1.SchemeGroupVersion.WithKind("ErrorPageRedirect"):
@@ -92,6 +100,12 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &applyconfigurationconfigurationv1.GlobalConfigurationSpecApplyConfiguration{}
 	case configurationv1.SchemeGroupVersion.WithKind("Header"):
 		return &applyconfigurationconfigurationv1.HeaderApplyConfiguration{}
+	case configurationv1.SchemeGroupVersion.WithKind("Headers"):
+		return &applyconfigurationconfigurationv1.HeadersApplyConfiguration{}
+	case configurationv1.SchemeGroupVersion.WithKind("HeadersRequest"):
+		return &applyconfigurationconfigurationv1.HeadersRequestApplyConfiguration{}
+	case configurationv1.SchemeGroupVersion.WithKind("HeadersResponse"):
+		return &applyconfigurationconfigurationv1.HeadersResponseApplyConfiguration{}
 	case configurationv1.SchemeGroupVersion.WithKind("HealthCheck"):
 		return &applyconfigurationconfigurationv1.HealthCheckApplyConfiguration{}
 	case configurationv1.SchemeGroupVersion.WithKind("IngressMTLS"):
@@ -102,6 +116,14 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &applyconfigurationconfigurationv1.JWTConditionApplyConfiguration{}
 	case configurationv1.SchemeGroupVersion.WithKind("Listener"):
 		return &applyconfigurationconfigurationv1.ListenerApplyConfiguration{}
+	case configurationv1.SchemeGroupVersion.WithKind("ListenerTLS"):
+		return &applyconfigurationconfigurationv1.ListenerTLSApplyConfiguration{}
+	case configurationv1.SchemeGroupVersion.WithKind("Maintenance"):
+		return &applyconfigurationconfigurationv1.MaintenanceApplyConfiguration{}
+	case configurationv1.SchemeGroupVersion.WithKind("MaintenanceBypass"):
+		return &applyconfigurationconfigurationv1.MaintenanceBypassApplyConfiguration{}
+	case configurationv1.SchemeGroupVersion.WithKind("MaintenanceConfigMap"):
+		return &applyconfigurationconfigurationv1.MaintenanceConfigMapApplyConfiguration{}
 	case configurationv1.SchemeGroupVersion.WithKind("Match"):
 		return &applyconfigurationconfigurationv1.MatchApplyConfiguration{}
 	case configurationv1.SchemeGroupVersion.WithKind("OIDC"):
@@ -118,12 +140,18 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &applyconfigurationconfigurationv1.ProviderSpecificPropertyApplyConfiguration{}
 	case configurationv1.SchemeGroupVersion.WithKind("ProxyRequestHeaders"):
 		return &applyconfigurationconfigurationv1.ProxyRequestHeadersApplyConfiguration{}
+	case configurationv1.SchemeGroupVersion.WithKind("ProxyResponseBody"):
+		return &applyconfigurationconfigurationv1.ProxyResponseBodyApplyConfiguration{}
 	case configurationv1.SchemeGroupVersion.WithKind("ProxyResponseHeaders"):
 		return &applyconfigurationconfigurationv1.ProxyResponseHeadersApplyConfiguration{}
 	case configurationv1.SchemeGroupVersion.WithKind("RateLimit"):
 		return &applyconfigurationconfigurationv1.RateLimitApplyConfiguration{}
 	case configurationv1.SchemeGroupVersion.WithKind("RateLimitCondition"):
 		return &applyconfigurationconfigurationv1.RateLimitConditionApplyConfiguration{}
+	case configurationv1.SchemeGroupVersion.WithKind("RequestID"):
+		return &applyconfigurationconfigurationv1.RequestIDApplyConfiguration{}
+	case configurationv1.SchemeGroupVersion.WithKind("ResponseBodyRewrite"):
+		return &applyconfigurationconfigurationv1.ResponseBodyRewriteApplyConfiguration{}
 	case configurationv1.SchemeGroupVersion.WithKind("Route"):
 		return &applyconfigurationconfigurationv1.RouteApplyConfiguration{}
 	case configurationv1.SchemeGroupVersion.WithKind("SecurityLog"):
@@ -142,8 +170,12 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &applyconfigurationconfigurationv1.TLSRedirectApplyConfiguration{}
 	case configurationv1.SchemeGroupVersion.WithKind("TransportServer"):
 		return &applyconfigurationconfigurationv1.TransportServerApplyConfiguration{}
+	case configurationv1.SchemeGroupVersion.WithKind("TransportServerAccessLog"):
+		return &applyconfigurationconfigurationv1.TransportServerAccessLogApplyConfiguration{}
 	case configurationv1.SchemeGroupVersion.WithKind("TransportServerAction"):
 		return &applyconfigurationconfigurationv1.TransportServerActionApplyConfiguration{}
+	case configurationv1.SchemeGroupVersion.WithKind("TransportServerActionMatch"):
+		return &applyconfigurationconfigurationv1.TransportServerActionMatchApplyConfiguration{}
 	case configurationv1.SchemeGroupVersion.WithKind("TransportServerHealthCheck"):
 		return &applyconfigurationconfigurationv1.TransportServerHealthCheckApplyConfiguration{}
 	case configurationv1.SchemeGroupVersion.WithKind("TransportServerListener"):
@@ -152,14 +184,20 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &applyconfigurationconfigurationv1.TransportServerMatchApplyConfiguration{}
 	case configurationv1.SchemeGroupVersion.WithKind("TransportServerSpec"):
 		return &applyconfigurationconfigurationv1.TransportServerSpecApplyConfiguration{}
+	case configurationv1.SchemeGroupVersion.WithKind("TransportServerSplit"):
+		return &applyconfigurationconfigurationv1.TransportServerSplitApplyConfiguration{}
 	case configurationv1.SchemeGroupVersion.WithKind("TransportServerStatus"):
 		return &applyconfigurationconfigurationv1.TransportServerStatusApplyConfiguration{}
 	case configurationv1.SchemeGroupVersion.WithKind("TransportServerTLS"):
 		return &applyconfigurationconfigurationv1.TransportServerTLSApplyConfiguration{}
 	case configurationv1.SchemeGroupVersion.WithKind("TransportServerUpstream"):
 		return &applyconfigurationconfigurationv1.TransportServerUpstreamApplyConfiguration{}
+	case configurationv1.SchemeGroupVersion.WithKind("TransportServerUpstreamTLS"):
+		return &applyconfigurationconfigurationv1.TransportServerUpstreamTLSApplyConfiguration{}
 	case configurationv1.SchemeGroupVersion.WithKind("Upstream"):
 		return &applyconfigurationconfigurationv1.UpstreamApplyConfiguration{}
+	case configurationv1.SchemeGroupVersion.WithKind("UpstreamAffinity"):
+		return &applyconfigurationconfigurationv1.UpstreamAffinityApplyConfiguration{}
 	case configurationv1.SchemeGroupVersion.WithKind("UpstreamBuffers"):
 		return &applyconfigurationconfigurationv1.UpstreamBuffersApplyConfiguration{}
 	case configurationv1.SchemeGroupVersion.WithKind("UpstreamParameters"):